@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/save"
+)
+
+// launch simule un lancement du jeu : lecture des préférences puis
+// introduction éventuelle
+func launch(t *testing.T, path string) (*core.EnhancedBuiltinStateManager, bool) {
+	t.Helper()
+
+	// Les phrases de l'introduction viennent des traductions ; sans elles,
+	// l'introduction se termine dès son lancement
+	config := &core.GameConfig{Language: "fr"}
+	config.Paths.DataDir = filepath.Join("..", "..", "assets", "data")
+	loadLocalization(config)
+
+	prefs, created, err := save.LoadUserPreferences(path)
+	if err != nil {
+		t.Fatalf("LoadUserPreferences: %v", err)
+	}
+	esm := core.NewEnhancedBuiltinStateManager(1280, 720)
+	setupIntro(esm, prefs, func() {
+		if err := prefs.Save(path); err != nil {
+			t.Errorf("Save: %v", err)
+		}
+	})
+	return esm, created
+}
+
+func TestIntroPlayedOnlyOnFirstRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs", "prefs.json")
+
+	tests := []struct {
+		name        string
+		wantCreated bool
+		wantIntro   bool
+	}{
+		{"premier lancement", true, true},
+		{"lancement suivant", false, false},
+		{"encore un lancement", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esm, created := launch(t, path)
+			if created != tt.wantCreated {
+				t.Errorf("fichier créé = %t, attendu %t", created, tt.wantCreated)
+			}
+			if _, err := os.Stat(path); err != nil {
+				t.Errorf("fichier des préférences absent: %v", err)
+			}
+
+			playing := esm.GetCurrentStateType() == core.StateIntro
+			if playing != tt.wantIntro {
+				t.Fatalf("introduction jouée = %t, attendu %t", playing, tt.wantIntro)
+			}
+			if playing {
+				esm.TogglePause() // ESC passe l'introduction
+			}
+			if esm.GetCurrentStateType() != core.StateMenu {
+				t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), core.StateMenu)
+			}
+		})
+	}
+}
+
+func TestReplayIntroResetsFlag(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prefs.json")
+	if err := (&save.UserPreferences{SeenIntro: true}).Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	esm, _ := launch(t, path)
+	if esm.GetCurrentStateType() == core.StateIntro {
+		t.Fatal("l'introduction déjà vue ne doit pas être jouée")
+	}
+	esm.OnReplayIntro() // Option « Revoir l'introduction »
+
+	if esm, _ = launch(t, path); esm.GetCurrentStateType() != core.StateIntro {
+		t.Errorf("état = %s, attendu %s après « Revoir l'introduction »", esm.GetCurrentStateType(), core.StateIntro)
+	}
+}
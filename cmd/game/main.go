@@ -2,20 +2,29 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"image"
 	"image/color"
 	"image/png"
 	"log"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
 	"zelda-souls-game/internal/assets"
+	"zelda-souls-game/internal/audio"
 	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/crafting"
 	"zelda-souls-game/internal/input"
+	"zelda-souls-game/internal/localization"
+	"zelda-souls-game/internal/network"
 	"zelda-souls-game/internal/rendering"
 	"zelda-souls-game/internal/save"
+	"zelda-souls-game/internal/skilltree"
+	"zelda-souls-game/internal/world"
 )
 
 // SpriteEbitenGame implémente l'interface ebiten.Game avec support des sprites
@@ -26,7 +35,10 @@ type SpriteEbitenGame struct {
 	inputWrapper         *input.FinalInputWrapper
 	enhancedStateManager *core.EnhancedBuiltinStateManager
 	spriteLoader         *assets.SpriteLoader
+	hotReload            *assets.HotReloadWatcher // nil hors mode debug
+	frameLimiter         *core.FrameLimiter       // Plafond des FPS sans VSync
 	frameCount           int
+	preloader            *assets.ResourcePreloader
 }
 
 // NewSpriteEbitenGame crée le jeu avec support des sprites
@@ -44,6 +56,25 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 	config.GameVersion = "0.3.0"
 	fmt.Printf("Configuration chargée: %s v%s\n", config.GameTitle, config.GameVersion)
 
+	// Préférences du joueur, communes à tous les slots
+	prefs, created, err := save.LoadUserPreferences(save.DefaultPreferencesPath)
+	if err != nil {
+		fmt.Printf("⚠ Préférences: %v\n", err)
+	} else if created {
+		fmt.Printf("✓ Préférences créées: %s\n", save.DefaultPreferencesPath)
+	} else {
+		config.Audio.MasterVolume = prefs.MasterVolume
+		fmt.Printf("✓ Préférences chargées (intro vue: %t, slot %d)\n", prefs.SeenIntro, prefs.LastSlot)
+	}
+	savePrefs := func() {
+		if err := prefs.Save(save.DefaultPreferencesPath); err != nil {
+			log.Printf("Sauvegarde des préférences impossible: %v", err)
+		}
+	}
+
+	// Textes de l'interface, avant la création des menus
+	loadLocalization(config)
+
 	// Créer le renderer
 	fmt.Println("Création du renderer...")
 	renderer, err := rendering.NewRenderer(config)
@@ -56,6 +87,8 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 	fmt.Println("Création des gestionnaires...")
 	assetManager := assets.NewAssetManager("assets")
 	saveManager := save.NewSaveManager("saves")
+	saveManager.SetGameVersion(config.GameVersion)
+	registerSaveMigrations(saveManager)
 	fmt.Println("✓ Gestionnaires créés")
 
 	// Créer le sprite loader - PREMIÈRE ÉTAPE
@@ -91,6 +124,9 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 	enhancedStateManager.SetSpriteLoader(spriteLoader)
 	fmt.Println("✓ SpriteLoader injecté en premier")
 
+	// Monde chargé plus bas, avant le lancement : les sauvegardes y accèdent
+	var gameWorld *world.World
+
 	// Configurer les callbacks du StateManager
 	fmt.Println("Configuration des callbacks...")
 	enhancedStateManager.SetCallbacks(
@@ -99,6 +135,7 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 		},
 		func() { // Charger partie
 			log.Println("Callback: Chargement de partie")
+			loadSaveSlot(saveManager, enhancedStateManager, gameWorld, prefs.LastSlot)
 		},
 		func() { // Quitter
 			log.Println("Callback: Fermeture du jeu")
@@ -106,6 +143,25 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 		},
 	)
 
+	// Les records des salles de défi sont sauvegardés dès qu'ils tombent
+	enhancedStateManager.GetChallengeSystem().OnNewBestTime = func(roomID string, elapsed time.Duration) {
+		if err := saveManager.SaveGame(prefs.LastSlot, buildSaveData(enhancedStateManager, gameWorld)); err != nil {
+			log.Printf("Sauvegarde du record %s impossible: %v", roomID, err)
+		}
+	}
+
+	// Se reposer à un feu de camp sauvegarde la partie
+	enhancedStateManager.SetOnRest(func(checkpoint core.BonfireCheckpoint) {
+		if err := saveManager.SaveGame(prefs.LastSlot, buildSaveData(enhancedStateManager, gameWorld)); err != nil {
+			log.Printf("Sauvegarde au feu de camp %s impossible: %v", checkpoint.Name, err)
+		}
+	})
+
+	// Sauvegarde demandée depuis le menu de pause
+	enhancedStateManager.SetOnSave(func() error {
+		return saveManager.SaveGame(prefs.LastSlot, buildSaveData(enhancedStateManager, gameWorld))
+	})
+
 	// Vérifier s'il y a des sauvegardes disponibles
 	hasSaves := false
 	if saveManager != nil {
@@ -135,8 +191,90 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 	camera := renderer.GetCamera()
 	enhancedStateManager.SetCamera(camera)
 	enhancedStateManager.SetInputManager(inputWrapper)
+	enhancedStateManager.SetMouseAim(config.Input.MouseEnabled && config.Input.MouseAim)
+	enhancedStateManager.SetMouseSensitivity(config.Input.MouseSensitivity)
+	enhancedStateManager.SetCameraZoomStep(config.Input.CameraZoomStep)
+	enhancedStateManager.OnMouseSensitivityChanged = func(sensitivity float64) {
+		config.Input.MouseSensitivity = sensitivity
+	}
 	fmt.Println("✓ Camera et InputManager injectés")
 
+	// Options de debug
+	enhancedStateManager.GetConsole().Enabled = config.Debug.ConsoleEnabled || config.Debug.EnableDebug
+	enhancedStateManager.GetFreeCamera().Enabled = config.Debug.EnableDebug
+	enhancedStateManager.GetPlayerSystem().SetGodMode(config.Debug.EnableGodMode)
+	enhancedStateManager.GetPlayerSystem().SetPerfectBlockWindow(
+		time.Duration(config.Gameplay.PerfectBlockWindow * float64(time.Second)))
+	enhancedStateManager.GetPlayerSystem().SetHealCharges(config.Gameplay.MaxHealCharges, config.Gameplay.HealAmount)
+	enhancedStateManager.GetPlayerSystem().SetMovementProfile(config.Gameplay.PlayerMovement.Profile())
+	enhancedStateManager.GetPlayerSystem().SetWeapons(config.Gameplay.WeaponCatalog())
+	if config.Gameplay.EquippedWeapon != "" {
+		enhancedStateManager.GetPlayerSystem().EquipWeapon(config.Gameplay.EquippedWeapon)
+	}
+	enhancedStateManager.GetEnemySystem().SetMovementProfile(config.Gameplay.EnemyMovement.Profile())
+
+	// Accessibilité : l'interface suit les options, le renderer applique palette, texte et caméra
+	enhancedStateManager.SetAccessibility(config.Accessibility)
+	enhancedStateManager.OnAccessibilityChanged = func(accessibility core.AccessibilityConfig) {
+		config.Accessibility = accessibility
+		renderer.ApplyAccessibility(accessibility)
+	}
+	enhancedStateManager.SetHUDTextOptions(config.Rendering.HUDText)
+	enhancedStateManager.SetParticles(config.Rendering.EnableParticles, config.Rendering.ParticleQuality)
+	enhancedStateManager.SetDayNight(config.Rendering.DayNight)
+	enhancedStateManager.SetShowPathfinding(config.Debug.ShowPathfinding)
+
+	// FPS cible : TPS d'Ebiten et plafond sans VSync, modifiables depuis les options
+	frameLimiter := core.NewFrameLimiter(config.FPSCap())
+	enhancedStateManager.SetTargetFPS(config.TargetFPS())
+	enhancedStateManager.OnTargetFPSChanged = func(fps int) {
+		config.Rendering.TargetFPS = fps
+		ebiten.SetTPS(fps)
+		frameLimiter.SetTarget(config.FPSCap())
+	}
+
+	// Classement en ligne (désactivé par défaut)
+	setupLeaderboard(config, enhancedStateManager)
+
+	// Carte de la zone de départ : tuiles solides (ligne de vue, recherche de
+	// chemin des ennemis) et entités placées au lancement d'une partie
+	gameWorld = loadWorld(config, assetManager, enhancedStateManager)
+	tileMap := gameWorld.GetTileMap()
+	enhancedStateManager.SetLineOfSight(tileMap)
+	enhancedStateManager.SetWalls(tileMap)
+	enhancedStateManager.SetDestructibleTiles(tileMap)
+	enhancedStateManager.SetMapBounds(tileMap)
+	enhancedStateManager.SetPathfinding(tileMap,
+		time.Duration(config.Gameplay.PathRecomputeInterval*float64(time.Second)),
+		config.Gameplay.PathMaxSearchNodes)
+	enhancedStateManager.SetEnemySeparation(config.Gameplay.EnemySeparationRadius, config.Gameplay.EnemySeparationWeight)
+	enhancedStateManager.SetCriticalMultiplier(config.Gameplay.CriticalMultiplier)
+	enhancedStateManager.SetSoulGainMultiplier(config.Gameplay.SoulGainMultiplier)
+	enhancedStateManager.SetExperienceMultiplier(config.Gameplay.ExperienceMultiplier)
+	enhancedStateManager.SetChainLightning(config.Gameplay.ChainLightningMaxBounces, config.Gameplay.ChainLightningRange)
+	enhancedStateManager.SetNPCChatter(
+		time.Duration(config.Gameplay.NPCChatterMinInterval*float64(time.Second)),
+		time.Duration(config.Gameplay.NPCChatterMaxInterval*float64(time.Second)),
+		time.Duration(config.Gameplay.NPCBubbleDuration*float64(time.Second)))
+
+	// Effets sonores (pas, coups critiques) : enregistrés dans la banque au chargement des sons
+	soundPool := setupSoundPool(config)
+	enhancedStateManager.SetFootsteps(tileMap, soundPool, config.Audio.FootstepInterval)
+	enhancedStateManager.SetStatusEffects(tileMap, gameWorld.GetPoisonFloors())
+	enhancedStateManager.SetSoundPlayer(soundPool)
+	loadEnemyArchetypes(config, enhancedStateManager)
+	loadRecipes(config, enhancedStateManager)
+	loadSkillTree(config, enhancedStateManager)
+	loadQuests(config, enhancedStateManager)
+	registerMacroCommands(enhancedStateManager.GetConsole(), inputWrapper)
+
+	// Voile de mort
+	enhancedStateManager.SetDeathFade(
+		time.Duration(config.Gameplay.DeathFadeDuration*float64(time.Second)),
+		config.Gameplay.DeathFadeTint,
+		config.Gameplay.DeathFadeTimeScale,
+	)
+
 	// VÉRIFICATION: S'assurer que le SpriteLoader est bien injecté
 	fmt.Println("\n=== VÉRIFICATION INJECTION SPRITELOADER ===")
 	playerSystem := enhancedStateManager.GetPlayerSystem()
@@ -150,6 +288,16 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 		fmt.Println("⚠ ERREUR: PlayerSystem non accessible")
 	}
 
+	// Rechargement à chaud des assets en développement
+	var hotReload *assets.HotReloadWatcher
+	if config.Debug.EnableDebug {
+		hotReload = setupHotReload(config, renderer, spriteLoader, enhancedStateManager)
+	}
+
+	preloader := setupPreloader(renderer, enhancedStateManager)
+
+	setupIntro(enhancedStateManager, prefs, savePrefs)
+
 	fmt.Println("=== INITIALISATION TERMINÉE ===")
 
 	return &SpriteEbitenGame{
@@ -159,10 +307,486 @@ func NewSpriteEbitenGame() (*SpriteEbitenGame, error) {
 		inputWrapper:         inputWrapper,
 		enhancedStateManager: enhancedStateManager,
 		spriteLoader:         spriteLoader,
+		hotReload:            hotReload,
+		preloader:            preloader,
+		frameLimiter:         frameLimiter,
 		frameCount:           0,
 	}, nil
 }
 
+// audioSettings expose la configuration audio du jeu au paquet audio
+type audioSettings struct {
+	config *core.GameConfig
+}
+
+// GetAudio convertit la configuration audio
+func (as audioSettings) GetAudio() audio.AudioConfig {
+	settings := as.config.GetAudio()
+	return audio.AudioConfig{
+		MasterVolume: settings.MasterVolume,
+		MusicVolume:  settings.MusicVolume,
+		SFXVolume:    settings.SFXVolume,
+		VoiceVolume:  settings.VoiceVolume,
+		EnableAudio:  settings.EnableAudio,
+		SampleRate:   settings.SampleRate,
+		BufferSize:   settings.BufferSize,
+		MaxSounds:    settings.MaxSounds,
+	}
+}
+
+// setupSoundPool crée la banque d'effets sonores, positionnés par l'AudioManager
+func setupSoundPool(config *core.GameConfig) *audio.SoundPool {
+	manager, err := audio.NewAudioManager(audioSettings{config: config})
+	if err != nil {
+		fmt.Printf("⚠ Audio positionnel indisponible: %v\n", err)
+		return audio.NewSoundPool(nil)
+	}
+	return audio.NewSoundPool(manager)
+}
+
+// registerMacroCommands expose l'enregistrement/rejeu de macros dans la console
+func registerMacroCommands(console *core.DebugConsole, inputWrapper *input.FinalInputWrapper) {
+	inputWrapper.SetMacroPauseCondition(console.IsOpen)
+
+	console.RegisterCommand("macro", "macro record start|stop, macro play", func(args []string) string {
+		switch {
+		case len(args) == 2 && args[0] == "record" && args[1] == "start":
+			inputWrapper.StartMacroRecording()
+			return "Enregistrement démarré"
+		case len(args) == 2 && args[0] == "record" && args[1] == "stop":
+			if !inputWrapper.IsRecordingMacro() {
+				return "Aucun enregistrement en cours"
+			}
+			macro := inputWrapper.StopMacroRecording()
+			return fmt.Sprintf("Macro enregistrée: %d frames", macro.Frames)
+		case len(args) == 1 && args[0] == "play":
+			if !inputWrapper.PlayLastMacro() {
+				return "Aucune macro enregistrée"
+			}
+			return "Rejeu de la macro"
+		default:
+			return "Usage: macro record start|stop, macro play"
+		}
+	})
+}
+
+// loadLocalization charge la table de chaînes de la langue configurée
+func loadLocalization(config *core.GameConfig) {
+	dataDir := config.Paths.DataDir
+	if dataDir == "" {
+		dataDir = "assets/data"
+	}
+
+	localizer := localization.Default()
+	localizer.SetDirectory(filepath.Join(dataDir, "strings"))
+	if err := localizer.SetLanguage(config.Language); err != nil {
+		log.Printf("Traductions indisponibles, affichage des clés: %v", err)
+	}
+}
+
+// registerSaveMigrations enregistre les migrations des sauvegardes d'anciennes versions
+func registerSaveMigrations(saveManager *save.SaveManager) {
+	// 0.3.0 : les records des salles de défi font partie de la sauvegarde
+	saveManager.SaveMigrationFn["0.2.0->0.3.0"] = func(full *save.FullSaveData) error {
+		if full.Data.ChallengeBestTimes == nil {
+			full.Data.ChallengeBestTimes = make(map[string]time.Duration)
+		}
+		return nil
+	}
+}
+
+// buildSaveData rassemble l'état à sauvegarder : records, dernier feu de camp,
+// âmes, panneaux lus et chunks modifiés du monde
+func buildSaveData(esm *core.EnhancedBuiltinStateManager, gameWorld *world.World) *save.SaveData {
+	saveData := &save.SaveData{
+		SaveTime:           time.Now(),
+		ChallengeBestTimes: esm.GetStatTracker().ChallengeBestTimes(),
+		Souls:              esm.GetSouls(),
+		EquippedWeapon:     esm.GetPlayerSystem().GetWeapon().ID,
+		ReadSigns:          esm.GetReadSigns(),
+	}
+	if stain := esm.GetBloodstain(); stain != nil {
+		saveData.Bloodstain = &save.BloodstainData{X: stain.Position.X, Y: stain.Position.Y, Souls: stain.Souls}
+	}
+	if checkpoint, ok := esm.GetLastBonfire(); ok {
+		saveData.LastBonfire = &save.BonfireData{
+			Name: checkpoint.Name,
+			X:    checkpoint.Position.X,
+			Y:    checkpoint.Position.Y,
+		}
+	}
+	if progression, ok := esm.GetProgression(); ok {
+		saveData.Progression = &save.ProgressionData{
+			Level:            progression.Level,
+			Experience:       progression.Experience,
+			ExperienceToNext: progression.ExperienceToNext,
+			SkillPoints:      progression.SkillPoints,
+			UnlockedSkills:   progression.UnlockedSkills,
+		}
+	}
+	if graph := esm.GetRoomGraph(); graph != nil {
+		saveData.RoomGraph = roomGraphToSave(graph)
+	}
+	if gameWorld != nil {
+		worldData, err := gameWorld.CreateSaveData()
+		if err != nil {
+			log.Printf("Sauvegarde du monde impossible: %v", err)
+		} else {
+			saveData.WorldData = worldData
+		}
+	}
+	return saveData
+}
+
+// roomGraphToSave convertit le plan du donjon pour la sauvegarde
+func roomGraphToSave(graph *core.RoomGraph) *save.RoomGraphData {
+	data := &save.RoomGraphData{Current: graph.Current}
+	for _, node := range graph.SortedNodes() {
+		data.Nodes = append(data.Nodes, save.RoomNodeData{
+			ID:      node.ID,
+			X:       node.GridX,
+			Y:       node.GridY,
+			Type:    string(node.Type),
+			Visited: node.Visited,
+		})
+	}
+	for _, edge := range graph.Edges {
+		data.Edges = append(data.Edges, save.RoomEdgeData{From: edge.From, To: edge.To, Direction: edge.Direction.Compass()})
+	}
+	return data
+}
+
+// roomGraphFromSave reconstruit le plan du donjon d'une sauvegarde
+func roomGraphFromSave(data *save.RoomGraphData) (*core.RoomGraph, error) {
+	graph := core.NewRoomGraph()
+	for _, node := range data.Nodes {
+		err := graph.AddNode(core.RoomNode{
+			ID:      node.ID,
+			GridX:   node.X,
+			GridY:   node.Y,
+			Type:    core.RoomType(node.Type),
+			Visited: node.Visited,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, edge := range data.Edges {
+		direction, ok := core.ParseCompass(edge.Direction)
+		if !ok {
+			return nil, fmt.Errorf("direction de passage invalide: %q", edge.Direction)
+		}
+		if err := graph.AddEdge(edge.From, edge.To, direction); err != nil {
+			return nil, err
+		}
+	}
+	graph.Current = data.Current
+	return graph, nil
+}
+
+// loadSaveSlot charge un slot ; une sauvegarde d'une autre version majeure n'est
+// chargée qu'après confirmation, une version mineure différente est signalée
+func loadSaveSlot(saveManager *save.SaveManager, esm *core.EnhancedBuiltinStateManager, gameWorld *world.World, slotID int) {
+	localizer := localization.Default()
+
+	full, err := saveManager.ReadSave(slotID)
+	if err != nil {
+		log.Printf("Chargement impossible: %v", err)
+		esm.ShowDialog(localizer.Get("ui.save.load_failed", err), nil)
+		return
+	}
+
+	apply := func() bool {
+		data, err := saveManager.LoadGame(slotID)
+		if err != nil {
+			log.Printf("Chargement impossible: %v", err)
+			esm.ShowDialog(localizer.Get("ui.save.load_failed", err), nil)
+			return false
+		}
+		if saveData, ok := data.(*save.SaveData); ok {
+			if gameWorld != nil {
+				if err := gameWorld.LoadFromSave(saveData); err != nil {
+					log.Printf("Restauration du monde impossible: %v", err)
+				}
+			}
+			esm.GetStatTracker().LoadChallengeBestTimes(saveData.ChallengeBestTimes)
+			if bonfire := saveData.LastBonfire; bonfire != nil {
+				esm.ResumeAtBonfire(core.BonfireCheckpoint{
+					Name:     bonfire.Name,
+					Position: core.Vector2{X: bonfire.X, Y: bonfire.Y},
+				})
+			} else {
+				// Pas encore de feu de camp : la partie reprend au départ, avec
+				// la progression de la sauvegarde restaurée ci-dessous
+				esm.ResumeAtStart()
+			}
+			esm.RestoreSouls(saveData.Souls)
+			esm.RestoreReadSigns(saveData.ReadSigns)
+			if saveData.RoomGraph != nil {
+				if graph, err := roomGraphFromSave(saveData.RoomGraph); err != nil {
+					log.Printf("Plan du donjon de la sauvegarde invalide: %v", err)
+				} else {
+					esm.RestoreRoomGraph(graph)
+				}
+			}
+			if progression := saveData.Progression; progression != nil {
+				esm.RestoreProgression(core.Progression{
+					Level:            progression.Level,
+					Experience:       progression.Experience,
+					ExperienceToNext: progression.ExperienceToNext,
+					SkillPoints:      progression.SkillPoints,
+					UnlockedSkills:   progression.UnlockedSkills,
+				})
+			}
+			if saveData.EquippedWeapon != "" {
+				esm.GetPlayerSystem().EquipWeapon(saveData.EquippedWeapon)
+			}
+			if stain := saveData.Bloodstain; stain != nil {
+				esm.RestoreBloodstain(core.Vector2{X: stain.X, Y: stain.Y}, stain.Souls)
+			}
+		}
+		return true
+	}
+
+	saveVersion := full.Metadata.GameVersion
+	switch save.CompareVersions(saveVersion, saveManager.GetGameVersion()) {
+	case save.VersionIncompatible:
+		esm.ShowDialog(localizer.Get("ui.save.version_warning", saveVersion), func() { apply() })
+	case save.VersionMigrated:
+		if apply() {
+			esm.ShowDialog(localizer.Get("ui.save.migrated", saveVersion, saveManager.GetGameVersion()), nil)
+		}
+	default:
+		apply()
+	}
+}
+
+// loadEnemyArchetypes charge les ennemis placés au lancement d'une partie
+func loadEnemyArchetypes(config *core.GameConfig, esm *core.EnhancedBuiltinStateManager) {
+	dataDir := config.Paths.DataDir
+	if dataDir == "" {
+		dataDir = "assets/data"
+	}
+
+	archetypes, err := core.LoadEnemyArchetypes(filepath.Join(dataDir, core.DefaultEnemyArchetypesFile))
+	if err != nil {
+		log.Printf("Archétypes d'ennemis indisponibles: %v", err)
+		return
+	}
+	esm.SetEnemyArchetypes(archetypes)
+	fmt.Printf("✓ %d archétype(s) d'ennemi chargé(s)\n", len(archetypes))
+}
+
+// loadRecipes charge les recettes de fabrication ; sans elles, le panneau reste indisponible
+func loadRecipes(config *core.GameConfig, esm *core.EnhancedBuiltinStateManager) {
+	dataDir := config.Paths.DataDir
+	if dataDir == "" {
+		dataDir = "assets/data"
+	}
+
+	recipes, err := crafting.LoadRecipes(filepath.Join(dataDir, crafting.DefaultRecipesFile))
+	if err != nil {
+		log.Printf("Recettes de fabrication indisponibles: %v", err)
+		return
+	}
+	esm.SetRecipes(recipes)
+	fmt.Printf("✓ %d recette(s) de fabrication chargée(s)\n", len(recipes.GetRecipes()))
+}
+
+// loadSkillTree charge l'arbre de compétences ; sans lui, le panneau reste indisponible
+func loadSkillTree(config *core.GameConfig, esm *core.EnhancedBuiltinStateManager) {
+	dataDir := config.Paths.DataDir
+	if dataDir == "" {
+		dataDir = "assets/data"
+	}
+
+	tree, err := skilltree.LoadSkillTree(filepath.Join(dataDir, skilltree.DefaultSkillsFile))
+	if err != nil {
+		log.Printf("Arbre de compétences indisponible: %v", err)
+		return
+	}
+	esm.SetSkillTree(tree)
+	fmt.Printf("✓ %d compétence(s) chargée(s)\n", len(tree.GetNodes()))
+}
+
+// loadQuests charge les quêtes ; sans elles, la mini-carte n'affiche aucun objectif
+func loadQuests(config *core.GameConfig, esm *core.EnhancedBuiltinStateManager) {
+	dataDir := config.Paths.DataDir
+	if dataDir == "" {
+		dataDir = "assets/data"
+	}
+
+	quests, err := core.LoadQuests(filepath.Join(dataDir, core.DefaultQuestsFile))
+	if err != nil {
+		log.Printf("Quêtes indisponibles: %v", err)
+		return
+	}
+	esm.SetQuests(quests)
+	fmt.Printf("✓ %d quête(s) chargée(s)\n", len(quests))
+}
+
+// leaderboardRequestTimeout durée maximale d'un échange avec le classement,
+// nouvelles tentatives comprises
+const leaderboardRequestTimeout = 30 * time.Second
+
+// setupLeaderboard envoie le bilan de chaque partie au classement et donne au
+// menu la lecture des meilleurs scores, si le classement est configuré
+func setupLeaderboard(config *core.GameConfig, esm *core.EnhancedBuiltinStateManager) {
+	settings := config.Leaderboard
+	if !settings.Enabled || settings.URL == "" {
+		return
+	}
+
+	client := network.NewLeaderboardClient(settings.URL, settings.APIKey,
+		time.Duration(settings.Timeout*float64(time.Second)))
+	topCount := settings.TopCount
+	if topCount <= 0 {
+		topCount = 10
+	}
+
+	// L'envoi se fait en arrière-plan : l'écran de mort ne l'attend pas
+	esm.OnRunEnded = func(summary core.RunSummary) {
+		entry := network.ScoreEntry{
+			PlayerName:    settings.PlayerName,
+			Score:         summary.Score(),
+			Difficulty:    config.Gameplay.Difficulty,
+			PlayTime:      summary.PlayTime.Seconds(),
+			EnemiesKilled: summary.EnemiesKilled,
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), leaderboardRequestTimeout)
+			defer cancel()
+			if err := client.PostScore(ctx, entry); err != nil {
+				log.Printf("⚠ Score non envoyé au classement: %v", err)
+				return
+			}
+			fmt.Printf("✓ Score %d envoyé au classement\n", entry.Score)
+		}()
+	}
+
+	esm.SetLeaderboardFetcher(func() ([]core.LeaderboardRow, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), leaderboardRequestTimeout)
+		defer cancel()
+		entries, err := client.FetchTopScores(ctx, topCount)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]core.LeaderboardRow, len(entries))
+		for i, entry := range entries {
+			rows[i] = core.LeaderboardRow{
+				Name:          entry.PlayerName,
+				Score:         entry.Score,
+				Difficulty:    entry.Difficulty,
+				PlayTime:      entry.PlayDuration(),
+				EnemiesKilled: entry.EnemiesKilled,
+			}
+		}
+		return rows, nil
+	})
+	fmt.Printf("✓ Classement en ligne: %s\n", settings.URL)
+}
+
+// loadWorld crée le monde et charge la carte de départ ; sans carte, la partie
+// utilise le placement par défaut des ennemis et objets
+func loadWorld(config *core.GameConfig, assetManager *assets.AssetManager, esm *core.EnhancedBuiltinStateManager) *world.World {
+	dataDir := config.Paths.DataDir
+	if dataDir == "" {
+		dataDir = "assets/data"
+	}
+
+	gameWorld, err := world.NewWorld(config, assetManager)
+	if err != nil {
+		log.Fatalf("Erreur création du monde: %v", err)
+	}
+	if err := gameWorld.LoadMap(filepath.Join(dataDir, world.DefaultMapFile)); err != nil {
+		log.Printf("Carte indisponible: %v", err)
+		return gameWorld
+	}
+	esm.SetLevelSpawns(gameWorld.GetSpawns())
+	esm.SetDensitySpawns(gameWorld.GetTileMap(), gameWorld.GetSpawnTable(),
+		gameWorld.GetDensitySpawnCount(), config.Gameplay.MinSpawnSeparation)
+	if room, ok := gameWorld.GetTreasureRoom(); ok {
+		esm.SetTreasureRoom(room)
+	}
+	esm.SetRopes(gameWorld.GetRopes())
+	esm.SetNPCs(gameWorld.GetNPCs())
+	return gameWorld
+}
+
+// setupHotReload branche le watcher d'assets sur le renderer, le joueur et la config
+func setupHotReload(config *core.GameConfig, renderer *rendering.Renderer, spriteLoader *assets.SpriteLoader, esm *core.EnhancedBuiltinStateManager) *assets.HotReloadWatcher {
+	configPath := "configs/game_config.yaml"
+	watcher, err := assets.NewHotReloadWatcher(spriteLoader, "assets", configPath)
+	if err != nil {
+		log.Printf("Hot reload désactivé: %v", err)
+		return nil
+	}
+
+	watcher.OnTextureChanged = renderer.ReloadTexture
+	watcher.OnPlayerSpritesReloaded = func(sprites *assets.PlayerSpriteSet) {
+		if player := esm.GetPlayerSystem().GetPlayer(); player != nil {
+			player.SetPlayerSprites(sprites)
+		}
+	}
+	watcher.OnConfigChanged = func(path string) error {
+		newConfig, err := core.LoadConfig(path)
+		if err != nil {
+			return err
+		}
+
+		applied := config.ApplyHotReload(newConfig)
+		renderer.RefreshDebugFlags()
+		for _, field := range applied {
+			if field == "debug" {
+				esm.GetPlayerSystem().SetGodMode(config.Debug.EnableGodMode)
+			}
+		}
+		fmt.Printf("Champs de configuration appliqués: %v\n", applied)
+		return nil
+	}
+
+	return watcher
+}
+
+// setupPreloader précharge la salle derrière un portail dès que le joueur en
+// approche ; les textures arrivent dans le cache du renderer et le passage du
+// portail attend la fin du préchargement
+func setupPreloader(renderer *rendering.Renderer, esm *core.EnhancedBuiltinStateManager) *assets.ResourcePreloader {
+	preloader := assets.NewResourcePreloader(nil)
+	preloader.OnTexture = func(path string, img image.Image) {
+		renderer.StoreTexture(path, img)
+	}
+	esm.OnPortalApproach = func(roomID string) {
+		if err := preloader.BeginPreload(roomID); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}
+	// Le fondu d'un portail attend la fin du préchargement de sa salle ; une
+	// salle dont le préchargement n'a pas pu démarrer ne le retient pas
+	esm.RoomReady = func(roomID string) bool {
+		return preloader.RoomID() != roomID || preloader.IsComplete(roomID)
+	}
+	return preloader
+}
+
+// setupIntro joue l'introduction au premier lancement ; elle est rejouable
+// depuis les options. savePrefs enregistre le drapeau SeenIntro.
+func setupIntro(esm *core.EnhancedBuiltinStateManager, prefs *save.UserPreferences, savePrefs func()) {
+	esm.OnIntroFinished = func() {
+		prefs.SeenIntro = true
+		savePrefs()
+	}
+	esm.OnReplayIntro = func() {
+		prefs.SeenIntro = false
+		savePrefs()
+	}
+	if prefs.SeenIntro {
+		fmt.Println("✓ Introduction déjà vue, ouverture du menu principal")
+	} else {
+		esm.PlayIntro()
+	}
+}
+
 // Update implémente ebiten.Game.Update
 func (seg *SpriteEbitenGame) Update() error {
 	seg.frameCount++
@@ -193,11 +817,17 @@ func (seg *SpriteEbitenGame) Update() error {
 		fmt.Println("=== FIN DEBUG SPRITES ===")
 	}
 
+	if seg.hotReload != nil {
+		seg.hotReload.Update()
+	}
+	seg.preloader.Update()
+
 	return seg.coreGame.Update()
 }
 
 // Draw implémente ebiten.Game.Draw
 func (seg *SpriteEbitenGame) Draw(screen *ebiten.Image) {
+	seg.frameLimiter.Wait()
 	seg.coreGame.Render(screen)
 }
 
@@ -236,10 +866,16 @@ func main() {
 	ebiten.SetWindowSize(config.WindowWidth(), config.WindowHeight())
 	ebiten.SetWindowTitle(config.GameTitle)
 	ebiten.SetVsyncEnabled(config.Window.VSync)
+	ebiten.SetTPS(config.TargetFPS())
 
 	fmt.Printf("✓ Fenêtre configurée: %dx%d\n", config.WindowWidth(), config.WindowHeight())
 	fmt.Printf("✓ Titre: %s\n", config.GameTitle)
 	fmt.Printf("✓ VSync: %t\n", config.Window.VSync)
+	if fpsCap := config.FPSCap(); fpsCap > 0 {
+		fmt.Printf("✓ TPS: %d, FPS plafonnés à %d\n", config.TargetFPS(), fpsCap)
+	} else {
+		fmt.Printf("✓ TPS: %d\n", config.TargetFPS())
+	}
 
 	// Afficher les informations du jeu
 	displayGameInfo(config)
@@ -256,6 +892,10 @@ func main() {
 
 	// Cleanup à la fin
 	fmt.Println("\n=== NETTOYAGE ===")
+	if game.hotReload != nil {
+		game.hotReload.Close()
+	}
+	game.preloader.Close()
 	if err := game.coreGame.Cleanup(); err != nil {
 		log.Printf("Erreur cleanup: %v", err)
 	}
@@ -407,7 +1047,7 @@ func displayGameInfo(config *core.GameConfig) {
 	fmt.Println("• ESPACE - Attaque")
 	fmt.Println("• C - Roulade (coûte 25 stamina)")
 	fmt.Println("• E - Interaction")
-	fmt.Println("• ESC - Retour au menu/Pause")
+	fmt.Println("• ESC - Pause")
 	fmt.Println("• I - Toggle instructions")
 
 	fmt.Println("\n=== FONCTIONNALITÉS ===")
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/save"
+)
+
+func TestRoomGraphSaveRoundTrip(t *testing.T) {
+	graph := core.NewRoomGraph()
+	for _, node := range []core.RoomNode{
+		{ID: "entree", GridX: 0, GridY: 0, Type: core.RoomSafe},
+		{ID: "crypte", GridX: 0, GridY: -1, Type: core.RoomCombat},
+		{ID: "tresor", GridX: -1, GridY: -1, Type: core.RoomTreasure},
+		{ID: "boss", GridX: 1, GridY: 0, Type: core.RoomBoss},
+	} {
+		if err := graph.AddNode(node); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, edge := range []core.RoomEdge{
+		{From: "entree", To: "crypte", Direction: core.DirectionUp},
+		{From: "crypte", To: "tresor", Direction: core.DirectionLeft},
+		{From: "entree", To: "boss", Direction: core.DirectionRight},
+	} {
+		if err := graph.AddEdge(edge.From, edge.To, edge.Direction); err != nil {
+			t.Fatal(err)
+		}
+	}
+	graph.Visit("entree")
+	graph.Visit("crypte")
+
+	// Passage par le JSON du fichier de sauvegarde
+	data, err := json.Marshal(save.SaveData{RoomGraph: roomGraphToSave(graph)})
+	if err != nil {
+		t.Fatalf("encodage: %v", err)
+	}
+	var loaded save.SaveData
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("décodage: %v", err)
+	}
+
+	restored, err := roomGraphFromSave(loaded.RoomGraph)
+	if err != nil {
+		t.Fatalf("roomGraphFromSave: %v", err)
+	}
+	if !reflect.DeepEqual(restored, graph) {
+		t.Errorf("plan restauré = %+v, attendu %+v", restored, graph)
+	}
+}
+
+func TestRoomGraphFromInvalidSave(t *testing.T) {
+	nodes := []save.RoomNodeData{{ID: "entree"}, {ID: "crypte"}}
+
+	tests := []struct {
+		name string
+		data save.RoomGraphData
+	}{
+		{"passage vers une salle inconnue", save.RoomGraphData{
+			Nodes: nodes,
+			Edges: []save.RoomEdgeData{{From: "entree", To: "grenier", Direction: "N"}},
+		}},
+		{"direction invalide", save.RoomGraphData{
+			Nodes: nodes,
+			Edges: []save.RoomEdgeData{{From: "entree", To: "crypte", Direction: "NE"}},
+		}},
+		{"salle en double", save.RoomGraphData{
+			Nodes: append(nodes, save.RoomNodeData{ID: "crypte"}),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := roomGraphFromSave(&tt.data); err == nil {
+				t.Error("roomGraphFromSave doit échouer")
+			}
+		})
+	}
+}
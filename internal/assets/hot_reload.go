@@ -0,0 +1,229 @@
+// internal/assets/hot_reload.go - Rechargement à chaud des assets (développement)
+package assets
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// HotReloadWatcher surveille les textures et la configuration et recharge
+// les fichiers modifiés. Les événements arrivent sur une goroutine mais sont
+// appliqués dans Update, depuis la boucle de jeu, pour rester sur le thread
+// de rendu.
+type HotReloadWatcher struct {
+	watcher      *fsnotify.Watcher
+	spriteLoader *SpriteLoader
+
+	assetsDir   string
+	texturesDir string
+	configPath  string
+
+	// Callbacks appliquant les rechargements
+	OnPlayerSpritesReloaded func(sprites *PlayerSpriteSet)
+	OnTextureChanged        func(id, path string) error
+	OnConfigChanged         func(path string) error
+
+	// Fichiers modifiés en attente (dédoublonnés)
+	mutex   sync.Mutex
+	pending map[string]bool
+	done    chan struct{}
+}
+
+// NewHotReloadWatcher crée un watcher sur assets/textures et le fichier de configuration
+func NewHotReloadWatcher(spriteLoader *SpriteLoader, assetsDir, configPath string) (*HotReloadWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("impossible de créer le watcher: %v", err)
+	}
+
+	hr := &HotReloadWatcher{
+		watcher:      watcher,
+		spriteLoader: spriteLoader,
+		assetsDir:    assetsDir,
+		texturesDir:  filepath.Clean(filepath.Join(assetsDir, "textures")),
+		configPath:   filepath.Clean(configPath),
+		pending:      make(map[string]bool),
+		done:         make(chan struct{}),
+	}
+
+	// fsnotify n'est pas récursif : surveiller chaque sous-dossier des textures
+	err = filepath.WalkDir(hr.texturesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+	if err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("impossible de surveiller %s: %v", hr.texturesDir, err)
+	}
+
+	// Surveiller le dossier de la config : les éditeurs remplacent souvent le fichier
+	if err := watcher.Add(filepath.Dir(hr.configPath)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("impossible de surveiller %s: %v", hr.configPath, err)
+	}
+
+	go hr.watchLoop()
+
+	fmt.Printf("✓ Hot reload actif sur %s et %s\n", hr.texturesDir, hr.configPath)
+	return hr, nil
+}
+
+// watchLoop collecte les événements du système de fichiers
+func (hr *HotReloadWatcher) watchLoop() {
+	for {
+		select {
+		case event, ok := <-hr.watcher.Events:
+			if !ok {
+				return
+			}
+			hr.handleEvent(event)
+		case err, ok := <-hr.watcher.Errors:
+			if !ok {
+				return
+			}
+			fmt.Printf("⚠ Erreur hot reload: %v\n", err)
+		case <-hr.done:
+			return
+		}
+	}
+}
+
+// handleEvent met en attente les fichiers pertinents
+func (hr *HotReloadWatcher) handleEvent(event fsnotify.Event) {
+	if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+		return
+	}
+
+	path := filepath.Clean(event.Name)
+
+	// Nouveau sous-dossier de textures
+	if event.Has(fsnotify.Create) && hr.isTexturePath(path) && filepath.Ext(path) == "" {
+		hr.watcher.Add(path)
+		return
+	}
+
+	if path != hr.configPath && !hr.isTexturePNG(path) {
+		return
+	}
+
+	hr.mutex.Lock()
+	hr.pending[path] = true
+	hr.mutex.Unlock()
+}
+
+// Update applique les rechargements en attente (à appeler depuis la boucle de jeu)
+func (hr *HotReloadWatcher) Update() {
+	hr.mutex.Lock()
+	if len(hr.pending) == 0 {
+		hr.mutex.Unlock()
+		return
+	}
+	paths := make([]string, 0, len(hr.pending))
+	for path := range hr.pending {
+		paths = append(paths, path)
+	}
+	hr.pending = make(map[string]bool)
+	hr.mutex.Unlock()
+
+	playerChanged := false
+	for _, path := range paths {
+		if path == hr.configPath {
+			hr.reloadConfig(path)
+			continue
+		}
+
+		hr.spriteLoader.InvalidateImage(path)
+		if hr.isPlayerTexture(path) {
+			playerChanged = true
+		} else {
+			hr.reloadTexture(path)
+		}
+	}
+
+	// Un seul rechargement des sprites joueur même si plusieurs fichiers ont changé
+	if playerChanged {
+		hr.reloadPlayerSprites()
+	}
+}
+
+// reloadTexture recharge une texture générique
+func (hr *HotReloadWatcher) reloadTexture(path string) {
+	if hr.OnTextureChanged == nil {
+		return
+	}
+
+	id := hr.textureID(path)
+	if err := hr.OnTextureChanged(id, path); err != nil {
+		fmt.Printf("⚠ Échec du rechargement de la texture %s: %v\n", id, err)
+		return
+	}
+	fmt.Printf("✓ Texture rechargée: %s\n", id)
+}
+
+// reloadPlayerSprites recharge le jeu de sprites du joueur
+func (hr *HotReloadWatcher) reloadPlayerSprites() {
+	sprites, err := hr.spriteLoader.ReloadPlayerSprites(hr.assetsDir)
+	if err != nil {
+		fmt.Printf("⚠ Échec du rechargement des sprites joueur: %v\n", err)
+		return
+	}
+
+	if hr.OnPlayerSpritesReloaded != nil {
+		hr.OnPlayerSpritesReloaded(sprites)
+	}
+	fmt.Println("✓ Sprites joueur rechargés")
+}
+
+// reloadConfig délègue l'application de la config (évite l'import de core)
+func (hr *HotReloadWatcher) reloadConfig(path string) {
+	if hr.OnConfigChanged == nil {
+		return
+	}
+
+	if err := hr.OnConfigChanged(path); err != nil {
+		fmt.Printf("⚠ Échec du rechargement de la configuration: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Configuration rechargée: %s\n", path)
+}
+
+// isTexturePath vérifie si un chemin est sous le dossier des textures
+func (hr *HotReloadWatcher) isTexturePath(path string) bool {
+	rel, err := filepath.Rel(hr.texturesDir, path)
+	return err == nil && !strings.HasPrefix(rel, "..")
+}
+
+// isTexturePNG vérifie si un chemin est une texture PNG
+func (hr *HotReloadWatcher) isTexturePNG(path string) bool {
+	return hr.isTexturePath(path) && strings.EqualFold(filepath.Ext(path), ".png")
+}
+
+// isPlayerTexture vérifie si une texture appartient aux sprites du joueur
+func (hr *HotReloadWatcher) isPlayerTexture(path string) bool {
+	return filepath.Dir(path) == filepath.Join(hr.texturesDir, "player")
+}
+
+// textureID construit l'identifiant d'une texture ("enemies/slime")
+func (hr *HotReloadWatcher) textureID(path string) string {
+	rel, err := filepath.Rel(hr.texturesDir, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+	return filepath.ToSlash(strings.TrimSuffix(rel, filepath.Ext(rel)))
+}
+
+// Close arrête la surveillance
+func (hr *HotReloadWatcher) Close() error {
+	close(hr.done)
+	return hr.watcher.Close()
+}
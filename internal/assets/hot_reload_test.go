@@ -0,0 +1,150 @@
+package assets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// newTestWatcher watcher sans surveillance réelle : les tests lui passent
+// directement des fsnotify.Event
+func newTestWatcher(root string) *HotReloadWatcher {
+	return &HotReloadWatcher{
+		spriteLoader: NewSpriteLoader(),
+		assetsDir:    root,
+		texturesDir:  filepath.Join(root, "textures"),
+		configPath:   filepath.Join(root, "configs", "game_config.yaml"),
+		pending:      make(map[string]bool),
+		done:         make(chan struct{}),
+	}
+}
+
+func TestHotReloadEventFiltering(t *testing.T) {
+	root := t.TempDir()
+	texture := filepath.Join(root, "textures", "enemies", "slime.png")
+	config := filepath.Join(root, "configs", "game_config.yaml")
+
+	tests := []struct {
+		name        string
+		event       fsnotify.Event
+		wantTexture string
+		wantConfig  bool
+	}{
+		{"écriture d'une texture", fsnotify.Event{Name: texture, Op: fsnotify.Write}, "enemies/slime", false},
+		{"création d'une texture", fsnotify.Event{Name: texture, Op: fsnotify.Create}, "enemies/slime", false},
+		{"extension en majuscules", fsnotify.Event{Name: filepath.Join(root, "textures", "TILE.PNG"), Op: fsnotify.Write}, "TILE", false},
+		{"écriture de la config", fsnotify.Event{Name: config, Op: fsnotify.Write}, "", true},
+		{"changement de droits ignoré", fsnotify.Event{Name: texture, Op: fsnotify.Chmod}, "", false},
+		{"suppression ignorée", fsnotify.Event{Name: texture, Op: fsnotify.Remove}, "", false},
+		{"fichier non PNG ignoré", fsnotify.Event{Name: filepath.Join(root, "textures", "notes.txt"), Op: fsnotify.Write}, "", false},
+		{"PNG hors des textures ignoré", fsnotify.Event{Name: filepath.Join(root, "ui", "logo.png"), Op: fsnotify.Write}, "", false},
+		{"autre fichier de config ignoré", fsnotify.Event{Name: filepath.Join(root, "configs", "keys.yaml"), Op: fsnotify.Write}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hr := newTestWatcher(root)
+			var textures []string
+			configReloads := 0
+			hr.OnTextureChanged = func(id, path string) error {
+				textures = append(textures, id)
+				return nil
+			}
+			hr.OnConfigChanged = func(path string) error {
+				configReloads++
+				return nil
+			}
+
+			hr.handleEvent(tt.event)
+			hr.Update()
+
+			if tt.wantTexture == "" && len(textures) != 0 {
+				t.Errorf("textures rechargées %v, attendu aucune", textures)
+			}
+			if tt.wantTexture != "" && (len(textures) != 1 || textures[0] != tt.wantTexture) {
+				t.Errorf("textures rechargées %v, attendu [%s]", textures, tt.wantTexture)
+			}
+			if (configReloads == 1) != tt.wantConfig || configReloads > 1 {
+				t.Errorf("%d rechargement(s) de la config, attendu %t", configReloads, tt.wantConfig)
+			}
+		})
+	}
+}
+
+func TestHotReloadDeduplicatesEvents(t *testing.T) {
+	root := t.TempDir()
+	hr := newTestWatcher(root)
+	reloads := 0
+	hr.OnTextureChanged = func(id, path string) error {
+		reloads++
+		return nil
+	}
+
+	// Un éditeur émet souvent plusieurs écritures pour une sauvegarde
+	texture := filepath.Join(root, "textures", "props", "barrel.png")
+	for i := 0; i < 3; i++ {
+		hr.handleEvent(fsnotify.Event{Name: texture, Op: fsnotify.Write})
+	}
+	hr.Update()
+	hr.Update()
+
+	if reloads != 1 {
+		t.Errorf("%d rechargement(s), attendu 1", reloads)
+	}
+}
+
+func TestHotReloadPlayerTextureClassification(t *testing.T) {
+	hr := newTestWatcher(t.TempDir())
+
+	if !hr.isPlayerTexture(filepath.Join(hr.texturesDir, "player", "player.png")) {
+		t.Error("une texture de textures/player doit recharger les sprites du joueur")
+	}
+	if hr.isPlayerTexture(filepath.Join(hr.texturesDir, "player", "up", "idle_up.png")) {
+		t.Error("seules les textures directement sous textures/player rechargent le jeu complet")
+	}
+}
+
+func TestHotReloadWatchesFileSystem(t *testing.T) {
+	root := t.TempDir()
+	texturesDir := filepath.Join(root, "textures", "enemies")
+	configDir := filepath.Join(root, "configs")
+	for _, dir := range []string{texturesDir, configDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hr, err := NewHotReloadWatcher(NewSpriteLoader(), root, filepath.Join(configDir, "game_config.yaml"))
+	if err != nil {
+		t.Skipf("fsnotify indisponible sur cette plateforme: %v", err)
+	}
+	defer hr.Close()
+
+	changed := make(chan string, 1)
+	hr.OnTextureChanged = func(id, path string) error {
+		changed <- id
+		return nil
+	}
+
+	if err := os.WriteFile(filepath.Join(texturesDir, "slime.png"), []byte("png"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.After(2 * time.Second)
+	for {
+		hr.Update()
+		select {
+		case id := <-changed:
+			if id != "enemies/slime" {
+				t.Errorf("texture rechargée %q, attendu enemies/slime", id)
+			}
+			return
+		case <-deadline:
+			t.Fatal("aucun rechargement après l'écriture de la texture")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
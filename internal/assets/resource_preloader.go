@@ -0,0 +1,288 @@
+// internal/assets/resource_preloader.go - Préchargement en arrière-plan des assets de la salle suivante
+package assets
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/png"
+	"os"
+	"path/filepath"
+)
+
+// RoomsDir dossier des salles : chacune a son manifest.json
+const RoomsDir = "assets/rooms"
+
+// RoomManifest fichiers à précharger pour une salle (chemins relatifs à la racine du jeu)
+type RoomManifest struct {
+	Textures []string `json:"textures"`
+	Sounds   []string `json:"sounds"`
+}
+
+// ResourceKind nature d'un fichier préchargé
+type ResourceKind int
+
+const (
+	ResourceTexture ResourceKind = iota
+	ResourceSound
+)
+
+// ResourceLoader lit un fichier depuis le disque. Il est appelé depuis la
+// goroutine de préchargement : il ne doit pas toucher au GPU (les textures sont
+// décodées en image.Image et envoyées à Ebiten sur le thread principal).
+type ResourceLoader interface {
+	LoadTexture(path string) (image.Image, error)
+	LoadSound(path string) ([]byte, error)
+}
+
+// fileResourceLoader chargeur par défaut : décode les images, lit les sons tels quels
+type fileResourceLoader struct{}
+
+// LoadTexture implémente ResourceLoader
+func (fileResourceLoader) LoadTexture(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	return img, err
+}
+
+// LoadSound implémente ResourceLoader
+func (fileResourceLoader) LoadSound(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// PreloadResult fichier chargé (ou en échec) par la goroutine de préchargement
+type PreloadResult struct {
+	Path    string
+	Kind    ResourceKind
+	Texture image.Image
+	Sound   []byte
+	Err     error
+}
+
+// preloadJob fichier à charger
+type preloadJob struct {
+	path string
+	kind ResourceKind
+}
+
+// ResourcePreloader charge en arrière-plan les textures et sons d'une salle
+// pendant que le joueur est encore dans la précédente. Les résultats arrivent
+// par un canal et sont distribués par Update, sur le thread principal.
+//
+// Au changement de salle, IsComplete indique si la bascule peut être
+// immédiate ; sinon le fondu de transition se prolonge jusqu'à ce qu'il le soit.
+type ResourcePreloader struct {
+	loader   ResourceLoader
+	roomsDir string
+
+	roomID   string
+	results  chan PreloadResult
+	cancel   chan struct{}
+	total    int
+	received int
+	failed   int
+
+	sounds map[string][]byte // Sons préchargés, par chemin
+
+	// Appelés sur le thread principal (Update)
+	OnTexture  func(path string, img image.Image)
+	OnSound    func(path string, data []byte)
+	OnComplete func(roomID string)
+}
+
+// NewResourcePreloader crée un préchargeur ; loader nil lit les fichiers du disque
+func NewResourcePreloader(loader ResourceLoader) *ResourcePreloader {
+	if loader == nil {
+		loader = fileResourceLoader{}
+	}
+	return &ResourcePreloader{
+		loader:   loader,
+		roomsDir: RoomsDir,
+		sounds:   make(map[string][]byte),
+	}
+}
+
+// SetRoomsDir change le dossier des salles
+func (rp *ResourcePreloader) SetRoomsDir(dir string) {
+	rp.roomsDir = dir
+}
+
+// ManifestPath retourne le chemin du manifeste d'une salle
+func (rp *ResourcePreloader) ManifestPath(roomID string) string {
+	return filepath.Join(rp.roomsDir, roomID, "manifest.json")
+}
+
+// LoadRoomManifest lit le manifeste d'une salle
+func (rp *ResourcePreloader) LoadRoomManifest(roomID string) (*RoomManifest, error) {
+	data, err := os.ReadFile(rp.ManifestPath(roomID))
+	if err != nil {
+		return nil, fmt.Errorf("impossible de lire le manifeste de la salle %s: %v", roomID, err)
+	}
+
+	var manifest RoomManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("manifeste de la salle %s invalide: %v", roomID, err)
+	}
+	return &manifest, nil
+}
+
+// BeginPreload lit le manifeste de la salle et lance son chargement en
+// arrière-plan. Sans effet si cette salle est déjà en cours ou chargée ; un
+// préchargement d'une autre salle encore en cours est abandonné.
+func (rp *ResourcePreloader) BeginPreload(roomID string) error {
+	if roomID == rp.roomID && rp.results != nil {
+		return nil
+	}
+
+	manifest, err := rp.LoadRoomManifest(roomID)
+	if err != nil {
+		return err
+	}
+
+	jobs := make([]preloadJob, 0, len(manifest.Textures)+len(manifest.Sounds))
+	for _, path := range manifest.Textures {
+		jobs = append(jobs, preloadJob{path: path, kind: ResourceTexture})
+	}
+	for _, path := range manifest.Sounds {
+		jobs = append(jobs, preloadJob{path: path, kind: ResourceSound})
+	}
+
+	rp.stop()
+	rp.roomID = roomID
+	rp.total = len(jobs)
+	rp.received = 0
+	rp.failed = 0
+	// Canal dimensionné pour tous les résultats : la goroutine ne bloque
+	// jamais, même si la salle est abandonnée avant d'avoir tout lu
+	rp.results = make(chan PreloadResult, len(jobs))
+	rp.cancel = make(chan struct{})
+
+	fmt.Printf("✓ Préchargement de la salle %s: %d fichiers\n", roomID, rp.total)
+	if rp.total == 0 {
+		rp.complete()
+		return nil
+	}
+
+	go rp.run(rp.loader, jobs, rp.results, rp.cancel)
+	return nil
+}
+
+// run charge les fichiers un à un (goroutine de préchargement)
+func (rp *ResourcePreloader) run(loader ResourceLoader, jobs []preloadJob, results chan<- PreloadResult, cancel <-chan struct{}) {
+	for _, job := range jobs {
+		select {
+		case <-cancel:
+			return
+		default:
+		}
+
+		result := PreloadResult{Path: job.path, Kind: job.kind}
+		switch job.kind {
+		case ResourceTexture:
+			result.Texture, result.Err = loader.LoadTexture(job.path)
+		case ResourceSound:
+			result.Sound, result.Err = loader.LoadSound(job.path)
+		}
+		results <- result
+	}
+}
+
+// Update distribue les fichiers arrivés depuis le dernier appel (sans bloquer)
+func (rp *ResourcePreloader) Update() {
+	if rp.results == nil {
+		return
+	}
+
+	for rp.received < rp.total {
+		select {
+		case result := <-rp.results:
+			rp.deliver(result)
+		default:
+			return
+		}
+	}
+}
+
+// deliver transmet un fichier chargé à son destinataire
+func (rp *ResourcePreloader) deliver(result PreloadResult) {
+	rp.received++
+	if result.Err != nil {
+		rp.failed++
+		fmt.Printf("⚠ Préchargement de %s impossible: %v\n", result.Path, result.Err)
+	} else {
+		switch result.Kind {
+		case ResourceTexture:
+			if rp.OnTexture != nil {
+				rp.OnTexture(result.Path, result.Texture)
+			}
+		case ResourceSound:
+			rp.sounds[result.Path] = result.Sound
+			if rp.OnSound != nil {
+				rp.OnSound(result.Path, result.Sound)
+			}
+		}
+	}
+
+	if rp.received == rp.total {
+		rp.complete()
+	}
+}
+
+// complete signale la fin du préchargement de la salle courante
+func (rp *ResourcePreloader) complete() {
+	if rp.failed > 0 {
+		fmt.Printf("⚠ Salle %s préchargée avec %d échec(s)\n", rp.roomID, rp.failed)
+	} else {
+		fmt.Printf("✓ Salle %s préchargée\n", rp.roomID)
+	}
+	if rp.OnComplete != nil {
+		rp.OnComplete(rp.roomID)
+	}
+}
+
+// IsComplete retourne si tous les fichiers de la salle ont été distribués ;
+// faux pour une salle dont le préchargement n'a pas été lancé
+func (rp *ResourcePreloader) IsComplete(roomID string) bool {
+	return rp.results != nil && roomID == rp.roomID && rp.received == rp.total
+}
+
+// Progress retourne l'avancement du préchargement en cours (0 à 1)
+func (rp *ResourcePreloader) Progress() float64 {
+	if rp.total == 0 {
+		if rp.results != nil {
+			return 1
+		}
+		return 0
+	}
+	return float64(rp.received) / float64(rp.total)
+}
+
+// RoomID retourne la salle en cours de préchargement (ou préchargée)
+func (rp *ResourcePreloader) RoomID() string {
+	return rp.roomID
+}
+
+// Sound retourne un son préchargé
+func (rp *ResourcePreloader) Sound(path string) ([]byte, bool) {
+	data, exists := rp.sounds[path]
+	return data, exists
+}
+
+// stop abandonne le préchargement en cours
+func (rp *ResourcePreloader) stop() {
+	if rp.cancel != nil {
+		close(rp.cancel)
+		rp.cancel = nil
+	}
+	rp.results = nil
+}
+
+// Close abandonne le préchargement en cours
+func (rp *ResourcePreloader) Close() {
+	rp.stop()
+}
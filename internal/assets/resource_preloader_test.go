@@ -0,0 +1,197 @@
+package assets
+
+import (
+	"errors"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// gatedLoader chargeur factice : chaque fichier attend une autorisation du test
+type gatedLoader struct {
+	gate    chan struct{}
+	missing string // Fichier en échec
+}
+
+func newGatedLoader() *gatedLoader {
+	return &gatedLoader{gate: make(chan struct{})}
+}
+
+// release laisse passer n fichiers
+func (gl *gatedLoader) release(n int) {
+	for i := 0; i < n; i++ {
+		gl.gate <- struct{}{}
+	}
+}
+
+func (gl *gatedLoader) LoadTexture(path string) (image.Image, error) {
+	<-gl.gate
+	if path == gl.missing {
+		return nil, errors.New("fichier absent")
+	}
+	return image.NewRGBA(image.Rect(0, 0, 1, 1)), nil
+}
+
+func (gl *gatedLoader) LoadSound(path string) ([]byte, error) {
+	<-gl.gate
+	return []byte(path), nil
+}
+
+// writeRoom écrit le manifeste d'une salle dans un dossier temporaire
+func writeRoom(t *testing.T, dir, roomID, manifest string) {
+	t.Helper()
+	roomDir := filepath.Join(dir, roomID)
+	if err := os.MkdirAll(roomDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(roomDir, "manifest.json"), []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// updateUntil appelle Update jusqu'à ce que la progression atteigne want
+func updateUntil(t *testing.T, rp *ResourcePreloader, want float64) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for rp.Progress() < want {
+		if time.Now().After(deadline) {
+			t.Fatalf("progression = %.2f, attendu %.2f", rp.Progress(), want)
+		}
+		rp.Update()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+const crypteManifest = `{"textures": ["tiles/crypte.png", "enemies/squelette.png"], "sounds": ["sfx/goutte.wav"]}`
+
+func TestPreloadCompletionDetection(t *testing.T) {
+	dir := t.TempDir()
+	writeRoom(t, dir, "crypte", crypteManifest)
+
+	loader := newGatedLoader()
+	rp := NewResourcePreloader(loader)
+	rp.SetRoomsDir(dir)
+	defer rp.Close()
+
+	var textures []string
+	completed := 0
+	rp.OnTexture = func(path string, img image.Image) { textures = append(textures, path) }
+	rp.OnComplete = func(roomID string) { completed++ }
+
+	if rp.IsComplete("crypte") {
+		t.Fatal("salle signalée complète avant le préchargement")
+	}
+	if err := rp.BeginPreload("crypte"); err != nil {
+		t.Fatalf("BeginPreload: %v", err)
+	}
+
+	// Rien n'est chargé tant que le chargeur bloque
+	rp.Update()
+	if rp.IsComplete("crypte") || rp.Progress() != 0 {
+		t.Errorf("complet = %t, progression = %.2f avant tout chargement", rp.IsComplete("crypte"), rp.Progress())
+	}
+
+	loader.release(2)
+	updateUntil(t, rp, 2.0/3)
+	if rp.IsComplete("crypte") {
+		t.Error("salle complète alors que le son n'est pas chargé")
+	}
+	if len(textures) != 2 {
+		t.Errorf("textures distribuées = %v, attendu 2", textures)
+	}
+
+	loader.release(1)
+	updateUntil(t, rp, 1)
+	if !rp.IsComplete("crypte") {
+		t.Fatal("salle incomplète après le dernier fichier")
+	}
+	if completed != 1 {
+		t.Errorf("OnComplete appelé %d fois, attendu 1", completed)
+	}
+	if data, ok := rp.Sound("sfx/goutte.wav"); !ok || string(data) != "sfx/goutte.wav" {
+		t.Errorf("son préchargé = %q, %t", data, ok)
+	}
+	if rp.IsComplete("autre") {
+		t.Error("une autre salle ne doit pas être signalée complète")
+	}
+}
+
+func TestPreloadCompletion(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		missing  string
+		release  int
+	}{
+		{"salle vide", `{}`, "", 0},
+		{"fichier en échec", crypteManifest, "tiles/crypte.png", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeRoom(t, dir, "salle", tt.manifest)
+
+			loader := newGatedLoader()
+			loader.missing = tt.missing
+			rp := NewResourcePreloader(loader)
+			rp.SetRoomsDir(dir)
+			defer rp.Close()
+
+			if err := rp.BeginPreload("salle"); err != nil {
+				t.Fatalf("BeginPreload: %v", err)
+			}
+			loader.release(tt.release)
+			updateUntil(t, rp, 1)
+
+			// Un échec ne doit pas bloquer la transition
+			if !rp.IsComplete("salle") {
+				t.Error("salle incomplète")
+			}
+		})
+	}
+}
+
+func TestPreloadSwitchRoom(t *testing.T) {
+	dir := t.TempDir()
+	writeRoom(t, dir, "crypte", crypteManifest)
+	writeRoom(t, dir, "jardin", `{"sounds": ["sfx/oiseaux.wav"]}`)
+
+	loader := newGatedLoader()
+	rp := NewResourcePreloader(loader)
+	rp.SetRoomsDir(dir)
+	defer rp.Close()
+
+	if err := rp.BeginPreload("crypte"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.BeginPreload("jardin"); err != nil {
+		t.Fatal(err)
+	}
+
+	// La goroutine de la crypte peut encore prendre une autorisation avant
+	// de voir l'abandon : on en laisse passer assez pour les deux
+	go loader.release(2)
+	updateUntil(t, rp, 1)
+
+	if rp.RoomID() != "jardin" || !rp.IsComplete("jardin") {
+		t.Errorf("salle = %s, complète = %t, attendu jardin préchargé", rp.RoomID(), rp.IsComplete("jardin"))
+	}
+	if rp.IsComplete("crypte") {
+		t.Error("la salle abandonnée ne doit pas être signalée complète")
+	}
+}
+
+func TestPreloadMissingManifest(t *testing.T) {
+	rp := NewResourcePreloader(newGatedLoader())
+	rp.SetRoomsDir(t.TempDir())
+
+	if err := rp.BeginPreload("inconnue"); err == nil {
+		t.Error("un manifeste absent doit être une erreur")
+	}
+	if rp.IsComplete("inconnue") {
+		t.Error("une salle sans manifeste ne doit pas être signalée complète")
+	}
+}
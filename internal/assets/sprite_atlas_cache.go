@@ -0,0 +1,110 @@
+// internal/assets/sprite_atlas_cache.go - Cache des sous-images des planches de sprites
+package assets
+
+import (
+	"container/list"
+	"image"
+	"unsafe"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// DefaultSpriteAtlasCacheSize nombre de sous-images gardées par défaut
+const DefaultSpriteAtlasCacheSize = 2048
+
+// subImageKey position d'une sous-image dans la liste LRU
+type subImageKey struct {
+	parent uintptr
+	rect   image.Rectangle
+}
+
+// subImageEntry sous-image en cache
+type subImageEntry struct {
+	key   subImageKey
+	image *ebiten.Image
+}
+
+// SpriteAtlasCache mémorise les sous-images découpées dans les planches de
+// sprites : SubImage ne copie pas les pixels mais alloue un nouvel
+// *ebiten.Image à chaque appel, soit plusieurs par frame et par sprite.
+// Au-delà de MaxEntries, la sous-image utilisée il y a le plus longtemps est
+// oubliée. Une sous-image référence sa planche : tant qu'elle est en cache,
+// la planche n'est pas libérée et son adresse reste une clé valide.
+type SpriteAtlasCache struct {
+	MaxEntries int
+
+	images map[uintptr]map[image.Rectangle]*list.Element
+	order  *list.List // Plus récemment utilisée en tête
+}
+
+// NewSpriteAtlasCache crée un cache de maxEntries sous-images
+// (DefaultSpriteAtlasCacheSize si maxEntries <= 0)
+func NewSpriteAtlasCache(maxEntries int) *SpriteAtlasCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultSpriteAtlasCacheSize
+	}
+	return &SpriteAtlasCache{
+		MaxEntries: maxEntries,
+		images:     make(map[uintptr]map[image.Rectangle]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get retourne la sous-image rect de parent, découpée au premier appel
+func (c *SpriteAtlasCache) Get(parent *ebiten.Image, rect image.Rectangle) *ebiten.Image {
+	key := subImageKey{parent: uintptr(unsafe.Pointer(parent)), rect: rect}
+
+	rects := c.images[key.parent]
+	if element, exists := rects[rect]; exists {
+		c.order.MoveToFront(element)
+		return element.Value.(*subImageEntry).image
+	}
+
+	subImage := parent.SubImage(rect).(*ebiten.Image)
+	if rects == nil {
+		rects = make(map[image.Rectangle]*list.Element)
+		c.images[key.parent] = rects
+	}
+	rects[rect] = c.order.PushFront(&subImageEntry{key: key, image: subImage})
+
+	for c.order.Len() > c.MaxEntries {
+		c.evictOldest()
+	}
+	return subImage
+}
+
+// Len retourne le nombre de sous-images en cache
+func (c *SpriteAtlasCache) Len() int {
+	return c.order.Len()
+}
+
+// Forget oublie les sous-images d'une planche (rechargée ou libérée)
+func (c *SpriteAtlasCache) Forget(parent *ebiten.Image) {
+	key := uintptr(unsafe.Pointer(parent))
+	for _, element := range c.images[key] {
+		c.order.Remove(element)
+	}
+	delete(c.images, key)
+}
+
+// Clear vide le cache
+func (c *SpriteAtlasCache) Clear() {
+	c.images = make(map[uintptr]map[image.Rectangle]*list.Element)
+	c.order.Init()
+}
+
+// evictOldest oublie la sous-image utilisée il y a le plus longtemps
+func (c *SpriteAtlasCache) evictOldest() {
+	oldest := c.order.Back()
+	if oldest == nil {
+		return
+	}
+	c.order.Remove(oldest)
+
+	key := oldest.Value.(*subImageEntry).key
+	rects := c.images[key.parent]
+	delete(rects, key.rect)
+	if len(rects) == 0 {
+		delete(c.images, key.parent)
+	}
+}
@@ -0,0 +1,140 @@
+package assets
+
+import (
+	"image"
+	"testing"
+	"unsafe"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// frameRects découpe une planche en count frames de 16x16, ligne par ligne
+func frameRects(count int) []image.Rectangle {
+	rects := make([]image.Rectangle, count)
+	for i := range rects {
+		x, y := (i%16)*16, (i/16)*16
+		rects[i] = image.Rect(x, y, x+16, y+16)
+	}
+	return rects
+}
+
+// uintptrOf clé d'une planche dans l'index du cache
+func uintptrOf(parent *ebiten.Image) uintptr {
+	return uintptr(unsafe.Pointer(parent))
+}
+
+func TestSpriteAtlasCacheReuses(t *testing.T) {
+	sheet := ebiten.NewImage(256, 256)
+	other := ebiten.NewImage(256, 256)
+	cache := NewSpriteAtlasCache(0)
+	rect := image.Rect(16, 0, 32, 16)
+
+	first := cache.Get(sheet, rect)
+	if cache.Get(sheet, rect) != first {
+		t.Error("une même sous-image doit être réutilisée")
+	}
+	if first.Bounds() != rect {
+		t.Errorf("Bounds = %v, attendu %v", first.Bounds(), rect)
+	}
+	if cache.Get(other, rect) == first {
+		t.Error("deux planches ne doivent pas partager leurs sous-images")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len = %d, attendu 2", cache.Len())
+	}
+
+	cache.Forget(sheet)
+	if cache.Len() != 1 {
+		t.Errorf("Len après Forget = %d, attendu 1", cache.Len())
+	}
+	if cache.Get(sheet, rect) == first {
+		t.Error("une planche oubliée doit être redécoupée")
+	}
+}
+
+func TestSpriteAtlasCacheEvictsLeastRecent(t *testing.T) {
+	sheet := ebiten.NewImage(256, 256)
+	rects := frameRects(4)
+	cache := NewSpriteAtlasCache(3)
+
+	images := make([]*ebiten.Image, 3)
+	for i := range images {
+		images[i] = cache.Get(sheet, rects[i])
+	}
+
+	// La frame 0 est réutilisée : la frame 1 devient la plus ancienne
+	cache.Get(sheet, rects[0])
+	cache.Get(sheet, rects[3])
+
+	if cache.Len() != 3 {
+		t.Fatalf("Len = %d, attendu 3", cache.Len())
+	}
+
+	tests := []struct {
+		name     string
+		frame    int
+		wantKept bool
+	}{
+		{"récemment utilisée", 0, true},
+		{"la plus ancienne", 1, false},
+		{"encore en cache", 2, true},
+	}
+
+	// Vérifier sans Get, qui modifierait l'ordre d'éviction
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, kept := cache.images[uintptrOf(sheet)][rects[tt.frame]]
+			if kept != tt.wantKept {
+				t.Fatalf("frame %d en cache = %t, attendu %t", tt.frame, kept, tt.wantKept)
+			}
+			if kept && element.Value.(*subImageEntry).image != images[tt.frame] {
+				t.Errorf("frame %d redécoupée", tt.frame)
+			}
+		})
+	}
+}
+
+func TestSpriteAtlasCacheBounded(t *testing.T) {
+	sheet := ebiten.NewImage(256, 256)
+	cache := NewSpriteAtlasCache(0)
+
+	// 4096 rectangles distincts : le double de la capacité par défaut
+	for i := 0; i < 2*DefaultSpriteAtlasCacheSize; i++ {
+		cache.Get(sheet, image.Rect(i%256, i/256, i%256+1, i/256+1))
+	}
+	if cache.Len() != DefaultSpriteAtlasCacheSize {
+		t.Errorf("Len = %d, attendu %d", cache.Len(), DefaultSpriteAtlasCacheSize)
+	}
+	if entries := len(cache.images[uintptrOf(sheet)]); entries != DefaultSpriteAtlasCacheSize {
+		t.Errorf("index = %d sous-images, attendu %d", entries, DefaultSpriteAtlasCacheSize)
+	}
+}
+
+// BenchmarkSubImageDirect découpe 100 frames par itération, comme avant le cache
+func BenchmarkSubImageDirect(b *testing.B) {
+	sheet := ebiten.NewImage(256, 256)
+	rects := frameRects(100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, rect := range rects {
+			_ = sheet.SubImage(rect).(*ebiten.Image)
+		}
+	}
+}
+
+// BenchmarkSubImageCached découpe les mêmes 100 frames via le cache
+func BenchmarkSubImageCached(b *testing.B) {
+	sheet := ebiten.NewImage(256, 256)
+	rects := frameRects(100)
+	cache := NewSpriteAtlasCache(0)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, rect := range rects {
+			_ = cache.Get(sheet, rect)
+		}
+	}
+}
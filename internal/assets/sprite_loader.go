@@ -30,6 +30,16 @@ type PlayerSpriteSet struct {
 	RightIdle   *SpriteAnimation
 	RightAttack *SpriteAnimation
 
+	// Sprites diagonaux optionnels (nil : repli sur la direction cardinale)
+	UpLeftIdle    *SpriteAnimation
+	UpLeftWalk    *SpriteAnimation
+	UpRightIdle   *SpriteAnimation
+	UpRightWalk   *SpriteAnimation
+	DownLeftIdle  *SpriteAnimation
+	DownLeftWalk  *SpriteAnimation
+	DownRightIdle *SpriteAnimation
+	DownRightWalk *SpriteAnimation
+
 	// Sprite principal
 	MainSprite *ebiten.Image
 
@@ -159,6 +169,16 @@ func (sl *SpriteLoader) tryLoadDirectionalSprites(assetsDir string, playerSprite
 		"left_idle/idle_left.png":   &playerSprites.LeftIdle,
 		"right/idle_right.png":      &playerSprites.RightIdle,
 		"right_idle/idle_right.png": &playerSprites.RightIdle,
+
+		// Diagonales (optionnelles)
+		"up_left/idle_up_left.png":       &playerSprites.UpLeftIdle,
+		"up_left/walk_up_left.png":       &playerSprites.UpLeftWalk,
+		"up_right/idle_up_right.png":     &playerSprites.UpRightIdle,
+		"up_right/walk_up_right.png":     &playerSprites.UpRightWalk,
+		"down_left/idle_down_left.png":   &playerSprites.DownLeftIdle,
+		"down_left/walk_down_left.png":   &playerSprites.DownLeftWalk,
+		"down_right/idle_down_right.png": &playerSprites.DownRightIdle,
+		"down_right/walk_down_right.png": &playerSprites.DownRightWalk,
 	}
 
 	loadedCount := 0
@@ -221,13 +241,69 @@ func (sl *SpriteLoader) createFallbackSprite(width, height int) *ebiten.Image {
 	return img
 }
 
+// diagonalAnimation retourne l'animation diagonale chargée (marche si en
+// mouvement et disponible, sinon idle), ou nil
+func (pss *PlayerSpriteSet) diagonalAnimation(direction string, isMoving bool) *SpriteAnimation {
+	var idle, walk *SpriteAnimation
+	switch direction {
+	case "up-left":
+		idle, walk = pss.UpLeftIdle, pss.UpLeftWalk
+	case "up-right":
+		idle, walk = pss.UpRightIdle, pss.UpRightWalk
+	case "down-left":
+		idle, walk = pss.DownLeftIdle, pss.DownLeftWalk
+	case "down-right":
+		idle, walk = pss.DownRightIdle, pss.DownRightWalk
+	default:
+		return nil
+	}
+
+	if isMoving && walk != nil {
+		return walk
+	}
+	if idle != nil {
+		return idle
+	}
+	return walk
+}
+
+// HasDirection retourne si un sprite dédié existe pour la direction
+// ("up", "down-left"...). Les directions cardinales sont toujours disponibles.
+func (pss *PlayerSpriteSet) HasDirection(direction string) bool {
+	switch direction {
+	case "up-left", "up-right", "down-left", "down-right":
+		return pss.diagonalAnimation(direction, false) != nil
+	default:
+		return true
+	}
+}
+
+// cardinalDirection rabat une diagonale sur la direction cardinale la plus proche
+func cardinalDirection(direction string) string {
+	switch direction {
+	case "up-left", "up-right":
+		return "up"
+	case "down-left", "down-right":
+		return "down"
+	default:
+		return direction
+	}
+}
+
 // GetPlayerAnimation retourne l'animation appropriée selon l'état
 func (pss *PlayerSpriteSet) GetPlayerAnimation(direction string, isAttacking bool) *SpriteAnimation {
 	if !pss.Loaded {
 		return nil
 	}
 
-	switch direction {
+	// Pas d'attaque diagonale : les diagonales n'ont que idle/marche
+	if !isAttacking {
+		if animation := pss.diagonalAnimation(direction, false); animation != nil {
+			return animation
+		}
+	}
+
+	switch cardinalDirection(direction) {
 	case "up":
 		if isAttacking {
 			return pss.UpAttack
@@ -270,6 +346,11 @@ func (pss *PlayerSpriteSet) GetSpriteForAnimation(direction string, isMoving boo
 	// Pour l'instant, toujours retourner le sprite principal
 	// TODO: Implémenter la sélection de frame dans les animations
 	animation := pss.GetPlayerAnimation(direction, isAttacking)
+	if !isAttacking {
+		if diagonal := pss.diagonalAnimation(direction, isMoving); diagonal != nil {
+			animation = diagonal
+		}
+	}
 	if animation != nil && len(animation.Frames) > 0 {
 		// Pour l'instant, on retourne toujours le sprite principal
 		// car toutes nos animations utilisent le même sprite de base
@@ -314,6 +395,11 @@ func (sl *SpriteLoader) GetLoadedImageCount() int {
 	return len(sl.loadedImages)
 }
 
+// InvalidateImage retire une image du cache pour forcer son rechargement
+func (sl *SpriteLoader) InvalidateImage(path string) {
+	delete(sl.loadedImages, path)
+}
+
 // ReloadPlayerSprites force le rechargement des sprites du joueur
 func (sl *SpriteLoader) ReloadPlayerSprites(assetsDir string) (*PlayerSpriteSet, error) {
 	fmt.Println("Rechargement forcé des sprites du joueur...")
@@ -18,13 +18,85 @@ type GameConfig interface {
 	GetAudio() AudioConfig
 }
 
+// Player interface minimale d'un lecteur audio (satisfaite par *audio.Player d'Ebiten)
+type Player interface {
+	Play()
+	IsPlaying() bool
+	SetVolume(volume float64)
+}
+
+// positionalSound effet sonore en cours de lecture avec sa source dans le monde
+type positionalSound struct {
+	player Player
+	stream *PannedStream // nil si le flux n'est pas panoramisé
+	source SoundSource
+}
+
 type AudioManager struct {
 	config *AudioConfig
+
+	// Effets sonores positionnés
+	propagation *SoundPropagation
+	sounds      []*positionalSound
 }
 
 func NewAudioManager(config GameConfig) (*AudioManager, error) {
 	audioConfig := config.GetAudio()
-	return &AudioManager{config: &audioConfig}, nil
+	return &AudioManager{
+		config:      &audioConfig,
+		propagation: NewSoundPropagation(),
+		sounds:      make([]*positionalSound, 0),
+	}, nil
+}
+
+// SetListenerPosition met à jour la position du joueur pour la propagation du son
+func (am *AudioManager) SetListenerPosition(position Vector2) {
+	am.propagation.SetListener(position)
+}
+
+// GetSoundPropagation retourne le calculateur de propagation
+func (am *AudioManager) GetSoundPropagation() *SoundPropagation {
+	return am.propagation
+}
+
+// PlaySFX joue un effet sonore depuis une source. stream est le flux panoramisé
+// alimentant le lecteur (nil pour un son sans panoramique).
+func (am *AudioManager) PlaySFX(player Player, stream *PannedStream, source SoundSource) {
+	if !am.config.EnableAudio || player == nil {
+		return
+	}
+
+	// Limiter le nombre de sons simultanés
+	if am.config.MaxSounds > 0 && len(am.sounds) >= am.config.MaxSounds {
+		return
+	}
+
+	sound := &positionalSound{player: player, stream: stream, source: source}
+	am.applyPropagation(sound)
+	player.Play()
+	am.sounds = append(am.sounds, sound)
+}
+
+// Update recalcule volume et panoramique des sons en cours et retire les sons terminés
+func (am *AudioManager) Update() {
+	playing := am.sounds[:0]
+	for _, sound := range am.sounds {
+		if !sound.player.IsPlaying() {
+			continue
+		}
+		am.applyPropagation(sound)
+		playing = append(playing, sound)
+	}
+	am.sounds = playing
+}
+
+// applyPropagation applique l'atténuation et la balance d'une source
+func (am *AudioManager) applyPropagation(sound *positionalSound) {
+	volume := am.propagation.Volume(sound.source, am.config.MasterVolume, am.config.SFXVolume)
+	sound.player.SetVolume(volume)
+	if sound.stream != nil {
+		sound.stream.SetPan(am.propagation.Pan(sound.source))
+	}
 }
 
 func (am *AudioManager) UpdateConfig(config *AudioConfig) {
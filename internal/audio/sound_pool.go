@@ -0,0 +1,73 @@
+// internal/audio/sound_pool.go - Banque d'effets sonores joués par identifiant
+package audio
+
+import "fmt"
+
+// SoundFactory crée un lecteur pour un effet, à la hauteur demandée (1 = normale).
+// stream est le flux panoramisé qui alimente le lecteur (nil : pas de panoramique).
+type SoundFactory func(pitch float64) (player Player, stream *PannedStream, err error)
+
+// SoundPool associe des identifiants d'effets à leurs lecteurs. Les effets sont
+// positionnés par l'AudioManager : volume et balance suivent le joueur.
+type SoundPool struct {
+	sounds  map[string]SoundFactory
+	missing map[string]bool // Effets manquants déjà signalés
+
+	manager *AudioManager // nil : effets joués sans propagation
+}
+
+// NewSoundPool crée une banque vide dont les effets passent par manager
+func NewSoundPool(manager *AudioManager) *SoundPool {
+	return &SoundPool{
+		sounds:  make(map[string]SoundFactory),
+		missing: make(map[string]bool),
+		manager: manager,
+	}
+}
+
+// Register enregistre un effet sonore
+func (sp *SoundPool) Register(id string, factory SoundFactory) {
+	sp.sounds[id] = factory
+	delete(sp.missing, id)
+}
+
+// Has vérifie qu'un effet est enregistré
+func (sp *SoundPool) Has(id string) bool {
+	_, exists := sp.sounds[id]
+	return exists
+}
+
+// Play joue un effet depuis une source, atténué et panoramisé selon sa
+// distance au joueur ; retourne false s'il est inconnu ou n'a pas pu être lu
+func (sp *SoundPool) Play(id string, pitch float64, source SoundSource) bool {
+	factory, exists := sp.sounds[id]
+	if !exists {
+		if !sp.missing[id] {
+			sp.missing[id] = true
+			fmt.Printf("⚠ Effet sonore inconnu: %s\n", id)
+		}
+		return false
+	}
+
+	player, stream, err := factory(pitch)
+	if err != nil || player == nil {
+		fmt.Printf("⚠ Impossible de jouer %s: %v\n", id, err)
+		return false
+	}
+
+	if sp.manager == nil {
+		player.Play()
+		return true
+	}
+	sp.manager.PlaySFX(player, stream, source)
+	return true
+}
+
+// SetListener déplace l'auditeur (le joueur) et met à jour les effets en cours
+func (sp *SoundPool) SetListener(position Vector2) {
+	if sp.manager == nil {
+		return
+	}
+	sp.manager.SetListenerPosition(position)
+	sp.manager.Update()
+}
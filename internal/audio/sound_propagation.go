@@ -0,0 +1,167 @@
+// internal/audio/sound_propagation.go - Atténuation et panoramique des effets sonores
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"sync"
+)
+
+// ===============================
+// SOURCE SONORE
+// ===============================
+
+// Vector2 position 2D (copié de core pour éviter le cycle)
+type Vector2 struct {
+	X, Y float64
+}
+
+// SoundSource décrit l'origine d'un effet sonore dans le monde
+type SoundSource struct {
+	Position        Vector2
+	RolloffDistance float64 // Distance (pixels) à laquelle le son devient inaudible
+}
+
+// ===============================
+// PROPAGATION
+// ===============================
+
+// SoundPropagation calcule le volume et le panoramique d'une source par rapport à l'auditeur (le joueur)
+type SoundPropagation struct {
+	listener Vector2
+}
+
+// NewSoundPropagation crée un nouveau calculateur de propagation
+func NewSoundPropagation() *SoundPropagation {
+	return &SoundPropagation{}
+}
+
+// SetListener met à jour la position de l'auditeur
+func (sp *SoundPropagation) SetListener(position Vector2) {
+	sp.listener = position
+}
+
+// Listener retourne la position de l'auditeur
+func (sp *SoundPropagation) Listener() Vector2 {
+	return sp.listener
+}
+
+// Attenuation retourne le facteur de distance entre 0 (inaudible) et 1 (plein volume)
+func (sp *SoundPropagation) Attenuation(source SoundSource) float64 {
+	if source.RolloffDistance <= 0 {
+		return 1.0
+	}
+	distance := math.Hypot(source.Position.X-sp.listener.X, source.Position.Y-sp.listener.Y)
+	return clamp(1-distance/source.RolloffDistance, 0, 1)
+}
+
+// Volume retourne le volume final d'une source selon les volumes configurés
+func (sp *SoundPropagation) Volume(source SoundSource, masterVolume, sfxVolume float64) float64 {
+	return masterVolume * sfxVolume * sp.Attenuation(source)
+}
+
+// Pan retourne la balance gauche/droite de la source, entre -1 (gauche) et 1 (droite)
+func (sp *SoundPropagation) Pan(source SoundSource) float64 {
+	if source.RolloffDistance <= 0 {
+		return 0
+	}
+	return clamp((source.Position.X-sp.listener.X)/source.RolloffDistance, -1, 1)
+}
+
+// clamp limite une valeur entre min et max
+func clamp(value, min, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// ===============================
+// MIXAGE STÉRÉO
+// ===============================
+
+// PannedStream applique un panoramique à un flux PCM stéréo 16 bits little-endian.
+// Le flux est lu par le thread audio, le panoramique est donc protégé par un mutex.
+type PannedStream struct {
+	source io.ReadSeeker
+
+	// Octets d'une trame coupée par la lecture précédente, pas encore mixés
+	remainder []byte
+
+	mutex sync.Mutex
+	pan   float64
+}
+
+// pannedFrameSize taille d'une trame : gauche int16, droite int16
+const pannedFrameSize = 4
+
+// NewPannedStream enveloppe un flux PCM stéréo
+func NewPannedStream(source io.ReadSeeker) *PannedStream {
+	return &PannedStream{source: source}
+}
+
+// SetPan définit la balance entre -1 (gauche) et 1 (droite)
+func (ps *PannedStream) SetPan(pan float64) {
+	ps.mutex.Lock()
+	ps.pan = clamp(pan, -1, 1)
+	ps.mutex.Unlock()
+}
+
+// Pan retourne la balance courante
+func (ps *PannedStream) Pan() float64 {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	return ps.pan
+}
+
+// channelGains retourne les gains gauche/droite : le canal opposé à la source est atténué
+func channelGains(pan float64) (left, right float64) {
+	return math.Min(1, 1-pan), math.Min(1, 1+pan)
+}
+
+// Read lit le flux source et applique les gains par canal. Seules des trames
+// complètes sont rendues : une trame coupée en fin de lecture est gardée et
+// mixée à la lecture suivante, sauf en fin de flux.
+func (ps *PannedStream) Read(buffer []byte) (int, error) {
+	n := copy(buffer, ps.remainder)
+	ps.remainder = ps.remainder[n:]
+
+	var err error
+	if n < len(buffer) {
+		var read int
+		read, err = ps.source.Read(buffer[n:])
+		n += read
+	}
+
+	if partial := n % pannedFrameSize; partial > 0 && err == nil {
+		n -= partial
+		ps.remainder = append(append([]byte(nil), buffer[n:n+partial]...), ps.remainder...)
+	}
+
+	left, right := channelGains(ps.Pan())
+	if left == 1 && right == 1 {
+		return n, err
+	}
+
+	for i := 0; i+pannedFrameSize <= n; i += pannedFrameSize {
+		l := int16(binary.LittleEndian.Uint16(buffer[i:]))
+		r := int16(binary.LittleEndian.Uint16(buffer[i+2:]))
+		binary.LittleEndian.PutUint16(buffer[i:], uint16(int16(float64(l)*left)))
+		binary.LittleEndian.PutUint16(buffer[i+2:], uint16(int16(float64(r)*right)))
+	}
+	return n, err
+}
+
+// Seek déplace la lecture dans le flux source
+func (ps *PannedStream) Seek(offset int64, whence int) (int64, error) {
+	// La source est en avance des octets gardés de côté
+	if whence == io.SeekCurrent {
+		offset -= int64(len(ps.remainder))
+	}
+	ps.remainder = nil
+	return ps.source.Seek(offset, whence)
+}
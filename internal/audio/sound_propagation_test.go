@@ -0,0 +1,255 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestSoundPropagationVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		position Vector2
+		want     float64
+	}{
+		{"sur l'auditeur", Vector2{X: 100, Y: 100}, 1},
+		{"à mi-distance", Vector2{X: 300, Y: 100}, 0.5},
+		{"à mi-distance en diagonale", Vector2{X: 100 + 200/math.Sqrt2, Y: 100 + 200/math.Sqrt2}, 0.5},
+		{"à la distance d'atténuation", Vector2{X: 100, Y: 500}, 0},
+		{"au-delà", Vector2{X: 1000, Y: 100}, 0},
+	}
+
+	sp := NewSoundPropagation()
+	sp.SetListener(Vector2{X: 100, Y: 100})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := SoundSource{Position: tt.position, RolloffDistance: 400}
+			if got := sp.Volume(source, 1, 1); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Volume = %.3f, attendu %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSoundPropagationScalesConfiguredVolumes(t *testing.T) {
+	sp := NewSoundPropagation()
+	source := SoundSource{Position: Vector2{X: 200}, RolloffDistance: 400}
+
+	// 0.8 × 0.5 × 0.5
+	if got := sp.Volume(source, 0.8, 0.5); math.Abs(got-0.2) > 1e-9 {
+		t.Errorf("Volume = %.3f, attendu 0.200", got)
+	}
+
+	// Sans distance d'atténuation, le son n'est pas positionné
+	if got := sp.Volume(SoundSource{Position: Vector2{X: 5000}}, 1, 1); got != 1 {
+		t.Errorf("Volume = %.3f, attendu 1 pour une source non positionnée", got)
+	}
+}
+
+func TestSoundPropagationPan(t *testing.T) {
+	tests := []struct {
+		name string
+		x    float64
+		want float64
+	}{
+		{"centré", 0, 0},
+		{"à droite", 100, 0.25},
+		{"à gauche", -200, -0.5},
+		{"tout à droite", 1000, 1},
+		{"tout à gauche", -1000, -1},
+	}
+
+	sp := NewSoundPropagation()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := SoundSource{Position: Vector2{X: tt.x, Y: 50}, RolloffDistance: 400}
+			if got := sp.Pan(source); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Pan = %.3f, attendu %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+// stereoFrame encode une trame PCM stéréo 16 bits
+func stereoFrame(left, right int16) []byte {
+	frame := make([]byte, 4)
+	binary.LittleEndian.PutUint16(frame, uint16(left))
+	binary.LittleEndian.PutUint16(frame[2:], uint16(right))
+	return frame
+}
+
+func TestPannedStreamChannelMixing(t *testing.T) {
+	tests := []struct {
+		name      string
+		pan       float64
+		wantLeft  int16
+		wantRight int16
+	}{
+		{"centré", 0, 1000, -1000},
+		{"à droite", 0.5, 500, -1000},
+		{"tout à gauche", -1, 1000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := NewPannedStream(bytes.NewReader(stereoFrame(1000, -1000)))
+			stream.SetPan(tt.pan)
+
+			data, err := io.ReadAll(stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+			left := int16(binary.LittleEndian.Uint16(data))
+			right := int16(binary.LittleEndian.Uint16(data[2:]))
+			if left != tt.wantLeft || right != tt.wantRight {
+				t.Errorf("trame = (%d, %d), attendu (%d, %d)", left, right, tt.wantLeft, tt.wantRight)
+			}
+		})
+	}
+}
+
+// chunkedSource flux rendu par morceaux de taille fixe, qui peuvent couper une trame
+type chunkedSource struct {
+	*bytes.Reader
+	chunk int
+}
+
+func (cs chunkedSource) Read(buffer []byte) (int, error) {
+	if len(buffer) > cs.chunk {
+		buffer = buffer[:cs.chunk]
+	}
+	return cs.Reader.Read(buffer)
+}
+
+func TestPannedStreamKeepsPartialFrames(t *testing.T) {
+	data := append(stereoFrame(1000, -1000), stereoFrame(2000, -2000)...)
+	data = append(data, stereoFrame(3000, -3000)...)
+
+	tests := []struct {
+		name  string
+		chunk int
+	}{
+		{"octet par octet", 1},
+		{"trois octets", 3},
+		{"six octets", 6},
+		{"d'un bloc", len(data)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := NewPannedStream(chunkedSource{bytes.NewReader(data), tt.chunk})
+			stream.SetPan(1) // Canal gauche coupé
+
+			mixed, err := io.ReadAll(stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(mixed) != len(data) {
+				t.Fatalf("%d octets lus, attendu %d", len(mixed), len(data))
+			}
+			for i := 0; i < len(mixed); i += 4 {
+				left := int16(binary.LittleEndian.Uint16(mixed[i:]))
+				right := int16(binary.LittleEndian.Uint16(mixed[i+2:]))
+				wantRight := int16(binary.LittleEndian.Uint16(data[i+2:]))
+				if left != 0 || right != wantRight {
+					t.Errorf("trame %d = (%d, %d), attendu (0, %d)", i/4, left, right, wantRight)
+				}
+			}
+		})
+	}
+}
+
+func TestPannedStreamReturnsWholeFrames(t *testing.T) {
+	stream := NewPannedStream(bytes.NewReader(append(stereoFrame(1000, 1000), stereoFrame(2000, 2000)...)))
+	stream.SetPan(-1)
+
+	// Une trame et demie demandée : la moitié de trame attend la lecture suivante
+	buffer := make([]byte, 6)
+	if n, err := stream.Read(buffer); n != 4 || err != nil {
+		t.Fatalf("Read = %d, %v, attendu 4, nil", n, err)
+	}
+	if n, _ := stream.Read(buffer); n != 4 {
+		t.Fatalf("Read = %d, attendu la seconde trame complète (4)", n)
+	}
+	if right := int16(binary.LittleEndian.Uint16(buffer[2:])); right != 0 {
+		t.Errorf("canal droit = %d, attendu 0", right)
+	}
+}
+
+// fakePlayer lecteur audio enregistrant le volume appliqué
+type fakePlayer struct {
+	playing bool
+	volume  float64
+}
+
+func (p *fakePlayer) Play()                    { p.playing = true }
+func (p *fakePlayer) IsPlaying() bool          { return p.playing }
+func (p *fakePlayer) SetVolume(volume float64) { p.volume = volume }
+
+type testConfig struct{ audio AudioConfig }
+
+func (c testConfig) GetAudio() AudioConfig { return c.audio }
+
+func TestAudioManagerUpdatesPlayingSounds(t *testing.T) {
+	am, err := NewAudioManager(testConfig{AudioConfig{MasterVolume: 1, SFXVolume: 1, EnableAudio: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	player := &fakePlayer{}
+	stream := NewPannedStream(bytes.NewReader(nil))
+	am.PlaySFX(player, stream, SoundSource{Position: Vector2{X: 200}, RolloffDistance: 400})
+
+	if !player.playing || math.Abs(player.volume-0.5) > 1e-9 {
+		t.Fatalf("volume initial = %.3f, attendu 0.5", player.volume)
+	}
+
+	// Le joueur se rapproche : le volume suit pendant la lecture
+	am.SetListenerPosition(Vector2{X: 200})
+	am.Update()
+	if player.volume != 1 || stream.Pan() != 0 {
+		t.Errorf("volume = %.3f, pan = %.2f, attendu 1 et 0", player.volume, stream.Pan())
+	}
+
+	// Un son terminé n'est plus mis à jour
+	player.playing = false
+	am.SetListenerPosition(Vector2{X: 600})
+	am.Update()
+	if player.volume != 1 {
+		t.Errorf("volume = %.3f, un son terminé ne doit plus être modifié", player.volume)
+	}
+}
+
+func TestSoundPoolPositionsEffects(t *testing.T) {
+	am, err := NewAudioManager(testConfig{AudioConfig{MasterVolume: 1, SFXVolume: 1, EnableAudio: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	player := &fakePlayer{}
+	stream := NewPannedStream(bytes.NewReader(nil))
+	pool := NewSoundPool(am)
+	pool.Register("sfx_heal", func(pitch float64) (Player, *PannedStream, error) {
+		return player, stream, nil
+	})
+
+	// Source à droite du joueur, à mi-distance d'atténuation
+	if !pool.Play("sfx_heal", 1, SoundSource{Position: Vector2{X: 200}, RolloffDistance: 400}) {
+		t.Fatal("Play = false, attendu true")
+	}
+	if !player.playing || math.Abs(player.volume-0.5) > 1e-9 || math.Abs(stream.Pan()-0.5) > 1e-9 {
+		t.Fatalf("volume = %.3f, pan = %.2f, attendu 0.5 et 0.5", player.volume, stream.Pan())
+	}
+
+	// Le joueur rejoint la source
+	pool.SetListener(Vector2{X: 200})
+	if player.volume != 1 || stream.Pan() != 0 {
+		t.Errorf("volume = %.3f, pan = %.2f, attendu 1 et 0", player.volume, stream.Pan())
+	}
+
+	if pool.Play("sfx_inconnu", 1, SoundSource{}) {
+		t.Error("Play d'un effet inconnu = true, attendu false")
+	}
+}
@@ -0,0 +1,97 @@
+// internal/core/accessibility.go - Options d'accessibilité (daltonisme, taille de l'UI)
+package core
+
+import "math"
+
+// Modes de daltonisme
+const (
+	ColorblindNone         = "none"
+	ColorblindDeuteranopia = "deuteranopia"
+	ColorblindProtanopia   = "protanopia"
+	ColorblindTritanopia   = "tritanopia"
+)
+
+// ColorblindModes liste des modes dans l'ordre du panneau d'options
+var ColorblindModes = []string{
+	ColorblindNone,
+	ColorblindDeuteranopia,
+	ColorblindProtanopia,
+	ColorblindTritanopia,
+}
+
+// UIScales échelles proposées dans le panneau d'options
+var UIScales = []float64{1.0, 1.25, 1.5, 2.0}
+
+// ColorMatrix matrice 3x3 appliquée aux composantes RGB
+type ColorMatrix [3][3]float64
+
+// Matrices de remappage de la palette pour chaque type de daltonisme
+var colorblindMatrices = map[string]ColorMatrix{
+	ColorblindDeuteranopia: {
+		{0.625, 0.375, 0.0},
+		{0.700, 0.300, 0.0},
+		{0.000, 0.300, 0.7},
+	},
+	ColorblindProtanopia: {
+		{0.567, 0.433, 0.000},
+		{0.558, 0.442, 0.000},
+		{0.000, 0.242, 0.758},
+	},
+	ColorblindTritanopia: {
+		{0.95, 0.050, 0.000},
+		{0.00, 0.433, 0.567},
+		{0.00, 0.475, 0.525},
+	},
+}
+
+// ColorblindMatrix retourne la matrice d'un mode ; false pour "none" ou un mode inconnu
+func ColorblindMatrix(mode string) (ColorMatrix, bool) {
+	matrix, exists := colorblindMatrices[mode]
+	return matrix, exists
+}
+
+// IsValidColorblindMode vérifie qu'un mode de daltonisme est connu
+func IsValidColorblindMode(mode string) bool {
+	for _, known := range ColorblindModes {
+		if mode == known {
+			return true
+		}
+	}
+	return false
+}
+
+// NextColorblindMode retourne le mode suivant (cycle du panneau d'options)
+func NextColorblindMode(mode string) string {
+	for i, known := range ColorblindModes {
+		if mode == known {
+			return ColorblindModes[(i+1)%len(ColorblindModes)]
+		}
+	}
+	return ColorblindNone
+}
+
+// NextUIScale retourne l'échelle suivante (cycle du panneau d'options)
+func NextUIScale(scale float64) float64 {
+	for i, known := range UIScales {
+		if math.Abs(scale-known) < 0.01 {
+			return UIScales[(i+1)%len(UIScales)]
+		}
+	}
+	return UIScales[0]
+}
+
+// Apply remappe une couleur ; l'alpha est conservé
+func (m ColorMatrix) Apply(c Color) Color {
+	r, g, b := float64(c.R), float64(c.G), float64(c.B)
+	return Color{
+		R: clampChannel(m[0][0]*r + m[0][1]*g + m[0][2]*b),
+		G: clampChannel(m[1][0]*r + m[1][1]*g + m[1][2]*b),
+		B: clampChannel(m[2][0]*r + m[2][1]*g + m[2][2]*b),
+		A: c.A,
+	}
+}
+
+// clampChannel arrondit et borne une composante de couleur
+func clampChannel(value float64) uint8 {
+	return uint8(math.Max(0, math.Min(255, math.Round(value))))
+}
@@ -0,0 +1,62 @@
+package core
+
+import "testing"
+
+func TestDeuteranopiaMatrixOnRed(t *testing.T) {
+	matrix, ok := ColorblindMatrix(ColorblindDeuteranopia)
+	if !ok {
+		t.Fatal("matrice deutéranopie absente")
+	}
+
+	// Rouge pur : R = 0.625×255 ≈ 159, G = 0.7×255 ≈ 179, B = 0 ; l'alpha est conservé
+	got := matrix.Apply(Color{R: 255, G: 0, B: 0, A: 200})
+	want := Color{R: 159, G: 179, B: 0, A: 200}
+	if got != want {
+		t.Errorf("Apply(rouge) = %+v, attendu %+v", got, want)
+	}
+}
+
+func TestColorblindMatricesPreserveGreys(t *testing.T) {
+	// Chaque ligne somme à 1 : les gris (dont blanc et noir) restent inchangés
+	for _, mode := range ColorblindModes[1:] {
+		t.Run(mode, func(t *testing.T) {
+			matrix, ok := ColorblindMatrix(mode)
+			if !ok {
+				t.Fatalf("matrice %s absente", mode)
+			}
+			for _, grey := range []Color{{0, 0, 0, 255}, {128, 128, 128, 255}, {255, 255, 255, 255}} {
+				if got := matrix.Apply(grey); got != grey {
+					t.Errorf("Apply(%+v) = %+v, attendu inchangé", grey, got)
+				}
+			}
+		})
+	}
+}
+
+func TestColorblindNoneHasNoMatrix(t *testing.T) {
+	for _, mode := range []string{ColorblindNone, "inconnu", ""} {
+		if _, ok := ColorblindMatrix(mode); ok {
+			t.Errorf("ColorblindMatrix(%q) ne doit pas retourner de matrice", mode)
+		}
+	}
+}
+
+func TestAccessibilityCycles(t *testing.T) {
+	mode := ColorblindNone
+	for range ColorblindModes {
+		mode = NextColorblindMode(mode)
+		if !IsValidColorblindMode(mode) {
+			t.Fatalf("mode %q invalide dans le cycle", mode)
+		}
+	}
+	if mode != ColorblindNone {
+		t.Errorf("le cycle des modes revient à %q, attendu %q", mode, ColorblindNone)
+	}
+
+	if got := NextUIScale(2.0); got != 1.0 {
+		t.Errorf("NextUIScale(2.0) = %.2f, attendu 1.00", got)
+	}
+	if got := NextUIScale(1.1); got != 1.0 {
+		t.Errorf("NextUIScale(1.1) = %.2f, attendu 1.00 pour une échelle hors liste", got)
+	}
+}
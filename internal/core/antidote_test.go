@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAntidoteCuresAllStacks(t *testing.T) {
+	tests := []struct {
+		name          string
+		antidotes     int
+		exposure      time.Duration
+		wantUsed      bool
+		wantAntidotes int
+	}{
+		{"poison aggravé", 2, 6 * time.Second, true, 1},
+		{"sans antidote", 0, 6 * time.Second, false, 0},
+		{"joueur sain", 1, 0, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esm := NewEnhancedBuiltinStateManager(1280, 720)
+			esm.startNewGame()
+			if tt.antidotes > 0 {
+				esm.inventory.Add(AntidoteItemID, tt.antidotes)
+			}
+			if tt.exposure > 0 {
+				esm.statusEffects.Poison.Tick(tt.exposure, true)
+			}
+			stacks := esm.statusEffects.Poison.Stacks
+
+			if used := esm.useAntidote(); used != tt.wantUsed {
+				t.Fatalf("useAntidote = %t, attendu %t", used, tt.wantUsed)
+			}
+			if count := esm.inventory.Count(AntidoteItemID); count != tt.wantAntidotes {
+				t.Errorf("antidotes restants = %d, attendu %d", count, tt.wantAntidotes)
+			}
+
+			wantStacks := stacks
+			if tt.wantUsed {
+				wantStacks = 0
+			}
+			if esm.statusEffects.Poison.Stacks != wantStacks {
+				t.Errorf("cumuls = %d, attendu %d", esm.statusEffects.Poison.Stacks, wantStacks)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// internal/core/boss_bar.go - Barre de vie du boss
+package core
+
+import (
+	"fmt"
+	"time"
+	"zelda-souls-game/internal/ecs/systems"
+)
+
+// BossBar affiche la vie du boss engagé en haut de l'écran.
+// La barre se vide progressivement vers la valeur réelle.
+type BossBar struct {
+	// Disposition
+	screenWidth int
+	width       float64
+	height      float64
+	top         float64
+
+	// Vitesse de vidage (fraction de la barre par seconde)
+	DrainSpeed float64
+
+	// Style
+	BackgroundColor Color
+	DrainColor      Color
+	HealthColor     Color
+	BorderColor     Color
+	TextOptions     TextRenderOptions // Ombre et contour du nom et des points de vie
+
+	// État
+	bossName      string
+	health        int
+	maxHealth     int
+	displayed     float64 // Ratio affiché, animé vers le ratio réel
+	visible       bool
+	currentBossID uint32
+}
+
+// NewBossBar crée une nouvelle barre de boss
+func NewBossBar(screenWidth int) *BossBar {
+	return &BossBar{
+		screenWidth: screenWidth,
+		width:       float64(screenWidth) * 0.5,
+		height:      14.0,
+		top:         40.0, // Sous la ligne de titre du gameplay
+
+		DrainSpeed: 0.4,
+
+		BackgroundColor: Color{20, 20, 20, 220},
+		DrainColor:      Color{230, 200, 120, 255},
+		HealthColor:     Color{170, 20, 20, 255},
+		BorderColor:     Color{200, 200, 200, 255},
+		TextOptions:     TextRenderOptions{Shadow: true, ShadowOffset: Vector2{X: 1, Y: 1}},
+	}
+}
+
+// Update suit le boss engagé (nil si aucun) et anime le vidage
+func (bb *BossBar) Update(deltaTime time.Duration, boss *systems.EnemyEntity) {
+	if boss == nil || !boss.Active || !boss.Enemy.IsAlive() {
+		bb.visible = false
+		return
+	}
+
+	enemy := boss.Enemy
+
+	// Nouveau boss : la barre démarre à sa vie actuelle
+	if !bb.visible || bb.currentBossID != boss.EntityID {
+		bb.currentBossID = boss.EntityID
+		bb.displayed = bb.ratio(enemy.Health, enemy.MaxHealth)
+	}
+
+	bb.visible = true
+	bb.bossName = enemy.Name
+	bb.health = enemy.Health
+	bb.maxHealth = enemy.MaxHealth
+
+	target := bb.ratio(bb.health, bb.maxHealth)
+	step := bb.DrainSpeed * deltaTime.Seconds()
+	if bb.displayed > target {
+		bb.displayed -= step
+		if bb.displayed < target {
+			bb.displayed = target
+		}
+	} else {
+		// Les soins sont affichés immédiatement
+		bb.displayed = target
+	}
+}
+
+// IsVisible retourne si la barre est affichée
+func (bb *BossBar) IsVisible() bool {
+	return bb.visible
+}
+
+// Render dessine la barre centrée en haut de l'écran
+func (bb *BossBar) Render(renderer Renderer) {
+	if !bb.visible {
+		return
+	}
+
+	x := (float64(bb.screenWidth) - bb.width) / 2
+	y := bb.top + 16 // Place pour le nom au-dessus
+
+	drawStyledText(renderer, bb.TextOptions, bb.bossName, Vector2{x, y - 4}, ColorWhite)
+
+	bar := Rectangle{X: x, Y: y, Width: bb.width, Height: bb.height}
+	renderer.DrawRectangle(bar, bb.BackgroundColor, true)
+
+	// Partie en cours de vidage, puis vie réelle par-dessus
+	if bb.displayed > 0 {
+		drain := Rectangle{X: x, Y: y, Width: bb.width * bb.displayed, Height: bb.height}
+		renderer.DrawRectangle(drain, bb.DrainColor, true)
+	}
+	if ratio := bb.ratio(bb.health, bb.maxHealth); ratio > 0 {
+		fill := Rectangle{X: x, Y: y, Width: bb.width * ratio, Height: bb.height}
+		renderer.DrawRectangle(fill, bb.HealthColor, true)
+	}
+
+	renderer.DrawRectangle(bar, bb.BorderColor, false)
+
+	healthText := fmt.Sprintf("%d/%d", bb.health, bb.maxHealth)
+	textX := x + bb.width - float64(len(healthText)*7) - 4
+	drawStyledText(renderer, bb.TextOptions, healthText, Vector2{textX, y - 4}, ColorWhite)
+}
+
+func (bb *BossBar) ratio(health, maxHealth int) float64 {
+	if maxHealth <= 0 {
+		return 0
+	}
+	ratio := float64(health) / float64(maxHealth)
+	if ratio < 0 {
+		return 0
+	}
+	if ratio > 1 {
+		return 1
+	}
+	return ratio
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"time"
+	"zelda-souls-game/internal/localization"
 )
 
 // Button structure intégrée dans core
@@ -14,10 +15,12 @@ type Button struct {
 	Enabled bool
 	Visible bool
 	OnClick func()
+	Tooltip string // Infobulle affichée au survol ("" : aucune)
 
 	// État
-	State      int // 0=normal, 1=hover, 2=pressed, 3=disabled
-	wasPressed bool
+	State        int // 0=normal, 1=hover, 2=pressed, 3=disabled
+	wasPressed   bool
+	pressStarted bool // L'appui en cours a commencé sur ce bouton
 
 	// Couleurs
 	NormalColor   Color
@@ -52,22 +55,52 @@ func (b *Button) Contains(point Vector2) bool {
 		point.Y <= b.Bounds.Y+b.Bounds.Height
 }
 
+// GetTooltip implémente Tooltipped ; un bouton masqué n'a pas d'infobulle
+func (b *Button) GetTooltip() string {
+	if !b.Visible {
+		return ""
+	}
+	return b.Tooltip
+}
+
+// CanFocus implémente Focusable : seul un bouton visible et actif se sélectionne
+func (b *Button) CanFocus() bool {
+	return b.Enabled && b.Visible
+}
+
+// Activate implémente Focusable : équivaut à un clic
+func (b *Button) Activate() {
+	if b.OnClick != nil {
+		b.OnClick()
+	}
+}
+
 // Update met à jour le bouton
 func (b *Button) Update(mousePos Vector2, mousePressed bool) {
 	if !b.Visible || !b.Enabled {
 		b.State = 3 // disabled
+		b.pressStarted = false
 		return
 	}
 
 	isHovering := b.Contains(mousePos)
 
+	// Début d'un appui : on retient s'il a commencé sur le bouton
+	if mousePressed && !b.wasPressed {
+		b.pressStarted = isHovering
+	}
+
+	// Relâchement : le clic n'est valide que si l'appui a commencé
+	// et se termine sur le même bouton
+	if !mousePressed && b.wasPressed {
+		if isHovering && b.pressStarted && b.OnClick != nil {
+			b.OnClick()
+		}
+		b.pressStarted = false
+	}
+
 	if isHovering {
-		if mousePressed && !b.wasPressed {
-			b.State = 2 // pressed
-			if b.OnClick != nil {
-				b.OnClick()
-			}
-		} else if mousePressed {
+		if mousePressed && b.pressStarted {
 			b.State = 2 // pressed
 		} else {
 			b.State = 1 // hover
@@ -147,6 +180,9 @@ type BuiltinStateManager struct {
 	// Souris
 	mousePos     Vector2
 	mousePressed bool
+
+	// Textes de l'interface
+	localizer *localization.Localizer
 }
 
 // NewBuiltinStateManager crée un gestionnaire d'états avec menu
@@ -157,6 +193,7 @@ func NewBuiltinStateManager(screenWidth, screenHeight int) *BuiltinStateManager
 		showInstructions: true,
 		screenWidth:      screenWidth,
 		screenHeight:     screenHeight,
+		localizer:        localization.Default(),
 	}
 
 	// Créer le joueur au centre de l'écran
@@ -182,7 +219,7 @@ func (bsm *BuiltinStateManager) createButtons() {
 		startY-buttonSpacing,
 		buttonWidth,
 		buttonHeight,
-		"Nouvelle Partie",
+		bsm.localizer.Get("ui.menu.new_game"),
 		func() {
 			log.Println("Nouvelle Partie cliquée")
 			bsm.ChangeState("gameplay")
@@ -200,7 +237,7 @@ func (bsm *BuiltinStateManager) createButtons() {
 		startY,
 		buttonWidth,
 		buttonHeight,
-		"Charger Partie",
+		bsm.localizer.Get("ui.menu.load_game"),
 		func() {
 			log.Println("Charger Partie cliquée")
 			if bsm.onLoadGame != nil {
@@ -215,7 +252,7 @@ func (bsm *BuiltinStateManager) createButtons() {
 		startY+buttonSpacing,
 		buttonWidth,
 		buttonHeight,
-		"Quitter",
+		bsm.localizer.Get("ui.menu.quit"),
 		func() {
 			log.Println("Quitter cliqué")
 			if bsm.onQuitGame != nil {
@@ -304,11 +341,12 @@ func (bsm *BuiltinStateManager) Render(renderer Renderer) error {
 // renderMenuState rend l'état menu
 func (bsm *BuiltinStateManager) renderMenuState(renderer Renderer) {
 	// Titre
-	titleX := float64(bsm.screenWidth)/2 - float64(len("ZELDA SOULS")*12)/2
-	renderer.DrawText("ZELDA SOULS", Vector2{titleX, 100}, ColorYellow)
+	title := bsm.localizer.Get("ui.title")
+	titleX := float64(bsm.screenWidth)/2 - float64(len(title)*12)/2
+	renderer.DrawText(title, Vector2{titleX, 100}, ColorYellow)
 
 	// Sous-titre
-	subtitle := "Adventure Awaits"
+	subtitle := bsm.localizer.Get("ui.subtitle")
 	subtitleX := float64(bsm.screenWidth)/2 - float64(len(subtitle)*8)/2
 	renderer.DrawText(subtitle, Vector2{subtitleX, 140}, Color{200, 200, 200, 255})
 
@@ -319,7 +357,7 @@ func (bsm *BuiltinStateManager) renderMenuState(renderer Renderer) {
 
 	// Instructions
 	instructionY := float64(bsm.screenHeight) - 50
-	instruction := "Utilisez la souris pour naviguer"
+	instruction := bsm.localizer.Get("ui.menu.hint")
 	instrX := float64(bsm.screenWidth)/2 - float64(len(instruction)*8)/2
 	renderer.DrawText(instruction, Vector2{instrX, instructionY}, Color{150, 150, 150, 255})
 }
@@ -332,25 +370,25 @@ func (bsm *BuiltinStateManager) renderGameplayState(renderer Renderer) {
 	}
 
 	// Interface de jeu
-	renderer.DrawText("=== JEU EN COURS ===", Vector2{10, 10}, ColorWhite)
-	renderer.DrawText("ESC - Retour menu", Vector2{10, 30}, ColorGreen)
+	renderer.DrawText(bsm.localizer.Get("ui.gameplay.title"), Vector2{10, 10}, ColorWhite)
+	renderer.DrawText(bsm.localizer.Get("ui.gameplay.back_to_menu"), Vector2{10, 30}, ColorGreen)
 
 	if bsm.showInstructions {
-		renderer.DrawText("ZQSD - Mouvement", Vector2{10, 60}, ColorWhite)
-		renderer.DrawText("I - Toggle instructions", Vector2{10, 80}, ColorWhite)
+		renderer.DrawText(bsm.localizer.Get("ui.gameplay.help.move"), Vector2{10, 60}, ColorWhite)
+		renderer.DrawText(bsm.localizer.Get("ui.gameplay.help.instructions"), Vector2{10, 80}, ColorWhite)
 	}
 
 	// Infos du joueur
 	if bsm.player != nil {
-		playerInfo := fmt.Sprintf("Joueur: (%.0f,%.0f)", bsm.player.Position.X, bsm.player.Position.Y)
+		playerInfo := bsm.localizer.Get("ui.player.coords", bsm.player.Position.X, bsm.player.Position.Y)
 		renderer.DrawText(playerInfo, Vector2{10, 120}, ColorYellow)
 
 		if bsm.player.Moving {
-			renderer.DrawText(fmt.Sprintf("Direction: %s", bsm.player.directionString()), Vector2{10, 140}, ColorYellow)
+			renderer.DrawText(bsm.localizer.Get("ui.player.direction", bsm.player.directionString()), Vector2{10, 140}, ColorYellow)
 		}
 	}
 
-	frameText := fmt.Sprintf("Frames: %d", bsm.frameCount)
+	frameText := bsm.localizer.Get("ui.stats.frames", bsm.frameCount)
 	renderer.DrawText(frameText, Vector2{10, 180}, ColorWhite)
 }
 
@@ -4,7 +4,10 @@ package core
 import (
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
 
 	"gopkg.in/yaml.v3"
 )
@@ -19,6 +22,9 @@ type GameConfig struct {
 	GameTitle   string `yaml:"game_title"`
 	GameVersion string `yaml:"game_version"`
 
+	// Langue de l'interface (assets/data/strings/<lang>.json)
+	Language string `yaml:"language"`
+
 	// Configuration de la fenêtre
 	Window WindowConfig `yaml:"window"`
 
@@ -34,9 +40,15 @@ type GameConfig struct {
 	// Configuration du gameplay
 	Gameplay GameplayConfig `yaml:"gameplay"`
 
+	// Options d'accessibilité
+	Accessibility AccessibilityConfig `yaml:"accessibility"`
+
 	// Configuration de débogage
 	Debug DebugConfig `yaml:"debug"`
 
+	// Classement en ligne
+	Leaderboard LeaderboardConfig `yaml:"leaderboard"`
+
 	// Chemins des ressources
 	Paths PathsConfig `yaml:"paths"`
 }
@@ -54,7 +66,8 @@ type WindowConfig struct {
 
 // RenderingConfig configuration du rendu
 type RenderingConfig struct {
-	TargetFPS      int     `yaml:"target_fps"`
+	TargetFPS      int     `yaml:"target_fps"` // Mises à jour par seconde (et plafond des FPS)
+	CapFPS         bool    `yaml:"cap_fps"`    // Sans VSync, plafonne les FPS à TargetFPS
 	TileSize       int     `yaml:"tile_size"`
 	ChunkSize      int     `yaml:"chunk_size"`
 	MaxDrawCalls   int     `yaml:"max_draw_calls"`
@@ -69,11 +82,39 @@ type RenderingConfig struct {
 	EnableShadows        bool `yaml:"enable_shadows"`
 	EnablePostProcessing bool `yaml:"enable_post_processing"`
 
+	// Effets de post-traitement, dans l'ordre : vignette, color_grade, bloom
+	PostEffects []string `yaml:"post_effects"`
+
+	// Éclairage 2D : opacité de l'obscurité hors des lumières (0 à 1)
+	AmbientDarkness float64 `yaml:"ambient_darkness"`
+
+	// Ondulation des tuiles d'eau (shader)
+	WaterAmplitude float64 `yaml:"water_amplitude"` // en pixels
+	WaterFrequency float64 `yaml:"water_frequency"` // en radians par pixel
+	WaterSpeed     float64 `yaml:"water_speed"`     // en radians par seconde
+
+	// Lisibilité du texte du HUD sur les fonds variés
+	HUDText TextRenderOptions `yaml:"hud_text"`
+
+	// Teinte globale de la scène selon l'heure
+	DayNight DayNightConfig `yaml:"day_night"`
+
+	// Marge (pixels) autour des entités cadrées ensemble par la caméra
+	// (Camera.SetMultiTarget : coop, boss et invocations)
+	CameraMultiTargetPadding float64 `yaml:"camera_multi_target_padding"`
+
 	// Qualité
 	TextureQuality  string `yaml:"texture_quality"` // "low", "medium", "high"
 	ParticleQuality string `yaml:"particle_quality"`
 }
 
+// TextRenderOptions effets de lisibilité du texte
+type TextRenderOptions struct {
+	Shadow       bool    `yaml:"shadow"`        // Ombre portée
+	ShadowOffset Vector2 `yaml:"shadow_offset"` // Décalage de l'ombre, en pixels
+	Stroke       bool    `yaml:"stroke"`        // Contour d'un pixel dans les quatre directions
+}
+
 // AudioConfig configuration audio
 type AudioConfig struct {
 	MasterVolume float64 `yaml:"master_volume"`
@@ -84,6 +125,9 @@ type AudioConfig struct {
 	SampleRate   int     `yaml:"sample_rate"`
 	BufferSize   int     `yaml:"buffer_size"`
 	MaxSounds    int     `yaml:"max_sounds"`
+
+	// Distance (pixels) parcourue entre deux bruits de pas
+	FootstepInterval float64 `yaml:"footstep_interval"`
 }
 
 // InputConfig configuration des contrôles
@@ -92,7 +136,10 @@ type InputConfig struct {
 	MouseEnabled    bool `yaml:"mouse_enabled"`
 	GamepadEnabled  bool `yaml:"gamepad_enabled"`
 
-	// Sensibilité
+	// Sensibilité. Celle de la souris règle la vitesse à laquelle la visée
+	// rattrape le curseur : à 1.0, elle le rejoint en un dixième de seconde
+	// environ ; 2.0 deux fois plus vite, 0.5 deux fois plus lentement.
+	// Ramenée entre MinMouseSensitivity et MaxMouseSensitivity.
 	MouseSensitivity   float64 `yaml:"mouse_sensitivity"`
 	GamepadSensitivity float64 `yaml:"gamepad_sensitivity"`
 
@@ -102,6 +149,13 @@ type InputConfig struct {
 
 	// Zones mortes
 	GamepadDeadzone float64 `yaml:"gamepad_deadzone"`
+
+	// Le joueur fait face au curseur de la souris plutôt qu'à son déplacement
+	MouseAim bool `yaml:"mouse_aim"`
+
+	// Facteur de zoom de la caméra par cran de molette ou appui sur
+	// camera_zoom_in / camera_zoom_out (DefaultCameraZoomStep si <= 1)
+	CameraZoomStep float64 `yaml:"camera_zoom_step"`
 }
 
 // GameplayConfig configuration du gameplay
@@ -123,6 +177,41 @@ type GameplayConfig struct {
 	InvulnerabilityTime float64 `yaml:"invulnerability_time"`
 	PerfectBlockWindow  float64 `yaml:"perfect_block_window"`
 
+	// Déplacement
+	PlayerMovement MovementConfig `yaml:"player_movement"`
+	EnemyMovement  MovementConfig `yaml:"enemy_movement"`
+
+	// Recherche de chemin des ennemis
+	PathRecomputeInterval float64 `yaml:"path_recompute_interval"` // en secondes, par ennemi
+	PathMaxSearchNodes    int     `yaml:"path_max_search_nodes"`   // Tuiles explorées au plus par calcul
+
+	// Séparation des ennemis qui poursuivent le joueur
+	EnemySeparationRadius float64 `yaml:"enemy_separation_radius"` // en pixels
+	EnemySeparationWeight float64 `yaml:"enemy_separation_weight"` // Poids face à la poursuite
+
+	// Distance minimale (pixels) entre entités placées selon la densité
+	MinSpawnSeparation float64 `yaml:"min_spawn_separation"`
+
+	// Fioles de soin, remplies aux feux de camp
+	MaxHealCharges int `yaml:"max_heal_charges"`
+	HealAmount     int `yaml:"heal_amount"` // Points de vie rendus par fiole
+
+	// Multiplicateur de dégâts des coups critiques du joueur
+	CriticalMultiplier float64 `yaml:"critical_multiplier"`
+
+	// Armes : caractéristiques par identifiant, et arme équipée au départ
+	Weapons        map[string]WeaponConfig `yaml:"weapons"`
+	EquippedWeapon string                  `yaml:"equipped_weapon"`
+
+	// Chaîne d'éclairs du joueur
+	ChainLightningMaxBounces int     `yaml:"chain_lightning_max_bounces"`
+	ChainLightningRange      float64 `yaml:"chain_lightning_range"` // Portée d'un rebond, en pixels
+
+	// Bavardages des PNJ : une phrase à intervalle aléatoire, en secondes
+	NPCChatterMinInterval float64 `yaml:"npc_chatter_min_interval"`
+	NPCChatterMaxInterval float64 `yaml:"npc_chatter_max_interval"`
+	NPCBubbleDuration     float64 `yaml:"npc_bubble_duration"` // Durée d'affichage d'une bulle
+
 	// Monde
 	EnemyRespawnTime float64 `yaml:"enemy_respawn_time"`
 	ItemDespawnTime  float64 `yaml:"item_despawn_time"`
@@ -130,6 +219,86 @@ type GameplayConfig struct {
 	// Sauvegarde
 	AutoSaveEnabled  bool    `yaml:"auto_save_enabled"`
 	AutoSaveInterval float64 `yaml:"auto_save_interval"` // en minutes
+
+	// Mort du joueur
+	DeathFadeDuration  float64 `yaml:"death_fade_duration"`   // en secondes
+	DeathFadeTint      Color   `yaml:"death_fade_tint"`       // Couleur du voile
+	DeathFadeTimeScale float64 `yaml:"death_fade_time_scale"` // Ralenti pendant le voile
+}
+
+// MovementConfig courbe d'accélération d'un type d'entité.
+// Vitesses en pixels/s, accélération et friction en pixels/s².
+type MovementConfig struct {
+	Speed          float64 `yaml:"speed"`
+	MaxSpeed       float64 `yaml:"max_speed"`
+	Acceleration   float64 `yaml:"acceleration"`
+	Friction       float64 `yaml:"friction"`
+	Responsiveness float64 `yaml:"responsiveness"` // 0..1, 1 = accélération constante
+}
+
+// Profile convertit la configuration en profil de déplacement ECS
+func (mc MovementConfig) Profile() components.MovementProfile {
+	return components.MovementProfile{
+		Speed:          mc.Speed,
+		MaxSpeed:       mc.MaxSpeed,
+		Acceleration:   mc.Acceleration,
+		Friction:       mc.Friction,
+		Responsiveness: mc.Responsiveness,
+	}
+}
+
+// WeaponConfig caractéristiques d'une arme ; rythme en secondes depuis le début de l'attaque
+type WeaponConfig struct {
+	Name        string  `yaml:"name"`         // Nom affiché (identifiant par défaut)
+	BaseDamage  int     `yaml:"base_damage"`  // Ajouté à la puissance d'attaque du joueur
+	StaminaCost float64 `yaml:"stamina_cost"` // Coût d'une attaque légère
+	AttackSpeed float64 `yaml:"attack_speed"` // Multiplicateur de vitesse du rythme
+	Range       float64 `yaml:"range"`        // Portée, en pixels
+	PoiseDamage float64 `yaml:"poise_damage"` // Équilibre entamé par coup léger
+	Knockback   float64 `yaml:"knockback"`    // Recul infligé, en pixels
+
+	CanDestroyTiles bool `yaml:"can_destroy_tiles"` // Brise les murs destructibles
+
+	CommitDuration float64 `yaml:"commit_duration"` // Durée pendant laquelle le joueur est engagé
+	ActiveStart    float64 `yaml:"active_start"`    // Début des frames actives
+	ActiveEnd      float64 `yaml:"active_end"`      // Fin des frames actives
+	MoveFactor     float64 `yaml:"move_factor"`     // 0..1, part du déplacement conservée
+}
+
+// Weapon convertit la configuration d'une arme en arme ECS
+func (wc WeaponConfig) Weapon(id string) components.Weapon {
+	return components.Weapon{
+		ID:              id,
+		Name:            wc.Name,
+		BaseDamage:      wc.BaseDamage,
+		StaminaCost:     wc.StaminaCost,
+		AttackSpeed:     wc.AttackSpeed,
+		Range:           wc.Range,
+		PoiseDamage:     wc.PoiseDamage,
+		Knockback:       wc.Knockback,
+		CanDestroyTiles: wc.CanDestroyTiles,
+		CommitDuration:  time.Duration(wc.CommitDuration * float64(time.Second)),
+		ActiveStart:     time.Duration(wc.ActiveStart * float64(time.Second)),
+		ActiveEnd:       time.Duration(wc.ActiveEnd * float64(time.Second)),
+		MoveFactor:      wc.MoveFactor,
+	}
+}
+
+// WeaponCatalog retourne toutes les armes configurées, par identifiant
+func (gc GameplayConfig) WeaponCatalog() map[string]components.Weapon {
+	catalog := make(map[string]components.Weapon, len(gc.Weapons))
+	for id, weapon := range gc.Weapons {
+		catalog[id] = weapon.Weapon(id)
+	}
+	return catalog
+}
+
+// AccessibilityConfig options d'accessibilité
+type AccessibilityConfig struct {
+	ColorblindMode string  `yaml:"colorblind_mode"` // "none", "deuteranopia", "protanopia", "tritanopia"
+	UIScale        float64 `yaml:"ui_scale"`        // Multiplicateur de taille de l'UI
+	HighContrast   bool    `yaml:"high_contrast"`   // Texte contouré pour plus de lisibilité
+	ReduceMotion   bool    `yaml:"reduce_motion"`   // Désactive particules et tremblements de caméra
 }
 
 // DebugConfig configuration de débogage
@@ -140,12 +309,24 @@ type DebugConfig struct {
 	ShowEntityInfo   bool   `yaml:"show_entity_info"`
 	ShowChunkBorders bool   `yaml:"show_chunk_borders"`
 	ShowPathfinding  bool   `yaml:"show_pathfinding"`
+	ShowRenderStats  bool   `yaml:"show_render_stats"` // Statistiques du renderer dans un coin
 	EnableGodMode    bool   `yaml:"enable_god_mode"`
 	EnableNoclip     bool   `yaml:"enable_noclip"`
 	LogLevel         string `yaml:"log_level"` // "debug", "info", "warn", "error"
 	ConsoleEnabled   bool   `yaml:"console_enabled"`
 }
 
+// LeaderboardConfig classement en ligne : scores envoyés en fin de partie,
+// meilleurs scores affichés depuis le menu
+type LeaderboardConfig struct {
+	Enabled    bool    `yaml:"enabled"`
+	URL        string  `yaml:"url"`     // Les requêtes visent <url>/scores
+	APIKey     string  `yaml:"api_key"` // Envoyée en « Authorization: Bearer »
+	PlayerName string  `yaml:"player_name"`
+	Timeout    float64 `yaml:"timeout"` // Secondes par tentative
+	TopCount   int     `yaml:"top_count"`
+}
+
 // PathsConfig chemins des ressources
 type PathsConfig struct {
 	AssetsDir      string `yaml:"assets_dir"`
@@ -230,6 +411,14 @@ func (c *GameConfig) Validate() error {
 		return fmt.Errorf("volume master invalide: %f", c.Audio.MasterVolume)
 	}
 
+	// Validation de l'accessibilité
+	if !IsValidColorblindMode(c.Accessibility.ColorblindMode) {
+		return fmt.Errorf("mode daltonien invalide: %s", c.Accessibility.ColorblindMode)
+	}
+	if c.Accessibility.UIScale < 0.5 || c.Accessibility.UIScale > 3.0 {
+		return fmt.Errorf("échelle d'UI invalide: %f", c.Accessibility.UIScale)
+	}
+
 	// Validation des chemins
 	if c.Paths.AssetsDir == "" {
 		return fmt.Errorf("répertoire d'assets non spécifié")
@@ -243,6 +432,7 @@ func GetDefaultConfig() *GameConfig {
 	return &GameConfig{
 		GameTitle:   "Zelda Souls Game",
 		GameVersion: "0.1.0",
+		Language:    "fr",
 
 		Window: WindowConfig{
 			Width:      1280,
@@ -256,6 +446,7 @@ func GetDefaultConfig() *GameConfig {
 
 		Rendering: RenderingConfig{
 			TargetFPS:            60,
+			CapFPS:               true,
 			TileSize:             32,
 			ChunkSize:            16,
 			MaxDrawCalls:         1000,
@@ -267,8 +458,22 @@ func GetDefaultConfig() *GameConfig {
 			EnableLighting:       false,
 			EnableShadows:        false,
 			EnablePostProcessing: false,
-			TextureQuality:       "high",
-			ParticleQuality:      "medium",
+			PostEffects:          []string{"vignette", "color_grade"},
+			AmbientDarkness:      DefaultAmbientDarkness,
+			WaterAmplitude:       1.5,
+			WaterFrequency:       0.35,
+			WaterSpeed:           2.5,
+			HUDText: TextRenderOptions{
+				Shadow:       true,
+				ShadowOffset: Vector2{X: 1, Y: 1},
+			},
+			DayNight: DayNightConfig{
+				CycleLength: DefaultDayLength.Seconds(),
+				StartTime:   DefaultDayStart,
+			},
+			CameraMultiTargetPadding: 64.0,
+			TextureQuality:           "high",
+			ParticleQuality:          "medium",
 		},
 
 		Audio: AudioConfig{
@@ -280,6 +485,8 @@ func GetDefaultConfig() *GameConfig {
 			SampleRate:   44100,
 			BufferSize:   1024,
 			MaxSounds:    32,
+
+			FootstepInterval: 24.0,
 		},
 
 		Input: InputConfig{
@@ -289,6 +496,7 @@ func GetDefaultConfig() *GameConfig {
 			MouseSensitivity:   1.0,
 			GamepadSensitivity: 1.0,
 			GamepadDeadzone:    0.15,
+			CameraZoomStep:     DefaultCameraZoomStep,
 			KeyMapping:         getDefaultKeyMapping(),
 			GamepadMapping:     getDefaultGamepadMapping(),
 		},
@@ -303,10 +511,62 @@ func GetDefaultConfig() *GameConfig {
 			StaminaPenalty:        0.5,
 			InvulnerabilityTime:   1.0,
 			PerfectBlockWindow:    0.2,
-			EnemyRespawnTime:      30.0,
-			ItemDespawnTime:       300.0,
-			AutoSaveEnabled:       true,
-			AutoSaveInterval:      5.0,
+			PlayerMovement: MovementConfig{
+				Speed:          DefaultPlayerSpeed,
+				MaxSpeed:       DefaultPlayerMaxSpeed,
+				Acceleration:   DefaultPlayerAcceleration,
+				Friction:       DefaultPlayerFriction,
+				Responsiveness: DefaultResponsiveness,
+			},
+			EnemyMovement: MovementConfig{
+				Speed:          DefaultEnemySpeed,
+				MaxSpeed:       DefaultEnemyMaxSpeed,
+				Acceleration:   DefaultEnemyAcceleration,
+				Friction:       DefaultEnemyFriction,
+				Responsiveness: DefaultResponsiveness,
+			},
+			PathRecomputeInterval: 0.5,
+			PathMaxSearchNodes:    2000,
+			EnemySeparationRadius: 40.0,
+			EnemySeparationWeight: 1.5,
+			MinSpawnSeparation:    64.0,
+			Weapons: map[string]WeaponConfig{
+				"epee_courte": {
+					Name: "Épée courte", BaseDamage: 0, StaminaCost: 15, AttackSpeed: 1, Range: 48, PoiseDamage: 12, Knockback: 6,
+					CommitDuration: 0.3, ActiveStart: 0.1, ActiveEnd: 0.2, MoveFactor: 0.2,
+				},
+				"hache": {
+					Name: "Hache", BaseDamage: 8, StaminaCost: 25, AttackSpeed: 1, Range: 56, PoiseDamage: 22, Knockback: 14,
+					CommitDuration: 0.55, ActiveStart: 0.3, ActiveEnd: 0.42, MoveFactor: 0,
+				},
+				"espadon": {
+					Name: "Espadon", BaseDamage: 14, StaminaCost: 32, AttackSpeed: 0.8, Range: 64, PoiseDamage: 30, Knockback: 18,
+					CommitDuration: 0.7, ActiveStart: 0.38, ActiveEnd: 0.52, MoveFactor: 0, CanDestroyTiles: true,
+				},
+			},
+			EquippedWeapon:           "epee_courte",
+			MaxHealCharges:           5,
+			HealAmount:               40,
+			CriticalMultiplier:       2.0,
+			ChainLightningMaxBounces: 3,
+			ChainLightningRange:      150.0,
+			NPCChatterMinInterval:    6.0,
+			NPCChatterMaxInterval:    14.0,
+			NPCBubbleDuration:        3.0,
+			EnemyRespawnTime:         30.0,
+			ItemDespawnTime:          300.0,
+			AutoSaveEnabled:          true,
+			AutoSaveInterval:         5.0,
+			DeathFadeDuration:        1.5,
+			DeathFadeTint:            Color{R: 140, G: 0, B: 0, A: 180},
+			DeathFadeTimeScale:       0.3,
+		},
+
+		Accessibility: AccessibilityConfig{
+			ColorblindMode: ColorblindNone,
+			UIScale:        1.0,
+			HighContrast:   false,
+			ReduceMotion:   false,
 		},
 
 		Debug: DebugConfig{
@@ -316,12 +576,20 @@ func GetDefaultConfig() *GameConfig {
 			ShowEntityInfo:   false,
 			ShowChunkBorders: false,
 			ShowPathfinding:  false,
+			ShowRenderStats:  false,
 			EnableGodMode:    false,
 			EnableNoclip:     false,
 			LogLevel:         "info",
 			ConsoleEnabled:   false,
 		},
 
+		Leaderboard: LeaderboardConfig{
+			Enabled:    false,
+			PlayerName: "Chevalier",
+			Timeout:    5.0,
+			TopCount:   10,
+		},
+
 		Paths: PathsConfig{
 			AssetsDir:      "assets",
 			TexturesDir:    "assets/textures",
@@ -340,25 +608,27 @@ func GetDefaultConfig() *GameConfig {
 // getDefaultKeyMapping retourne le mapping par défaut des touches
 func getDefaultKeyMapping() map[string]string {
 	return map[string]string{
-		"move_up":       "W",
-		"move_down":     "S",
-		"move_left":     "A",
-		"move_right":    "D",
-		"attack":        "Space",
-		"block":         "Shift",
-		"roll":          "LeftControl",
-		"interact":      "E",
-		"inventory":     "I",
-		"map":           "M",
-		"pause":         "Escape",
-		"quick_slot_1":  "1",
-		"quick_slot_2":  "2",
-		"quick_slot_3":  "3",
-		"quick_slot_4":  "4",
-		"cast_spell":    "F",
-		"camera_reset":  "R",
-		"screenshot":    "F12",
-		"debug_console": "BackQuote",
+		"move_up":         "W",
+		"move_down":       "S",
+		"move_left":       "A",
+		"move_right":      "D",
+		"attack":          "Space",
+		"block":           "Shift",
+		"roll":            "LeftControl",
+		"interact":        "E",
+		"inventory":       "I",
+		"map":             "M",
+		"pause":           "Escape",
+		"quick_slot_1":    "1",
+		"quick_slot_2":    "2",
+		"quick_slot_3":    "3",
+		"quick_slot_4":    "4",
+		"cast_spell":      "F",
+		"camera_reset":    "R",
+		"camera_zoom_in":  "Equal",
+		"camera_zoom_out": "Minus",
+		"screenshot":      "F12",
+		"debug_console":   "BackQuote",
 	}
 }
 
@@ -436,6 +706,44 @@ func GetDifficultySettings(difficulty string) DifficultySettings {
 	}
 }
 
+// ApplyHotReload applique les champs modifiables à chaud d'une nouvelle
+// configuration (volumes, options de debug). Les changements nécessitant un
+// redémarrage (fenêtre, rendu) sont ignorés. Retourne la liste des champs appliqués.
+func (c *GameConfig) ApplyHotReload(other *GameConfig) []string {
+	applied := make([]string, 0)
+
+	// Audio (hors paramètres du périphérique)
+	if c.Audio.MasterVolume != other.Audio.MasterVolume {
+		c.Audio.MasterVolume = other.Audio.MasterVolume
+		applied = append(applied, "audio.master_volume")
+	}
+	if c.Audio.MusicVolume != other.Audio.MusicVolume {
+		c.Audio.MusicVolume = other.Audio.MusicVolume
+		applied = append(applied, "audio.music_volume")
+	}
+	if c.Audio.SFXVolume != other.Audio.SFXVolume {
+		c.Audio.SFXVolume = other.Audio.SFXVolume
+		applied = append(applied, "audio.sfx_volume")
+	}
+	if c.Audio.VoiceVolume != other.Audio.VoiceVolume {
+		c.Audio.VoiceVolume = other.Audio.VoiceVolume
+		applied = append(applied, "audio.voice_volume")
+	}
+
+	// Debug
+	if c.Debug != other.Debug {
+		c.Debug = other.Debug
+		applied = append(applied, "debug")
+	}
+
+	if c.Window.Width != other.Window.Width || c.Window.Height != other.Window.Height ||
+		c.Window.Fullscreen != other.Window.Fullscreen {
+		fmt.Println("⚠ Changement de fenêtre ignoré (redémarrage nécessaire)")
+	}
+
+	return applied
+}
+
 // ===============================
 // CONFIG UTILITIES
 // ===============================
@@ -461,6 +769,55 @@ func (c *GameConfig) TargetFPS() int {
 	return c.Rendering.TargetFPS
 }
 
+// FPSCap retourne le plafond de FPS à appliquer (0 : aucun, la VSync limite déjà)
+func (c *GameConfig) FPSCap() int {
+	if c.Window.VSync || !c.Rendering.CapFPS {
+		return 0
+	}
+	return c.Rendering.TargetFPS
+}
+
+// TargetFPSOptions valeurs proposées par l'écran d'options
+var TargetFPSOptions = []int{30, 60, 120, 144}
+
+// NextTargetFPS retourne la valeur suivante (cycle de l'écran d'options)
+func NextTargetFPS(fps int) int {
+	for i, known := range TargetFPSOptions {
+		if fps == known {
+			return TargetFPSOptions[(i+1)%len(TargetFPSOptions)]
+		}
+	}
+	return TargetFPSOptions[0]
+}
+
+// Bornes de la sensibilité de la souris (InputConfig.MouseSensitivity)
+const (
+	MinMouseSensitivity = 0.25
+	MaxMouseSensitivity = 3.0
+)
+
+// MouseSensitivityOptions valeurs proposées par l'écran d'options
+var MouseSensitivityOptions = []float64{0.25, 0.5, 0.75, 1.0, 1.5, 2.0, 3.0}
+
+// ClampMouseSensitivity ramène une sensibilité dans ses bornes ; une valeur
+// nulle ou négative (absente du fichier) vaut 1.0
+func ClampMouseSensitivity(sensitivity float64) float64 {
+	if sensitivity <= 0 {
+		return 1.0
+	}
+	return Clamp(sensitivity, MinMouseSensitivity, MaxMouseSensitivity)
+}
+
+// NextMouseSensitivity retourne la valeur suivante (cycle de l'écran d'options)
+func NextMouseSensitivity(sensitivity float64) float64 {
+	for i, known := range MouseSensitivityOptions {
+		if math.Abs(sensitivity-known) < 0.01 {
+			return MouseSensitivityOptions[(i+1)%len(MouseSensitivityOptions)]
+		}
+	}
+	return 1.0
+}
+
 // TileSize retourne la taille des tiles
 func (c *GameConfig) TileSize() int {
 	return c.Rendering.TileSize
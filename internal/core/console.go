@@ -0,0 +1,164 @@
+// internal/core/console.go - Console de debug
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// ConsoleHandler exécute une commande et retourne le texte à afficher
+type ConsoleHandler func(args []string) string
+
+// consoleCommand commande enregistrée dans la console
+type consoleCommand struct {
+	help    string
+	handler ConsoleHandler
+}
+
+// DebugConsole console de commandes ouverte avec la touche ` (BackQuote)
+type DebugConsole struct {
+	Enabled bool // La console peut être ouverte
+	open    bool
+
+	commands map[string]consoleCommand
+	input    string
+	output   *RingBuffer[string]
+
+	// Buffer réutilisé pour la saisie
+	chars []rune
+}
+
+// NewDebugConsole crée une console de debug
+func NewDebugConsole() *DebugConsole {
+	console := &DebugConsole{
+		commands: make(map[string]consoleCommand),
+		output:   NewRingBuffer[string](12),
+		chars:    make([]rune, 0, 16),
+	}
+
+	console.RegisterCommand("help", "liste les commandes", func(args []string) string {
+		names := make([]string, 0, len(console.commands))
+		for name := range console.commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		lines := make([]string, 0, len(names))
+		for _, name := range names {
+			lines = append(lines, fmt.Sprintf("%s - %s", name, console.commands[name].help))
+		}
+		return strings.Join(lines, "\n")
+	})
+
+	return console
+}
+
+// RegisterCommand enregistre une commande
+func (dc *DebugConsole) RegisterCommand(name, help string, handler ConsoleHandler) {
+	dc.commands[name] = consoleCommand{help: help, handler: handler}
+}
+
+// Execute exécute une ligne de commande et retourne le résultat
+func (dc *DebugConsole) Execute(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	command, exists := dc.commands[fields[0]]
+	if !exists {
+		return fmt.Sprintf("Commande inconnue: %s (tapez help)", fields[0])
+	}
+	return command.handler(fields[1:])
+}
+
+// IsOpen retourne si la console est ouverte
+func (dc *DebugConsole) IsOpen() bool {
+	return dc.open
+}
+
+// Toggle ouvre/ferme la console
+func (dc *DebugConsole) Toggle() {
+	if !dc.Enabled {
+		return
+	}
+	dc.open = !dc.open
+	dc.input = ""
+}
+
+// Print ajoute des lignes à la sortie de la console
+func (dc *DebugConsole) Print(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		dc.output.Push(line)
+	}
+}
+
+// Update gère l'ouverture et la saisie clavier
+func (dc *DebugConsole) Update() {
+	if !dc.Enabled {
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackquote) {
+		dc.Toggle()
+		return
+	}
+	if !dc.open {
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		dc.Toggle()
+		return
+	}
+
+	dc.chars = ebiten.AppendInputChars(dc.chars[:0])
+	for _, char := range dc.chars {
+		if char != '`' {
+			dc.input += string(char)
+		}
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(dc.input) > 0 {
+		runes := []rune(dc.input)
+		dc.input = string(runes[:len(runes)-1])
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		line := strings.TrimSpace(dc.input)
+		dc.input = ""
+		if line == "" {
+			return
+		}
+
+		dc.Print("> " + line)
+		if result := dc.Execute(line); result != "" {
+			dc.Print(result)
+		}
+		fmt.Printf("Console: %s\n", line)
+	}
+}
+
+// Render dessine la console en haut de l'écran
+func (dc *DebugConsole) Render(renderer Renderer, screenWidth int) {
+	if !dc.open {
+		return
+	}
+
+	lineHeight := 15.0
+	height := lineHeight*float64(dc.output.Cap()+1) + 10
+	background := Rectangle{X: 0, Y: 0, Width: float64(screenWidth), Height: height}
+	renderer.DrawRectangle(background, Color{0, 0, 0, 200}, true)
+
+	for i := 0; i < dc.output.Len(); i++ {
+		line, _ := dc.output.At(i)
+		renderer.DrawText(line, Vector2{8, 15 + float64(i)*lineHeight}, Color{200, 200, 200, 255})
+	}
+
+	prompt := "> " + dc.input + "_"
+	renderer.DrawText(prompt, Vector2{8, height - 8}, ColorYellow)
+}
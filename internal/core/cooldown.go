@@ -0,0 +1,63 @@
+// internal/core/cooldown.go - Temps de recharge des capacités
+package core
+
+import "time"
+
+// CooldownManager temps de recharge des capacités, un Timer par nom
+// (systems.AbilityAttack, systems.AbilityRoll...). Une capacité jamais
+// lancée est prête.
+type CooldownManager struct {
+	timers map[string]*Timer
+}
+
+// NewCooldownManager crée un gestionnaire sans recharge en cours
+func NewCooldownManager() *CooldownManager {
+	return &CooldownManager{timers: make(map[string]*Timer)}
+}
+
+// Start lance (ou relance) la recharge d'une capacité
+func (cm *CooldownManager) Start(name string, duration time.Duration) {
+	timer, exists := cm.timers[name]
+	if !exists {
+		timer = NewTimer(duration)
+		cm.timers[name] = timer
+	}
+	timer.Duration = duration
+	timer.Start()
+}
+
+// IsReady retourne si la capacité peut être utilisée
+func (cm *CooldownManager) IsReady(name string) bool {
+	timer, exists := cm.timers[name]
+	return !exists || !timer.Running || timer.IsComplete()
+}
+
+// Remaining retourne le temps de recharge restant (0 si prête)
+func (cm *CooldownManager) Remaining(name string) time.Duration {
+	if cm.IsReady(name) {
+		return 0
+	}
+	return cm.timers[name].Remaining()
+}
+
+// Progress retourne l'avancement de la recharge (0 : vient d'être lancée, 1 : prête)
+func (cm *CooldownManager) Progress(name string) float64 {
+	if cm.IsReady(name) {
+		return 1
+	}
+	return cm.timers[name].Progress()
+}
+
+// Update fait avancer toutes les recharges
+func (cm *CooldownManager) Update(deltaTime time.Duration) {
+	for _, timer := range cm.timers {
+		timer.Update(deltaTime)
+	}
+}
+
+// Reset rend toutes les capacités prêtes (nouvelle partie, réapparition)
+func (cm *CooldownManager) Reset() {
+	for _, timer := range cm.timers {
+		timer.Stop()
+	}
+}
@@ -0,0 +1,173 @@
+// internal/core/crafting_panel.go - Panneau de fabrication
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"zelda-souls-game/internal/crafting"
+	"zelda-souls-game/internal/localization"
+)
+
+// CraftingPanel liste les recettes : grisées si indisponibles, quantités
+// possédées face aux quantités requises, et barre de progression pendant la
+// fabrication des recettes longues. Ouvert, il fige le jeu.
+type CraftingPanel struct {
+	recipes   *crafting.RecipeSystem
+	inventory *crafting.Inventory
+	localizer *localization.Localizer
+
+	buttons []*Button // Un bouton par recette, dans l'ordre des recettes
+
+	// Fabrication en cours (nil : aucune)
+	crafting *crafting.Recipe
+	progress time.Duration
+
+	screenWidth  int
+	screenHeight int
+	visible      bool
+}
+
+// Disposition du panneau
+const (
+	craftingPanelWidth = 560.0
+	craftingRowHeight  = 56.0
+	craftingButtonW    = 180.0
+	craftingButtonH    = 32.0
+)
+
+// NewCraftingPanel crée un panneau masqué
+func NewCraftingPanel(screenWidth, screenHeight int, recipes *crafting.RecipeSystem, inventory *crafting.Inventory, localizer *localization.Localizer) *CraftingPanel {
+	panel := &CraftingPanel{
+		recipes:      recipes,
+		inventory:    inventory,
+		localizer:    localizer,
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+	}
+
+	bounds := panel.bounds()
+	for i, recipe := range recipes.GetRecipes() {
+		recipe := recipe
+		y := bounds.Y + 50 + float64(i)*craftingRowHeight
+		panel.buttons = append(panel.buttons, NewButton(bounds.X+16, y, craftingButtonW, craftingButtonH,
+			recipe.Output.ItemID, func() { panel.start(recipe) }))
+	}
+	return panel
+}
+
+// bounds zone du panneau, centrée à l'écran
+func (cp *CraftingPanel) bounds() Rectangle {
+	height := 70 + float64(len(cp.recipes.GetRecipes()))*craftingRowHeight
+	return Rectangle{
+		X:      (float64(cp.screenWidth) - craftingPanelWidth) / 2,
+		Y:      (float64(cp.screenHeight) - height) / 2,
+		Width:  craftingPanelWidth,
+		Height: height,
+	}
+}
+
+// Toggle ouvre ou ferme le panneau
+func (cp *CraftingPanel) Toggle() {
+	cp.visible = !cp.visible
+}
+
+// IsVisible retourne si le panneau est ouvert
+func (cp *CraftingPanel) IsVisible() bool {
+	return cp.visible
+}
+
+// start lance la fabrication : immédiate, ou après CraftTime secondes
+func (cp *CraftingPanel) start(recipe *crafting.Recipe) {
+	if cp.crafting != nil {
+		return
+	}
+	if recipe.CraftTime <= 0 {
+		cp.craft(recipe)
+		return
+	}
+	cp.crafting = recipe
+	cp.progress = 0
+}
+
+// craft fabrique la recette ; l'inventaire a pu changer depuis le lancement
+func (cp *CraftingPanel) craft(recipe *crafting.Recipe) {
+	if err := cp.recipes.Craft(recipe.ID, cp.inventory); err != nil {
+		fmt.Printf("⚠ Fabrication de '%s' impossible: %v\n", recipe.ID, err)
+	}
+}
+
+// Update avance la fabrication en cours, sinon met à jour les boutons
+func (cp *CraftingPanel) Update(deltaTime time.Duration, mousePos Vector2, mousePressed bool) {
+	if !cp.visible {
+		return
+	}
+
+	if cp.crafting != nil {
+		cp.progress += deltaTime
+		if cp.progress.Seconds() >= cp.crafting.CraftTime {
+			cp.craft(cp.crafting)
+			cp.crafting = nil
+		}
+		return
+	}
+
+	for i, recipe := range cp.recipes.GetRecipes() {
+		cp.buttons[i].SetEnabled(cp.recipes.CanCraft(recipe.ID, cp.inventory) == nil)
+		cp.buttons[i].Update(mousePos, mousePressed)
+	}
+}
+
+// Render dessine le panneau, ses recettes et la fabrication en cours
+func (cp *CraftingPanel) Render(renderer Renderer) {
+	if !cp.visible {
+		return
+	}
+
+	bounds := cp.bounds()
+	renderer.DrawRectangle(bounds, Color{25, 25, 30, 235}, true)
+	renderer.DrawRectangle(bounds, Color{200, 200, 200, 255}, false)
+	renderer.DrawText(cp.localizer.Get("ui.crafting.title"), Vector2{bounds.X + 16, bounds.Y + 20}, ColorYellow)
+
+	for i, recipe := range cp.recipes.GetRecipes() {
+		button := cp.buttons[i]
+		button.Render(renderer)
+
+		x := button.Bounds.X + craftingButtonW + 16
+		y := button.Bounds.Y + 4
+		err := cp.recipes.CanCraft(recipe.ID, cp.inventory)
+
+		// Ingrédients : possédé / requis, en rouge s'il en manque
+		for _, input := range recipe.Inputs {
+			owned := cp.inventory.Count(input.ItemID)
+			color := ColorWhite
+			if owned < input.Qty {
+				color = Color{220, 80, 80, 255}
+			}
+			renderer.DrawText(fmt.Sprintf("%s %d/%d", input.ItemID, owned, input.Qty), Vector2{x, y}, color)
+			y += 14
+		}
+
+		if errors.Is(err, crafting.ErrWorkbenchRequired) {
+			renderer.DrawText(cp.localizer.Get("ui.crafting.workbench_required", recipe.RequiredWorkbench),
+				Vector2{x, y}, Color{150, 150, 150, 255})
+		}
+
+		if cp.crafting == recipe {
+			cp.renderProgress(renderer, button.Bounds)
+		}
+	}
+}
+
+// renderProgress dessine la barre de fabrication sous le bouton de la recette
+func (cp *CraftingPanel) renderProgress(renderer Renderer, button Rectangle) {
+	ratio := cp.progress.Seconds() / cp.crafting.CraftTime
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	bar := Rectangle{X: button.X, Y: button.Y + button.Height + 4, Width: button.Width, Height: 6}
+	renderer.DrawRectangle(bar, Color{50, 50, 50, 255}, true)
+	renderer.DrawRectangle(Rectangle{X: bar.X, Y: bar.Y, Width: bar.Width * ratio, Height: bar.Height},
+		Color{240, 170, 60, 255}, true)
+}
@@ -0,0 +1,136 @@
+// internal/core/day_night.go - Cycle jour/nuit : teinte globale de la scène
+package core
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// TintKeyframe teinte de la scène à un moment de la journée
+type TintKeyframe struct {
+	At    float64 `yaml:"at"` // Moment de la journée : 0 minuit, 0.5 midi
+	Color Color   `yaml:"color"`
+}
+
+// DayNightConfig réglages du cycle jour/nuit
+type DayNightConfig struct {
+	Enabled     bool           `yaml:"enabled"`
+	CycleLength float64        `yaml:"cycle_length"` // Durée d'une journée, en secondes
+	StartTime   float64        `yaml:"start_time"`   // Moment de la journée au lancement (0 à 1)
+	Palette     []TintKeyframe `yaml:"palette"`      // Vide : DefaultDayNightPalette
+}
+
+// DefaultDayNightPalette nuit bleutée, aube et crépuscule orangés, jour chaud
+var DefaultDayNightPalette = []TintKeyframe{
+	{At: 0.0, Color: Color{95, 110, 170, 255}},   // Minuit
+	{At: 0.25, Color: Color{230, 175, 150, 255}}, // Aube
+	{At: 0.5, Color: Color{255, 248, 232, 255}},  // Midi
+	{At: 0.75, Color: Color{240, 160, 110, 255}}, // Crépuscule
+}
+
+// Réglages du cycle
+const (
+	DefaultDayLength     = 10 * time.Minute
+	DefaultDayStart      = 0.35  // Matinée
+	bonfireDaylightRange = 180.0 // Distance (pixels) où un feu allumé ramène le jour
+)
+
+// DayNightCycle fait avancer l'heure et interpole la teinte de la palette ;
+// la palette boucle de la dernière teinte vers la première
+type DayNightCycle struct {
+	length  time.Duration
+	palette []TintKeyframe
+	elapsed time.Duration
+}
+
+// NewDayNightCycle crée un cycle ; une durée nulle ou une palette vide
+// prennent les valeurs par défaut
+func NewDayNightCycle(config DayNightConfig) *DayNightCycle {
+	length := time.Duration(config.CycleLength * float64(time.Second))
+	if length <= 0 {
+		length = DefaultDayLength
+	}
+
+	palette := config.Palette
+	if len(palette) == 0 {
+		palette = DefaultDayNightPalette
+	}
+	palette = append([]TintKeyframe(nil), palette...)
+	sort.Slice(palette, func(i, j int) bool { return palette[i].At < palette[j].At })
+
+	cycle := &DayNightCycle{length: length, palette: palette}
+	start := config.StartTime
+	if start <= 0 || start >= 1 {
+		start = DefaultDayStart
+	}
+	cycle.SetTimeOfDay(start)
+	return cycle
+}
+
+// Update fait avancer l'heure
+func (dnc *DayNightCycle) Update(deltaTime time.Duration) {
+	dnc.elapsed = (dnc.elapsed + deltaTime) % dnc.length
+}
+
+// TimeOfDay retourne le moment de la journée (0 minuit, 0.5 midi)
+func (dnc *DayNightCycle) TimeOfDay() float64 {
+	return float64(dnc.elapsed) / float64(dnc.length)
+}
+
+// SetTimeOfDay règle le moment de la journée (0 à 1)
+func (dnc *DayNightCycle) SetTimeOfDay(t float64) {
+	t -= math.Floor(t)
+	dnc.elapsed = time.Duration(t * float64(dnc.length))
+}
+
+// Tint retourne la teinte du moment
+func (dnc *DayNightCycle) Tint() Color {
+	return dnc.TintAt(dnc.TimeOfDay())
+}
+
+// TintAt interpole la teinte de la palette au moment t
+func (dnc *DayNightCycle) TintAt(t float64) Color {
+	count := len(dnc.palette)
+	if count == 1 {
+		return dnc.palette[0].Color
+	}
+
+	// Clé précédente et suivante, en bouclant autour de minuit
+	next := sort.Search(count, func(i int) bool { return dnc.palette[i].At > t })
+	prev := (next - 1 + count) % count
+	next %= count
+
+	from, to := dnc.palette[prev], dnc.palette[next]
+	span := to.At - from.At
+	offset := t - from.At
+	if span <= 0 {
+		span += 1
+	}
+	if offset < 0 {
+		offset += 1
+	}
+	return lerpColor(from.Color, to.Color, Clamp(offset/span, 0, 1))
+}
+
+// Daylight retourne la teinte de midi
+func (dnc *DayNightCycle) Daylight() Color {
+	return dnc.TintAt(0.5)
+}
+
+// TintNear retourne la teinte vue depuis une position : plus un feu de camp
+// allumé est proche (distance bonfireDistance), plus elle tend vers le jour
+func (dnc *DayNightCycle) TintNear(bonfireDistance float64) Color {
+	daylight := 1 - Clamp(bonfireDistance/bonfireDaylightRange, 0, 1)
+	return lerpColor(dnc.Tint(), dnc.Daylight(), daylight)
+}
+
+// lerpColor interpole deux couleurs composante par composante
+func lerpColor(from, to Color, t float64) Color {
+	return Color{
+		R: uint8(math.Round(Lerp(float64(from.R), float64(to.R), t))),
+		G: uint8(math.Round(Lerp(float64(from.G), float64(to.G), t))),
+		B: uint8(math.Round(Lerp(float64(from.B), float64(to.B), t))),
+		A: uint8(math.Round(Lerp(float64(from.A), float64(to.A), t))),
+	}
+}
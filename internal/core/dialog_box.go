@@ -0,0 +1,214 @@
+// internal/core/dialog_box.go - Boîte de dialogue modale
+package core
+
+import "strings"
+
+// DialogBox message centré à l'écran avec ses boutons.
+// Tant qu'elle est visible, elle capture la souris.
+type DialogBox struct {
+	Message string
+	Buttons []*Button
+	Hint    string // Aide affichée en bas à droite (touches)
+
+	// Hauteur d'une ligne du message ; les lignes qui dépassent défilent
+	LineHeight float64
+	scroll     int // Première ligne affichée
+
+	// Disposition
+	screenWidth  int
+	screenHeight int
+	width        float64
+	height       float64
+
+	// Style
+	BackgroundColor Color
+	BorderColor     Color
+	OverlayColor    Color
+
+	visible bool
+}
+
+// Disposition des boîtes de dialogue
+const (
+	dialogWidth         = 460.0
+	dialogHeight        = 170.0
+	dialogButtonWidth   = 140.0
+	dialogButtonHeight  = 36.0
+	dialogButtonSpacing = 20.0
+	dialogLineHeight    = 20.0
+	dialogCharsPerLine  = 52 // Retour à la ligne du message
+)
+
+// NewDialogBox crée une boîte de dialogue masquée
+func NewDialogBox(screenWidth, screenHeight int, message string) *DialogBox {
+	return &DialogBox{
+		Message:      message,
+		Buttons:      make([]*Button, 0, 2),
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+		width:        dialogWidth,
+		height:       dialogHeight,
+		LineHeight:   dialogLineHeight,
+
+		BackgroundColor: Color{30, 30, 35, 240},
+		BorderColor:     Color{200, 200, 200, 255},
+		OverlayColor:    Color{0, 0, 0, 150},
+	}
+}
+
+// AddButton ajoute un bouton ; un clic ferme la boîte puis appelle onClick
+func (d *DialogBox) AddButton(text string, onClick func()) *Button {
+	button := NewButton(0, 0, dialogButtonWidth, dialogButtonHeight, text, func() {
+		d.Hide()
+		if onClick != nil {
+			onClick()
+		}
+	})
+	d.Buttons = append(d.Buttons, button)
+	d.layoutButtons()
+	return button
+}
+
+// layoutButtons centre les boutons en bas de la boîte
+func (d *DialogBox) layoutButtons() {
+	bounds := d.bounds()
+	count := float64(len(d.Buttons))
+	total := count*dialogButtonWidth + (count-1)*dialogButtonSpacing
+
+	x := bounds.X + (bounds.Width-total)/2
+	y := bounds.Y + bounds.Height - dialogButtonHeight - 16
+	for _, button := range d.Buttons {
+		button.Bounds.X = x
+		button.Bounds.Y = y
+		x += dialogButtonWidth + dialogButtonSpacing
+	}
+}
+
+// bounds zone de la boîte, centrée à l'écran
+func (d *DialogBox) bounds() Rectangle {
+	return Rectangle{
+		X:      (float64(d.screenWidth) - d.width) / 2,
+		Y:      (float64(d.screenHeight) - d.height) / 2,
+		Width:  d.width,
+		Height: d.height,
+	}
+}
+
+// Show affiche la boîte
+func (d *DialogBox) Show() {
+	d.visible = true
+}
+
+// Hide masque la boîte
+func (d *DialogBox) Hide() {
+	d.visible = false
+}
+
+// IsVisible retourne si la boîte est affichée
+func (d *DialogBox) IsVisible() bool {
+	return d.visible
+}
+
+// Update met à jour les boutons
+func (d *DialogBox) Update(mousePos Vector2, mousePressed bool) {
+	if !d.visible {
+		return
+	}
+	for _, button := range d.Buttons {
+		button.Update(mousePos, mousePressed)
+	}
+}
+
+// lines retourne le message découpé en lignes
+func (d *DialogBox) lines() []string {
+	return wrapText(d.Message, dialogCharsPerLine)
+}
+
+// visibleLines retourne le nombre de lignes du message affichables à la fois
+func (d *DialogBox) visibleLines() int {
+	area := d.height - 36 - 16
+	if len(d.Buttons) > 0 {
+		area -= dialogButtonHeight + 16
+	}
+	if d.LineHeight <= 0 || area < d.LineHeight {
+		return 1
+	}
+	return int(area/d.LineHeight) + 1
+}
+
+// ScrollBy fait défiler le message de delta lignes, sans dépasser ses bornes
+func (d *DialogBox) ScrollBy(delta int) {
+	d.scroll += delta
+	if maxScroll := len(d.lines()) - d.visibleLines(); d.scroll > maxScroll {
+		d.scroll = maxScroll
+	}
+	if d.scroll < 0 {
+		d.scroll = 0
+	}
+}
+
+// Render assombrit l'écran puis dessine la boîte, son message et ses boutons
+func (d *DialogBox) Render(renderer Renderer) {
+	if !d.visible {
+		return
+	}
+
+	screen := Rectangle{X: 0, Y: 0, Width: float64(d.screenWidth), Height: float64(d.screenHeight)}
+	renderer.DrawRectangle(screen, d.OverlayColor, true)
+
+	bounds := d.bounds()
+	renderer.DrawRectangle(bounds, d.BackgroundColor, true)
+	renderer.DrawRectangle(bounds, d.BorderColor, false)
+
+	lines := d.lines()
+	first := d.scroll
+	if first > len(lines) {
+		first = len(lines)
+	}
+	last := first + d.visibleLines()
+	if last > len(lines) {
+		last = len(lines)
+	}
+	y := bounds.Y + 36
+	for _, line := range lines[first:last] {
+		renderer.DrawText(line, Vector2{bounds.X + 20, y}, ColorWhite)
+		y += d.LineHeight
+	}
+
+	// Indicateurs de défilement
+	if first > 0 {
+		renderer.DrawText("^", Vector2{bounds.X + bounds.Width - 20, bounds.Y + 36}, ColorGray)
+	}
+	if last < len(lines) {
+		renderer.DrawText("v", Vector2{bounds.X + bounds.Width - 20, bounds.Y + bounds.Height - 30}, ColorGray)
+	}
+	if d.Hint != "" {
+		hintX := bounds.X + bounds.Width - 16 - float64(len([]rune(d.Hint)))*7
+		renderer.DrawText(d.Hint, Vector2{hintX, bounds.Y + bounds.Height - 12}, ColorGray)
+	}
+
+	for _, button := range d.Buttons {
+		button.Render(renderer)
+	}
+}
+
+// wrapText coupe un texte en lignes d'au plus width caractères, entre les mots
+func wrapText(text string, width int) []string {
+	lines := make([]string, 0, 2)
+	current := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case current == "":
+			current = word
+		case len([]rune(current))+1+len([]rune(word)) > width:
+			lines = append(lines, current)
+			current = word
+		default:
+			current += " " + word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
@@ -0,0 +1,55 @@
+// internal/core/enemy_archetypes.go - Archétypes d'ennemis définis en YAML
+package core
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultEnemyArchetypesFile fichier des archétypes, relatif au dossier de données
+const DefaultEnemyArchetypesFile = "enemies/archetypes.yaml"
+
+// PatrolConfig chemin de patrouille d'un archétype
+type PatrolConfig struct {
+	Loop          bool      `yaml:"loop"`
+	ControlPoints []Vector2 `yaml:"control_points"` // Points de contrôle Bézier (3n+1)
+	Waypoints     []Vector2 `yaml:"waypoints"`      // Points reliés en ligne droite (prioritaires)
+}
+
+// EnemyArchetype description d'un ennemi à placer dans le monde
+type EnemyArchetype struct {
+	Name        string       `yaml:"name"`
+	Health      int          `yaml:"health"`
+	AttackPower int          `yaml:"attack_power"`
+	Poise       float64      `yaml:"poise"`       // Équilibre maximal (0 : valeur par défaut)
+	PoiseRegen  float64      `yaml:"poise_regen"` // Équilibre regagné par seconde
+	Spawn       Vector2      `yaml:"spawn"`
+	Hull        []Vector2    `yaml:"hull"` // Sommets convexes du corps autour de sa position (vide : boîte)
+	Patrol      PatrolConfig `yaml:"patrol"`
+	Boss        string       `yaml:"boss"` // Nom affiché sur la barre de vie de boss ("" : ennemi ordinaire)
+}
+
+// DefaultBossHealth vie d'un boss dont l'archétype ne précise pas health
+const DefaultBossHealth = 300
+
+// enemyArchetypesFile structure du fichier YAML
+type enemyArchetypesFile struct {
+	Archetypes []EnemyArchetype `yaml:"archetypes"`
+}
+
+// LoadEnemyArchetypes charge les archétypes d'ennemis depuis un fichier YAML
+func LoadEnemyArchetypes(path string) ([]EnemyArchetype, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossible de lire %s: %w", path, err)
+	}
+
+	var file enemyArchetypesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("archétypes invalides dans %s: %w", path, err)
+	}
+
+	return file.Archetypes, nil
+}
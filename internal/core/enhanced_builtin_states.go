@@ -5,10 +5,17 @@ import (
 	"fmt"
 	"image"
 	"log"
+	"math"
+	"strconv"
 	"time"
 	"zelda-souls-game/internal/assets"
+	"zelda-souls-game/internal/audio"
+	"zelda-souls-game/internal/crafting"
 	"zelda-souls-game/internal/ecs/components"
 	"zelda-souls-game/internal/ecs/systems"
+	"zelda-souls-game/internal/localization"
+	"zelda-souls-game/internal/pathfinding"
+	"zelda-souls-game/internal/skilltree"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -16,7 +23,7 @@ import (
 // EnhancedBuiltinStateManager gestionnaire d'états avec joueur intégré
 type EnhancedBuiltinStateManager struct {
 	// État de base
-	currentState     GameStateType
+	states           *StateMachine[GameStateType, time.Duration]
 	frameCount       int
 	showInstructions bool
 	screenWidth      int
@@ -25,21 +32,237 @@ type EnhancedBuiltinStateManager struct {
 	// Menu intégré (réutilisé du système précédent)
 	buttons []*Button
 
+	// Widgets des écrans à boutons (menu, options, écran de mort)
+	menuUI     *UIManager
+	settingsUI *UIManager
+	gameOverUI *UIManager
+
+	// Position de la souris qui a servi en dernier : dès qu'elle bouge, elle
+	// reprend la main sur la sélection au clavier ou à la manette
+	menuMousePos Vector2
+
+	// Infobulle de l'élément survolé (menu, options, écran de mort)
+	tooltip *TooltipManager
+
+	// Échelle de temps du gameplay (ralentis)
+	timeScale     float64
+	slowMoScale   float64       // Ralenti temporaire (coup fatal, parade)
+	slowMoTimeout time.Duration // Temps réel restant du ralenti temporaire
+	focusTimeout  time.Duration // Temps réel restant de la concentration
+
+	// Sous-images des planches de sprites, partagées d'une frame à l'autre
+	subImages *assets.SpriteAtlasCache
+
+	// Coups critiques : flash jaune (frames restantes) et nombres de dégâts
+	critFlashFrames int
+	damageNumbers   *systems.DamageNumberSystem
+	particleSystem  *systems.ParticleSystem
+	sounds          systems.SoundPlayer
+
+	// Voile rouge à la mort, avant l'écran de mort
+	dying             bool
+	deathFadeTime     time.Duration
+	deathFadeDuration time.Duration
+	deathFadeTint     Color
+	deathTimeScale    float64
+
+	// Écran de mort
+	gameOverButtons []*Button
+	gameOverFade    time.Duration // Temps écoulé depuis la mort
+	runDuration     time.Duration // Durée de la partie figée à la mort
+
+	// Options d'accessibilité et notification de leur changement
+	accessibility          AccessibilityConfig
+	OnAccessibilityChanged func(accessibility AccessibilityConfig)
+	settingsButtons        []*Button // Options d'accessibilité, puis Retour
+	hasSaves               bool      // Mémorisé pour recréer les boutons à la bonne taille
+
+	// FPS cible et notification de son changement (TPS d'Ebiten, plafond)
+	targetFPS          int
+	OnTargetFPSChanged func(fps int)
+
+	// Salle derrière le portail dont le joueur s'approche, signalée une fois
+	// pour que ses assets soient préchargés avant la transition
+	preloadRoom      string
+	OnPortalApproach func(roomID string)
+
+	// Passage d'un portail : le fondu reste au noir tant que RoomReady ne
+	// confirme pas le préchargement de la salle (nil : toujours prête)
+	roomTransition *RoomTransition
+	portalInside   *systems.ItemEntity // Portail touché à la frame précédente
+	portalTarget   *systems.ItemEntity // Portail en cours de passage
+	RoomReady      func(roomID string) bool
+
+	// Menu de pause, états empilés (GoBack y revient) et sauvegarde demandée
+	pauseMenu  *PauseMenu
+	stateStack []GameStateType
+	onSave     func() error
+
 	// Système de joueur
 	playerSystem *systems.PlayerSystem
 
+	// Système des ennemis
+	enemySystem *systems.EnemySystem
+
+	// Résolution des coups (blocage compris)
+	combatSystem *systems.CombatSystem
+
+	// Le joueur ne traverse pas le corps des ennemis
+	collisionSystem *systems.CollisionSystem
+
+	// Décors de premier plan estompés devant le joueur
+	transparencySystem *systems.TransparencySystem
+
+	// Empreintes, sang et brûlures au sol
+	decalSystem *systems.DecalSystem
+
+	// Bruits de pas selon le sol
+	footstepSystem *systems.FootstepSystem
+
+	// Murs brisés par les coups de l'espadon
+	destructibleTiles *systems.DestructibleTileSystem
+	statusEffects     *systems.StatusEffectSystem
+
+	// Objets au sol, portails et leurs signaux sur la mini-carte
+	itemSystem *systems.ItemSystem
+	miniMap    *MiniMap
+
+	// Plan du donjon (vue d'ensemble de la mini-carte) et zone de chaque salle
+	roomGraph *RoomGraph
+	roomAreas []roomArea
+
+	// Sorts du joueur (chaîne d'éclairs)
+	spellSystem *systems.SpellSystem
+
+	// Âmes laissées à la mort, récupérables en touchant la tache de sang
+	bloodstainSystem *systems.BloodstainSystem
+
+	// Feux de camp : repos, sauvegarde et point de réapparition
+	interactionSystem *systems.InteractionSystem
+	bonfires          []*systems.Bonfire
+	lastBonfire       *BonfireCheckpoint // nil : réapparition impossible (écran de mort)
+	onRest            func(checkpoint BonfireCheckpoint)
+
+	// Panneaux lisibles, panneaux déjà lus (sauvegardés) et lecture en cours
+	signs          []*systems.Sign
+	readSigns      map[uint32]bool
+	signDialog     *DialogBox
+	signScrollWait time.Duration // Répétition du défilement touche maintenue
+
+	// Ennemis placés au lancement d'une partie
+	enemyArchetypes []EnemyArchetype
+
+	// Entités définies par la carte (vide : placement par défaut)
+	levelSpawns []SpawnDef
+
+	// Entités tirées au hasard selon la densité de la carte
+	spawnSystem   *systems.SpawnSystem
+	densityTable  systems.SpawnTable
+	densitySpawns int
+
+	// Salle au trésor générée par la carte (nil : aucune)
+	treasureRoomDef *TreasureRoomDef
+	treasureRoom    *systems.TreasureRoom
+
+	// Chaînes, lianes et ponts de corde placés par la carte
+	ropeDefs   []RopeDef
+	ropeSystem *systems.RopeSystem
+
+	// PNJ placés par la carte et leurs bulles de paroles
+	npcDefs   []NPCDef
+	npcSystem *systems.NPCSystem
+
+	// Quêtes et leurs marqueurs sur la mini-carte
+	questSystem *QuestSystem
+
+	// Entités dessinées par couche puis par profondeur
+	renderQueue *systems.RenderQueue
+
+	// Cycle jour/nuit (nil : désactivé)
+	dayNight *DayNightCycle
+
+	// Salles de défi et records
+	statTracker     *systems.StatTracker
+	challengeSystem *systems.ChallengeSystem
+
+	// HUD fixe (vie, stamina, XP) et barre de boss
+	hud     *HUD
+	bossBar *BossBar
+
+	// Coups enchaînés : multiplient les âmes et l'expérience gagnées
+	combo *components.ComboMeter
+
+	// Recharge des capacités du joueur, affichée par le HUD
+	cooldowns *CooldownManager
+
 	// Callbacks
 	onNewGame  func()
 	onLoadGame func()
 	onQuitGame func()
 
+	// Classement en ligne : bilan envoyé en fin de partie, meilleurs scores
+	// lus depuis le menu (bouton absent sans lecteur)
+	OnRunEnded         func(summary RunSummary)
+	leaderboardFetcher LeaderboardFetcher
+	leaderboard        *LeaderboardScreen
+
+	// Introduction du premier lancement : vue jusqu'au bout ou passée, puis
+	// redemandée depuis les options (préférences du joueur)
+	intro              *IntroScreen
+	introReplayPending bool
+	OnIntroFinished    func()
+	OnReplayIntro      func()
+
 	// Entrées souris
 	mousePos     Vector2
 	mousePressed bool
 
+	// Visée à la souris (InputConfig.MouseAim) et caméra qui convertit le
+	// curseur en position monde
+	mouseAim  bool
+	aimCamera interface{ ScreenToWorld(Vector2) Vector2 }
+
+	// Point visé (monde), qui rattrape le curseur d'autant plus vite que la
+	// sensibilité est forte ; notification du changement de sensibilité
+	mouseSensitivity          float64
+	aimPoint                  Vector2
+	aimPointSet               bool
+	OnMouseSensitivityChanged func(sensitivity float64)
+
 	// Statistiques de jeu
 	gameStartTime time.Time
 
+	// Console de debug et mode benchmark (commande benchmark)
+	console    *DebugConsole
+	freeCamera *FreeCamera
+	benchmark  *BenchmarkMode
+
+	// Zoom de la caméra à la molette et au clavier, recentrage sur le joueur
+	cameraZoom     *CameraZoomControl
+	recenterCamera CameraRecenterTarget
+
+	// Limites de la carte chargée, imposées à la caméra en jeu (nil : aucune)
+	boundedCamera MapBoundedCamera
+	mapBounds     *Rectangle
+
+	// Boîte de dialogue modale (nil si aucune)
+	dialog *DialogBox
+
+	// Inventaire et fabrication (panneau nil tant qu'aucune recette n'est chargée)
+	inventory     *crafting.Inventory
+	recipeSystem  *crafting.RecipeSystem
+	craftingPanel *CraftingPanel
+
+	// Arbre de compétences (panneau nil tant qu'aucun arbre n'est chargé)
+	skillTree      *skilltree.SkillTree
+	skillTreePanel *SkillTreePanel
+
+	// Textes de l'interface
+	localizer *localization.Localizer
+
+	// Entrées clavier (lecture des panneaux)
+	input systems.InputManager
+
 	// Debug
 	debugSprites bool
 }
@@ -49,17 +272,129 @@ func NewEnhancedBuiltinStateManager(screenWidth, screenHeight int) *EnhancedBuil
 	fmt.Printf("Création EnhancedBuiltinStateManager (%dx%d)\n", screenWidth, screenHeight)
 
 	esm := &EnhancedBuiltinStateManager{
-		currentState:     "menu",
-		frameCount:       0,
-		showInstructions: true,
-		screenWidth:      screenWidth,
-		screenHeight:     screenHeight,
-		playerSystem:     systems.NewPlayerSystem(),
-		gameStartTime:    time.Now(),
-		debugSprites:     true,
+		states:            NewStateMachine[GameStateType, time.Duration](StateMenu),
+		frameCount:        0,
+		showInstructions:  true,
+		screenWidth:       screenWidth,
+		screenHeight:      screenHeight,
+		playerSystem:      systems.NewPlayerSystem(),
+		enemySystem:       systems.NewEnemySystem(),
+		combatSystem:      systems.NewCombatSystem(),
+		hud:               NewHUD(screenWidth, screenHeight),
+		bossBar:           NewBossBar(screenWidth),
+		console:           NewDebugConsole(),
+		tooltip:           NewTooltipManager(),
+		roomTransition:    NewRoomTransition(),
+		freeCamera:        NewFreeCamera(),
+		cameraZoom:        NewCameraZoomControl(),
+		benchmark:         NewBenchmarkMode(Rectangle{Width: float64(screenWidth), Height: float64(screenHeight)}),
+		localizer:         localization.Default(),
+		deathFadeDuration: 1500 * time.Millisecond,
+		deathFadeTint:     Color{140, 0, 0, 180},
+		deathTimeScale:    0.3,
+		gameStartTime:     time.Now(),
+		debugSprites:      true,
+		accessibility:     AccessibilityConfig{ColorblindMode: ColorblindNone, UIScale: 1.0},
+		targetFPS:         60,
+		mouseSensitivity:  1.0,
 	}
 
+	esm.transparencySystem = systems.NewTransparencySystem()
+	esm.ropeSystem = systems.NewRopeSystem()
+	esm.npcSystem = systems.NewNPCSystem()
+	esm.renderQueue = systems.NewRenderQueue()
+	esm.questSystem = NewQuestSystem()
+	esm.readSigns = make(map[uint32]bool)
+	esm.npcSystem.WorldBounds = components.Rectangle{Width: float64(screenWidth), Height: float64(screenHeight)}
+	esm.collisionSystem = systems.NewCollisionSystem()
+	esm.decalSystem = systems.NewDecalSystem()
+	esm.footstepSystem = systems.NewFootstepSystem()
+	esm.itemSystem = systems.NewItemSystem()
+	esm.interactionSystem = systems.NewInteractionSystem()
+	esm.spellSystem = systems.NewSpellSystem()
+	esm.damageNumbers = systems.NewDamageNumberSystem()
+	esm.particleSystem = systems.NewParticleSystem()
+	esm.destructibleTiles = systems.NewDestructibleTileSystem(esm.particleSystem)
+	esm.statusEffects = systems.NewStatusEffectSystem(esm.particleSystem)
+	esm.bloodstainSystem = systems.NewBloodstainSystem()
+	esm.subImages = assets.NewSpriteAtlasCache(assets.DefaultSpriteAtlasCacheSize)
+	esm.combo = components.NewComboMeter()
+	esm.combatSystem.Combo = esm.combo
+	esm.cooldowns = NewCooldownManager()
+	esm.playerSystem.SetCooldowns(esm.cooldowns)
+	esm.spawnSystem = systems.NewSpawnSystem()
+	esm.spawnSystem.Spawn = esm.spawnFromTable
+	esm.inventory = crafting.NewInventory()
+	esm.enemySystem.SetNeighborIndex(neighborAdapter{grid: NewSpatialGrid(DefaultSpatialCellSize)})
+	esm.miniMap = NewMiniMap(screenWidth, float64(screenWidth), float64(screenHeight))
+	esm.questSystem.LocateNPC = esm.locateNPC
+	esm.questSystem.OnMarkerAdded = esm.miniMap.AddMarker
+	esm.questSystem.OnMarkerRemoved = esm.miniMap.RemoveMarker
+	esm.statTracker = systems.NewStatTracker()
+	esm.challengeSystem = systems.NewChallengeSystem(esm.statTracker)
+
 	esm.createButtons()
+	esm.registerConsoleCommands()
+	esm.setupStates()
+
+	// Bref ralenti et étincelles sur un blocage parfait
+	esm.combatSystem.OnPerfectBlock = func(event systems.PerfectBlockEvent) {
+		esm.SlowMotion(0.4, 250*time.Millisecond)
+		esm.particleSystem.EmitBurst(event.Position, systems.SparkBurst)
+	}
+
+	// Poussière soulevée derrière une roulade
+	esm.playerSystem.OnRoll = func(position, direction components.Vector2) {
+		behind := math.Atan2(-direction.Y, -direction.X)
+		esm.particleSystem.EmitBurst(position, systems.DustBurst.WithDirection(behind))
+	}
+
+	// Coup critique : nombre jaune, flash des bords de l'écran et son dédié
+	esm.combatSystem.OnCriticalHit = func(event systems.CriticalHitEvent) {
+		esm.damageNumbers.Spawn(event.Position, event.Damage, systems.DamageColorCritical)
+		esm.critFlashFrames = critFlashFrameCount
+		if esm.sounds != nil {
+			esm.sounds.Play(sfxCriticalHit, 1.0, systems.SoundAt(event.Position))
+		}
+	}
+
+	// Concentration : le gameplay ralentit pendant quelques secondes réelles
+	esm.playerSystem.OnFocus = func() {
+		esm.focusTimeout = focusDuration
+	}
+
+	// Fiole bue, ou tentée sans charge : son de soin ou d'échec
+	esm.playerSystem.OnHeal = func(success bool) {
+		if success {
+			esm.playPlayerSound(sfxHeal)
+		} else {
+			esm.playPlayerSound(sfxHealFailed)
+		}
+	}
+
+	// Utiliser un objet empoisonné boit un antidote plutôt qu'une fiole
+	esm.playerSystem.OnUseItem = esm.useAntidote
+
+	// Tache et gerbe de sang à chaque coup porté, qui prolonge le combo
+	esm.combatSystem.OnEnemyHit = func(enemy *systems.EnemyEntity, position components.Vector2) {
+		esm.decalSystem.SpawnBlood(position)
+		esm.particleSystem.EmitBurst(position, systems.BloodBurst)
+		esm.registerComboHit()
+	}
+	esm.spellSystem.OnEnemyHit = esm.combatSystem.OnEnemyHit
+
+	// Un objet ramassé est découvert : son signal disparaît et il rejoint l'inventaire
+	esm.itemSystem.OnItemPickedUp = func(event systems.ItemPickedUpEvent) {
+		esm.miniMap.RemovePing(EntityID(event.Item.EntityID))
+		esm.inventory.Add(event.Item.Name, 1)
+	}
+
+	// Butin : les objets de l'ennemi tombent là où il est vaincu
+	esm.enemySystem.OnEnemyDefeated = func(enemy *systems.EnemyEntity) {
+		for _, drop := range enemy.Drops {
+			esm.itemSystem.SpawnItem(drop, enemy.Position.Position.X, enemy.Position.Position.Y)
+		}
+	}
 	fmt.Println("✓ EnhancedBuiltinStateManager créé")
 	return esm
 }
@@ -68,9 +403,9 @@ func NewEnhancedBuiltinStateManager(screenWidth, screenHeight int) *EnhancedBuil
 func (esm *EnhancedBuiltinStateManager) createButtons() {
 	centerX := float64(esm.screenWidth) / 2
 	startY := float64(esm.screenHeight) / 2
-	buttonWidth := 200.0
-	buttonHeight := 50.0
-	buttonSpacing := 70.0
+	buttonWidth := 200.0 * esm.accessibility.UIScale
+	buttonHeight := 50.0 * esm.accessibility.UIScale
+	buttonSpacing := 70.0 * esm.accessibility.UIScale
 
 	// Bouton "Nouvelle Partie"
 	newGameBtn := NewButton(
@@ -78,12 +413,13 @@ func (esm *EnhancedBuiltinStateManager) createButtons() {
 		startY-buttonSpacing,
 		buttonWidth,
 		buttonHeight,
-		"Nouvelle Partie",
+		esm.localizer.Get("ui.menu.new_game"),
 		func() {
 			log.Println("Nouvelle Partie cliquée")
 			esm.startNewGame()
 		},
 	)
+	newGameBtn.Tooltip = esm.localizer.Get("ui.tooltip.new_game")
 	newGameBtn.NormalColor = Color{50, 120, 50, 255} // Vert
 	newGameBtn.HoverColor = Color{70, 150, 70, 255}
 
@@ -93,7 +429,7 @@ func (esm *EnhancedBuiltinStateManager) createButtons() {
 		startY,
 		buttonWidth,
 		buttonHeight,
-		"Charger Partie",
+		esm.localizer.Get("ui.menu.load_game"),
 		func() {
 			log.Println("Charger Partie cliquée")
 			if esm.onLoadGame != nil {
@@ -101,6 +437,7 @@ func (esm *EnhancedBuiltinStateManager) createButtons() {
 			}
 		},
 	)
+	loadGameBtn.Tooltip = esm.localizer.Get("ui.tooltip.load_game")
 
 	// Bouton "Quitter"
 	quitBtn := NewButton(
@@ -108,7 +445,7 @@ func (esm *EnhancedBuiltinStateManager) createButtons() {
 		startY+buttonSpacing,
 		buttonWidth,
 		buttonHeight,
-		"Quitter",
+		esm.localizer.Get("ui.menu.quit"),
 		func() {
 			log.Println("Quitter cliqué")
 			if esm.onQuitGame != nil {
@@ -116,11 +453,492 @@ func (esm *EnhancedBuiltinStateManager) createButtons() {
 			}
 		},
 	)
+	quitBtn.Tooltip = esm.localizer.Get("ui.tooltip.quit")
 	quitBtn.NormalColor = Color{120, 50, 50, 255} // Rouge
 	quitBtn.HoverColor = Color{150, 70, 70, 255}
 
 	esm.buttons = []*Button{newGameBtn, loadGameBtn, quitBtn}
+
+	// Bouton "Classement", avant "Quitter", si le classement est configuré
+	if esm.leaderboardFetcher != nil {
+		leaderboardBtn := NewButton(
+			centerX-buttonWidth/2,
+			startY+buttonSpacing,
+			buttonWidth,
+			buttonHeight,
+			esm.localizer.Get("ui.menu.leaderboard"),
+			func() {
+				log.Println("Classement cliqué")
+				esm.PushState(StateLeaderboard)
+			},
+		)
+		leaderboardBtn.Tooltip = esm.localizer.Get("ui.tooltip.leaderboard")
+		quitBtn.Bounds.Y += buttonSpacing
+		esm.buttons = []*Button{newGameBtn, loadGameBtn, leaderboardBtn, quitBtn}
+	}
+	esm.menuUI = newButtonScreen(esm.tooltip, esm.buttons)
 	fmt.Printf("✓ %d boutons de menu créés\n", len(esm.buttons))
+
+	esm.createGameOverButtons()
+	esm.createLeaderboardScreen()
+	esm.createPauseMenu()
+	esm.createSettingsButtons()
+}
+
+// createGameOverButtons crée les boutons de l'écran de mort
+func (esm *EnhancedBuiltinStateManager) createGameOverButtons() {
+	centerX := float64(esm.screenWidth) / 2
+	startY := float64(esm.screenHeight)/2 + 60
+	buttonWidth := 220.0 * esm.accessibility.UIScale
+	buttonHeight := 45.0 * esm.accessibility.UIScale
+
+	// Bouton "Charger Sauvegarde"
+	reloadBtn := NewButton(
+		centerX-buttonWidth/2,
+		startY,
+		buttonWidth,
+		buttonHeight,
+		esm.localizer.Get("ui.gameover.load_save"),
+		func() {
+			log.Println("Charger Sauvegarde cliqué")
+			if esm.onLoadGame != nil {
+				esm.onLoadGame()
+			}
+		},
+	)
+	reloadBtn.Tooltip = esm.localizer.Get("ui.tooltip.load_save")
+
+	// Bouton "Menu Principal"
+	menuBtn := NewButton(
+		centerX-buttonWidth/2,
+		startY+buttonHeight+20,
+		buttonWidth,
+		buttonHeight,
+		esm.localizer.Get("ui.gameover.main_menu"),
+		func() {
+			log.Println("Menu Principal cliqué")
+			esm.ChangeState("menu")
+		},
+	)
+	menuBtn.Tooltip = esm.localizer.Get("ui.tooltip.main_menu")
+
+	esm.gameOverButtons = []*Button{reloadBtn, menuBtn}
+	esm.gameOverUI = newButtonScreen(esm.tooltip, esm.gameOverButtons)
+}
+
+// newButtonScreen regroupe les boutons d'un écran, dans l'ordre de navigation
+func newButtonScreen(tooltips *TooltipManager, buttons []*Button) *UIManager {
+	ui := NewUIManager(tooltips)
+	for _, button := range buttons {
+		ui.AddWidget(button)
+	}
+	return ui
+}
+
+// createPauseMenu crée le menu de pause et branche ses boutons
+func (esm *EnhancedBuiltinStateManager) createPauseMenu() {
+	esm.pauseMenu = NewPauseMenu(esm.screenWidth, esm.screenHeight, esm.accessibility.UIScale, PauseMenuLabels{
+		Title:       esm.localizer.Get("ui.pause.title"),
+		Resume:      esm.localizer.Get("ui.pause.resume"),
+		Save:        esm.localizer.Get("ui.pause.save"),
+		Settings:    esm.localizer.Get("ui.pause.settings"),
+		Quit:        esm.localizer.Get("ui.pause.quit"),
+		ConfirmQuit: esm.localizer.Get("ui.pause.confirm_quit"),
+		Yes:         esm.localizer.Get("ui.dialog.yes"),
+		No:          esm.localizer.Get("ui.dialog.no"),
+	})
+	esm.pauseMenu.OnResume = func() { esm.GoBack() }
+	esm.pauseMenu.OnSave = esm.SaveOnExit
+	esm.pauseMenu.OnSettings = func() { esm.PushState(StateSettings) }
+	esm.pauseMenu.OnQuit = func() {
+		log.Println("Retour au menu principal depuis la pause")
+		esm.ChangeState(StateMenu)
+	}
+}
+
+// createLeaderboardScreen crée l'écran du classement
+func (esm *EnhancedBuiltinStateManager) createLeaderboardScreen() {
+	esm.leaderboard = NewLeaderboardScreen(esm.screenWidth, esm.screenHeight, esm.accessibility.UIScale, LeaderboardLabels{
+		Title:   esm.localizer.Get("ui.leaderboard.title"),
+		Loading: esm.localizer.Get("ui.leaderboard.loading"),
+		Empty:   esm.localizer.Get("ui.leaderboard.empty"),
+		Error:   esm.localizer.Get("ui.leaderboard.error"),
+		Back:    esm.localizer.Get("ui.settings.back"),
+		Row:     esm.localizer.Get("ui.leaderboard.row"),
+	}, func() { esm.GoBack() })
+}
+
+// SetLeaderboardFetcher définit la lecture des meilleurs scores et ajoute le
+// bouton "Classement" au menu
+func (esm *EnhancedBuiltinStateManager) SetLeaderboardFetcher(fetch LeaderboardFetcher) {
+	esm.leaderboardFetcher = fetch
+	esm.createButtons()
+	esm.SetHasSaves(esm.hasSaves)
+}
+
+// enterLeaderboardState lance la lecture du classement
+func (esm *EnhancedBuiltinStateManager) enterLeaderboardState() {
+	esm.leaderboard.Load(esm.leaderboardFetcher)
+}
+
+// updateLeaderboardState met à jour l'écran du classement
+func (esm *EnhancedBuiltinStateManager) updateLeaderboardState(deltaTime time.Duration) {
+	esm.leaderboard.Update(esm.mousePos, esm.mousePressed)
+}
+
+// PlayIntro joue l'introduction (phrases intro.line.1, intro.line.2...) puis
+// ouvre le menu principal
+func (esm *EnhancedBuiltinStateManager) PlayIntro() {
+	esm.intro = NewIntroScreen(esm.screenWidth, esm.screenHeight,
+		esm.chatterPhrases("intro.line"), esm.localizer.Get("ui.intro.skip_hint"))
+	esm.intro.OnFinished = func() {
+		fmt.Println("Introduction terminée")
+		esm.ChangeState(StateMenu)
+		if esm.OnIntroFinished != nil {
+			esm.OnIntroFinished()
+		}
+	}
+	esm.ChangeState(StateIntro)
+	esm.intro.Start()
+}
+
+// updateIntroState fait défiler l'introduction : Espace ou un clic passe à
+// la phrase suivante, ESC passe tout (TogglePause)
+func (esm *EnhancedBuiltinStateManager) updateIntroState(deltaTime time.Duration) {
+	if esm.intro == nil {
+		esm.ChangeState(StateMenu)
+		return
+	}
+	next := false
+	if esm.input != nil {
+		next = esm.input.IsKeyJustPressedSystems(int(ebiten.KeySpace))
+		if mouse, ok := esm.input.(systems.MouseButtons); ok && mouse.IsMouseButtonJustPressed(int(ebiten.MouseButtonLeft)) {
+			next = true
+		}
+	}
+	esm.intro.Update(deltaTime, next)
+}
+
+// createSettingsButtons crée les options d'accessibilité de l'écran d'options
+func (esm *EnhancedBuiltinStateManager) createSettingsButtons() {
+	centerX := float64(esm.screenWidth) / 2
+	startY := float64(esm.screenHeight)/2 - 60
+	buttonWidth := 300.0 * esm.accessibility.UIScale
+	buttonHeight := 30.0 * esm.accessibility.UIScale
+	buttonSpacing := buttonHeight + 10
+
+	// Chaque bouton fait défiler les valeurs d'une option
+	actions := []func(accessibility *AccessibilityConfig){
+		func(a *AccessibilityConfig) { a.ColorblindMode = NextColorblindMode(a.ColorblindMode) },
+		func(a *AccessibilityConfig) { a.UIScale = NextUIScale(a.UIScale) },
+		func(a *AccessibilityConfig) { a.HighContrast = !a.HighContrast },
+		func(a *AccessibilityConfig) { a.ReduceMotion = !a.ReduceMotion },
+	}
+
+	esm.settingsButtons = make([]*Button, 0, len(actions)+4)
+	for i, action := range actions {
+		action := action
+		button := NewButton(centerX-buttonWidth/2, startY+float64(i)*buttonSpacing, buttonWidth, buttonHeight, "",
+			func() {
+				accessibility := esm.accessibility
+				action(&accessibility)
+				esm.SetAccessibility(accessibility)
+				if esm.OnAccessibilityChanged != nil {
+					esm.OnAccessibilityChanged(accessibility)
+				}
+			},
+		)
+		esm.settingsButtons = append(esm.settingsButtons, button)
+	}
+
+	// FPS cible, modifiable en cours de partie
+	fpsBtn := NewButton(centerX-buttonWidth/2, startY+float64(len(actions))*buttonSpacing, buttonWidth, buttonHeight, "",
+		func() { esm.SetTargetFPS(NextTargetFPS(esm.targetFPS)) },
+	)
+	esm.settingsButtons = append(esm.settingsButtons, fpsBtn)
+
+	// Sensibilité de la souris (réactivité de la visée)
+	sensitivityBtn := NewButton(centerX-buttonWidth/2, startY+float64(len(actions)+1)*buttonSpacing, buttonWidth, buttonHeight, "",
+		func() {
+			esm.SetMouseSensitivity(NextMouseSensitivity(esm.mouseSensitivity))
+			if esm.OnMouseSensitivityChanged != nil {
+				esm.OnMouseSensitivityChanged(esm.mouseSensitivity)
+			}
+		},
+	)
+	esm.settingsButtons = append(esm.settingsButtons, sensitivityBtn)
+
+	// Revoir l'introduction au prochain lancement
+	replayIntroBtn := NewButton(centerX-buttonWidth/2, startY+float64(len(actions)+2)*buttonSpacing, buttonWidth, buttonHeight, "",
+		func() {
+			esm.introReplayPending = true
+			esm.refreshSettingsButtons()
+			if esm.OnReplayIntro != nil {
+				esm.OnReplayIntro()
+			}
+		},
+	)
+	esm.settingsButtons = append(esm.settingsButtons, replayIntroBtn)
+
+	backBtn := NewButton(centerX-buttonWidth/2, startY+float64(len(actions)+3)*buttonSpacing+buttonSpacing/2,
+		buttonWidth, buttonHeight, esm.localizer.Get("ui.settings.back"), func() { esm.GoBack() })
+	esm.settingsButtons = append(esm.settingsButtons, backBtn)
+
+	// Infobulles décrivant chaque option, dans l'ordre des boutons
+	tooltips := []string{
+		"ui.tooltip.colorblind",
+		"ui.tooltip.ui_scale",
+		"ui.tooltip.high_contrast",
+		"ui.tooltip.reduce_motion",
+		"ui.tooltip.target_fps",
+		"ui.tooltip.mouse_sensitivity",
+		"ui.tooltip.replay_intro",
+		"ui.tooltip.back",
+	}
+	for i, key := range tooltips {
+		esm.settingsButtons[i].Tooltip = esm.localizer.Get(key)
+	}
+	esm.settingsUI = newButtonScreen(esm.tooltip, esm.settingsButtons)
+	esm.refreshSettingsButtons()
+}
+
+// refreshSettingsButtons met à jour les libellés des options d'accessibilité
+func (esm *EnhancedBuiltinStateManager) refreshSettingsButtons() {
+	if len(esm.settingsButtons) < 7 {
+		return
+	}
+
+	onOff := func(enabled bool) string {
+		if enabled {
+			return esm.localizer.Get("ui.common.on")
+		}
+		return esm.localizer.Get("ui.common.off")
+	}
+
+	a := esm.accessibility
+	esm.settingsButtons[0].Text = esm.localizer.Get("ui.accessibility.colorblind",
+		esm.localizer.Get("ui.accessibility.colorblind."+a.ColorblindMode))
+	esm.settingsButtons[1].Text = esm.localizer.Get("ui.accessibility.ui_scale", int(math.Round(a.UIScale*100)))
+	esm.settingsButtons[2].Text = esm.localizer.Get("ui.accessibility.high_contrast", onOff(a.HighContrast))
+	esm.settingsButtons[3].Text = esm.localizer.Get("ui.accessibility.reduce_motion", onOff(a.ReduceMotion))
+	esm.settingsButtons[4].Text = esm.localizer.Get("ui.settings.target_fps", esm.targetFPS)
+	esm.settingsButtons[5].Text = esm.localizer.Get("ui.settings.mouse_sensitivity", esm.mouseSensitivity)
+	esm.settingsButtons[6].Text = esm.localizer.Get("ui.settings.replay_intro")
+	if esm.introReplayPending {
+		esm.settingsButtons[6].Text = esm.localizer.Get("ui.settings.replay_intro_pending")
+	}
+}
+
+// SetTargetFPS change les FPS cibles et prévient OnTargetFPSChanged
+func (esm *EnhancedBuiltinStateManager) SetTargetFPS(fps int) {
+	if fps <= 0 {
+		return
+	}
+	esm.targetFPS = fps
+	esm.refreshSettingsButtons()
+	fmt.Printf("FPS cible: %d\n", fps)
+	if esm.OnTargetFPSChanged != nil {
+		esm.OnTargetFPSChanged(fps)
+	}
+}
+
+// SetAccessibility applique les options d'accessibilité à l'interface
+func (esm *EnhancedBuiltinStateManager) SetAccessibility(accessibility AccessibilityConfig) {
+	if accessibility.UIScale <= 0 {
+		accessibility.UIScale = 1.0
+	}
+	if !IsValidColorblindMode(accessibility.ColorblindMode) {
+		accessibility.ColorblindMode = ColorblindNone
+	}
+
+	rescale := accessibility.UIScale != esm.accessibility.UIScale
+	esm.accessibility = accessibility
+	esm.hud.SetScale(accessibility.UIScale)
+	esm.miniMap.SetScale(accessibility.UIScale)
+
+	// Les boutons sont recréés à la nouvelle taille
+	if rescale {
+		esm.createButtons()
+		esm.SetHasSaves(esm.hasSaves)
+	}
+	esm.refreshSettingsButtons()
+}
+
+// SetHUDTextOptions règle l'ombre et le contour des textes du HUD
+func (esm *EnhancedBuiltinStateManager) SetHUDTextOptions(options TextRenderOptions) {
+	esm.hud.TextOptions = options
+	esm.bossBar.TextOptions = options
+}
+
+// GetAccessibility retourne les options d'accessibilité courantes
+func (esm *EnhancedBuiltinStateManager) GetAccessibility() AccessibilityConfig {
+	return esm.accessibility
+}
+
+// registerConsoleCommands enregistre les commandes de debug du gameplay
+func (esm *EnhancedBuiltinStateManager) registerConsoleCommands() {
+	esm.console.RegisterCommand("freecam", "freecam - caméra libre (F3), le joueur est figé", func(args []string) string {
+		if !esm.freeCamera.Enabled {
+			return "Caméra libre indisponible (mode debug désactivé)"
+		}
+		if esm.freeCamera.Toggle() {
+			return "Caméra libre activée"
+		}
+		return "Caméra libre désactivée"
+	})
+
+	esm.console.RegisterCommand("godmode", "godmode on|off", func(args []string) string {
+		if len(args) != 1 || (args[0] != "on" && args[0] != "off") {
+			return "Usage: godmode on|off"
+		}
+		esm.playerSystem.SetGodMode(args[0] == "on")
+		return fmt.Sprintf("God mode: %s", args[0])
+	})
+
+	esm.console.RegisterCommand("dex", "dex <points> - attribue des points de dextérité", func(args []string) string {
+		player := esm.playerSystem.GetPlayer()
+		if player == nil {
+			return "Aucun joueur"
+		}
+		if len(args) != 1 {
+			return "Usage: dex <points>"
+		}
+		points, err := strconv.Atoi(args[0])
+		if err != nil || points <= 0 {
+			return "Usage: dex <points>"
+		}
+		player.Player.AllocateDexterity(points)
+		return fmt.Sprintf("Dextérité %d : %.0f%% de critique", player.Player.Dexterity, player.Player.CriticalChance*100)
+	})
+
+	esm.console.RegisterCommand("lockon", "lockon [off] - verrouille l'ennemi le plus proche", func(args []string) string {
+		if len(args) == 1 && args[0] == "off" {
+			esm.playerSystem.ClearLockOn()
+			return "Verrouillage désactivé"
+		}
+
+		target := esm.nearestEnemy()
+		if target == nil {
+			return "Aucun ennemi à verrouiller"
+		}
+		esm.playerSystem.SetLockOnTarget(target)
+		return fmt.Sprintf("Ennemi %d verrouillé", target.EntityID)
+	})
+
+	esm.console.RegisterCommand("benchmark", "benchmark <count> [batch|naive|instanced]|off - anime N sprites pour tester le rendu", func(args []string) string {
+		const usage = "Usage: benchmark <count> [batch|naive|instanced]|off"
+		if len(args) == 1 && args[0] == "off" {
+			esm.benchmark.Stop()
+			return "Benchmark arrêté"
+		}
+		if len(args) < 1 || len(args) > 2 {
+			return usage
+		}
+		count, err := strconv.Atoi(args[0])
+		if err != nil || count <= 0 {
+			return usage
+		}
+		path := BenchmarkBatch
+		if len(args) == 2 {
+			parsed, ok := ParseBenchmarkPath(args[1])
+			if !ok {
+				return usage
+			}
+			path = parsed
+		}
+		esm.benchmark.Path = path
+		esm.benchmark.Start(count)
+		return fmt.Sprintf("Benchmark: %d sprites (%s)", esm.benchmark.Count(), path)
+	})
+}
+
+// ShowDialog affiche une boîte de dialogue modale. Avec onConfirm, elle propose
+// Continuer/Annuler et n'appelle onConfirm que sur Continuer ; sinon un simple OK.
+func (esm *EnhancedBuiltinStateManager) ShowDialog(message string, onConfirm func()) {
+	dialog := NewDialogBox(esm.screenWidth, esm.screenHeight, message)
+	if onConfirm != nil {
+		dialog.AddButton(esm.localizer.Get("ui.dialog.continue"), onConfirm)
+		dialog.AddButton(esm.localizer.Get("ui.dialog.cancel"), nil)
+	} else {
+		dialog.AddButton(esm.localizer.Get("ui.dialog.ok"), nil)
+	}
+
+	dialog.Show()
+	esm.dialog = dialog
+}
+
+// IsDialogOpen retourne si une boîte de dialogue est affichée
+func (esm *EnhancedBuiltinStateManager) IsDialogOpen() bool {
+	return esm.dialog != nil && esm.dialog.IsVisible()
+}
+
+// nearestEnemy retourne l'ennemi vivant le plus proche du joueur, ou nil
+func (esm *EnhancedBuiltinStateManager) nearestEnemy() *systems.EnemyEntity {
+	playerPos := esm.playerSystem.GetPlayerPosition()
+
+	var nearest *systems.EnemyEntity
+	nearestDistance := math.MaxFloat64
+	for _, enemy := range esm.enemySystem.GetEnemies() {
+		if !enemy.IsTargetable() {
+			continue
+		}
+		diff := enemy.Position.Position.Sub(playerPos)
+		if distance := math.Hypot(diff.X, diff.Y); distance < nearestDistance {
+			nearest = enemy
+			nearestDistance = distance
+		}
+	}
+	return nearest
+}
+
+// SetTimeScale définit l'échelle de temps globale du gameplay
+func (esm *EnhancedBuiltinStateManager) SetTimeScale(scale float64) {
+	esm.timeScale = scale
+}
+
+// Concentration du joueur : ralenti du gameplay, mesuré en temps réel, qui
+// s'ajoute à l'échelle globale et la laisse intacte à la fin
+const (
+	focusTimeScale = 0.3
+	focusDuration  = 2 * time.Second
+)
+
+// SlowMotion applique un ralenti temporaire, mesuré en temps réel
+func (esm *EnhancedBuiltinStateManager) SlowMotion(scale float64, duration time.Duration) {
+	esm.slowMoScale = scale
+	esm.slowMoTimeout = duration
+}
+
+// gameplayTimeScale combine l'échelle globale, le ralenti temporaire et le voile de mort
+func (esm *EnhancedBuiltinStateManager) gameplayTimeScale(realDelta time.Duration) float64 {
+	scale := esm.timeScale
+
+	if esm.slowMoTimeout > 0 {
+		esm.slowMoTimeout -= realDelta
+		scale *= esm.slowMoScale
+	}
+	if esm.focusTimeout > 0 {
+		esm.focusTimeout -= realDelta
+		scale *= focusTimeScale
+	}
+	if esm.dying {
+		scale *= esm.deathTimeScale
+	}
+
+	return scale
+}
+
+// SetDeathFade configure le voile de mort (les valeurs nulles sont ignorées)
+func (esm *EnhancedBuiltinStateManager) SetDeathFade(duration time.Duration, tint Color, timeScale float64) {
+	if duration > 0 {
+		esm.deathFadeDuration = duration
+	}
+	if tint.A > 0 {
+		esm.deathFadeTint = tint
+	}
+	if timeScale > 0 {
+		esm.deathTimeScale = timeScale
+	}
 }
 
 // SetCallbacks définit les callbacks externes
@@ -132,9 +950,13 @@ func (esm *EnhancedBuiltinStateManager) SetCallbacks(onNewGame, onLoadGame, onQu
 
 // SetHasSaves définit si des sauvegardes existent
 func (esm *EnhancedBuiltinStateManager) SetHasSaves(hasSaves bool) {
+	esm.hasSaves = hasSaves
 	if len(esm.buttons) >= 2 {
 		esm.buttons[1].SetEnabled(hasSaves) // Bouton "Charger Partie"
 	}
+	if len(esm.gameOverButtons) >= 1 {
+		esm.gameOverButtons[0].SetEnabled(hasSaves) // Bouton "Charger Sauvegarde"
+	}
 }
 
 // SetInputManager injecte le gestionnaire d'entrées dans le système joueur
@@ -145,55 +967,1393 @@ func (esm *EnhancedBuiltinStateManager) SetInputManager(inputManager interface{}
 
 	// Adaptation de l'interface
 	if im, ok := inputManager.(systems.InputManager); ok {
+		esm.input = im
 		esm.playerSystem.SetInputManager(im)
 		if esm.debugSprites {
 			fmt.Println("✓ InputManager injecté dans PlayerSystem")
 		}
-	} else {
-		fmt.Printf("⚠ InputManager type incompatible: %T\n", inputManager)
+	} else {
+		fmt.Printf("⚠ InputManager type incompatible: %T\n", inputManager)
+	}
+}
+
+// SetCamera injecte la caméra
+func (esm *EnhancedBuiltinStateManager) SetCamera(camera interface{}) {
+	if esm.debugSprites {
+		fmt.Printf("SetCamera appelé avec: %T\n", camera)
+	}
+
+	esm.playerSystem.SetCamera(camera)
+	esm.aimCamera, _ = camera.(interface{ ScreenToWorld(Vector2) Vector2 })
+	if target, ok := camera.(FreeCameraTarget); ok {
+		esm.freeCamera.SetCamera(target)
+	}
+	if zoomable, ok := camera.(ZoomCamera); ok {
+		esm.cameraZoom.SetCamera(zoomable)
+	}
+	esm.recenterCamera, _ = camera.(CameraRecenterTarget)
+	esm.boundedCamera, _ = camera.(MapBoundedCamera)
+
+	if esm.debugSprites {
+		fmt.Println("✓ Camera injectée dans PlayerSystem")
+	}
+}
+
+// SetMouseAim active la visée à la souris : le joueur fait face au curseur
+// (sauf à la manette, où l'orientation suit le déplacement), attaque au clic
+// gauche et bloque au clic droit
+func (esm *EnhancedBuiltinStateManager) SetMouseAim(enabled bool) {
+	esm.mouseAim = enabled
+	esm.playerSystem.SetMouseCombat(enabled)
+	if !enabled {
+		esm.playerSystem.ClearAim()
+	}
+}
+
+// mouseAimFollowRate vitesse (par seconde) à laquelle le point visé rattrape
+// le curseur, à une sensibilité de 1.0
+const mouseAimFollowRate = 20.0
+
+// SetCameraZoomStep règle le facteur de zoom par cran de molette ou appui
+// (InputConfig.CameraZoomStep), en jeu comme en caméra libre
+func (esm *EnhancedBuiltinStateManager) SetCameraZoomStep(step float64) {
+	esm.cameraZoom.SetStep(step)
+	esm.freeCamera.ZoomStep = esm.cameraZoom.Step
+}
+
+// Suivi du joueur rétabli par RecenterCamera (comme PlayerSystem.updateCamera)
+const cameraFollowSpeed = 3.0
+
+var cameraFollowOffset = Vector2{X: 0, Y: -20}
+
+// CameraRecenterTarget caméra que ActionCameraReset recentre (rendering.Camera)
+type CameraRecenterTarget interface {
+	Reset()
+	SetPosition(position Vector2)
+	FollowTarget(target interface{}, speed float64, offset Vector2)
+}
+
+// playerCameraTarget présente le joueur comme cible suivie par la caméra
+type playerCameraTarget struct {
+	players *systems.PlayerSystem
+}
+
+// GetPosition retourne la position du joueur
+func (pt playerCameraTarget) GetPosition() Vector2 {
+	position := pt.players.GetPlayerPosition()
+	return Vector2{X: position.X, Y: position.Y}
+}
+
+// RecenterCamera (ActionCameraReset, Origine) ramène la caméra sur le joueur au
+// zoom par défaut, après la caméra libre ou un panoramique, et rétablit son
+// suivi
+func (esm *EnhancedBuiltinStateManager) RecenterCamera() {
+	esm.freeCamera.Deactivate()
+	esm.cameraZoom.Reset()
+	if esm.recenterCamera == nil {
+		return
+	}
+
+	target := playerCameraTarget{players: esm.playerSystem}
+	esm.recenterCamera.Reset()
+	esm.recenterCamera.SetPosition(target.GetPosition().Add(cameraFollowOffset))
+	esm.recenterCamera.FollowTarget(target, cameraFollowSpeed, cameraFollowOffset)
+	esm.applyMapBounds()
+	fmt.Println("Caméra recentrée sur le joueur")
+}
+
+// MapBoundedCamera caméra que les limites de la carte retiennent (rendering.Camera)
+type MapBoundedCamera interface {
+	SetBounds(bounds Rectangle)
+	RemoveBounds()
+}
+
+// MapGrid dimensions d'une carte en tuiles (world.TileMap)
+type MapGrid interface {
+	GridSize() (int, int)
+	GetTileSize() float64
+}
+
+// MapWorldBounds retourne le rectangle couvert par la carte dans le monde,
+// en pixels ; ok est faux pour une carte vide
+func MapWorldBounds(grid MapGrid) (bounds Rectangle, ok bool) {
+	width, height := grid.GridSize()
+	tileSize := grid.GetTileSize()
+	if width <= 0 || height <= 0 || tileSize <= 0 {
+		return Rectangle{}, false
+	}
+	return NewRectangle(0, 0, float64(width)*tileSize, float64(height)*tileSize), true
+}
+
+// SetMapBounds retient la caméra dans la carte chargée : la vue ne montre
+// jamais au-delà de ses bords (nil ou carte vide : aucune limite)
+func (esm *EnhancedBuiltinStateManager) SetMapBounds(grid MapGrid) {
+	esm.mapBounds = nil
+	if grid != nil {
+		if bounds, ok := MapWorldBounds(grid); ok {
+			esm.mapBounds = &bounds
+			fmt.Printf("✓ Caméra limitée à la carte: %.0fx%.0f px\n", bounds.Width, bounds.Height)
+		}
+	}
+	if esm.states.Current() == StateGameplay {
+		esm.applyMapBounds()
+	}
+}
+
+// applyMapBounds impose les limites de la carte à la caméra, ou les retire
+func (esm *EnhancedBuiltinStateManager) applyMapBounds() {
+	if esm.boundedCamera == nil {
+		return
+	}
+	if esm.mapBounds == nil {
+		esm.boundedCamera.RemoveBounds()
+		return
+	}
+	esm.boundedCamera.SetBounds(*esm.mapBounds)
+}
+
+// cameraZoomInput retourne les crans de zoom demandés cette frame
+func (esm *EnhancedBuiltinStateManager) cameraZoomInput() float64 {
+	if zoom, ok := esm.input.(interface{ CameraZoomInput() float64 }); ok {
+		return zoom.CameraZoomInput()
+	}
+	return 0
+}
+
+// SetMouseSensitivity règle la réactivité de la visée à la souris
+// (InputConfig.MouseSensitivity, ramenée dans ses bornes)
+func (esm *EnhancedBuiltinStateManager) SetMouseSensitivity(sensitivity float64) {
+	esm.mouseSensitivity = ClampMouseSensitivity(sensitivity)
+	esm.refreshSettingsButtons()
+}
+
+// GetMouseSensitivity retourne la sensibilité de la souris
+func (esm *EnhancedBuiltinStateManager) GetMouseSensitivity() float64 {
+	return esm.mouseSensitivity
+}
+
+// updateMouseAim oriente le joueur vers le point visé, qui suit le curseur
+// converti en position monde (en temps réel, même pendant un ralenti)
+func (esm *EnhancedBuiltinStateManager) updateMouseAim(realDelta time.Duration) {
+	if !esm.mouseAim || esm.aimCamera == nil || esm.usingGamepad() {
+		esm.playerSystem.ClearAim()
+		esm.aimPointSet = false
+		return
+	}
+
+	cursor := esm.aimCamera.ScreenToWorld(esm.mousePos)
+	if !esm.aimPointSet {
+		esm.aimPoint = cursor
+		esm.aimPointSet = true
+	} else {
+		follow := 1 - math.Exp(-mouseAimFollowRate*esm.mouseSensitivity*realDelta.Seconds())
+		esm.aimPoint = esm.aimPoint.Add(cursor.Sub(esm.aimPoint).Mul(follow))
+	}
+	esm.playerSystem.SetAimTarget(components.Vector2{X: esm.aimPoint.X, Y: esm.aimPoint.Y})
+}
+
+// SetSpriteLoader injecte le chargeur de sprites dans le système de joueur
+func (esm *EnhancedBuiltinStateManager) SetSpriteLoader(loader interface{}) {
+	fmt.Printf("\n=== SetSpriteLoader appelé ===\n")
+	fmt.Printf("Type du loader: %T\n", loader)
+
+	// Vérifier que c'est bien notre SpriteLoader des assets
+	if spriteLoader, ok := loader.(*assets.SpriteLoader); ok {
+		fmt.Println("✓ Type SpriteLoader correct détecté")
+
+		// Injecter dans le PlayerSystem
+		if esm.playerSystem != nil {
+			fmt.Println("PlayerSystem disponible, injection du SpriteLoader...")
+			esm.playerSystem.SetSpriteLoader(spriteLoader)
+			fmt.Println("✓ SpriteLoader injecté dans le PlayerSystem")
+
+			// Vérifier si on a déjà un joueur créé
+			if esm.playerSystem.GetPlayer() != nil {
+				fmt.Println("Joueur déjà existant, sprites seront chargés automatiquement...")
+			}
+		} else {
+			fmt.Println("⚠ ERREUR: PlayerSystem est nil!")
+		}
+	} else {
+		fmt.Printf("⚠ Type inattendu pour SpriteLoader: %T\n", loader)
+	}
+
+	fmt.Println("=== Fin SetSpriteLoader ===")
+}
+
+// setupChallengeRooms place les salles de défi de la zone de départ
+func (esm *EnhancedBuiltinStateManager) setupChallengeRooms() {
+	esm.challengeSystem.Clear()
+
+	bounds := components.Rectangle{X: 60, Y: 440, Width: 320, Height: 220}
+	esm.challengeSystem.AddRoom("arena_sud_ouest", bounds, 45*time.Second, 150,
+		[]components.Vector2{
+			{X: bounds.X + 60, Y: bounds.Y + 50},
+			{X: bounds.X + bounds.Width - 60, Y: bounds.Y + 50},
+			{X: bounds.X + bounds.Width/2, Y: bounds.Y + bounds.Height - 50},
+		},
+	)
+}
+
+// SetTreasureRoom définit la salle au trésor recréée à chaque nouvelle partie
+func (esm *EnhancedBuiltinStateManager) SetTreasureRoom(room TreasureRoomDef) {
+	esm.treasureRoomDef = &room
+}
+
+// setupTreasureRoom recrée la salle au trésor : rochers à leur place, coffre fermé
+func (esm *EnhancedBuiltinStateManager) setupTreasureRoom() {
+	esm.treasureRoom = nil
+	if esm.treasureRoomDef == nil {
+		return
+	}
+
+	def := esm.treasureRoomDef
+	walls := make([]components.Rectangle, len(def.Walls))
+	for i, wall := range def.Walls {
+		walls[i] = toComponentRect(wall)
+	}
+
+	room := systems.NewTreasureRoom(walls, toComponentRect(def.Door), components.Vector2{X: def.Chest.X, Y: def.Chest.Y},
+		toComponentPoints(def.Plates), toComponentPoints(def.Boulders), def.Loot)
+
+	// Le butin se répand autour du coffre
+	room.OnChestOpened = func(chest *systems.TreasureChest) {
+		for i, item := range chest.Loot {
+			angle := 2 * math.Pi * float64(i) / float64(len(chest.Loot))
+			esm.itemSystem.SpawnItem(item, chest.Position.X+36*math.Cos(angle), chest.Position.Y+36*math.Sin(angle))
+		}
+	}
+
+	esm.interactionSystem.Register(room.Door)
+	esm.treasureRoom = room
+}
+
+// roomEntrance salle de départ du plan du donjon : tout ce qui n'est pas
+// dans une autre salle
+const roomEntrance = "entree"
+
+// treasureRoomID identifiant de la salle au trésor dans le plan du donjon
+const treasureRoomID = "salle_tresor"
+
+// roomArea zone du monde occupée par une salle du plan
+type roomArea struct {
+	id     string
+	bounds Rectangle
+}
+
+// setupRoomGraph recrée le plan du donjon : l'entrée, reliée aux salles de
+// défi, à la salle au trésor et aux destinations des portails (une salle par
+// direction libre)
+func (esm *EnhancedBuiltinStateManager) setupRoomGraph() {
+	graph := NewRoomGraph()
+	graph.AddNode(RoomNode{ID: roomEntrance, Type: RoomSafe})
+	esm.roomAreas = esm.roomAreas[:0]
+
+	directions := []Direction{DirectionDown, DirectionRight, DirectionLeft, DirectionUp}
+	link := func(id string, roomType RoomType) {
+		if _, exists := graph.Node(id); exists {
+			return
+		}
+		if len(directions) == 0 {
+			fmt.Printf("⚠ Plan du donjon: aucune sortie libre pour la salle %s\n", id)
+			return
+		}
+		direction := directions[0]
+		directions = directions[1:]
+		offset := direction.ToVector2()
+		graph.AddNode(RoomNode{ID: id, GridX: int(offset.X), GridY: int(offset.Y), Type: roomType})
+		graph.AddEdge(roomEntrance, id, direction)
+	}
+
+	for _, room := range esm.challengeSystem.GetRooms() {
+		link(room.ID, RoomCombat)
+		bounds := Rectangle{X: room.Bounds.X, Y: room.Bounds.Y, Width: room.Bounds.Width, Height: room.Bounds.Height}
+		esm.roomAreas = append(esm.roomAreas, roomArea{id: room.ID, bounds: bounds})
+	}
+	if esm.treasureRoomDef != nil {
+		link(treasureRoomID, RoomTreasure)
+		esm.roomAreas = append(esm.roomAreas, roomArea{id: treasureRoomID, bounds: esm.treasureRoomDef.Bounds})
+	}
+	for _, item := range esm.itemSystem.GetItems() {
+		if item.Kind == systems.ItemKindPortal && item.Destination != "" {
+			link(item.Destination, RoomCombat)
+		}
+	}
+
+	graph.Visit(roomEntrance)
+	esm.roomGraph = graph
+	esm.miniMap.SetRoomGraph(graph)
+}
+
+// updateRoomGraph marque comme visitée la salle où se trouve le joueur
+func (esm *EnhancedBuiltinStateManager) updateRoomGraph(playerPos components.Vector2) {
+	if esm.roomGraph == nil {
+		return
+	}
+	current := roomEntrance
+	for _, area := range esm.roomAreas {
+		if area.bounds.Contains(Vector2{playerPos.X, playerPos.Y}) {
+			current = area.id
+			break
+		}
+	}
+	if current != esm.roomGraph.Current {
+		esm.roomGraph.Visit(current)
+	}
+}
+
+// GetRoomGraph retourne le plan du donjon (sauvegarde)
+func (esm *EnhancedBuiltinStateManager) GetRoomGraph() *RoomGraph {
+	return esm.roomGraph
+}
+
+// RestoreRoomGraph remplace le plan du donjon par celui d'une sauvegarde
+func (esm *EnhancedBuiltinStateManager) RestoreRoomGraph(graph *RoomGraph) {
+	esm.roomGraph = graph
+	esm.miniMap.SetRoomGraph(graph)
+}
+
+// SetRopes définit les cordes et ponts recréés à chaque nouvelle partie
+func (esm *EnhancedBuiltinStateManager) SetRopes(ropes []RopeDef) {
+	esm.ropeDefs = ropes
+}
+
+// setupRopes recrée les cordes au repos, tendues entre leurs points
+func (esm *EnhancedBuiltinStateManager) setupRopes() {
+	esm.ropeSystem.Clear()
+	for _, def := range esm.ropeDefs {
+		segments := def.Segments
+		if segments <= 0 {
+			segments = DefaultRopeSegments
+		}
+		start := components.Vector2{X: def.Start.X, Y: def.Start.Y}
+		end := components.Vector2{X: def.End.X, Y: def.End.Y}
+
+		slack := def.Slack
+		if slack < 1 {
+			slack = 1
+		}
+
+		switch def.Kind {
+		case RopeKindBridge:
+			if bridge := esm.ropeSystem.AddBridge(start, end, segments, def.Width); bridge != nil {
+				bridge.Rails[0].SegmentLength *= slack
+				bridge.Rails[1].SegmentLength *= slack
+			}
+		case RopeKindVine:
+			rope := esm.ropeSystem.AddRope(start, end, segments, def.Attached, components.Color{R: 60, G: 140, B: 50, A: 255}, 3)
+			rope.Rope.SegmentLength *= slack
+		default:
+			rope := esm.ropeSystem.AddRope(start, end, segments, def.Attached, components.Color{R: 150, G: 150, B: 160, A: 255}, 2)
+			rope.Rope.SegmentLength *= slack
+		}
+	}
+}
+
+// SetNPCs définit les PNJ placés à chaque nouvelle partie
+func (esm *EnhancedBuiltinStateManager) SetNPCs(npcs []NPCDef) {
+	esm.npcDefs = npcs
+}
+
+// SetParticles active les particules et les plafonne selon la qualité ("low", "medium", "high")
+func (esm *EnhancedBuiltinStateManager) SetParticles(enabled bool, quality string) {
+	esm.particleSystem.Enabled = enabled
+	esm.particleSystem.SetQuality(quality)
+	if !enabled {
+		esm.particleSystem.Clear()
+	}
+}
+
+// SetDayNight active le cycle jour/nuit selon la configuration
+func (esm *EnhancedBuiltinStateManager) SetDayNight(config DayNightConfig) {
+	if !config.Enabled {
+		esm.dayNight = nil
+		return
+	}
+	esm.dayNight = NewDayNightCycle(config)
+	fmt.Printf("✓ Cycle jour/nuit activé (%.0fs)\n", esm.dayNight.length.Seconds())
+}
+
+// GetDayNight retourne le cycle jour/nuit (nil s'il est désactivé)
+func (esm *EnhancedBuiltinStateManager) GetDayNight() *DayNightCycle {
+	return esm.dayNight
+}
+
+// SetQuests définit les quêtes suivies ; elles repartent à chaque nouvelle partie
+func (esm *EnhancedBuiltinStateManager) SetQuests(quests []QuestDef) {
+	esm.questSystem.SetQuests(quests)
+}
+
+// GetQuestSystem retourne le système de quêtes
+func (esm *EnhancedBuiltinStateManager) GetQuestSystem() *QuestSystem {
+	return esm.questSystem
+}
+
+// locateNPC retourne la position d'un PNJ par son nom
+func (esm *EnhancedBuiltinStateManager) locateNPC(name string) (Vector2, bool) {
+	for _, npc := range esm.npcSystem.GetNPCs() {
+		if npc.Name == name {
+			return Vector2{npc.Position.X, npc.Position.Y}, true
+		}
+	}
+	return Vector2{}, false
+}
+
+// SetNPCChatter règle l'intervalle aléatoire entre deux phrases des PNJ et la
+// durée d'affichage des bulles
+func (esm *EnhancedBuiltinStateManager) SetNPCChatter(minInterval, maxInterval, bubbleDuration time.Duration) {
+	if minInterval > 0 {
+		esm.npcSystem.MinInterval = minInterval
+	}
+	if maxInterval >= esm.npcSystem.MinInterval {
+		esm.npcSystem.MaxInterval = maxInterval
+	}
+	if bubbleDuration > 0 {
+		esm.npcSystem.BubbleDuration = bubbleDuration
+	}
+}
+
+// setupNPCs recrée les PNJ avec les phrases de la langue courante
+func (esm *EnhancedBuiltinStateManager) setupNPCs() {
+	esm.npcSystem.Clear()
+	for _, def := range esm.npcDefs {
+		esm.npcSystem.AddNPC(def.Name, components.Vector2{X: def.Position.X, Y: def.Position.Y}, esm.chatterPhrases(def.Chatter))
+	}
+}
+
+// chatterPhrases retourne les phrases prefix.1, prefix.2... jusqu'à la première absente
+func (esm *EnhancedBuiltinStateManager) chatterPhrases(prefix string) []string {
+	if prefix == "" {
+		prefix = DefaultNPCChatter
+	}
+	var phrases []string
+	for i := 1; esm.localizer.Has(fmt.Sprintf("%s.%d", prefix, i)); i++ {
+		phrases = append(phrases, esm.localizer.Get(fmt.Sprintf("%s.%d", prefix, i)))
+	}
+	return phrases
+}
+
+// SetEnemyArchetypes définit les ennemis placés à chaque nouvelle partie
+func (esm *EnhancedBuiltinStateManager) SetEnemyArchetypes(archetypes []EnemyArchetype) {
+	esm.enemyArchetypes = archetypes
+}
+
+// Effets sonores du gameplay et flash d'un coup critique
+const (
+	critFlashFrameCount = 2
+	sfxCriticalHit      = "sfx_critical_hit"
+	sfxHeal             = "sfx_heal"
+	sfxHealFailed       = "sfx_heal_failed"
+	sfxAntidote         = "sfx_antidote"
+)
+
+// AntidoteItemID objet de l'inventaire qui guérit le poison
+const AntidoteItemID = "Antidote"
+
+// useAntidote boit un antidote de l'inventaire si le joueur est empoisonné :
+// tous les cumuls de poison sont guéris. Retourne false (la fiole est bue à
+// la place) si le joueur n'est pas empoisonné ou n'a pas d'antidote.
+func (esm *EnhancedBuiltinStateManager) useAntidote() bool {
+	if !esm.statusEffects.IsPoisoned() || !esm.inventory.Has(AntidoteItemID, 1) {
+		return false
+	}
+	if err := esm.inventory.Remove(AntidoteItemID, 1); err != nil {
+		return false
+	}
+	esm.statusEffects.Cure(esm.playerSystem.GetPlayer())
+	esm.playPlayerSound(sfxAntidote)
+	return true
+}
+
+// SetStatusEffects branche la grille du monde sur les altérations d'état
+// (sols empoisonnés) ; poisonFloors sont dessinés sous les entités
+func (esm *EnhancedBuiltinStateManager) SetStatusEffects(tiles TileSource, poisonFloors []Rectangle) {
+	if tiles != nil {
+		esm.statusEffects.SetGrid(tileAdapter{source: tiles})
+	}
+	esm.statusEffects.PoisonFloors = make([]components.Rectangle, len(poisonFloors))
+	for i, floor := range poisonFloors {
+		esm.statusEffects.PoisonFloors[i] = toComponentRect(floor)
+	}
+}
+
+// GetStatusEffects retourne le système des altérations d'état
+func (esm *EnhancedBuiltinStateManager) GetStatusEffects() *systems.StatusEffectSystem {
+	return esm.statusEffects
+}
+
+// SetSoundPlayer définit la banque des effets sonores du gameplay
+func (esm *EnhancedBuiltinStateManager) SetSoundPlayer(sounds systems.SoundPlayer) {
+	esm.sounds = sounds
+	esm.destructibleTiles.SetSoundPlayer(sounds)
+}
+
+// soundListener est implémenté par les banques qui positionnent leurs effets
+// par rapport au joueur
+type soundListener interface {
+	SetListener(position audio.Vector2)
+}
+
+// playPlayerSound joue un effet émis par le joueur
+func (esm *EnhancedBuiltinStateManager) playPlayerSound(sfxID string) {
+	if esm.sounds == nil {
+		return
+	}
+	esm.sounds.Play(sfxID, 1.0, systems.SoundAt(esm.playerSystem.GetPlayerPosition()))
+}
+
+// updateSoundListener place l'auditeur des effets sonores sur le joueur
+func (esm *EnhancedBuiltinStateManager) updateSoundListener() {
+	listener, ok := esm.sounds.(soundListener)
+	if !ok {
+		return
+	}
+	position := esm.playerSystem.GetPlayerPosition()
+	listener.SetListener(audio.Vector2{X: position.X, Y: position.Y})
+}
+
+// SetSoulGainMultiplier multiplie les âmes gagnées par ennemi vaincu
+func (esm *EnhancedBuiltinStateManager) SetSoulGainMultiplier(multiplier float64) {
+	if multiplier > 0 {
+		esm.combatSystem.SoulsPerKill = int(math.Round(float64(esm.combatSystem.SoulsPerKill) * multiplier))
+	}
+}
+
+// SetExperienceMultiplier applique le multiplicateur d'expérience par ennemi vaincu
+func (esm *EnhancedBuiltinStateManager) SetExperienceMultiplier(multiplier float64) {
+	if multiplier > 0 {
+		esm.combatSystem.ExperiencePerKill = int(math.Round(float64(esm.combatSystem.ExperiencePerKill) * multiplier))
+	}
+}
+
+// GetSouls retourne les âmes portées par le joueur
+func (esm *EnhancedBuiltinStateManager) GetSouls() int {
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		return player.Player.Souls
+	}
+	return 0
+}
+
+// GetBloodstain retourne la tache de sang en attente (nil si aucune)
+func (esm *EnhancedBuiltinStateManager) GetBloodstain() *systems.Bloodstain {
+	return esm.bloodstainSystem.GetBloodstain()
+}
+
+// RestoreSouls remet les âmes portées par le joueur depuis une sauvegarde
+func (esm *EnhancedBuiltinStateManager) RestoreSouls(souls int) {
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		player.Player.Souls = souls
+	}
+}
+
+// RestoreBloodstain remet la tache de sang en attente depuis une sauvegarde
+func (esm *EnhancedBuiltinStateManager) RestoreBloodstain(position Vector2, souls int) {
+	esm.bloodstainSystem.Restore(&systems.Bloodstain{
+		Position: components.Vector2{X: position.X, Y: position.Y},
+		Souls:    souls,
+	})
+}
+
+// SetCriticalMultiplier règle le multiplicateur de dégâts des coups critiques
+func (esm *EnhancedBuiltinStateManager) SetCriticalMultiplier(multiplier float64) {
+	if multiplier > 0 {
+		esm.combatSystem.CriticalMultiplier = multiplier
+	}
+}
+
+// SetChainLightning règle le nombre de rebonds et leur portée (pixels)
+func (esm *EnhancedBuiltinStateManager) SetChainLightning(maxBounces int, bounceRange float64) {
+	if maxBounces > 0 {
+		esm.spellSystem.MaxBounces = maxBounces
+	}
+	if bounceRange > 0 {
+		esm.spellSystem.BounceRange = bounceRange
+	}
+}
+
+// SetRecipes définit les recettes de fabrication et crée le panneau associé
+func (esm *EnhancedBuiltinStateManager) SetRecipes(recipes *crafting.RecipeSystem) {
+	esm.recipeSystem = recipes
+	esm.craftingPanel = NewCraftingPanel(esm.screenWidth, esm.screenHeight, recipes, esm.inventory, esm.localizer)
+}
+
+// SetSkillTree définit l'arbre de compétences et crée le panneau associé
+func (esm *EnhancedBuiltinStateManager) SetSkillTree(tree *skilltree.SkillTree) {
+	esm.skillTree = tree
+	esm.skillTreePanel = NewSkillTreePanel(esm.screenWidth, esm.screenHeight, tree, esm.localizer)
+	esm.skillTreePanel.OnUnlock = esm.unlockSkill
+}
+
+// ToggleSkillTree ouvre ou ferme l'arbre de compétences (en jeu uniquement)
+func (esm *EnhancedBuiltinStateManager) ToggleSkillTree() {
+	if esm.skillTreePanel == nil || esm.states.Current() != StateGameplay {
+		return
+	}
+	esm.skillTreePanel.Toggle()
+}
+
+// skillPoints retourne les points de compétence du joueur
+func (esm *EnhancedBuiltinStateManager) skillPoints() int {
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		return player.Player.SkillPoints
+	}
+	return 0
+}
+
+// unlockSkill débloque un nœud avec les points du joueur, qui en paie le coût
+func (esm *EnhancedBuiltinStateManager) unlockSkill(nodeID string) {
+	player := esm.playerSystem.GetPlayer()
+	if esm.skillTree == nil || player == nil {
+		return
+	}
+	esm.skillTree.SetPlayer(player.Player)
+	if err := esm.skillTree.Unlock(nodeID, player.Player.SkillPoints); err != nil {
+		fmt.Printf("⚠ Compétence '%s' non débloquée: %v\n", nodeID, err)
+		return
+	}
+	player.Player.SkillPoints -= esm.skillTree.GetNode(nodeID).Cost
+	fmt.Printf("✓ Compétence débloquée: %s\n", esm.skillTree.GetNode(nodeID).Name)
+}
+
+// Progression niveau, expérience et compétences du joueur, sauvegardés
+type Progression struct {
+	Level            int
+	Experience       int
+	ExperienceToNext int
+	SkillPoints      int
+	UnlockedSkills   []string
+}
+
+// GetProgression retourne la progression du joueur (false : aucun joueur)
+func (esm *EnhancedBuiltinStateManager) GetProgression() (Progression, bool) {
+	player := esm.playerSystem.GetPlayer()
+	if player == nil {
+		return Progression{}, false
+	}
+	progression := Progression{
+		Level:            player.Player.Level,
+		Experience:       player.Player.Experience,
+		ExperienceToNext: player.Player.ExperienceToNext,
+		SkillPoints:      player.Player.SkillPoints,
+	}
+	if esm.skillTree != nil {
+		progression.UnlockedSkills = esm.skillTree.Unlocked()
+	}
+	return progression, true
+}
+
+// RestoreProgression remet la progression d'une sauvegarde et réapplique les
+// effets des compétences débloquées au joueur
+func (esm *EnhancedBuiltinStateManager) RestoreProgression(progression Progression) {
+	player := esm.playerSystem.GetPlayer()
+	if player == nil {
+		return
+	}
+	if progression.Level > 0 {
+		player.Player.Level = progression.Level
+		player.Player.Experience = progression.Experience
+		player.Player.ExperienceToNext = progression.ExperienceToNext
+	}
+	player.Player.SkillPoints = progression.SkillPoints
+	if esm.skillTree != nil {
+		esm.skillTree.SetPlayer(player.Player)
+		esm.skillTree.Restore(progression.UnlockedSkills)
+	}
+}
+
+// GetInventory retourne l'inventaire du joueur
+func (esm *EnhancedBuiltinStateManager) GetInventory() *crafting.Inventory {
+	return esm.inventory
+}
+
+// ToggleCrafting ouvre ou ferme le panneau de fabrication (en jeu uniquement)
+func (esm *EnhancedBuiltinStateManager) ToggleCrafting() {
+	if esm.craftingPanel == nil || esm.states.Current() != StateGameplay {
+		return
+	}
+	esm.craftingPanel.Toggle()
+}
+
+// updateWorkbench le feu de camp le plus proche sert d'établi
+func (esm *EnhancedBuiltinStateManager) updateWorkbench(playerPos components.Vector2) {
+	if esm.recipeSystem == nil {
+		return
+	}
+	if _, ok := esm.interactionSystem.Nearest(playerPos).(*systems.Bonfire); ok {
+		esm.recipeSystem.SetWorkbench(crafting.WorkbenchBonfire)
+	} else {
+		esm.recipeSystem.SetWorkbench("")
+	}
+}
+
+// SetLevelSpawns définit les entités de la carte placées à chaque nouvelle partie
+func (esm *EnhancedBuiltinStateManager) SetLevelSpawns(spawns []SpawnDef) {
+	esm.levelSpawns = spawns
+}
+
+// SetDensitySpawns définit les entités tirées au hasard à chaque nouvelle partie :
+// count tirages dans table, selon la couche de densité, espacés de minSeparation pixels
+func (esm *EnhancedBuiltinStateManager) SetDensitySpawns(density systems.SpawnDensityMap, table []SpawnTableEntry, count int, minSeparation float64) {
+	esm.spawnSystem.SetDensityMap(density)
+	if minSeparation > 0 {
+		esm.spawnSystem.MinSeparation = minSeparation
+	}
+
+	esm.densityTable = make(systems.SpawnTable, len(table))
+	for i, entry := range table {
+		esm.densityTable[i] = systems.SpawnTableEntry{Kind: entry.Type, ID: entry.ID, Weight: entry.Weight}
+	}
+	esm.densitySpawns = count
+}
+
+// SightSource grille du monde capable de tester la ligne de vue (world.TileMap)
+type SightSource interface {
+	HasLineOfSight(from, to Vector2) bool
+}
+
+// sightAdapter adapte une SightSource aux vecteurs des systèmes ECS
+type sightAdapter struct {
+	source SightSource
+}
+
+// HasLineOfSight convertit les positions et délègue à la grille
+func (sa sightAdapter) HasLineOfSight(from, to components.Vector2) bool {
+	return sa.source.HasLineOfSight(Vector2{X: from.X, Y: from.Y}, Vector2{X: to.X, Y: to.Y})
+}
+
+// SetLineOfSight branche la grille des tuiles solides sur l'aggro des ennemis
+func (esm *EnhancedBuiltinStateManager) SetLineOfSight(source SightSource) {
+	if source == nil {
+		esm.enemySystem.SetLineOfSight(nil)
+		return
+	}
+	esm.enemySystem.SetLineOfSight(sightAdapter{source: source})
+}
+
+// WallSource grille des tuiles solides qui bloquent le joueur (world.TileMap)
+type WallSource interface {
+	GetTileSize() float64
+	IsSolid(tx, ty int) bool
+}
+
+// SetWalls branche la grille des tuiles solides sur les collisions du joueur
+func (esm *EnhancedBuiltinStateManager) SetWalls(source WallSource) {
+	if source == nil {
+		esm.collisionSystem.SetWalls(nil)
+		return
+	}
+	esm.collisionSystem.SetWalls(source)
+}
+
+// TileSource grille du monde donnant la tuile sous une position (world.TileMap)
+type TileSource interface {
+	GetTileAt(position Vector2) (components.TileComponent, bool)
+}
+
+// tileAdapter adapte une TileSource aux vecteurs des systèmes ECS
+type tileAdapter struct {
+	source TileSource
+}
+
+// GetTileAt convertit la position et délègue à la grille
+func (ta tileAdapter) GetTileAt(position components.Vector2) (components.TileComponent, bool) {
+	return ta.source.GetTileAt(Vector2{X: position.X, Y: position.Y})
+}
+
+// SetFootsteps branche la grille du monde et la banque de sons sur les bruits de pas
+func (esm *EnhancedBuiltinStateManager) SetFootsteps(tiles TileSource, sounds systems.SoundPlayer, stepInterval float64) {
+	if tiles != nil {
+		esm.footstepSystem.SetGrid(tileAdapter{source: tiles})
+	}
+	esm.footstepSystem.SetSoundPlayer(sounds)
+	if stepInterval > 0 {
+		esm.footstepSystem.StepInterval = stepInterval
+	}
+}
+
+// SetDestructibleTiles branche la grille du monde sur les murs que l'espadon
+// peut briser
+func (esm *EnhancedBuiltinStateManager) SetDestructibleTiles(grid systems.DestructibleTileGrid) {
+	esm.destructibleTiles.SetGrid(grid)
+}
+
+// SetShowPathfinding active le tracé des chemins de patrouille et des chemins A*
+func (esm *EnhancedBuiltinStateManager) SetShowPathfinding(show bool) {
+	esm.enemySystem.GetPatrolSystem().ShowDebug = show
+	esm.enemySystem.ShowPathDebug = show
+}
+
+// SetPathfinding branche la grille du monde sur la recherche de chemin des ennemis.
+// interval borne le coût CPU : chaque ennemi recalcule son chemin au plus une fois par interval.
+func (esm *EnhancedBuiltinStateManager) SetPathfinding(grid pathfinding.Grid, interval time.Duration, maxNodes int) {
+	esm.enemySystem.SetPathGrid(grid)
+	if interval > 0 {
+		esm.enemySystem.PathRecomputeInterval = interval
+	}
+	if maxNodes > 0 {
+		esm.enemySystem.MaxPathNodes = maxNodes
+	}
+}
+
+// neighborAdapter adapte la grille spatiale aux identifiants et rectangles des systèmes ECS
+type neighborAdapter struct {
+	grid *SpatialGrid
+}
+
+// Clear vide la grille
+func (na neighborAdapter) Clear() {
+	na.grid.Clear()
+}
+
+// Insert convertit les bornes et indexe l'entité
+func (na neighborAdapter) Insert(id uint32, bounds components.Rectangle) {
+	na.grid.Insert(EntityID(id), Rectangle{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: bounds.Height})
+}
+
+// QueryRegion convertit la zone et les identifiants retournés
+func (na neighborAdapter) QueryRegion(region components.Rectangle) []uint32 {
+	ids := na.grid.QueryRegion(Rectangle{X: region.X, Y: region.Y, Width: region.Width, Height: region.Height})
+	result := make([]uint32, len(ids))
+	for i, id := range ids {
+		result[i] = uint32(id)
+	}
+	return result
+}
+
+// SetEnemySeparation règle la répulsion entre ennemis qui poursuivent le joueur
+func (esm *EnhancedBuiltinStateManager) SetEnemySeparation(radius, weight float64) {
+	if radius > 0 {
+		esm.enemySystem.SeparationRadius = radius
+	}
+	if weight > 0 {
+		esm.enemySystem.SeparationWeight = weight
+	}
+}
+
+// spawnArchetypes place les ennemis des archétypes et leurs patrouilles
+func (esm *EnhancedBuiltinStateManager) spawnArchetypes() {
+	for _, archetype := range esm.enemyArchetypes {
+		esm.spawnArchetype(archetype, archetype.Spawn)
+		fmt.Printf("✓ Ennemi '%s' placé en (%.0f, %.0f)\n", archetype.Name, archetype.Spawn.X, archetype.Spawn.Y)
+	}
+}
+
+// spawnArchetype place un ennemi de l'archétype ; sa patrouille suit le
+// déplacement entre le point d'apparition de l'archétype et position
+func (esm *EnhancedBuiltinStateManager) spawnArchetype(archetype EnemyArchetype, position Vector2) *systems.EnemyEntity {
+	enemy := esm.newEnemy(archetype, position, "")
+	esm.applyArchetype(enemy, archetype, position)
+	return enemy
+}
+
+// newEnemy crée l'ennemi de base d'un archétype : un boss nommé, un membre de
+// formation ou un ennemi isolé
+func (esm *EnhancedBuiltinStateManager) newEnemy(archetype EnemyArchetype, position Vector2, formationID string) *systems.EnemyEntity {
+	switch {
+	case archetype.Boss != "":
+		health := archetype.Health
+		if health <= 0 {
+			health = DefaultBossHealth
+		}
+		return esm.enemySystem.SpawnBoss(archetype.Boss, position.X, position.Y, health)
+	case formationID != "":
+		return esm.enemySystem.SpawnInFormation(formationID, position.X, position.Y)
+	default:
+		return esm.enemySystem.SpawnEnemy(position.X, position.Y)
+	}
+}
+
+// applyArchetype donne à un ennemi placé en position les caractéristiques et
+// la patrouille de l'archétype
+func (esm *EnhancedBuiltinStateManager) applyArchetype(enemy *systems.EnemyEntity, archetype EnemyArchetype, position Vector2) {
+	if archetype.Health > 0 {
+		enemy.Enemy.Health = archetype.Health
+		enemy.Enemy.MaxHealth = archetype.Health
+	}
+	if archetype.AttackPower > 0 {
+		enemy.Enemy.AttackPower = archetype.AttackPower
+	}
+	enemy.Enemy.SetPoise(archetype.Poise, archetype.PoiseRegen)
+	if len(archetype.Hull) > 0 {
+		enemy.Hull = components.NewConvexHullCollider(toComponentPoints(archetype.Hull), components.LayerEnemy)
+	}
+
+	offset := position.Sub(archetype.Spawn)
+	switch patrol := archetype.Patrol; {
+	case len(patrol.Waypoints) > 0:
+		esm.enemySystem.GetPatrolSystem().AssignWaypoints(enemy, toComponentPoints(translatePoints(patrol.Waypoints, offset)), patrol.Loop)
+	case len(patrol.ControlPoints) > 0:
+		path := pathfinding.NewBezierPath(toComponentPoints(translatePoints(patrol.ControlPoints, offset)))
+		esm.enemySystem.GetPatrolSystem().Assign(enemy, path, patrol.Loop)
+	}
+}
+
+// findArchetype retourne l'archétype d'ennemi portant ce nom
+func (esm *EnhancedBuiltinStateManager) findArchetype(name string) (EnemyArchetype, bool) {
+	for _, archetype := range esm.enemyArchetypes {
+		if archetype.Name == name {
+			return archetype, true
+		}
+	}
+	return EnemyArchetype{}, false
+}
+
+// spawnLevel place les ennemis, objets, portails et feux de camp définis par la carte
+func (esm *EnhancedBuiltinStateManager) spawnLevel() {
+	esm.spawnLevelEnemies()
+	for i, spawn := range esm.levelSpawns {
+		switch spawn.Type {
+		case SpawnTypeItem:
+			esm.itemSystem.SpawnItem(spawn.Name, spawn.Position.X, spawn.Position.Y)
+		case SpawnTypePortal:
+			portal := esm.itemSystem.SpawnPortal(spawn.Name, spawn.Position.X, spawn.Position.Y)
+			portal.Destination = spawn.Destination
+			portal.Arrival = components.Vector2{X: spawn.Arrival.X, Y: spawn.Arrival.Y}
+		case SpawnTypeBonfire:
+			esm.addBonfire(spawn.Name, spawn.Position.X, spawn.Position.Y)
+		case SpawnTypeSign:
+			esm.addSign(signFirstID+uint32(i), spawn.Position, components.NewSignComponent(spawn.Message, spawn.FontSize))
+		}
+	}
+	fmt.Printf("✓ %d entité(s) de la carte placée(s)\n", len(esm.levelSpawns))
+}
+
+// spawnLevelEnemies place les ennemis définis par la carte
+func (esm *EnhancedBuiltinStateManager) spawnLevelEnemies() {
+	for _, spawn := range esm.levelSpawns {
+		if spawn.Type == SpawnTypeEnemy {
+			archetype, ok := esm.findArchetype(spawn.Archetype)
+			enemy := esm.newEnemy(archetype, spawn.Position, spawn.Formation)
+			if ok {
+				esm.applyArchetype(enemy, archetype, spawn.Position)
+			} else {
+				fmt.Printf("⚠ Archétype '%s' inconnu : ennemi par défaut\n", spawn.Archetype)
+			}
+			if len(spawn.Patrol) > 0 {
+				esm.enemySystem.GetPatrolSystem().AssignWaypoints(enemy, toComponentPoints(spawn.Patrol), spawn.Loop)
+			}
+			enemy.Drops = spawn.Drops
+		}
+	}
+}
+
+// populateLevel place les entités de la carte, sinon celles par défaut
+func (esm *EnhancedBuiltinStateManager) populateLevel() {
+	esm.enemySystem.Clear()
+	esm.itemSystem.Clear()
+	esm.miniMap.ClearPings()
+	esm.interactionSystem.Clear()
+	esm.bonfires = esm.bonfires[:0]
+	esm.signs = esm.signs[:0]
+
+	if len(esm.levelSpawns) > 0 {
+		esm.spawnLevel()
+	} else {
+		esm.spawnArchetypes()
+		esm.setupItems()
+	}
+
+	esm.spawnSystem.Clear()
+	if placed := esm.spawnSystem.PopulateRoom(esm.densityTable, esm.densitySpawns); len(placed) > 0 {
+		fmt.Printf("✓ %d entité(s) placée(s) selon la densité\n", len(placed))
+	}
+}
+
+// spawnFromTable crée une entité tirée dans la table d'apparition
+func (esm *EnhancedBuiltinStateManager) spawnFromTable(entry systems.SpawnTableEntry, position components.Vector2) {
+	switch entry.Kind {
+	case SpawnTypeEnemy:
+		if archetype, ok := esm.findArchetype(entry.ID); ok {
+			esm.spawnArchetype(archetype, Vector2{position.X, position.Y})
+			return
+		}
+		fmt.Printf("⚠ Archétype '%s' inconnu : ennemi par défaut\n", entry.ID)
+		esm.enemySystem.SpawnEnemy(position.X, position.Y)
+	case SpawnTypeItem:
+		esm.itemSystem.SpawnItem(entry.ID, position.X, position.Y)
+	default:
+		fmt.Printf("⚠ Type d'entité inconnu dans la table d'apparition: %q\n", entry.Kind)
+	}
+}
+
+// translatePoints décale des points de offset
+func translatePoints(points []Vector2, offset Vector2) []Vector2 {
+	translated := make([]Vector2, len(points))
+	for i, point := range points {
+		translated[i] = point.Add(offset)
+	}
+	return translated
+}
+
+// toComponentRect convertit un rectangle de configuration en rectangle des systèmes ECS
+func toComponentRect(rect Rectangle) components.Rectangle {
+	return components.Rectangle{X: rect.X, Y: rect.Y, Width: rect.Width, Height: rect.Height}
+}
+
+// toComponentPoints convertit des points de configuration en vecteurs des systèmes ECS
+func toComponentPoints(points []Vector2) []components.Vector2 {
+	converted := make([]components.Vector2, len(points))
+	for i, point := range points {
+		converted[i] = components.Vector2{X: point.X, Y: point.Y}
+	}
+	return converted
+}
+
+// setupProps place les piliers et arbres de la zone de départ
+func (esm *EnhancedBuiltinStateManager) setupProps() {
+	esm.transparencySystem.Clear()
+
+	pillar := components.Color{R: 110, G: 105, B: 100, A: 255}
+	tree := components.Color{R: 40, G: 110, B: 50, A: 255}
+
+	esm.transparencySystem.AddProp(460, 220, 40, 110, pillar, true)
+	esm.transparencySystem.AddProp(820, 220, 40, 110, pillar, true)
+	esm.transparencySystem.AddProp(980, 480, 90, 130, tree, true)
+	esm.transparencySystem.AddProp(640, 560, 70, 100, tree, true)
+}
+
+// setupItems place les objets et le portail de la zone de départ
+func (esm *EnhancedBuiltinStateManager) setupItems() {
+	esm.itemSystem.SpawnItem("Fiole d'Estus", 300, 420)
+	esm.itemSystem.SpawnItem("Éclat de titanite", 900, 140)
+	esm.itemSystem.SpawnPortal("Portail ancien", 1100, 620)
+	esm.addBonfire("Feu du sanctuaire", 560, 360)
+	esm.addSign(signFirstID, Vector2{520, 300}, components.NewSignComponent("sign.sanctuary", 0))
+}
+
+// ===============================
+// PANNEAUX
+// ===============================
+
+// Réglages des panneaux
+const (
+	signFirstID         = 30000 // Plage d'IDs des panneaux : un par entité de la carte
+	signScrollRepeat    = 150 * time.Millisecond
+	signPromptOffset    = 34.0 // Hauteur de l'infobulle au-dessus du panneau
+	signFontLineSpacing = 7.0  // Interligne ajouté à la taille de police
+)
+
+// Événement de lecture d'un panneau
+const stateEventReadSign = "read_sign"
+
+// addSign place un panneau, déjà lu s'il l'a été dans la partie sauvegardée
+func (esm *EnhancedBuiltinStateManager) addSign(id uint32, position Vector2, sign *components.SignComponent) {
+	s := systems.NewSign(id, position.X, position.Y, sign)
+	s.Read = esm.readSigns[id]
+	s.OnRead = esm.openSign
+	esm.interactionSystem.Register(s)
+	esm.signs = append(esm.signs, s)
+}
+
+// openSign retient le panneau comme lu et affiche son message
+func (esm *EnhancedBuiltinStateManager) openSign(sign *systems.Sign) {
+	esm.readSigns[sign.EntityID] = true
+
+	message := sign.Sign.Message
+	if esm.localizer.Has(message) {
+		message = esm.localizer.Get(message)
+	}
+	esm.signDialog = NewDialogBox(esm.screenWidth, esm.screenHeight, message)
+	esm.signDialog.LineHeight = float64(sign.Sign.FontSize) + signFontLineSpacing
+	esm.signDialog.Hint = esm.localizer.Get("ui.sign.close_hint")
+	esm.signDialog.Show()
+	esm.signScrollWait = 0
+	esm.pushEvent(stateEventReadSign)
+}
+
+// closeSign masque le panneau lu et reprend la partie
+func (esm *EnhancedBuiltinStateManager) closeSign() {
+	esm.signDialog = nil
+	esm.GoBack()
+}
+
+// updateSignReadState fait défiler le message (haut/bas) ; E le ferme
+func (esm *EnhancedBuiltinStateManager) updateSignReadState(deltaTime time.Duration) {
+	if esm.signDialog == nil {
+		esm.GoBack()
+		return
+	}
+	if esm.input == nil {
+		return
+	}
+	if esm.input.IsKeyJustPressedSystems(int(ebiten.KeyE)) {
+		esm.closeSign()
+		return
+	}
+
+	direction := 0
+	if esm.input.IsActionPressedSystems(0) { // Haut
+		direction--
+	}
+	if esm.input.IsActionPressedSystems(1) { // Bas
+		direction++
+	}
+	if direction == 0 {
+		esm.signScrollWait = 0
+		return
+	}
+	esm.signScrollWait -= deltaTime
+	if esm.signScrollWait <= 0 {
+		esm.signDialog.ScrollBy(direction)
+		esm.signScrollWait = signScrollRepeat
+	}
+}
+
+// renderSignPrompt affiche l'infobulle au-dessus du panneau à portée du joueur
+func (esm *EnhancedBuiltinStateManager) renderSignPrompt(renderer Renderer) {
+	if !esm.playerSystem.IsPlayerAlive() {
+		return
+	}
+	sign, ok := esm.interactionSystem.Nearest(esm.playerSystem.GetPlayerPosition()).(*systems.Sign)
+	if !ok {
+		return
+	}
+	prompt := esm.localizer.Get("ui.sign.read_prompt")
+	width := float64(len([]rune(prompt))) * 7
+	esm.drawHUDText(renderer, prompt, Vector2{sign.Position.X - width/2, sign.Position.Y - signPromptOffset}, ColorWhite)
+}
+
+// GetReadSigns retourne les panneaux déjà lus (sauvegarde)
+func (esm *EnhancedBuiltinStateManager) GetReadSigns() map[uint32]bool {
+	readSigns := make(map[uint32]bool, len(esm.readSigns))
+	for id, read := range esm.readSigns {
+		readSigns[id] = read
+	}
+	return readSigns
+}
+
+// RestoreReadSigns restaure les panneaux déjà lus d'une sauvegarde
+func (esm *EnhancedBuiltinStateManager) RestoreReadSigns(readSigns map[uint32]bool) {
+	esm.readSigns = make(map[uint32]bool, len(readSigns))
+	for id, read := range readSigns {
+		esm.readSigns[id] = read
+	}
+	for _, sign := range esm.signs {
+		sign.Read = esm.readSigns[sign.EntityID]
+	}
+}
+
+// ===============================
+// FEUX DE CAMP
+// ===============================
+
+// BonfireCheckpoint dernier feu de camp où le joueur s'est reposé
+type BonfireCheckpoint struct {
+	Name     string
+	Position Vector2
+}
+
+// SetOnRest définit l'action déclenchée à chaque repos (sauvegarde de la partie)
+func (esm *EnhancedBuiltinStateManager) SetOnRest(onRest func(checkpoint BonfireCheckpoint)) {
+	esm.onRest = onRest
+}
+
+// GetLastBonfire retourne le dernier feu de camp où le joueur s'est reposé
+func (esm *EnhancedBuiltinStateManager) GetLastBonfire() (BonfireCheckpoint, bool) {
+	if esm.lastBonfire == nil {
+		return BonfireCheckpoint{}, false
+	}
+	return *esm.lastBonfire, true
+}
+
+// ResumeAtBonfire démarre une partie au feu de camp d'une sauvegarde
+func (esm *EnhancedBuiltinStateManager) ResumeAtBonfire(checkpoint BonfireCheckpoint) {
+	esm.startNewGame()
+	esm.setLastBonfire(checkpoint)
+	esm.playerSystem.CreatePlayer(checkpoint.Position.X, checkpoint.Position.Y)
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		esm.hud.Reset(player.Player)
+	}
+	fmt.Printf("✓ Reprise au feu de camp '%s'\n", checkpoint.Name)
+}
+
+// ResumeAtStart démarre une partie au point de départ, pour une sauvegarde
+// faite avant tout repos à un feu de camp
+func (esm *EnhancedBuiltinStateManager) ResumeAtStart() {
+	esm.startNewGame()
+	fmt.Println("✓ Reprise au point de départ")
+}
+
+// addBonfire place un feu de camp interactif
+func (esm *EnhancedBuiltinStateManager) addBonfire(name string, x, y float64) {
+	bonfire := systems.NewBonfire(name, x, y)
+	bonfire.OnRest = esm.restAtBonfire
+	esm.interactionSystem.Register(bonfire)
+	esm.bonfires = append(esm.bonfires, bonfire)
+}
+
+// restAtBonfire retient le feu de camp, ramène les ennemis puis sauvegarde
+func (esm *EnhancedBuiltinStateManager) restAtBonfire(bonfire *systems.Bonfire, player *systems.PlayerEntity) {
+	checkpoint := BonfireCheckpoint{
+		Name:     bonfire.Name,
+		Position: Vector2{bonfire.Position.X, bonfire.Position.Y},
+	}
+	esm.setLastBonfire(checkpoint)
+	esm.respawnEnemies()
+
+	if esm.onRest != nil {
+		esm.onRest(checkpoint)
+	}
+}
+
+// setLastBonfire retient le point de réapparition et allume son feu de camp
+func (esm *EnhancedBuiltinStateManager) setLastBonfire(checkpoint BonfireCheckpoint) {
+	esm.lastBonfire = &checkpoint
+	for _, bonfire := range esm.bonfires {
+		if bonfire.Name == checkpoint.Name {
+			bonfire.Lit = true
+		}
+	}
+}
+
+// respawnEnemies ramène tous les ennemis ordinaires à leur point d'apparition
+func (esm *EnhancedBuiltinStateManager) respawnEnemies() {
+	esm.enemySystem.ClearNonBoss()
+	if len(esm.levelSpawns) > 0 {
+		esm.spawnLevelEnemies()
+	} else {
+		esm.spawnArchetypes()
+	}
+	esm.spawnSystem.Respawn(SpawnTypeEnemy)
+}
+
+// respawnAtBonfire ramène le joueur mort au dernier feu de camp
+func (esm *EnhancedBuiltinStateManager) respawnAtBonfire() {
+	checkpoint := *esm.lastBonfire
+	fmt.Printf("Réapparition au feu de camp '%s'\n", checkpoint.Name)
+
+	esm.playerSystem.CreatePlayer(checkpoint.Position.X, checkpoint.Position.Y)
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		esm.hud.Reset(player.Player)
+	}
+	esm.respawnEnemies()
+	esm.combo.Reset()
+	esm.cooldowns.Reset()
+	esm.dying = false
+	esm.slowMoTimeout = 0
+	esm.focusTimeout = 0
+}
+
+// registerComboHit compte un coup porté dans le combo ; un palier affiche un
+// « COMBO BONUS »
+func (esm *EnhancedBuiltinStateManager) registerComboHit() {
+	milestone := esm.combo.Hit()
+	esm.hud.PulseCombo()
+	if !milestone {
+		return
+	}
+	fmt.Printf("✓ COMBO BONUS : %d coups enchaînés (x%.1f)\n", esm.combo.Count, esm.combo.Multiplier)
+	esm.hud.Notify(esm.localizer.Get("ui.hud.combo_bonus", esm.combo.Count))
+}
+
+// GetCooldowns retourne les temps de recharge des capacités
+func (esm *EnhancedBuiltinStateManager) GetCooldowns() *CooldownManager {
+	return esm.cooldowns
+}
+
+// GetCombo retourne le combo en cours
+func (esm *EnhancedBuiltinStateManager) GetCombo() *components.ComboMeter {
+	return esm.combo
+}
+
+// updateItems ramasse les objets touchés, marque comme vus ceux proches quand
+// le joueur interagit, et signale sur la mini-carte ceux encore à découvrir
+func (esm *EnhancedBuiltinStateManager) updateItems(playerPos components.Vector2) {
+	esm.itemSystem.Update(playerPos)
+
+	// Un feu de camp à portée a priorité sur le marquage des objets
+	if esm.playerSystem.ConsumeInteract() && !esm.interactionSystem.TryInteract(esm.playerSystem.GetPlayer()) {
+		for _, item := range esm.itemSystem.MarkSeen(playerPos, minimapPingRadius) {
+			esm.miniMap.RemovePing(EntityID(item.EntityID))
+		}
+	}
+
+	for _, item := range esm.itemSystem.Undiscovered(playerPos, minimapPingRadius) {
+		pingType := PingItem
+		if item.Kind == systems.ItemKindPortal {
+			pingType = PingPortal
+		}
+		esm.miniMap.AddPing(EntityID(item.EntityID), Vector2{item.Position.X, item.Position.Y}, pingType)
+	}
+
+	// Portail à portée : sa salle de destination est préchargée
+	portal := esm.itemSystem.NearestPortal(playerPos, portalPreloadRadius)
+	if portal != nil && portal.Destination != esm.preloadRoom {
+		esm.preloadRoom = portal.Destination
+		if esm.OnPortalApproach != nil {
+			esm.OnPortalApproach(portal.Destination)
+		}
 	}
+
+	esm.updatePortalEntry(playerPos)
 }
 
-// SetCamera injecte la caméra
-func (esm *EnhancedBuiltinStateManager) SetCamera(camera interface{}) {
-	if esm.debugSprites {
-		fmt.Printf("SetCamera appelé avec: %T\n", camera)
-	}
+// Distances (pixels) auxquelles un portail déclenche le préchargement de sa
+// salle, puis le passage du joueur
+const (
+	portalPreloadRadius = 8 * TileSize
+	portalEnterRadius   = 20.0
+)
 
-	esm.playerSystem.SetCamera(camera)
+// updatePortalEntry fait passer le joueur qui entre dans un portail : tout de
+// suite si sa salle est préchargée, sinon après un fondu au noir qui dure
+// jusqu'à la fin du préchargement
+func (esm *EnhancedBuiltinStateManager) updatePortalEntry(playerPos components.Vector2) {
+	// Seule l'entrée compte : rester sur le portail ne le fait pas repasser
+	portal := esm.itemSystem.NearestPortal(playerPos, portalEnterRadius)
+	entered := portal != nil && portal != esm.portalInside
+	esm.portalInside = portal
+	if !entered {
+		return
+	}
 
-	if esm.debugSprites {
-		fmt.Println("✓ Camera injectée dans PlayerSystem")
+	esm.portalTarget = portal
+	if esm.roomReady(portal.Destination) {
+		esm.passPortal(portal)
+		return
 	}
+	fmt.Printf("Salle %s en cours de préchargement : le fondu attend\n", portal.Destination)
+	esm.roomTransition.Start(portal.Destination)
 }
 
-// SetSpriteLoader injecte le chargeur de sprites dans le système de joueur
-func (esm *EnhancedBuiltinStateManager) SetSpriteLoader(loader interface{}) {
-	fmt.Printf("\n=== SetSpriteLoader appelé ===\n")
-	fmt.Printf("Type du loader: %T\n", loader)
-
-	// Vérifier que c'est bien notre SpriteLoader des assets
-	if spriteLoader, ok := loader.(*assets.SpriteLoader); ok {
-		fmt.Println("✓ Type SpriteLoader correct détecté")
+// passPortal fait entrer le joueur dans la salle du portail, à son point
+// d'arrivée s'il en a un
+func (esm *EnhancedBuiltinStateManager) passPortal(portal *systems.ItemEntity) {
+	fmt.Printf("Passage du portail '%s' vers la salle %s\n", portal.Name, portal.Destination)
+	player := esm.playerSystem.GetPlayer()
+	if player == nil || portal.Arrival == (components.Vector2{}) {
+		return
+	}
+	player.Position.Position = portal.Arrival
+	player.Position.BeginStep() // Pas d'interpolation depuis le portail
+	player.Movement.Velocity = components.Vector2{}
+}
 
-		// Injecter dans le PlayerSystem
-		if esm.playerSystem != nil {
-			fmt.Println("PlayerSystem disponible, injection du SpriteLoader...")
-			esm.playerSystem.SetSpriteLoader(spriteLoader)
-			fmt.Println("✓ SpriteLoader injecté dans le PlayerSystem")
+// roomReady indique si une salle est préchargée ; sans préchargeur, elles le
+// sont toutes
+func (esm *EnhancedBuiltinStateManager) roomReady(roomID string) bool {
+	return esm.RoomReady == nil || esm.RoomReady(roomID)
+}
 
-			// Vérifier si on a déjà un joueur créé
-			if esm.playerSystem.GetPlayer() != nil {
-				fmt.Println("Joueur déjà existant, sprites seront chargés automatiquement...")
-			}
-		} else {
-			fmt.Println("⚠ ERREUR: PlayerSystem est nil!")
-		}
-	} else {
-		fmt.Printf("⚠ Type inattendu pour SpriteLoader: %T\n", loader)
+// SetInterpolationAlpha transmet aux systèmes l'avancement entre deux ticks
+// utilisé pour interpoler les positions au rendu. Hors gameplay les entités
+// sont figées : elles restent dessinées à leur position courante.
+func (esm *EnhancedBuiltinStateManager) SetInterpolationAlpha(alpha float64) {
+	if esm.states.Current() != StateGameplay {
+		alpha = 1
 	}
+	esm.playerSystem.SetInterpolationAlpha(alpha)
+	esm.enemySystem.SetInterpolationAlpha(alpha)
+}
 
-	fmt.Println("=== Fin SetSpriteLoader ===")
+// GetStatTracker retourne les statistiques persistantes
+func (esm *EnhancedBuiltinStateManager) GetStatTracker() *systems.StatTracker {
+	return esm.statTracker
+}
+
+// GetChallengeSystem retourne le système des salles de défi
+func (esm *EnhancedBuiltinStateManager) GetChallengeSystem() *systems.ChallengeSystem {
+	return esm.challengeSystem
 }
 
 // startNewGame démarre une nouvelle partie
@@ -205,13 +2365,46 @@ func (esm *EnhancedBuiltinStateManager) startNewGame() {
 	playerY := float64(esm.screenHeight) / 2
 
 	fmt.Printf("Création du joueur à la position (%.1f, %.1f)\n", playerX, playerY)
+	esm.lastBonfire = nil
+	esm.readSigns = make(map[uint32]bool)
+	esm.signDialog = nil
+	esm.roomTransition = NewRoomTransition()
+	esm.portalInside = nil
+	esm.portalTarget = nil
+	esm.combo.Reset()
+	esm.cooldowns.Reset()
+	esm.focusTimeout = 0
+	esm.freeCamera.Deactivate()
+	esm.statusEffects.Clear(nil)
 	esm.playerSystem.CreatePlayer(playerX, playerY)
+	if esm.skillTree != nil {
+		esm.skillTree.Reset()
+	}
+	esm.populateLevel()
+	esm.setupChallengeRooms()
+	esm.setupTreasureRoom()
+	esm.setupRoomGraph()
+	esm.setupRopes()
+	esm.setupNPCs()
+	esm.questSystem.Reset()
+	esm.setupProps()
+	esm.decalSystem.Clear()
+	esm.spellSystem.Clear()
+	esm.damageNumbers.Clear()
+	esm.particleSystem.Clear()
+	esm.bloodstainSystem.Clear()
+	esm.inventory.Clear()
+	esm.critFlashFrames = 0
+	esm.footstepSystem.Reset()
+	esm.dying = false
+	esm.slowMoTimeout = 0
 
 	// Vérifier que le joueur est bien créé
 	if esm.playerSystem.GetPlayer() != nil {
 		fmt.Println("✓ Joueur créé avec succès")
 
 		player := esm.playerSystem.GetPlayer()
+		esm.hud.Reset(player.Player)
 		fmt.Printf("  - Position: (%.1f, %.1f)\n", player.Position.Position.X, player.Position.Position.Y)
 		fmt.Printf("  - Actif: %t\n", player.Active)
 		fmt.Printf("  - Sprites: %t\n", player.PlayerSprites != nil)
@@ -232,7 +2425,7 @@ func (esm *EnhancedBuiltinStateManager) startNewGame() {
 	}
 
 	fmt.Println("✓ Nouvelle partie démarrée!")
-	fmt.Println("=== FIN DÉMARRAGE NOUVELLE PARTIE ===\n")
+	fmt.Print("=== FIN DÉMARRAGE NOUVELLE PARTIE ===\n\n")
 }
 
 // UpdateMouseInput met à jour les entrées souris
@@ -255,23 +2448,89 @@ func (esm *EnhancedBuiltinStateManager) Update(deltaTime time.Duration) error {
 		esm.debugSpriteState()
 	}
 
-	// Mettre à jour selon l'état actuel
-	switch esm.currentState {
-	case "menu":
-		esm.updateMenuState(deltaTime)
-	case "gameplay":
-		esm.updateGameplayState(deltaTime)
-	case "pause":
-		esm.updatePauseState(deltaTime)
+	// La console ouverte capture le clavier et fige le jeu
+	esm.console.Update()
+	if esm.console.IsOpen() {
+		return nil
+	}
+
+	// Une boîte de dialogue ouverte capture la souris et fige le jeu ; ses
+	// clics ne doivent pas devenir des attaques une fois fermée
+	if esm.dialog != nil && esm.dialog.IsVisible() {
+		esm.dialog.Update(esm.mousePos, esm.mousePressed)
+		esm.playerSystem.SuppressMouseUntilRelease()
+		return nil
+	}
+
+	// Le panneau de fabrication ouvert fige aussi le jeu
+	if esm.states.Current() == StateGameplay && esm.craftingPanel != nil && esm.craftingPanel.IsVisible() {
+		esm.craftingPanel.Update(deltaTime, esm.mousePos, esm.mousePressed)
+		esm.playerSystem.SuppressMouseUntilRelease()
+		return nil
+	}
+
+	// De même pour l'arbre de compétences
+	if esm.states.Current() == StateGameplay && esm.skillTreePanel != nil && esm.skillTreePanel.IsVisible() {
+		esm.skillTreePanel.Update(esm.skillPoints(), esm.mousePos, esm.mousePressed)
+		esm.playerSystem.SuppressMouseUntilRelease()
+		return nil
 	}
 
+	// Mettre à jour selon l'état actuel
+	esm.states.Update(deltaTime)
+	esm.hud.UpdateNotifications(deltaTime)
+
 	return nil
 }
 
+// Événements des transitions d'état
+const (
+	stateEventPause = "pause" // ESC en jeu
+	stateEventDie   = "die"   // Fin du fondu de mort sans feu de camp
+)
+
+// setupStates déclare les états du jeu et les transitions déclenchées par
+// événement ; les autres changements passent par la pile d'états
+func (esm *EnhancedBuiltinStateManager) setupStates() {
+	esm.states.
+		AddState(StateMenu, nil, nil, esm.updateMenuState).
+		AddState(StateGameplay, esm.enterGameplayState, nil, esm.updateGameplayState).
+		AddState(StatePause, nil, nil, esm.updatePauseState).
+		AddState(StateSettings, nil, nil, esm.updateSettingsState).
+		AddState(StateGameOver, esm.enterGameOverState, nil, esm.updateGameOverState).
+		AddState(StateSignRead, nil, nil, esm.updateSignReadState).
+		AddState(StateLeaderboard, esm.enterLeaderboardState, nil, esm.updateLeaderboardState).
+		AddState(StateIntro, nil, nil, esm.updateIntroState)
+
+	// Un panneau ouvert est fermé par ESC au lieu de mettre en pause
+	esm.states.
+		Transition(StateGameplay, stateEventPause, StatePause, func() bool { return !esm.panelOpen() }).
+		Transition(StateGameplay, stateEventDie, StateGameOver, nil).
+		Transition(StateGameplay, stateEventReadSign, StateSignRead, nil)
+
+	esm.states.OnTransition = func(from, to GameStateType) {
+		esm.tooltip.Hide()
+		fmt.Printf("Changement d'état: %s -> %s\n", from, to)
+	}
+}
+
+// enterGameplayState ignore les boutons de la souris jusqu'à leur
+// relâchement : le clic du menu qui lance ou reprend la partie n'attaque pas
+func (esm *EnhancedBuiltinStateManager) enterGameplayState() {
+	esm.playerSystem.SuppressMouseUntilRelease()
+	esm.applyMapBounds()
+}
+
+// panelOpen retourne si un panneau qui fige le jeu est ouvert
+func (esm *EnhancedBuiltinStateManager) panelOpen() bool {
+	return (esm.craftingPanel != nil && esm.craftingPanel.IsVisible()) ||
+		(esm.skillTreePanel != nil && esm.skillTreePanel.IsVisible())
+}
+
 // debugSpriteState affiche l'état des sprites pour debug
 func (esm *EnhancedBuiltinStateManager) debugSpriteState() {
 	fmt.Println("\n=== DEBUG ÉTAT SPRITES ===")
-	fmt.Printf("Frame: %d, État: %s\n", esm.frameCount, esm.currentState)
+	fmt.Printf("Frame: %d, État: %s\n", esm.frameCount, esm.states.Current())
 
 	if esm.playerSystem != nil {
 		player := esm.playerSystem.GetPlayer()
@@ -293,7 +2552,7 @@ func (esm *EnhancedBuiltinStateManager) debugSpriteState() {
 	} else {
 		fmt.Println("PlayerSystem null")
 	}
-	fmt.Println("=== FIN DEBUG SPRITES ===\n")
+	fmt.Print("=== FIN DEBUG SPRITES ===\n\n")
 }
 
 // updateMenuState met à jour l'état menu
@@ -304,15 +2563,50 @@ func (esm *EnhancedBuiltinStateManager) updateMenuState(deltaTime time.Duration)
 			esm.mousePos.X, esm.mousePos.Y, esm.mousePressed)
 	}
 
-	// Mettre à jour les boutons
-	for i, button := range esm.buttons {
-		button.Update(esm.mousePos, esm.mousePressed)
+	esm.menuUI.Update(esm.mousePos, esm.mousePressed, deltaTime)
 
-		// Debug pour voir si les boutons détectent la souris
-		if button.Contains(esm.mousePos) && esm.frameCount%60 == 0 {
-			fmt.Printf("Souris survole le bouton %d (%s)\n", i, button.Text)
-		}
+	// Debug pour voir si les boutons détectent la souris
+	if button, ok := esm.menuUI.WidgetAt(esm.mousePos).(*Button); ok && esm.frameCount%60 == 0 {
+		fmt.Printf("Souris survole le bouton %s\n", button.Text)
+	}
+
+	esm.updateMenuFocus()
+}
+
+// updateMenuFocus déplace la sélection des boutons au clavier ou à la manette
+// et valide le bouton sélectionné ; la souris reprend la main dès qu'elle bouge
+func (esm *EnhancedBuiltinStateManager) updateMenuFocus() {
+	if esm.mousePos != esm.menuMousePos {
+		esm.menuMousePos = esm.mousePos
+		esm.menuUI.SetFocus(nil)
+	}
+
+	navigator, ok := esm.input.(interface {
+		MenuNavigation() (step int, confirm bool)
+	})
+	if !ok {
+		return
+	}
+	step, confirm := navigator.MenuNavigation()
+
+	// Première entrée : sélectionne le premier bouton utilisable
+	if step != 0 || (confirm && esm.menuUI.GetFocused() == nil) {
+		esm.menuUI.MoveFocus(step)
 	}
+	if confirm && esm.menuUI.ActivateFocused() {
+		return
+	}
+	if button, ok := esm.menuUI.GetFocused().(*Button); ok && button.State != 3 {
+		button.State = 1 // Survol
+	}
+}
+
+// usingGamepad indique si le joueur utilise une manette (textes d'aide)
+func (esm *EnhancedBuiltinStateManager) usingGamepad() bool {
+	device, ok := esm.input.(interface {
+		UsingGamepad() bool
+	})
+	return ok && device.UsingGamepad()
 }
 
 // updateGameplayState met à jour l'état de jeu
@@ -327,20 +2621,160 @@ func (esm *EnhancedBuiltinStateManager) updateGameplayState(deltaTime time.Durat
 		}
 	}
 
-	// Mettre à jour le système de joueur
-	esm.playerSystem.Update(deltaTime)
+	// Ralentis éventuels (le voile de mort avance en temps réel)
+	realDelta := deltaTime
+	deltaTime = time.Duration(float64(deltaTime) * esm.gameplayTimeScale(realDelta))
+
+	// Passage d'un portail : le jeu reste figé pendant le fondu
+	if esm.roomTransition.IsActive() {
+		if esm.roomTransition.Update(realDelta, esm.roomReady(esm.roomTransition.Destination)) {
+			esm.passPortal(esm.portalTarget)
+		}
+		return
+	}
+
+	// Mettre à jour le système de joueur ; les murs l'arrêtent, ou le font
+	// courir le long de la paroi s'il y roule. La caméra libre de debug le
+	// fige (et l'empêche de reprendre la caméra).
+	esm.freeCamera.Update(realDelta)
+	if !esm.freeCamera.IsActive() {
+		esm.cameraZoom.Update(realDelta, esm.cameraZoomInput())
+		esm.updateMouseAim(realDelta)
+		esm.playerSystem.Update(deltaTime)
+	} else {
+		esm.cameraZoom.Reset() // La caméra libre zoome elle-même
+	}
+	if response := esm.collisionSystem.ResolveWalls(esm.playerSystem.GetPlayer()); response.Hit {
+		esm.playerSystem.HandleWallContact(response)
+	}
+	if esm.treasureRoom != nil {
+		esm.treasureRoom.Update(deltaTime, esm.playerSystem.GetPlayer())
+	}
+	esm.ropeSystem.Update(deltaTime)
+	esm.npcSystem.Update(deltaTime)
+	esm.benchmark.Update(realDelta)
+	esm.decalSystem.UpdateFootprints(esm.playerSystem.GetPlayer())
+	if esm.playerSystem.IsPlayerAlive() {
+		esm.footstepSystem.Update(esm.playerSystem.GetPlayerPosition())
+	}
+	esm.updateSoundListener()
+	esm.statusEffects.Update(deltaTime, esm.playerSystem.GetPlayer())
+	esm.decalSystem.Update(deltaTime)
+	if esm.playerSystem.IsPlayerAlive() {
+		esm.updateItems(esm.playerSystem.GetPlayerPosition())
+		esm.updateWorkbench(esm.playerSystem.GetPlayerPosition())
+		playerPos := esm.playerSystem.GetPlayerPosition()
+		esm.questSystem.Update(Vector2{playerPos.X, playerPos.Y})
+		esm.updateRoomGraph(playerPos)
+	}
+	esm.bloodstainSystem.Update(deltaTime, esm.playerSystem.GetPlayer())
+	esm.miniMap.Update(realDelta)
+
+	// Mettre à jour les ennemis (formations comprises)
+	esm.enemySystem.Update(deltaTime, esm.playerSystem.GetPlayerPosition())
+	esm.collisionSystem.ResolvePlayer(esm.playerSystem.GetPlayer(), esm.enemySystem.GetEnemies())
+	// Le coup ne touche que pendant les frames actives de l'attaque
+	if esm.playerSystem.ConsumeHeavyAttack() {
+		esm.combatSystem.BeginSwing(true)
+		esm.destructibleTiles.BeginSwing()
+	} else if esm.playerSystem.ConsumeAttack() {
+		esm.combatSystem.BeginSwing(false)
+		esm.destructibleTiles.BeginSwing()
+	}
+	if esm.playerSystem.IsAttackActive() {
+		esm.combatSystem.Swing(esm.playerSystem.GetPlayer(), esm.enemySystem.GetEnemies())
+		esm.destructibleTiles.Strike(esm.combatSystem.SwingHitbox(esm.playerSystem.GetPlayer()))
+	}
+	esm.combatSystem.Update(esm.playerSystem.GetPlayer(), esm.enemySystem.GetEnemies())
+	if esm.playerSystem.ConsumeCast() {
+		player := esm.playerSystem.GetPlayer()
+		esm.spellSystem.CastChainLightning(player.Position.Position, player.Movement.FacingDir.ToVector2())
+	}
+	esm.spellSystem.Update(deltaTime, esm.enemySystem.GetEnemies())
+	esm.damageNumbers.Update(deltaTime)
+	esm.particleSystem.Update(deltaTime)
+	if esm.dayNight != nil {
+		esm.dayNight.Update(deltaTime)
+	}
+	esm.challengeSystem.Update(deltaTime, esm.playerSystem.GetPlayer(), esm.enemySystem)
+
+	// Le fondu des décors suit l'affichage, pas le ralenti
+	esm.transparencySystem.Update(realDelta, esm.playerSystem.GetPlayerPosition())
+	esm.bossBar.Update(deltaTime, esm.enemySystem.GetActiveBoss())
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		esm.hud.Update(deltaTime, player.Player)
+	}
+	esm.combo.Update(deltaTime)
+	esm.cooldowns.Update(deltaTime)
 
-	// Vérifier si le joueur est mort
+	// Vérifier si le joueur est mort : voile rouge puis écran de mort
 	if !esm.playerSystem.IsPlayerAlive() {
-		fmt.Println("Joueur mort - retour au menu")
-		esm.ChangeState("menu")
+		if !esm.dying {
+			fmt.Println("Joueur mort - voile de mort")
+			esm.bloodstainSystem.Drop(esm.playerSystem.GetPlayer())
+			esm.dying = true
+			esm.deathFadeTime = 0
+			return
+		}
+
+		// Le voile avance en temps réel, indépendamment du ralenti
+		esm.deathFadeTime += realDelta
+		// Après un repos, le joueur réapparaît au feu de camp plutôt que de perdre la partie
+		if esm.deathFadeTime >= esm.deathFadeDuration {
+			if esm.lastBonfire != nil {
+				esm.respawnAtBonfire()
+				return
+			}
+			fmt.Println("Joueur mort - écran de mort")
+			esm.dying = false
+			esm.enterGameOver()
+		}
+	}
+}
+
+// enterGameOver passe à l'écran de mort et oublie les états empilés
+func (esm *EnhancedBuiltinStateManager) enterGameOver() {
+	esm.stateStack = esm.stateStack[:0]
+	esm.states.Fire(stateEventDie)
+}
+
+// enterGameOverState fige les stats de la partie et lance le fondu de l'écran de mort
+func (esm *EnhancedBuiltinStateManager) enterGameOverState() {
+	esm.runDuration = time.Since(esm.gameStartTime)
+	esm.gameOverFade = 0
+
+	if esm.OnRunEnded != nil {
+		summary := RunSummary{PlayTime: esm.runDuration}
+		if player := esm.playerSystem.GetPlayer(); player != nil {
+			summary.EnemiesKilled = player.Player.EnemiesKilled
+			summary.Souls = player.Player.Souls
+			summary.Level = player.Player.Level
+		}
+		esm.OnRunEnded(summary)
+	}
+}
+
+// updateGameOverState met à jour l'écran de mort
+func (esm *EnhancedBuiltinStateManager) updateGameOverState(deltaTime time.Duration) {
+	esm.gameOverFade += deltaTime
+
+	// Les boutons ne sont actifs qu'une fois le fondu terminé
+	if esm.gameOverFade < gameOverFadeDuration {
 		return
 	}
+
+	esm.gameOverUI.Update(esm.mousePos, esm.mousePressed, deltaTime)
 }
 
 // updatePauseState met à jour l'état de pause
 func (esm *EnhancedBuiltinStateManager) updatePauseState(deltaTime time.Duration) {
-	// En pause, on ne met pas à jour le joueur
+	// En pause, on ne met pas à jour le joueur, seulement le menu
+	esm.pauseMenu.Update(esm.mousePos, esm.mousePressed)
+}
+
+// updateSettingsState met à jour l'écran d'options
+func (esm *EnhancedBuiltinStateManager) updateSettingsState(deltaTime time.Duration) {
+	esm.settingsUI.Update(esm.mousePos, esm.mousePressed, deltaTime)
 }
 
 // UpdateWithInput met à jour avec InputManager (nouvelle méthode)
@@ -354,38 +2788,72 @@ func (esm *EnhancedBuiltinStateManager) UpdateWithInput(deltaTime time.Duration,
 
 // Render rend l'état actuel
 func (esm *EnhancedBuiltinStateManager) Render(renderer Renderer) error {
-	switch esm.currentState {
+	switch esm.states.Current() {
 	case "menu":
 		esm.renderMenuState(renderer)
 	case "gameplay":
 		esm.renderGameplayState(renderer)
 	case "pause":
 		esm.renderPauseState(renderer)
+	case StateSettings:
+		esm.renderSettingsState(renderer)
+	case StateLeaderboard:
+		esm.renderMenuState(renderer)
+		esm.leaderboard.Render(renderer)
+	case StateIntro:
+		if esm.intro != nil {
+			esm.intro.Render(renderer)
+		}
+	case StateGameOver:
+		esm.renderGameOverState(renderer)
+	case StateSignRead:
+		esm.renderGameplayState(renderer)
+		if esm.signDialog != nil {
+			esm.signDialog.Render(renderer)
+		}
 	default:
 		esm.renderMenuState(renderer)
 	}
+
+	if esm.states.Current() == StateGameplay && esm.craftingPanel != nil {
+		esm.craftingPanel.Render(renderer)
+	}
+	if esm.states.Current() == StateGameplay && esm.skillTreePanel != nil {
+		esm.skillTreePanel.Render(renderer, esm.skillPoints())
+	}
+	if esm.dialog != nil {
+		esm.dialog.Render(renderer)
+	}
+	esm.hud.RenderNotifications(renderer)
+	esm.console.Render(renderer, esm.screenWidth)
 	return nil
 }
 
 // renderMenuState rend l'état menu
 func (esm *EnhancedBuiltinStateManager) renderMenuState(renderer Renderer) {
 	// Titre
-	titleX := float64(esm.screenWidth)/2 - float64(len("ZELDA SOULS")*12)/2
-	renderer.DrawText("ZELDA SOULS", Vector2{titleX, 100}, ColorYellow)
+	title := esm.localizer.Get("ui.title")
+	titleX := float64(esm.screenWidth)/2 - float64(len(title)*12)/2
+	renderer.DrawText(title, Vector2{titleX, 100}, ColorYellow)
 
 	// Sous-titre
-	subtitle := "Adventure Awaits"
+	subtitle := esm.localizer.Get("ui.subtitle")
 	subtitleX := float64(esm.screenWidth)/2 - float64(len(subtitle)*8)/2
 	renderer.DrawText(subtitle, Vector2{subtitleX, 140}, Color{200, 200, 200, 255})
 
 	// Boutons
-	for _, button := range esm.buttons {
-		button.Render(renderer)
+	esm.menuUI.Render(renderer)
+	if esm.states.Current() == StateMenu {
+		esm.tooltip.Render(renderer, esm.screenWidth, esm.screenHeight)
 	}
 
 	// Instructions
 	instructionY := float64(esm.screenHeight) - 50
-	instruction := "Utilisez la souris pour naviguer"
+	hintKey := "ui.menu.hint_keyboard"
+	if esm.usingGamepad() {
+		hintKey = "ui.menu.hint_gamepad"
+	}
+	instruction := esm.localizer.Get(hintKey)
 	instrX := float64(esm.screenWidth)/2 - float64(len(instruction)*8)/2
 	renderer.DrawText(instruction, Vector2{instrX, instructionY}, Color{150, 150, 150, 255})
 
@@ -399,26 +2867,92 @@ func (esm *EnhancedBuiltinStateManager) renderMenuState(renderer Renderer) {
 // renderGameplayState rend l'état gameplay
 func (esm *EnhancedBuiltinStateManager) renderGameplayState(renderer Renderer) {
 	// Interface de jeu
-	renderer.DrawText("=== JEU EN COURS ===", Vector2{10, 10}, ColorWhite)
-	renderer.DrawText("ESC - Retour menu", Vector2{10, 30}, ColorGreen)
+	esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.title"), Vector2{10, 10}, ColorWhite)
+	esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.back_to_menu"), Vector2{10, 30}, ColorGreen)
 
 	if esm.showInstructions {
-		renderer.DrawText("ZQSD/WASD - Mouvement", Vector2{10, 60}, ColorWhite)
-		renderer.DrawText("ESPACE - Attaque", Vector2{10, 80}, ColorWhite)
-		renderer.DrawText("C - Roulade", Vector2{10, 100}, ColorWhite)
-		renderer.DrawText("E - Interaction", Vector2{10, 120}, ColorWhite)
-		renderer.DrawText("I - Toggle instructions", Vector2{10, 140}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.move"), Vector2{10, 60}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.attack"), Vector2{10, 80}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.roll"), Vector2{10, 100}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.interact"), Vector2{10, 120}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.spell"), Vector2{10, 140}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.heal"), Vector2{10, 160}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.instructions"), Vector2{10, 180}, ColorWhite)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.gameplay.help.hud"), Vector2{10, 200}, ColorWhite)
 	}
 
 	// Informations du joueur
 	esm.renderPlayerInfo(renderer)
 
 	// Rendre le joueur avec une adaptation d'interface
-	rendererAdapter := &RendererAdapter{coreRenderer: renderer}
-	esm.playerSystem.Render(rendererAdapter)
+	rendererAdapter := &RendererAdapter{coreRenderer: renderer, subImages: esm.subImages}
+	esm.challengeSystem.Render(rendererAdapter)
+	if esm.treasureRoom != nil {
+		esm.treasureRoom.Render(rendererAdapter)
+	}
+	esm.statusEffects.Render(rendererAdapter)
+	esm.ropeSystem.Render(rendererAdapter)
+	esm.decalSystem.Render(rendererAdapter)
+	rendererAdapter.ComposeDecals()
+	for _, bonfire := range esm.bonfires {
+		bonfire.Render(rendererAdapter)
+	}
+	for _, sign := range esm.signs {
+		sign.Render(rendererAdapter)
+	}
+	esm.bloodstainSystem.Render(rendererAdapter)
+	esm.itemSystem.Render(rendererAdapter)
+	esm.enemySystem.GetPatrolSystem().RenderDebug(rendererAdapter)
+	esm.enemySystem.RenderPathDebug(rendererAdapter)
+	esm.enemySystem.Submit(esm.renderQueue)
+	esm.npcSystem.Submit(esm.renderQueue)
+	esm.playerSystem.Submit(esm.renderQueue)
+	esm.transparencySystem.Submit(esm.renderQueue)
+	esm.particleSystem.Submit(esm.renderQueue)
+	esm.renderQueue.Flush(rendererAdapter)
+	esm.submitLights(renderer)
+	esm.submitAmbientTint(renderer)
+	esm.spellSystem.Render(rendererAdapter)
+	esm.benchmark.Render(renderer)
+	esm.damageNumbers.Render(rendererAdapter)
+	if esm.states.Current() == StateGameplay {
+		esm.renderSignPrompt(renderer)
+	}
+
+	// Flash jaune d'un coup critique, pendant quelques frames
+	if esm.critFlashFrames > 0 {
+		esm.critFlashFrames--
+		screen := Rectangle{X: 0, Y: 0, Width: float64(esm.screenWidth), Height: float64(esm.screenHeight)}
+		renderer.DrawRectangle(screen, Color{255, 220, 40, 30}, true)
+	}
+
+	// HUD par-dessus le monde
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		esm.hud.Render(renderer, player.Player)
+	}
+	esm.hud.RenderCombo(renderer, esm.combo)
+	esm.hud.RenderCooldowns(renderer, esm.cooldowns)
+	if room := esm.challengeSystem.GetActiveRoom(); room != nil {
+		esm.hud.RenderChallengeTimer(renderer, room.Challenge)
+	}
+	esm.bossBar.Render(renderer)
+	playerPos := esm.playerSystem.GetPlayerPosition()
+	esm.miniMap.Render(renderer, Vector2{playerPos.X, playerPos.Y})
+	esm.miniMap.RenderOverview(renderer)
 
 	// Stats de jeu
 	esm.renderGameStats(renderer)
+	if esm.freeCamera.IsActive() {
+		position := esm.freeCamera.Position()
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.debug.free_camera", position.X, position.Y, esm.freeCamera.Zoom()),
+			Vector2{10, float64(esm.screenHeight) - 30}, ColorYellow)
+	}
+
+	// Voile rouge de mort
+	if esm.dying {
+		esm.renderDeathFade(renderer)
+	}
+	esm.roomTransition.Render(renderer, esm.screenWidth, esm.screenHeight)
 
 	// Debug sprites info
 	if esm.debugSprites {
@@ -426,52 +2960,176 @@ func (esm *EnhancedBuiltinStateManager) renderGameplayState(renderer Renderer) {
 	}
 }
 
+// submitLights transmet au renderer la torche du joueur et les feux de camp,
+// si le renderer gère l'éclairage
+func (esm *EnhancedBuiltinStateManager) submitLights(renderer Renderer) {
+	lightRenderer, ok := renderer.(interface{ AddLight(light Light) })
+	if !ok {
+		return
+	}
+
+	if player := esm.playerSystem.GetPlayer(); player != nil && player.Active {
+		position := player.Position.Position
+		lightRenderer.AddLight(PlayerTorchLight.At(Vector2{position.X, position.Y}))
+	}
+	for _, bonfire := range esm.bonfires {
+		light := EmberLight
+		if bonfire.Lit {
+			light = BonfireLight
+		}
+		lightRenderer.AddLight(light.At(Vector2{bonfire.Position.X, bonfire.Position.Y}))
+	}
+}
+
+// submitAmbientTint transmet au renderer la teinte du cycle jour/nuit, ramenée
+// vers le jour près d'un feu de camp allumé
+func (esm *EnhancedBuiltinStateManager) submitAmbientTint(renderer Renderer) {
+	if esm.dayNight == nil {
+		return
+	}
+	tintRenderer, ok := renderer.(interface{ SetAmbientTint(tint Color) })
+	if !ok {
+		return
+	}
+
+	nearest := math.MaxFloat64
+	playerPos := esm.playerSystem.GetPlayerPosition()
+	for _, bonfire := range esm.bonfires {
+		if bonfire.Lit {
+			nearest = math.Min(nearest, math.Hypot(bonfire.Position.X-playerPos.X, bonfire.Position.Y-playerPos.Y))
+		}
+	}
+	tintRenderer.SetAmbientTint(esm.dayNight.TintNear(nearest))
+}
+
 // renderPauseState rend l'état de pause
 func (esm *EnhancedBuiltinStateManager) renderPauseState(renderer Renderer) {
 	// Assombrir l'arrière-plan
 	overlay := Rectangle{X: 0, Y: 0, Width: float64(esm.screenWidth), Height: float64(esm.screenHeight)}
 	renderer.DrawRectangle(overlay, Color{0, 0, 0, 128}, true)
 
-	// Menu de pause
+	esm.pauseMenu.Render(renderer)
+}
+
+// renderSettingsState rend l'écran d'options
+func (esm *EnhancedBuiltinStateManager) renderSettingsState(renderer Renderer) {
+	overlay := Rectangle{X: 0, Y: 0, Width: float64(esm.screenWidth), Height: float64(esm.screenHeight)}
+	renderer.DrawRectangle(overlay, Color{0, 0, 0, 128}, true)
+
+	if len(esm.settingsButtons) > 0 {
+		first := esm.settingsButtons[0].Bounds
+		title := esm.localizer.Get("ui.accessibility.title")
+		renderer.DrawText(title, Vector2{first.X + first.Width/2 - float64(len(title)*7)/2, first.Y - 25}, ColorYellow)
+	}
+	esm.settingsUI.Render(renderer)
+	esm.tooltip.Render(renderer, esm.screenWidth, esm.screenHeight)
+}
+
+// renderDeathFade dessine le voile rouge dont l'alpha monte avec le temps :
+// une vignette du post-traitement s'il est actif, sinon un voile uni
+func (esm *EnhancedBuiltinStateManager) renderDeathFade(renderer Renderer) {
+	progress := float64(esm.deathFadeTime) / float64(esm.deathFadeDuration)
+	if progress > 1 {
+		progress = 1
+	}
+
+	if vignetteRenderer, ok := renderer.(interface {
+		SetVignette(tint Color, strength float64) bool
+	}); ok && vignetteRenderer.SetVignette(esm.deathFadeTint, progress) {
+		return
+	}
+
+	tint := esm.deathFadeTint
+	tint.A = uint8(float64(tint.A) * progress)
+
+	overlay := Rectangle{X: 0, Y: 0, Width: float64(esm.screenWidth), Height: float64(esm.screenHeight)}
+	renderer.DrawRectangle(overlay, tint, true)
+}
+
+// gameOverFadeDuration durée du fondu au noir de l'écran de mort
+const gameOverFadeDuration = 1500 * time.Millisecond
+
+// renderGameOverState rend l'écran de mort
+func (esm *EnhancedBuiltinStateManager) renderGameOverState(renderer Renderer) {
+	progress := float64(esm.gameOverFade) / float64(gameOverFadeDuration)
+	if progress > 1 {
+		progress = 1
+	}
+
+	// Fondu au noir par-dessus la scène figée
+	rendererAdapter := &RendererAdapter{coreRenderer: renderer, subImages: esm.subImages}
+	esm.decalSystem.Render(rendererAdapter)
+	rendererAdapter.ComposeDecals()
+	esm.enemySystem.Submit(esm.renderQueue)
+	esm.playerSystem.Submit(esm.renderQueue)
+	esm.transparencySystem.Submit(esm.renderQueue)
+	esm.renderQueue.Flush(rendererAdapter)
+	esm.submitLights(renderer)
+
+	// Le voile rouge reste en place sous le fondu au noir
+	overlay := Rectangle{X: 0, Y: 0, Width: float64(esm.screenWidth), Height: float64(esm.screenHeight)}
+	renderer.DrawRectangle(overlay, esm.deathFadeTint, true)
+	renderer.DrawRectangle(overlay, Color{0, 0, 0, uint8(progress * 220)}, true)
+
+	if progress < 1 {
+		return
+	}
+
 	centerX := float64(esm.screenWidth) / 2
 	centerY := float64(esm.screenHeight) / 2
 
-	renderer.DrawText("=== PAUSE ===", Vector2{centerX - 60, centerY - 50}, ColorYellow)
-	renderer.DrawText("ESC - Reprendre", Vector2{centerX - 70, centerY - 20}, ColorWhite)
-	renderer.DrawText("Q - Retour menu", Vector2{centerX - 70, centerY}, ColorWhite)
+	title := esm.localizer.Get("ui.gameover.title")
+	esm.drawHUDText(renderer, title, Vector2{centerX - float64(len(title)*7)/2, centerY - 80}, Color{180, 20, 20, 255})
+
+	// Stats de la partie
+	enemiesKilled := 0
+	if player := esm.playerSystem.GetPlayer(); player != nil {
+		enemiesKilled = player.Player.EnemiesKilled
+	}
+	killsText := esm.localizer.Get("ui.gameover.kills", enemiesKilled)
+	timeText := esm.localizer.Get("ui.gameover.play_time", formatDuration(esm.runDuration))
+	esm.drawHUDText(renderer, killsText, Vector2{centerX - float64(len(killsText)*7)/2, centerY - 30}, ColorWhite)
+	esm.drawHUDText(renderer, timeText, Vector2{centerX - float64(len(timeText)*7)/2, centerY - 10}, ColorWhite)
+
+	esm.gameOverUI.Render(renderer)
+	esm.tooltip.Render(renderer, esm.screenWidth, esm.screenHeight)
 }
 
 // renderPlayerInfo affiche les informations du joueur
 func (esm *EnhancedBuiltinStateManager) renderPlayerInfo(renderer Renderer) {
 	if !esm.playerSystem.IsPlayerAlive() {
-		renderer.DrawText("JOUEUR MORT", Vector2{10, 180}, ColorRed)
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.player.dead"), Vector2{10, 180}, ColorRed)
 		return
 	}
 
 	// Position du joueur
 	playerPos := esm.playerSystem.GetPlayerPosition()
-	posText := fmt.Sprintf("Position: (%.0f, %.0f)", playerPos.X, playerPos.Y)
-	renderer.DrawText(posText, Vector2{10, 180}, ColorYellow)
+	posText := esm.localizer.Get("ui.player.position", playerPos.X, playerPos.Y)
+	esm.drawHUDText(renderer, posText, Vector2{10, 180}, ColorYellow)
 
 	// Santé et stamina
 	health, maxHealth := esm.playerSystem.GetPlayerHealth()
 	stamina, maxStamina := esm.playerSystem.GetPlayerStamina()
 
-	healthText := fmt.Sprintf("Vie: %d/%d", health, maxHealth)
-	staminaText := fmt.Sprintf("Stamina: %.0f/%.0f", stamina, maxStamina)
+	healthText := esm.localizer.Get("ui.player.health", health, maxHealth)
+	staminaText := esm.localizer.Get("ui.player.stamina", stamina, maxStamina)
 
-	renderer.DrawText(healthText, Vector2{10, 200}, ColorGreen)
-	renderer.DrawText(staminaText, Vector2{10, 220}, ColorCyan)
+	esm.drawHUDText(renderer, healthText, Vector2{10, 200}, ColorGreen)
+	esm.drawHUDText(renderer, staminaText, Vector2{10, 220}, ColorCyan)
+	if poison := esm.statusEffects.Poison; poison.IsActive() {
+		esm.drawHUDText(renderer, esm.localizer.Get("ui.player.poisoned", poison.Stacks, poison.Rate()),
+			Vector2{200, 200}, Color{120, 220, 90, 255})
+	}
 
 	// État du mouvement
 	player := esm.playerSystem.GetPlayer()
 	if player != nil && player.Movement.IsMoving {
-		dirText := fmt.Sprintf("Direction: %s", player.Movement.Direction.String())
-		renderer.DrawText(dirText, Vector2{10, 240}, ColorYellow)
+		dirText := esm.localizer.Get("ui.player.direction", player.Movement.Direction.String())
+		esm.drawHUDText(renderer, dirText, Vector2{10, 240}, ColorYellow)
 
 		velocityLength := player.Movement.Velocity.Length()
-		velocityText := fmt.Sprintf("Vitesse: %.1f", velocityLength)
-		renderer.DrawText(velocityText, Vector2{10, 260}, ColorWhite)
+		velocityText := esm.localizer.Get("ui.player.speed", velocityLength)
+		esm.drawHUDText(renderer, velocityText, Vector2{10, 260}, ColorWhite)
 	}
 }
 
@@ -481,7 +3139,7 @@ func (esm *EnhancedBuiltinStateManager) renderSpriteDebugInfo(renderer Renderer)
 
 	player := esm.playerSystem.GetPlayer()
 	if player == nil {
-		renderer.DrawText("DEBUG: Aucun joueur", Vector2{10, startY}, ColorRed)
+		esm.drawHUDText(renderer, "DEBUG: Aucun joueur", Vector2{10, startY}, ColorRed)
 		return
 	}
 
@@ -517,7 +3175,7 @@ func (esm *EnhancedBuiltinStateManager) renderSpriteDebugInfo(renderer Renderer)
 		if i == 0 {
 			color = ColorYellow // Titre en jaune
 		}
-		renderer.DrawText(text, Vector2{10, startY + float64(i*15)}, color)
+		esm.drawHUDText(renderer, text, Vector2{10, startY + float64(i*15)}, color)
 	}
 }
 
@@ -525,17 +3183,22 @@ func (esm *EnhancedBuiltinStateManager) renderSpriteDebugInfo(renderer Renderer)
 func (esm *EnhancedBuiltinStateManager) renderGameStats(renderer Renderer) {
 	// Temps de jeu
 	gameTime := time.Since(esm.gameStartTime)
-	timeText := fmt.Sprintf("Temps: %s", formatDuration(gameTime))
+	timeText := esm.localizer.Get("ui.stats.time", formatDuration(gameTime))
 
 	// Frames
-	frameText := fmt.Sprintf("Frames: %d", esm.frameCount)
+	frameText := esm.localizer.Get("ui.stats.frames", esm.frameCount)
 
 	// Affichage en bas à droite
 	rightX := float64(esm.screenWidth) - 150
 	bottomY := float64(esm.screenHeight) - 60
 
-	renderer.DrawText(timeText, Vector2{rightX, bottomY}, ColorGray)
-	renderer.DrawText(frameText, Vector2{rightX, bottomY + 20}, ColorGray)
+	esm.drawHUDText(renderer, timeText, Vector2{rightX, bottomY}, ColorGray)
+	esm.drawHUDText(renderer, frameText, Vector2{rightX, bottomY + 20}, ColorGray)
+}
+
+// drawHUDText dessine un texte par-dessus le jeu avec l'ombre du HUD
+func (esm *EnhancedBuiltinStateManager) drawHUDText(renderer Renderer, text string, pos Vector2, color Color) {
+	esm.hud.drawText(renderer, text, pos, color)
 }
 
 // formatDuration formate une durée en string lisible
@@ -547,14 +3210,115 @@ func formatDuration(d time.Duration) string {
 
 // GetCurrentStateType retourne le type d'état actuel
 func (esm *EnhancedBuiltinStateManager) GetCurrentStateType() GameStateType {
-	return GameStateType(esm.currentState)
+	return esm.states.Current()
 }
 
-// ChangeState change l'état
+// ChangeState change l'état et oublie les états empilés
 func (esm *EnhancedBuiltinStateManager) ChangeState(stateType GameStateType) {
-	oldState := esm.currentState
-	esm.currentState = GameStateType(stateType)
-	fmt.Printf("Changement d'état: %s -> %s\n", oldState, esm.currentState)
+	esm.stateStack = esm.stateStack[:0]
+	esm.setState(stateType)
+}
+
+// setState change l'état sans toucher à la pile
+func (esm *EnhancedBuiltinStateManager) setState(stateType GameStateType) {
+	if !esm.states.ForceState(stateType) {
+		fmt.Printf("⚠ État inconnu: %s\n", stateType)
+	}
+}
+
+// PushState passe à un état en empilant l'état courant, que GoBack restaure
+func (esm *EnhancedBuiltinStateManager) PushState(stateType GameStateType) {
+	previous := esm.states.Current()
+	if esm.states.ForceState(stateType) {
+		esm.stateStack = append(esm.stateStack, previous)
+		return
+	}
+	fmt.Printf("⚠ État inconnu: %s\n", stateType)
+}
+
+// pushEvent déclenche une transition en empilant l'état quitté, que GoBack restaure
+func (esm *EnhancedBuiltinStateManager) pushEvent(event string) bool {
+	previous := esm.states.Current()
+	if !esm.states.Fire(event) {
+		return false
+	}
+	esm.stateStack = append(esm.stateStack, previous)
+	return true
+}
+
+// GoBack revient à l'état empilé précédent ; false si la pile est vide
+func (esm *EnhancedBuiltinStateManager) GoBack() bool {
+	if len(esm.stateStack) == 0 {
+		return false
+	}
+	previous := esm.stateStack[len(esm.stateStack)-1]
+	esm.stateStack = esm.stateStack[:len(esm.stateStack)-1]
+	esm.setState(previous)
+	return true
+}
+
+// TogglePause (ESC) met le jeu en pause, ferme la confirmation de sortie
+// ouverte, ou revient à l'état précédent depuis la pause et les options
+func (esm *EnhancedBuiltinStateManager) TogglePause() {
+	switch esm.states.Current() {
+	case StateGameplay:
+		if esm.pushEvent(stateEventPause) {
+			return
+		}
+		if esm.craftingPanel != nil && esm.craftingPanel.IsVisible() {
+			esm.craftingPanel.Toggle()
+			return
+		}
+		if esm.skillTreePanel != nil && esm.skillTreePanel.IsVisible() {
+			esm.skillTreePanel.Toggle()
+		}
+	case StatePause:
+		if esm.pauseMenu.IsConfirming() {
+			esm.pauseMenu.CancelConfirm()
+			return
+		}
+		esm.GoBack()
+	case StateSettings, StateLeaderboard:
+		esm.GoBack()
+	case StateSignRead:
+		esm.closeSign()
+	case StateIntro:
+		if esm.intro != nil {
+			esm.intro.Skip()
+		}
+	}
+}
+
+// GamepadConnected signale une manette branchée en cours de partie
+func (esm *EnhancedBuiltinStateManager) GamepadConnected(id int) {
+	esm.hud.Notify(esm.localizer.Get("ui.gamepad.connected", id))
+}
+
+// GamepadDisconnected signale une manette débranchée et met le jeu en pause
+// si une partie est en cours
+func (esm *EnhancedBuiltinStateManager) GamepadDisconnected(id int) {
+	esm.hud.Notify(esm.localizer.Get("ui.gamepad.disconnected", id))
+	if esm.states.Current() == StateGameplay {
+		esm.pushEvent(stateEventPause)
+	}
+}
+
+// SetOnSave définit la sauvegarde déclenchée depuis le menu de pause
+func (esm *EnhancedBuiltinStateManager) SetOnSave(onSave func() error) {
+	esm.onSave = onSave
+}
+
+// SaveOnExit sauvegarde la partie en cours (menu de pause)
+func (esm *EnhancedBuiltinStateManager) SaveOnExit() {
+	if esm.onSave == nil {
+		fmt.Println("⚠ Aucune sauvegarde configurée")
+		return
+	}
+	if err := esm.onSave(); err != nil {
+		esm.ShowDialog(esm.localizer.Get("ui.pause.save_failed", err), nil)
+		return
+	}
+	esm.ShowDialog(esm.localizer.Get("ui.pause.saved"), nil)
 }
 
 // ToggleInstructions active/désactive les instructions
@@ -563,24 +3327,45 @@ func (esm *EnhancedBuiltinStateManager) ToggleInstructions() {
 	fmt.Printf("Instructions: %t\n", esm.showInstructions)
 }
 
+// ToggleHUD affiche/masque le HUD
+func (esm *EnhancedBuiltinStateManager) ToggleHUD() {
+	esm.hud.Toggle()
+	fmt.Printf("HUD: %t\n", esm.hud.Visible)
+}
+
+// GetConsole retourne la console de debug
+func (esm *EnhancedBuiltinStateManager) GetConsole() *DebugConsole {
+	return esm.console
+}
+
+// GetFreeCamera retourne la caméra libre de debug
+func (esm *EnhancedBuiltinStateManager) GetFreeCamera() *FreeCamera {
+	return esm.freeCamera
+}
+
 // GetPlayerSystem retourne le système de joueur
 func (esm *EnhancedBuiltinStateManager) GetPlayerSystem() *systems.PlayerSystem {
 	return esm.playerSystem
 }
 
+// GetEnemySystem retourne le système des ennemis
+func (esm *EnhancedBuiltinStateManager) GetEnemySystem() *systems.EnemySystem {
+	return esm.enemySystem
+}
+
 // IsInGame retourne si on est en jeu
 func (esm *EnhancedBuiltinStateManager) IsInGame() bool {
-	return esm.currentState == "gameplay"
+	return esm.states.Current() == StateGameplay
 }
 
 // IsInMenu retourne si on est dans le menu
 func (esm *EnhancedBuiltinStateManager) IsInMenu() bool {
-	return esm.currentState == "menu"
+	return esm.states.Current() == StateMenu
 }
 
 // IsPaused retourne si le jeu est en pause
 func (esm *EnhancedBuiltinStateManager) IsPaused() bool {
-	return esm.currentState == "pause"
+	return esm.states.Current() == StatePause
 }
 
 // ToggleDebugSprites active/désactive le debug des sprites
@@ -596,6 +3381,7 @@ func (esm *EnhancedBuiltinStateManager) ToggleDebugSprites() {
 // RendererAdapter adapte le renderer core vers l'interface systems
 type RendererAdapter struct {
 	coreRenderer Renderer
+	subImages    *assets.SpriteAtlasCache // nil : sous-images découpées à chaque appel
 }
 
 // DrawRectangle adapte l'appel de rendu de rectangle vers components.Rectangle
@@ -644,6 +3430,44 @@ func (r *RendererAdapter) DrawSprite(sprite interface{}, position components.Vec
 	r.DrawRectangle(rect, borderColor, false)
 }
 
+// DrawLine trace une ligne si le renderer core le permet, sinon une suite de points
+func (r *RendererAdapter) DrawLine(start, end components.Vector2, color components.Color, thickness float32) {
+	coreColor := Color{R: color.R, G: color.G, B: color.B, A: color.A}
+	if lineRenderer, ok := r.coreRenderer.(interface {
+		DrawLine(start, end Vector2, color Color, thickness float32)
+	}); ok {
+		lineRenderer.DrawLine(Vector2{X: start.X, Y: start.Y}, Vector2{X: end.X, Y: end.Y}, coreColor, thickness)
+		return
+	}
+
+	size := float64(thickness)
+	r.DrawRectangle(components.Rectangle{X: end.X - size/2, Y: end.Y - size/2, Width: size, Height: size}, color, true)
+}
+
+// DecalLayer renderer disposant d'une couche dédiée aux décalques
+type DecalLayer interface {
+	DrawDecal(textureID string, position Vector2, rotation float64, alpha uint8)
+	ComposeDecals()
+}
+
+// DrawDecal dessine un décalque sur la couche dédiée, ou un petit rectangle à défaut
+func (r *RendererAdapter) DrawDecal(textureID string, position components.Vector2, rotation float64, alpha uint8) {
+	if layer, ok := r.coreRenderer.(DecalLayer); ok {
+		layer.DrawDecal(textureID, Vector2{X: position.X, Y: position.Y}, rotation, alpha)
+		return
+	}
+
+	rect := components.Rectangle{X: position.X - 3, Y: position.Y - 3, Width: 6, Height: 6}
+	r.DrawRectangle(rect, components.Color{R: 60, G: 30, B: 20, A: alpha}, true)
+}
+
+// ComposeDecals dépose la couche des décalques sous les entités
+func (r *RendererAdapter) ComposeDecals() {
+	if layer, ok := r.coreRenderer.(DecalLayer); ok {
+		layer.ComposeDecals()
+	}
+}
+
 // drawEbitenSprite dessine un sprite Ebiten réel
 func (r *RendererAdapter) drawEbitenSprite(spriteImage *ebiten.Image, position components.Vector2, sourceRect components.Rectangle, scale components.Vector2, rotation float64, tint components.Color) {
 	// Vérifier si le renderer core supporte les sprites Ebiten
@@ -675,7 +3499,11 @@ func (r *RendererAdapter) drawSpriteToEbitenImage(targetImage *ebiten.Image, spr
 			int(sourceRect.X+sourceRect.Width),
 			int(sourceRect.Y+sourceRect.Height),
 		)
-		subImage = spriteImage.SubImage(srcBounds).(*ebiten.Image)
+		if r.subImages != nil {
+			subImage = r.subImages.Get(spriteImage, srcBounds)
+		} else {
+			subImage = spriteImage.SubImage(srcBounds).(*ebiten.Image)
+		}
 	}
 
 	// Scale
@@ -0,0 +1,48 @@
+// internal/core/frame_limiter.go - Limitation des FPS sans VSync
+package core
+
+import "time"
+
+// FrameLimiter plafonne le nombre d'images par seconde quand la VSync ne le
+// fait pas : Wait dort jusqu'au créneau de l'image suivante
+type FrameLimiter struct {
+	frameTime time.Duration // 0 : aucune limite
+	next      time.Time
+}
+
+// NewFrameLimiter crée un limiteur (fps <= 0 : aucune limite)
+func NewFrameLimiter(fps int) *FrameLimiter {
+	fl := &FrameLimiter{}
+	fl.SetTarget(fps)
+	return fl
+}
+
+// SetTarget change le plafond (fps <= 0 : aucune limite)
+func (fl *FrameLimiter) SetTarget(fps int) {
+	fl.frameTime = 0
+	if fps > 0 {
+		fl.frameTime = time.Second / time.Duration(fps)
+	}
+	fl.next = time.Time{}
+}
+
+// Wait attend le début de l'image suivante
+func (fl *FrameLimiter) Wait() {
+	if fl.frameTime <= 0 {
+		return
+	}
+
+	now := time.Now()
+	if fl.next.IsZero() {
+		fl.next = now
+	}
+	if wait := fl.next.Sub(now); wait > 0 {
+		time.Sleep(wait)
+	}
+
+	// Une image trop lente ne provoque pas de rafale pour rattraper le retard
+	fl.next = fl.next.Add(fl.frameTime)
+	if now.After(fl.next) {
+		fl.next = now.Add(fl.frameTime)
+	}
+}
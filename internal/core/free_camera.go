@@ -0,0 +1,139 @@
+// internal/core/free_camera.go - Caméra libre de debug pour inspecter les niveaux
+package core
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// freeCameraPanSpeed vitesse de la caméra libre, en pixels monde par
+// seconde à un zoom de 1
+const freeCameraPanSpeed = 480.0
+
+// FreeCameraTarget caméra pilotée par la caméra libre (rendering.Camera)
+type FreeCameraTarget interface {
+	SetPosition(position Vector2)
+	SetZoom(zoom float64)
+	GetCenter() Vector2
+	GetZoom() float64
+	StopFollowing()
+}
+
+// FreeCamera caméra de debug détachée du joueur, activée avec F3 : ZQSD/WASD
+// ou les flèches la déplacent, la molette zoome. Le joueur reste immobile
+// tant qu'elle est active ; à la désactivation, le zoom d'origine revient et
+// le système de joueur reprend le suivi.
+type FreeCamera struct {
+	Enabled  bool    // La caméra libre peut être activée (mode debug)
+	ZoomStep float64 // Facteur de zoom par cran de molette
+	active   bool
+
+	camera    FreeCameraTarget
+	position  Vector2
+	zoom      float64
+	savedZoom float64
+}
+
+// NewFreeCamera crée une caméra libre inactive
+func NewFreeCamera() *FreeCamera {
+	return &FreeCamera{ZoomStep: DefaultCameraZoomStep, zoom: 1, savedZoom: 1}
+}
+
+// SetCamera définit la caméra à piloter
+func (fc *FreeCamera) SetCamera(camera FreeCameraTarget) {
+	fc.camera = camera
+}
+
+// IsActive retourne si la caméra libre a la main
+func (fc *FreeCamera) IsActive() bool {
+	return fc.active
+}
+
+// Position retourne le centre de la vue de la caméra libre
+func (fc *FreeCamera) Position() Vector2 {
+	return fc.position
+}
+
+// Zoom retourne le zoom de la caméra libre
+func (fc *FreeCamera) Zoom() float64 {
+	return fc.zoom
+}
+
+// Toggle active ou désactive la caméra libre ; retourne si elle est active
+func (fc *FreeCamera) Toggle() bool {
+	if fc.active {
+		fc.Deactivate()
+	} else {
+		fc.Activate()
+	}
+	return fc.active
+}
+
+// Activate détache la caméra du joueur, à sa position et son zoom actuels
+func (fc *FreeCamera) Activate() {
+	if !fc.Enabled || fc.camera == nil || fc.active {
+		return
+	}
+	fc.active = true
+	fc.position = fc.camera.GetCenter()
+	fc.zoom = fc.camera.GetZoom()
+	fc.savedZoom = fc.zoom
+	fc.camera.StopFollowing()
+	fmt.Println("Caméra libre activée")
+}
+
+// Deactivate rend la caméra au joueur avec son zoom d'origine
+func (fc *FreeCamera) Deactivate() {
+	if !fc.active {
+		return
+	}
+	fc.active = false
+	if fc.camera != nil {
+		fc.camera.SetZoom(fc.savedZoom)
+	}
+	fmt.Println("Caméra libre désactivée")
+}
+
+// Update bascule la caméra avec F3 puis, si elle est active, la déplace et
+// la zoome (en temps réel, indépendamment des ralentis)
+func (fc *FreeCamera) Update(realDelta time.Duration) {
+	if !fc.Enabled || fc.camera == nil {
+		return
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyF3) {
+		fc.Toggle()
+	}
+	if !fc.active {
+		return
+	}
+
+	var pan Vector2
+	if ebiten.IsKeyPressed(ebiten.KeyW) || ebiten.IsKeyPressed(ebiten.KeyZ) || ebiten.IsKeyPressed(ebiten.KeyArrowUp) {
+		pan.Y--
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyS) || ebiten.IsKeyPressed(ebiten.KeyArrowDown) {
+		pan.Y++
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyA) || ebiten.IsKeyPressed(ebiten.KeyQ) || ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
+		pan.X--
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyD) || ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
+		pan.X++
+	}
+
+	// Vitesse constante à l'écran : plus lente dans le monde quand on zoome
+	if length := math.Hypot(pan.X, pan.Y); length > 0 {
+		step := freeCameraPanSpeed / fc.zoom * realDelta.Seconds() / length
+		fc.position = fc.position.Add(pan.Mul(step))
+	}
+
+	if _, wheel := ebiten.Wheel(); wheel != 0 {
+		fc.camera.SetZoom(fc.zoom * math.Pow(fc.ZoomStep, wheel))
+		fc.zoom = fc.camera.GetZoom() // Limité par la caméra
+	}
+	fc.camera.SetPosition(fc.position)
+}
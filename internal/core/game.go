@@ -75,6 +75,7 @@ type Game struct {
 	Paused        bool
 	LastFrameTime time.Time
 	DeltaTime     time.Duration
+	TimeScale     float64 // Multiplicateur du temps de gameplay (1.0 = normal)
 
 	// Stats
 	FrameCount    uint64
@@ -92,6 +93,7 @@ func NewGame(config *GameConfig, assetManager AssetManager, saveManager SaveMana
 		Paused:        false,
 		LastFrameTime: time.Now(),
 		LastFPSUpdate: time.Now(),
+		TimeScale:     1.0,
 	}
 
 	log.Println("Jeu minimal initialisé")
@@ -108,6 +110,7 @@ func NewGameWithBuiltinStates(config *GameConfig, assetManager AssetManager, sav
 		Paused:        false,
 		LastFrameTime: time.Now(),
 		LastFPSUpdate: time.Now(),
+		TimeScale:     1.0,
 	}
 
 	// Créer le StateManager avec les dimensions d'écran
@@ -194,6 +197,10 @@ func (g *Game) Render(screen *ebiten.Image) {
 
 		// Rendre l'état actuel
 		if g.stateManager != nil {
+			// Les entités sont dessinées entre leurs positions des deux derniers ticks
+			if interpolated, ok := g.stateManager.(interface{ SetInterpolationAlpha(alpha float64) }); ok {
+				interpolated.SetInterpolationAlpha(g.InterpolationAlpha())
+			}
 			if err := g.stateManager.Render(g.renderer); err != nil {
 				log.Printf("Erreur rendu état: %v", err)
 			}
@@ -221,6 +228,17 @@ func (g *Game) Render(screen *ebiten.Image) {
 	}
 }
 
+// InterpolationAlpha retourne l'avancement (0 à 1) vers le prochain tick
+// logique : un écran à 144 Hz dessine plusieurs images par tick à 60 TPS
+func (g *Game) InterpolationAlpha() float64 {
+	tps := ebiten.TPS()
+	if tps <= 0 {
+		return 1
+	}
+	tick := time.Second / time.Duration(tps)
+	return Clamp(float64(time.Since(g.LastFrameTime))/float64(tick), 0, 1)
+}
+
 // renderFallback rendu de secours sans renderer
 func (g *Game) renderFallback(screen *ebiten.Image) {
 	// Dessiner un rectangle bleu pour indiquer que le jeu fonctionne
@@ -259,9 +277,41 @@ func (g *Game) SetRenderer(renderer Renderer) {
 // SetStateManager injecte le gestionnaire d'états
 func (g *Game) SetStateManager(stateManager StateManager) {
 	g.stateManager = stateManager
+	g.applyTimeScale()
 	log.Println("StateManager injecté")
 }
 
+// Bornes du multiplicateur du temps de gameplay
+const (
+	MinTimeScale = 0.1
+	MaxTimeScale = 5.0
+)
+
+// SetTimeScale définit le multiplicateur du temps de gameplay (ralenti, accéléré),
+// ramené entre MinTimeScale et MaxTimeScale. Les menus, l'UI et le rendu ne
+// sont pas affectés.
+func (g *Game) SetTimeScale(scale float64) {
+	if scale < MinTimeScale || scale > MaxTimeScale {
+		clamped := Clamp(scale, MinTimeScale, MaxTimeScale)
+		log.Printf("⚠ Échelle de temps %.2f hors limites (%.1f à %.1f), ramenée à %.2f", scale, MinTimeScale, MaxTimeScale, clamped)
+		scale = clamped
+	}
+	g.TimeScale = scale
+	g.applyTimeScale()
+}
+
+// GetTimeScale retourne le multiplicateur du temps de gameplay
+func (g *Game) GetTimeScale() float64 {
+	return g.TimeScale
+}
+
+// applyTimeScale transmet le multiplicateur au StateManager s'il le supporte
+func (g *Game) applyTimeScale() {
+	if sm, ok := g.stateManager.(interface{ SetTimeScale(float64) }); ok {
+		sm.SetTimeScale(g.TimeScale)
+	}
+}
+
 // SetInputManager injecte le gestionnaire d'entrées
 func (g *Game) SetInputManager(inputManager InputManager) {
 	g.inputManager = inputManager
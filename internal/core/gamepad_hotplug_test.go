@@ -0,0 +1,38 @@
+package core
+
+import "testing"
+
+func TestGamepadDisconnectPausesGameplay(t *testing.T) {
+	tests := []struct {
+		name  string
+		start GameStateType
+		want  GameStateType
+	}{
+		{"en partie", StateGameplay, StatePause},
+		{"au menu", StateMenu, StateMenu},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esm := NewEnhancedBuiltinStateManager(1280, 720)
+			if tt.start != StateMenu {
+				esm.ChangeState(tt.start)
+			}
+
+			esm.GamepadDisconnected(0)
+			if esm.GetCurrentStateType() != tt.want {
+				t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), tt.want)
+			}
+		})
+	}
+}
+
+func TestGamepadConnectKeepsPlaying(t *testing.T) {
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	esm.ChangeState(StateGameplay)
+
+	esm.GamepadConnected(1)
+	if esm.GetCurrentStateType() != StateGameplay {
+		t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), StateGameplay)
+	}
+}
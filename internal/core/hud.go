@@ -0,0 +1,407 @@
+// internal/core/hud.go - HUD fixe (vie, stamina, expérience)
+package core
+
+import (
+	"fmt"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+	"zelda-souls-game/internal/ecs/systems"
+	"zelda-souls-game/internal/localization"
+)
+
+// HUD affiche les barres du joueur dans le coin supérieur droit de l'écran,
+// à l'écart des textes de debug (colonne de gauche et coin inférieur droit)
+type HUD struct {
+	Visible bool
+
+	// Disposition
+	screenWidth  int
+	screenHeight int
+	margin       float64
+	barWidth     float64
+	barHeight    float64
+	barSpacing   float64
+
+	// Style
+	BackgroundColor Color
+	BorderColor     Color
+	HealthColor     Color
+	StaminaColor    Color
+	ExperienceColor Color
+
+	// Ombre et contour des textes
+	TextOptions TextRenderOptions
+
+	// Valeurs affichées, animées vers les valeurs réelles
+	health     *components.SmoothValue
+	stamina    *components.SmoothValue
+	experience *components.SmoothValue
+
+	// Clignotement de l'indicateur de god mode
+	blinkTime time.Duration
+
+	// Notifications temporaires (manette branchée...), la plus ancienne en tête
+	notifications []hudNotification
+
+	// Agrandissement du compteur de combo à chaque coup, qui retombe à 1
+	comboPulse *Tween
+
+	// Textes des labels
+	localizer *localization.Localizer
+}
+
+// Disposition du HUD à l'échelle 1
+const (
+	hudMargin     = 10.0
+	hudBarWidth   = 180.0
+	hudBarHeight  = 12.0
+	hudBarSpacing = 22.0
+)
+
+// hudNotification message affiché en haut de l'écran pendant remaining
+type hudNotification struct {
+	text      string
+	remaining time.Duration
+}
+
+// Réglages des notifications
+const (
+	hudNotificationDuration = 3 * time.Second
+	hudNotificationFade     = 500 * time.Millisecond // Fondu final
+	hudMaxNotifications     = 3
+)
+
+// Réglages du compteur de combo
+const (
+	hudComboMinCount   = 2   // Un coup isolé n'est pas un combo
+	hudComboDigitScale = 2.5 // Taille des chiffres au repos
+	hudComboPulseScale = 1.5 // Agrandissement au coup porté
+	hudComboPulse      = 200 * time.Millisecond
+	hudComboFadeFrom   = 0.3 // Fraction du délai restant où le compteur s'estompe
+	hudComboBottom     = 78  // Ligne de base du libellé, au-dessus des statistiques de jeu
+)
+
+// NewHUD crée un nouveau HUD
+func NewHUD(screenWidth, screenHeight int) *HUD {
+	comboPulse := NewTween(hudComboPulseScale, 1, hudComboPulse, EaseOutCubic)
+	comboPulse.Update(hudComboPulse) // Au repos jusqu'au premier coup
+
+	return &HUD{
+		Visible:      true,
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+		margin:       hudMargin,
+		barWidth:     hudBarWidth,
+		barHeight:    hudBarHeight,
+		barSpacing:   hudBarSpacing,
+		comboPulse:   comboPulse,
+
+		BackgroundColor: Color{20, 20, 20, 200},
+		BorderColor:     Color{200, 200, 200, 255},
+		HealthColor:     Color{200, 40, 40, 255},
+		StaminaColor:    Color{40, 180, 60, 255},
+		ExperienceColor: Color{200, 170, 40, 255},
+
+		TextOptions: TextRenderOptions{Shadow: true, ShadowOffset: Vector2{X: 1, Y: 1}},
+
+		health:     components.NewSmoothValue(8.0),
+		stamina:    components.NewSmoothValue(8.0),
+		experience: components.NewSmoothValue(4.0),
+
+		localizer: localization.Default(),
+	}
+}
+
+// SetScale agrandit les barres du HUD (accessibilité)
+func (h *HUD) SetScale(scale float64) {
+	if scale <= 0 {
+		scale = 1.0
+	}
+	h.margin = hudMargin * scale
+	h.barWidth = hudBarWidth * scale
+	h.barHeight = hudBarHeight * scale
+	h.barSpacing = hudBarSpacing * scale
+}
+
+// Toggle affiche/masque le HUD
+func (h *HUD) Toggle() {
+	h.Visible = !h.Visible
+}
+
+// Update anime les barres vers les valeurs du joueur
+func (h *HUD) Update(deltaTime time.Duration, player *components.PlayerComponent) {
+	if player == nil {
+		return
+	}
+
+	h.blinkTime += deltaTime
+	h.comboPulse.Update(deltaTime)
+	h.health.Update(float64(player.Health), deltaTime)
+	h.stamina.Update(player.Stamina, deltaTime)
+	h.experience.Update(float64(player.Experience), deltaTime)
+}
+
+// Reset place les barres directement sur les valeurs du joueur (nouvelle partie)
+func (h *HUD) Reset(player *components.PlayerComponent) {
+	if player == nil {
+		return
+	}
+
+	h.health.Snap(float64(player.Health))
+	h.stamina.Snap(player.Stamina)
+	h.experience.Snap(float64(player.Experience))
+}
+
+// Render dessine le HUD à partir du composant joueur
+func (h *HUD) Render(renderer Renderer, player *components.PlayerComponent) {
+	if !h.Visible || player == nil {
+		return
+	}
+
+	x := float64(h.screenWidth) - h.barWidth - h.margin
+	y := h.margin
+
+	h.renderBar(renderer, x, y, h.health.Displayed, float64(player.MaxHealth),
+		h.HealthColor, h.localizer.Get("ui.hud.health", player.Health, player.MaxHealth))
+
+	// Indicateur doré clignotant à gauche de la barre de vie
+	if player.GodMode && (h.blinkTime.Milliseconds()/300)%2 == 0 {
+		h.drawText(renderer, h.localizer.Get("ui.hud.god"), Vector2{x - 28, y + h.barHeight - 2}, Color{255, 215, 0, 255})
+	}
+
+	y += h.barSpacing
+	h.renderBar(renderer, x, y, h.stamina.Displayed, player.MaxStamina,
+		h.StaminaColor, h.localizer.Get("ui.hud.stamina", player.Stamina, player.MaxStamina))
+
+	y += h.barSpacing
+	h.renderBar(renderer, x, y, h.experience.Displayed, float64(player.ExperienceToNext),
+		h.ExperienceColor, h.localizer.Get("ui.hud.experience", player.Level, player.Experience, player.ExperienceToNext))
+
+	// Âmes portées, à gauche de la barre d'expérience
+	souls := h.localizer.Get("ui.hud.souls", player.Souls)
+	h.drawText(renderer, souls, Vector2{x - float64(len([]rune(souls)))*7 - 8, y + h.barHeight - 2}, Color{220, 200, 150, 255})
+
+	// Fioles restantes, à gauche de la barre de stamina (grisées une fois vides)
+	flaskColor := Color{240, 170, 60, 255}
+	if player.HealCharges == 0 {
+		flaskColor = Color{120, 120, 120, 255}
+	}
+	flasks := h.localizer.Get("ui.hud.heal_charges", player.HealCharges, player.MaxHealCharges)
+	flaskY := h.margin + h.barSpacing + h.barHeight - 2
+	h.drawText(renderer, flasks, Vector2{x - float64(len([]rune(flasks)))*7 - 8, flaskY}, flaskColor)
+}
+
+// Notify affiche une notification temporaire ; au-delà de hudMaxNotifications,
+// la plus ancienne disparaît
+func (h *HUD) Notify(text string) {
+	if len(h.notifications) >= hudMaxNotifications {
+		h.notifications = h.notifications[1:]
+	}
+	h.notifications = append(h.notifications, hudNotification{text: text, remaining: hudNotificationDuration})
+}
+
+// UpdateNotifications fait expirer les notifications, y compris hors du jeu
+func (h *HUD) UpdateNotifications(deltaTime time.Duration) {
+	alive := h.notifications[:0]
+	for _, notification := range h.notifications {
+		notification.remaining -= deltaTime
+		if notification.remaining > 0 {
+			alive = append(alive, notification)
+		}
+	}
+	h.notifications = alive
+}
+
+// RenderNotifications dessine les notifications centrées sous le haut de
+// l'écran, même HUD masqué
+func (h *HUD) RenderNotifications(renderer Renderer) {
+	y := h.margin + 60
+	for _, notification := range h.notifications {
+		alpha := 1.0
+		if notification.remaining < hudNotificationFade {
+			alpha = float64(notification.remaining) / float64(hudNotificationFade)
+		}
+		width := float64(len([]rune(notification.text))) * 7
+		x := (float64(h.screenWidth) - width) / 2
+
+		background := h.BackgroundColor
+		background.A = uint8(float64(background.A) * alpha)
+		renderer.DrawRectangle(Rectangle{X: x - 8, Y: y - 14, Width: width + 16, Height: 20}, background, true)
+		h.drawText(renderer, notification.text, Vector2{x, y}, Color{255, 255, 255, uint8(255 * alpha)})
+		y += 24
+	}
+}
+
+// PulseCombo fait grossir le compteur de combo (coup porté)
+func (h *HUD) PulseCombo() {
+	h.comboPulse.Reset()
+}
+
+// RenderCombo dessine le combo en cours dans le coin inférieur droit : le
+// nombre de coups en grand, qui pulse à chaque coup et s'estompe à
+// l'approche de la perte du combo, puis le multiplicateur de récompense
+func (h *HUD) RenderCombo(renderer Renderer, combo *components.ComboMeter) {
+	if !h.Visible || combo == nil || combo.Count < hudComboMinCount {
+		return
+	}
+
+	alpha := 1.0
+	if remaining := combo.Remaining(); remaining < hudComboFadeFrom {
+		alpha = 0.3 + 0.7*remaining/hudComboFadeFrom
+	}
+	gold := Color{255, 200, 60, uint8(255 * alpha)}
+
+	// Chiffres centrés sur leur ancre pour que la pulsation grossisse sur place
+	digits := fmt.Sprintf("%d", combo.Count)
+	scale := hudComboDigitScale * h.comboPulse.Value()
+	centerX := float64(h.screenWidth) - h.margin - 50
+	labelY := float64(h.screenHeight) - hudComboBottom
+	centerY := labelY - 36
+	width := float64(len(digits)) * 7 * scale
+	pos := Vector2{centerX - width/2, centerY + 5*scale}
+	if scaled, ok := renderer.(interface {
+		DrawTextScaled(text string, pos Vector2, scale float64, color Color)
+	}); ok {
+		scaled.DrawTextScaled(digits, pos.Add(Vector2{1, 1}.Mul(scale)), scale, Color{0, 0, 0, gold.A})
+		scaled.DrawTextScaled(digits, pos, scale, gold)
+	} else {
+		h.drawText(renderer, digits, Vector2{centerX - float64(len(digits))*7/2, centerY + 5}, gold)
+	}
+
+	label := h.localizer.Get("ui.hud.combo", combo.Multiplier)
+	labelX := centerX - float64(len([]rune(label)))*7/2
+	h.drawText(renderer, label, Vector2{labelX, labelY}, Color{255, 255, 255, uint8(255 * alpha)})
+}
+
+// hudAbilities capacités affichées par RenderCooldowns, de gauche à droite
+var hudAbilities = []string{systems.AbilityAttack, systems.AbilityRoll, systems.AbilitySpell, systems.AbilityFocus}
+
+// Disposition des jauges de recharge
+const (
+	hudCooldownWidth   = 70.0
+	hudCooldownHeight  = 6.0
+	hudCooldownSpacing = 12.0
+)
+
+// RenderCooldowns dessine en bas au centre une jauge par capacité, qui se
+// remplit pendant la recharge et s'éclaire une fois la capacité prête
+func (h *HUD) RenderCooldowns(renderer Renderer, cooldowns *CooldownManager) {
+	if !h.Visible || cooldowns == nil {
+		return
+	}
+
+	count := float64(len(hudAbilities))
+	total := count*hudCooldownWidth + (count-1)*hudCooldownSpacing
+	x := (float64(h.screenWidth) - total) / 2
+	y := float64(h.screenHeight) - h.margin - hudCooldownHeight
+
+	for _, ability := range hudAbilities {
+		gauge := Rectangle{X: x, Y: y, Width: hudCooldownWidth, Height: hudCooldownHeight}
+		renderer.DrawRectangle(gauge, h.BackgroundColor, true)
+
+		fill := Color{110, 110, 130, 255}
+		border := h.BorderColor
+		labelColor := ColorGray
+		if cooldowns.IsReady(ability) {
+			fill = Color{90, 170, 255, 255}
+			labelColor = ColorWhite
+		} else {
+			border = Color{90, 90, 90, 255}
+		}
+		filled := gauge
+		filled.Width *= cooldowns.Progress(ability)
+		renderer.DrawRectangle(filled, fill, true)
+		renderer.DrawRectangle(gauge, border, false)
+
+		label := h.localizer.Get("ui.hud.ability." + ability)
+		labelX := x + (hudCooldownWidth-float64(len([]rune(label)))*7)/2
+		h.drawText(renderer, label, Vector2{labelX, y - 4}, labelColor)
+
+		x += hudCooldownWidth + hudCooldownSpacing
+	}
+}
+
+// RenderChallengeTimer affiche le compte à rebours d'un défi au centre du haut de l'écran
+func (h *HUD) RenderChallengeTimer(renderer Renderer, challenge *components.ChallengeRoomComponent) {
+	if challenge == nil || !challenge.Active {
+		return
+	}
+
+	remaining := challenge.Remaining()
+	text := h.localizer.Get("ui.challenge.timer", formatTimer(remaining))
+
+	width := float64(len(text))*7 + 24
+	box := Rectangle{X: float64(h.screenWidth)/2 - width/2, Y: h.margin, Width: width, Height: 26}
+	renderer.DrawRectangle(box, h.BackgroundColor, true)
+
+	// Rouge clignotant sur les dix dernières secondes
+	color := ColorYellow
+	if remaining <= 10*time.Second {
+		color = ColorRed
+		if (remaining.Milliseconds()/250)%2 == 0 {
+			color = ColorWhite
+		}
+	}
+	renderer.DrawRectangle(box, color, false)
+	h.drawText(renderer, text, Vector2{box.X + 12, box.Y + 18}, color)
+
+	if challenge.BestTime > 0 {
+		best := h.localizer.Get("ui.challenge.best", formatTimer(challenge.BestTime))
+		h.drawText(renderer, best, Vector2{float64(h.screenWidth)/2 - float64(len(best)*7)/2, box.Y + box.Height + 14}, ColorGray)
+	}
+}
+
+// drawText dessine un texte du HUD avec l'ombre et le contour de TextOptions
+func (h *HUD) drawText(renderer Renderer, text string, pos Vector2, color Color) {
+	drawStyledText(renderer, h.TextOptions, text, pos, color)
+}
+
+// drawStyledText dessine un texte avec l'ombre et le contour d'options ; sans
+// DrawTextWithShadow, l'ombre est dessinée par un premier DrawText
+func drawStyledText(renderer Renderer, options TextRenderOptions, text string, pos Vector2, color Color) {
+	offset := Vector2{}
+	if options.Shadow {
+		offset = options.ShadowOffset
+	}
+	shadow := Color{0, 0, 0, color.A}
+
+	if options.Shadow || options.Stroke {
+		if shadowRenderer, ok := renderer.(interface {
+			DrawTextWithShadow(text string, pos Vector2, color, shadowColor Color, shadowOffset Vector2)
+		}); ok {
+			shadowRenderer.DrawTextWithShadow(text, pos, color, shadow, offset)
+			return
+		}
+	}
+
+	if offset != (Vector2{}) {
+		renderer.DrawText(text, pos.Add(offset), shadow)
+	}
+	renderer.DrawText(text, pos, color)
+}
+
+// formatTimer formate une durée en mm:ss.d
+func formatTimer(d time.Duration) string {
+	tenths := int(d.Milliseconds() / 100)
+	return fmt.Sprintf("%02d:%02d.%d", tenths/600, (tenths/10)%60, tenths%10)
+}
+
+// renderBar dessine une barre horizontale avec son label numérique
+func (h *HUD) renderBar(renderer Renderer, x, y, value, maxValue float64, fillColor Color, label string) {
+	bar := Rectangle{X: x, Y: y, Width: h.barWidth, Height: h.barHeight}
+	renderer.DrawRectangle(bar, h.BackgroundColor, true)
+
+	ratio := 0.0
+	if maxValue > 0 {
+		ratio = components.Clamp(value/maxValue, 0, 1)
+	}
+	if ratio > 0 {
+		fill := Rectangle{X: x, Y: y, Width: h.barWidth * ratio, Height: h.barHeight}
+		renderer.DrawRectangle(fill, fillColor, true)
+	}
+
+	renderer.DrawRectangle(bar, h.BorderColor, false)
+
+	// DrawText place le texte sur sa ligne de base
+	h.drawText(renderer, label, Vector2{x + 4, y + h.barHeight - 2}, ColorWhite)
+}
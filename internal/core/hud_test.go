@@ -0,0 +1,143 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// textCall appel de dessin de texte enregistré
+type textCall struct {
+	text  string
+	pos   Vector2
+	color Color
+}
+
+// recordingRenderer enregistre les textes dessinés, dans l'ordre
+type recordingRenderer struct {
+	texts []textCall
+}
+
+func (r *recordingRenderer) BeginFrame()                                            {}
+func (r *recordingRenderer) EndFrame()                                              {}
+func (r *recordingRenderer) DrawRectangle(rect Rectangle, color Color, filled bool) {}
+func (r *recordingRenderer) GetMainImage() *ebiten.Image                            { return nil }
+func (r *recordingRenderer) Cleanup()                                               {}
+
+func (r *recordingRenderer) DrawText(text string, pos Vector2, color Color) {
+	r.texts = append(r.texts, textCall{text, pos, color})
+}
+
+// shadowRecordingRenderer sait dessiner l'ombre lui-même
+type shadowRecordingRenderer struct {
+	recordingRenderer
+	shadows int
+}
+
+func (r *shadowRecordingRenderer) DrawTextWithShadow(text string, pos Vector2, color, shadowColor Color, shadowOffset Vector2) {
+	r.shadows++
+	r.DrawText(text, pos.Add(shadowOffset), shadowColor)
+	r.DrawText(text, pos, color)
+}
+
+func TestHUDTextShadowDrawnFirst(t *testing.T) {
+	pos := Vector2{X: 20, Y: 30}
+	color := Color{255, 220, 0, 200}
+	shadow := Color{0, 0, 0, 200}
+
+	tests := []struct {
+		name    string
+		options TextRenderOptions
+		want    []textCall
+	}{
+		{
+			name:    "ombre",
+			options: TextRenderOptions{Shadow: true, ShadowOffset: Vector2{X: 1, Y: 1}},
+			want:    []textCall{{"Âmes", Vector2{X: 21, Y: 31}, shadow}, {"Âmes", pos, color}},
+		},
+		{
+			name:    "ombre décalée",
+			options: TextRenderOptions{Shadow: true, ShadowOffset: Vector2{X: 2, Y: -1}},
+			want:    []textCall{{"Âmes", Vector2{X: 22, Y: 29}, shadow}, {"Âmes", pos, color}},
+		},
+		{
+			name:    "sans ombre",
+			options: TextRenderOptions{},
+			want:    []textCall{{"Âmes", pos, color}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hud := NewHUD(1280, 720)
+			hud.TextOptions = tt.options
+			renderer := &recordingRenderer{}
+
+			hud.drawText(renderer, "Âmes", pos, color)
+
+			if len(renderer.texts) != len(tt.want) {
+				t.Fatalf("%d textes dessinés, attendu %d : %+v", len(renderer.texts), len(tt.want), renderer.texts)
+			}
+			for i, call := range renderer.texts {
+				if call != tt.want[i] {
+					t.Errorf("texte %d = %+v, attendu %+v", i, call, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHUDTextUsesRendererShadow(t *testing.T) {
+	hud := NewHUD(1280, 720)
+	hud.TextOptions = TextRenderOptions{Shadow: true, ShadowOffset: Vector2{X: 1, Y: 1}}
+	renderer := &shadowRecordingRenderer{}
+
+	hud.drawText(renderer, "Âmes", Vector2{X: 20, Y: 30}, ColorWhite)
+
+	if renderer.shadows != 1 {
+		t.Fatalf("DrawTextWithShadow appelé %d fois, attendu 1", renderer.shadows)
+	}
+	if len(renderer.texts) != 2 || renderer.texts[1].color != ColorWhite {
+		t.Errorf("textes = %+v, attendu l'ombre puis le texte", renderer.texts)
+	}
+}
+
+func TestBossBarTextUsesShadow(t *testing.T) {
+	bar := NewBossBar(1280)
+	bar.visible, bar.bossName, bar.health, bar.maxHealth = true, "Gardien", 40, 100
+	renderer := &shadowRecordingRenderer{}
+
+	bar.Render(renderer)
+
+	if renderer.shadows != 2 {
+		t.Errorf("DrawTextWithShadow appelé %d fois, attendu 2 (nom et points de vie)", renderer.shadows)
+	}
+}
+
+func TestGameplayTextUsesShadow(t *testing.T) {
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	esm.startNewGame()
+	renderer := &shadowRecordingRenderer{}
+
+	esm.Render(renderer)
+
+	// Chaque texte d'interface est précédé de son ombre ; seuls les marqueurs
+	// dessinés dans le monde par les systèmes (panneaux) n'en ont pas
+	worldTexts := map[string]bool{"!": true}
+	shadowed := 0
+	for i := 0; i < len(renderer.texts); i++ {
+		call := renderer.texts[i]
+		if worldTexts[call.text] {
+			continue
+		}
+		if i+1 >= len(renderer.texts) || renderer.texts[i+1].text != call.text {
+			t.Errorf("texte %q dessiné sans ombre", call.text)
+			continue
+		}
+		shadowed++
+		i++
+	}
+	if shadowed == 0 || shadowed != renderer.shadows {
+		t.Errorf("%d textes ombrés, attendu %d appels à DrawTextWithShadow", shadowed, renderer.shadows)
+	}
+}
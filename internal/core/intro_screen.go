@@ -0,0 +1,109 @@
+// internal/core/intro_screen.go - Introduction jouée au premier lancement
+package core
+
+import "time"
+
+// Rythme de l'introduction
+const (
+	introLineDuration = 3500 * time.Millisecond // Affichage d'une phrase, fondus compris
+	introFadeDuration = 600 * time.Millisecond  // Fondu d'entrée et de sortie d'une phrase
+)
+
+// IntroScreen fait défiler les phrases de l'introduction sur fond noir, une
+// à la fois en fondu. Une touche passe à la phrase suivante, ESC passe tout ;
+// OnFinished est appelé une fois à la fin, vue ou passée.
+type IntroScreen struct {
+	lines        []string
+	skipHint     string
+	screenWidth  int
+	screenHeight int
+
+	current  int
+	elapsed  time.Duration
+	finished bool
+
+	OnFinished func()
+}
+
+// NewIntroScreen crée l'introduction ; skipHint est rappelé en bas d'écran
+func NewIntroScreen(screenWidth, screenHeight int, lines []string, skipHint string) *IntroScreen {
+	return &IntroScreen{
+		lines:        lines,
+		skipHint:     skipHint,
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+	}
+}
+
+// Start reprend l'introduction à sa première phrase
+func (is *IntroScreen) Start() {
+	is.current = 0
+	is.elapsed = 0
+	is.finished = len(is.lines) == 0
+	if is.finished {
+		is.finish()
+	}
+}
+
+// IsFinished retourne si l'introduction est terminée
+func (is *IntroScreen) IsFinished() bool {
+	return is.finished
+}
+
+// Update fait avancer l'introduction (en temps réel) ; next passe à la
+// phrase suivante
+func (is *IntroScreen) Update(realDelta time.Duration, next bool) {
+	if is.finished {
+		return
+	}
+	is.elapsed += realDelta
+	if next || is.elapsed >= introLineDuration {
+		is.current++
+		is.elapsed = 0
+		if is.current >= len(is.lines) {
+			is.finish()
+		}
+	}
+}
+
+// Skip passe le reste de l'introduction
+func (is *IntroScreen) Skip() {
+	if !is.finished {
+		is.finish()
+	}
+}
+
+// finish termine l'introduction et prévient OnFinished
+func (is *IntroScreen) finish() {
+	is.finished = true
+	if is.OnFinished != nil {
+		is.OnFinished()
+	}
+}
+
+// lineAlpha opacité de la phrase courante : fondu d'entrée puis de sortie
+func (is *IntroScreen) lineAlpha() uint8 {
+	alpha := 1.0
+	switch {
+	case is.elapsed < introFadeDuration:
+		alpha = float64(is.elapsed) / float64(introFadeDuration)
+	case is.elapsed > introLineDuration-introFadeDuration:
+		alpha = float64(introLineDuration-is.elapsed) / float64(introFadeDuration)
+	}
+	return uint8(Clamp(alpha, 0, 1) * 255)
+}
+
+// Render dessine la phrase courante au centre de l'écran
+func (is *IntroScreen) Render(renderer Renderer) {
+	screen := Rectangle{X: 0, Y: 0, Width: float64(is.screenWidth), Height: float64(is.screenHeight)}
+	renderer.DrawRectangle(screen, ColorBlack, true)
+	if is.finished || is.current >= len(is.lines) {
+		return
+	}
+
+	centerX := float64(is.screenWidth) / 2
+	line := is.lines[is.current]
+	renderer.DrawText(line, Vector2{centerX - float64(len([]rune(line))*7)/2, float64(is.screenHeight) / 2},
+		Color{220, 210, 190, is.lineAlpha()})
+	renderer.DrawText(is.skipHint, Vector2{centerX - float64(len([]rune(is.skipHint))*7)/2, float64(is.screenHeight) - 40}, ColorGray)
+}
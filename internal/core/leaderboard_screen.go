@@ -0,0 +1,153 @@
+// internal/core/leaderboard_screen.go - Écran du classement en ligne
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// RunSummary bilan d'une partie terminée, envoyé au classement
+type RunSummary struct {
+	EnemiesKilled int
+	Souls         int
+	Level         int
+	PlayTime      time.Duration
+}
+
+// Points du score d'une partie
+const (
+	scorePerKill  = 100
+	scorePerLevel = 250
+)
+
+// Score retourne le score de la partie : 100 par ennemi tué, 250 par niveau
+// au-delà du premier, plus les âmes détenues
+func (rs RunSummary) Score() int {
+	score := rs.EnemiesKilled*scorePerKill + rs.Souls
+	if rs.Level > 1 {
+		score += (rs.Level - 1) * scorePerLevel
+	}
+	return score
+}
+
+// LeaderboardRow ligne du classement
+type LeaderboardRow struct {
+	Name          string
+	Score         int
+	Difficulty    string
+	PlayTime      time.Duration
+	EnemiesKilled int
+}
+
+// LeaderboardFetcher lit les meilleurs scores (appelé hors de la boucle de jeu)
+type LeaderboardFetcher func() ([]LeaderboardRow, error)
+
+// LeaderboardLabels libellés de l'écran du classement ; Row est un format
+// (rang, nom, score, difficulté, durée) et Error un format (message)
+type LeaderboardLabels struct {
+	Title, Loading, Empty, Error, Back, Row string
+}
+
+// leaderboardResult réponse d'un chargement du classement
+type leaderboardResult struct {
+	rows []LeaderboardRow
+	err  error
+}
+
+// LeaderboardScreen affiche les meilleurs scores, chargés en arrière-plan
+// pour ne pas figer le menu pendant la requête
+type LeaderboardScreen struct {
+	labels       LeaderboardLabels
+	screenWidth  int
+	screenHeight int
+	BackButton   *Button
+
+	rows    []LeaderboardRow
+	err     error
+	loading bool
+	results chan leaderboardResult
+}
+
+// NewLeaderboardScreen crée l'écran ; onBack est appelé par le bouton Retour
+func NewLeaderboardScreen(screenWidth, screenHeight int, scale float64, labels LeaderboardLabels, onBack func()) *LeaderboardScreen {
+	if scale <= 0 {
+		scale = 1
+	}
+	width := pauseButtonWidth * scale
+	height := pauseButtonHeight * scale
+	back := NewButton((float64(screenWidth)-width)/2, float64(screenHeight)-height-40, width, height, labels.Back, onBack)
+
+	return &LeaderboardScreen{
+		labels:       labels,
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+		BackButton:   back,
+	}
+}
+
+// Load lance la lecture du classement ; un chargement précédent encore en
+// cours est ignoré à son retour
+func (ls *LeaderboardScreen) Load(fetch LeaderboardFetcher) {
+	ls.rows = nil
+	ls.err = nil
+	if fetch == nil {
+		ls.loading = false
+		return
+	}
+
+	results := make(chan leaderboardResult, 1)
+	ls.results = results
+	ls.loading = true
+	go func() {
+		rows, err := fetch()
+		results <- leaderboardResult{rows: rows, err: err}
+	}()
+}
+
+// Update relève le résultat du chargement et met à jour le bouton Retour
+func (ls *LeaderboardScreen) Update(mousePos Vector2, mousePressed bool) {
+	if ls.loading {
+		select {
+		case result := <-ls.results:
+			ls.rows, ls.err = result.rows, result.err
+			ls.loading = false
+			if result.err != nil {
+				fmt.Printf("⚠ Classement indisponible: %v\n", result.err)
+			}
+		default:
+		}
+	}
+	ls.BackButton.Update(mousePos, mousePressed)
+}
+
+// Render dessine le titre, puis les scores ou l'état du chargement
+func (ls *LeaderboardScreen) Render(renderer Renderer) {
+	overlay := Rectangle{X: 0, Y: 0, Width: float64(ls.screenWidth), Height: float64(ls.screenHeight)}
+	renderer.DrawRectangle(overlay, Color{0, 0, 0, 200}, true)
+
+	centerX := float64(ls.screenWidth) / 2
+	drawCentered := func(text string, y float64, color Color) {
+		renderer.DrawText(text, Vector2{centerX - float64(len([]rune(text))*7)/2, y}, color)
+	}
+	drawCentered(ls.labels.Title, 60, ColorYellow)
+
+	switch {
+	case ls.loading:
+		drawCentered(ls.labels.Loading, 120, ColorGray)
+	case ls.err != nil:
+		drawCentered(fmt.Sprintf(ls.labels.Error, ls.err), 120, ColorRed)
+	case len(ls.rows) == 0:
+		drawCentered(ls.labels.Empty, 120, ColorGray)
+	default:
+		for i, row := range ls.rows {
+			line := fmt.Sprintf(ls.labels.Row, i+1, row.Name, row.Score, row.Difficulty, formatDuration(row.PlayTime))
+			color := ColorWhite
+			if i == 0 {
+				color = ColorYellow
+			}
+			renderer.DrawText(line, Vector2{centerX - 200, 110 + float64(i)*22}, color)
+		}
+	}
+
+	ls.BackButton.Render(renderer)
+}
@@ -0,0 +1,27 @@
+// internal/core/light.go - Sources de lumière de l'éclairage 2D
+package core
+
+// Light source de lumière : perce l'obscurité dans un disque dégradé et teinte
+// la scène de sa couleur
+type Light struct {
+	Position  Vector2 // Centre, dans le monde
+	Radius    float64 // Rayon, en pixels
+	Color     Color
+	Intensity float64 // 0 à 1 : part de l'obscurité retirée au centre
+}
+
+// Lumières du gameplay
+var (
+	PlayerTorchLight = Light{Radius: 160, Color: Color{255, 200, 140, 255}, Intensity: 0.95}
+	BonfireLight     = Light{Radius: 220, Color: Color{255, 140, 50, 255}, Intensity: 1.0}
+	EmberLight       = Light{Radius: 70, Color: Color{200, 80, 30, 255}, Intensity: 0.5} // Feu de camp éteint
+)
+
+// DefaultAmbientDarkness opacité par défaut de l'obscurité hors des lumières
+const DefaultAmbientDarkness = 0.7
+
+// At retourne une copie de la lumière placée en position
+func (l Light) At(position Vector2) Light {
+	l.Position = position
+	return l
+}
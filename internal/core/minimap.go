@@ -0,0 +1,392 @@
+// internal/core/minimap.go - Mini-carte, signaux des objets à découvrir et objectifs de quête
+package core
+
+import (
+	"math"
+	"time"
+)
+
+// PingType nature d'un signal de la mini-carte
+type PingType int
+
+const (
+	PingItem   PingType = iota // Objet à ramasser
+	PingPortal                 // Portail
+	PingBoss                   // Boss
+)
+
+// Color retourne la couleur du signal (alpha plein)
+func (pt PingType) Color() Color {
+	switch pt {
+	case PingPortal:
+		return Color{60, 220, 90, 255} // Vert
+	case PingBoss:
+		return Color{230, 40, 40, 255} // Rouge
+	default:
+		return Color{40, 220, 230, 255} // Cyan
+	}
+}
+
+// MinimapPing point clignotant de la mini-carte
+type MinimapPing struct {
+	EntityID EntityID
+	WorldPos Vector2
+	Type     PingType
+}
+
+// MiniMap affiche le monde réduit dans le coin supérieur droit, sous le HUD,
+// avec la position du joueur et les signaux pulsants
+type MiniMap struct {
+	Visible bool
+
+	// Monde représenté (pixels)
+	worldWidth  float64
+	worldHeight float64
+
+	// Disposition à l'écran
+	screenWidth int
+	margin      float64
+	top         float64
+	width       float64
+	height      float64
+
+	// Fréquence de la pulsation des signaux (Hz)
+	PulseFrequency float64
+
+	// Style
+	BackgroundColor Color
+	BorderColor     Color
+	PlayerColor     Color
+
+	pings     []MinimapPing
+	markers   []ObjectiveMarker
+	pulseTime time.Duration
+
+	// Plan du donjon de la vue d'ensemble (nil : pas de vue d'ensemble)
+	roomGraph *RoomGraph
+}
+
+// Disposition de la mini-carte à l'échelle 1
+const (
+	minimapWidth    = 160.0
+	minimapTop      = 90.0 // Sous les barres du HUD
+	minimapMargin   = 10.0
+	minimapDotSize  = 4.0
+	minimapIconSize = 8.0
+
+	// Fréquence du clignotement des marqueurs d'objectif (Hz)
+	minimapBlinkFrequency = 1.0
+
+	// Distance à laquelle un objet non découvert est signalé (5 tuiles)
+	minimapPingRadius = 5 * TileSize
+
+	// Vue d'ensemble du donjon, sous la mini-carte
+	overviewHeightRatio = 0.6 // Hauteur du panneau / largeur de la mini-carte
+	overviewPadding     = 8.0
+	overviewRoomFill    = 0.6 // Part de la case occupée par la salle
+)
+
+// NewMiniMap crée une mini-carte couvrant un monde de worldWidth x worldHeight pixels
+func NewMiniMap(screenWidth int, worldWidth, worldHeight float64) *MiniMap {
+	mm := &MiniMap{
+		Visible:     true,
+		worldWidth:  worldWidth,
+		worldHeight: worldHeight,
+		screenWidth: screenWidth,
+
+		PulseFrequency: 1.0,
+
+		BackgroundColor: Color{20, 20, 20, 180},
+		BorderColor:     Color{200, 200, 200, 255},
+		PlayerColor:     ColorWhite,
+
+		pings: make([]MinimapPing, 0),
+	}
+	mm.SetScale(1.0)
+	return mm
+}
+
+// SetScale agrandit la mini-carte (accessibilité)
+func (mm *MiniMap) SetScale(scale float64) {
+	if scale <= 0 {
+		scale = 1.0
+	}
+	mm.margin = minimapMargin * scale
+	mm.top = minimapTop * scale
+	mm.width = minimapWidth * scale
+	mm.height = mm.width
+	if mm.worldWidth > 0 {
+		mm.height = mm.width * mm.worldHeight / mm.worldWidth
+	}
+}
+
+// AddPing ajoute (ou déplace) le signal d'une entité
+func (mm *MiniMap) AddPing(entityID EntityID, worldPos Vector2, pingType PingType) {
+	for i := range mm.pings {
+		if mm.pings[i].EntityID == entityID {
+			mm.pings[i].WorldPos = worldPos
+			mm.pings[i].Type = pingType
+			return
+		}
+	}
+	mm.pings = append(mm.pings, MinimapPing{EntityID: entityID, WorldPos: worldPos, Type: pingType})
+}
+
+// RemovePing retire le signal d'une entité
+func (mm *MiniMap) RemovePing(entityID EntityID) {
+	for i := range mm.pings {
+		if mm.pings[i].EntityID == entityID {
+			mm.pings = append(mm.pings[:i], mm.pings[i+1:]...)
+			return
+		}
+	}
+}
+
+// HasPing vérifie si une entité a un signal sur la mini-carte
+func (mm *MiniMap) HasPing(entityID EntityID) bool {
+	for _, ping := range mm.pings {
+		if ping.EntityID == entityID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPings retourne les signaux actifs
+func (mm *MiniMap) GetPings() []MinimapPing {
+	return mm.pings
+}
+
+// ClearPings retire tous les signaux (nouvelle partie)
+func (mm *MiniMap) ClearPings() {
+	mm.pings = mm.pings[:0]
+	mm.pulseTime = 0
+}
+
+// AddMarker ajoute (ou déplace) le marqueur d'un objectif
+func (mm *MiniMap) AddMarker(marker ObjectiveMarker) {
+	for i := range mm.markers {
+		if mm.markers[i].EntityID == marker.EntityID {
+			mm.markers[i] = marker
+			return
+		}
+	}
+	mm.markers = append(mm.markers, marker)
+}
+
+// RemoveMarker retire le marqueur d'un objectif
+func (mm *MiniMap) RemoveMarker(entityID EntityID) {
+	for i := range mm.markers {
+		if mm.markers[i].EntityID == entityID {
+			mm.markers = append(mm.markers[:i], mm.markers[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetMarkers retourne les marqueurs d'objectif
+func (mm *MiniMap) GetMarkers() []ObjectiveMarker {
+	return mm.markers
+}
+
+// ClearMarkers retire tous les marqueurs d'objectif
+func (mm *MiniMap) ClearMarkers() {
+	mm.markers = mm.markers[:0]
+}
+
+// MarkerVisible indique si les marqueurs clignotants sont affichés : visibles
+// puis masqués chaque demi-période, à minimapBlinkFrequency Hz
+func (mm *MiniMap) MarkerVisible() bool {
+	halfPeriod := time.Duration(float64(time.Second) / (2 * minimapBlinkFrequency))
+	return (mm.pulseTime/halfPeriod)%2 == 0
+}
+
+// Update fait avancer la pulsation
+func (mm *MiniMap) Update(deltaTime time.Duration) {
+	mm.pulseTime += deltaTime
+}
+
+// PulseAlpha alpha courant des signaux : 255 → 0 → 255 à PulseFrequency Hz
+func (mm *MiniMap) PulseAlpha() uint8 {
+	phase := 2 * math.Pi * mm.PulseFrequency * mm.pulseTime.Seconds()
+	return uint8(math.Round(255 * (0.5 + 0.5*math.Cos(phase))))
+}
+
+// bounds zone de la mini-carte à l'écran
+func (mm *MiniMap) bounds() Rectangle {
+	return Rectangle{
+		X:      float64(mm.screenWidth) - mm.width - mm.margin,
+		Y:      mm.top,
+		Width:  mm.width,
+		Height: mm.height,
+	}
+}
+
+// WorldToMap convertit une position du monde en position à l'écran ;
+// les positions hors du monde sont ramenées au bord de la carte
+func (mm *MiniMap) WorldToMap(worldPos Vector2) Vector2 {
+	area := mm.bounds()
+	if mm.worldWidth <= 0 || mm.worldHeight <= 0 {
+		return Vector2{area.X, area.Y}
+	}
+
+	u := math.Max(0, math.Min(1, worldPos.X/mm.worldWidth))
+	v := math.Max(0, math.Min(1, worldPos.Y/mm.worldHeight))
+	return Vector2{area.X + u*area.Width, area.Y + v*area.Height}
+}
+
+// Render dessine la mini-carte, les signaux, les objectifs puis le joueur
+func (mm *MiniMap) Render(renderer Renderer, playerPos Vector2) {
+	if !mm.Visible {
+		return
+	}
+
+	area := mm.bounds()
+	renderer.DrawRectangle(area, mm.BackgroundColor, true)
+
+	alpha := mm.PulseAlpha()
+	for _, ping := range mm.pings {
+		color := ping.Type.Color()
+		color.A = alpha
+		mm.renderDot(renderer, mm.WorldToMap(ping.WorldPos), minimapDotSize*1.5, color)
+	}
+
+	blinkOn := mm.MarkerVisible()
+	for _, marker := range mm.markers {
+		if marker.Blinking && !blinkOn {
+			continue
+		}
+		mm.renderIcon(renderer, mm.WorldToMap(marker.WorldPos), marker.Icon)
+	}
+
+	mm.renderDot(renderer, mm.WorldToMap(playerPos), minimapDotSize, mm.PlayerColor)
+	renderer.DrawRectangle(area, mm.BorderColor, false)
+}
+
+// SetRoomGraph définit le plan affiché par la vue d'ensemble
+func (mm *MiniMap) SetRoomGraph(graph *RoomGraph) {
+	mm.roomGraph = graph
+}
+
+// overviewBounds zone de la vue d'ensemble à l'écran, sous la mini-carte
+func (mm *MiniMap) overviewBounds() Rectangle {
+	area := mm.bounds()
+	return Rectangle{
+		X:      area.X,
+		Y:      area.Y + area.Height + mm.margin,
+		Width:  area.Width,
+		Height: area.Width * overviewHeightRatio,
+	}
+}
+
+// RenderOverview dessine le plan du donjon : les passages, puis les salles
+// (plus vives une fois visitées) et le joueur dans la salle courante
+func (mm *MiniMap) RenderOverview(renderer Renderer) {
+	if !mm.Visible || mm.roomGraph == nil {
+		return
+	}
+	minX, minY, maxX, maxY, ok := mm.roomGraph.GridBounds()
+	if !ok {
+		return
+	}
+
+	panel := mm.overviewBounds()
+	renderer.DrawRectangle(panel, mm.BackgroundColor, true)
+
+	// Cases carrées, plan centré dans le panneau
+	scale := mm.width / minimapWidth
+	padding := overviewPadding * scale
+	cols, rows := float64(maxX-minX+1), float64(maxY-minY+1)
+	cell := math.Min((panel.Width-2*padding)/cols, (panel.Height-2*padding)/rows)
+	originX := panel.X + (panel.Width-cell*cols)/2
+	originY := panel.Y + (panel.Height-cell*rows)/2
+	center := func(node *RoomNode) Vector2 {
+		return Vector2{
+			originX + (float64(node.GridX-minX)+0.5)*cell,
+			originY + (float64(node.GridY-minY)+0.5)*cell,
+		}
+	}
+
+	// Passages : traits entre les centres des salles voisines (en L si elles
+	// ne sont pas alignées)
+	thickness := math.Max(1, 2*scale)
+	segment := func(a, b Vector2, color Color) {
+		renderer.DrawRectangle(Rectangle{
+			X:      math.Min(a.X, b.X) - thickness/2,
+			Y:      math.Min(a.Y, b.Y) - thickness/2,
+			Width:  math.Abs(b.X-a.X) + thickness,
+			Height: math.Abs(b.Y-a.Y) + thickness,
+		}, color, true)
+	}
+	for _, edge := range mm.roomGraph.Edges {
+		from, to := mm.roomGraph.Nodes[edge.From], mm.roomGraph.Nodes[edge.To]
+		a, b := center(from), center(to)
+		color := mm.BorderColor
+		if !from.Visited && !to.Visited {
+			color.A = 80
+		}
+		corner := Vector2{b.X, a.Y}
+		segment(a, corner, color)
+		segment(corner, b, color)
+	}
+
+	size := cell * overviewRoomFill
+	for _, node := range mm.roomGraph.SortedNodes() {
+		position := center(node)
+		room := Rectangle{X: position.X - size/2, Y: position.Y - size/2, Width: size, Height: size}
+
+		// Salle non visitée : couleur assombrie
+		color := node.Type.Color()
+		if !node.Visited {
+			color = Color{color.R / 3, color.G / 3, color.B / 3, 200}
+		}
+		renderer.DrawRectangle(room, color, true)
+		renderer.DrawRectangle(room, mm.BorderColor, false)
+
+		if node.ID == mm.roomGraph.Current {
+			mm.renderDot(renderer, position, minimapDotSize*scale, mm.PlayerColor)
+		}
+	}
+
+	renderer.DrawRectangle(panel, mm.BorderColor, false)
+}
+
+// renderDot dessine un point carré centré sur position
+func (mm *MiniMap) renderDot(renderer Renderer, position Vector2, size float64, color Color) {
+	renderer.DrawRectangle(Rectangle{
+		X:      position.X - size/2,
+		Y:      position.Y - size/2,
+		Width:  size,
+		Height: size,
+	}, color, true)
+}
+
+// renderIcon dessine l'icône d'un objectif centrée sur position, à l'échelle
+// de la mini-carte
+func (mm *MiniMap) renderIcon(renderer Renderer, position Vector2, icon IconType) {
+	size := minimapIconSize * mm.width / minimapWidth
+	color := icon.Color()
+	rect := func(x, y, w, h float64, clr Color) {
+		renderer.DrawRectangle(Rectangle{
+			X: position.X + x*size, Y: position.Y + y*size, Width: w * size, Height: h * size,
+		}, clr, true)
+	}
+
+	switch icon {
+	case IconSkull:
+		// Crâne, mâchoire et orbites
+		rect(-0.5, -0.5, 1, 0.6, color)
+		rect(-0.3, 0.1, 0.6, 0.4, color)
+		rect(-0.35, -0.3, 0.25, 0.25, mm.BackgroundColor)
+		rect(0.1, -0.3, 0.25, 0.25, mm.BackgroundColor)
+	case IconSpeech:
+		// Bulle et sa queue
+		rect(-0.5, -0.5, 1, 0.7, color)
+		rect(-0.3, 0.2, 0.25, 0.3, color)
+	default:
+		// Étoile à quatre branches
+		rect(-0.125, -0.5, 0.25, 1, color)
+		rect(-0.5, -0.125, 1, 0.25, color)
+		rect(-0.25, -0.25, 0.5, 0.5, color)
+	}
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+func TestMinimapPingRemovedOnPickup(t *testing.T) {
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	item := esm.itemSystem.SpawnItem("potion", 400, 300)
+	far := esm.itemSystem.SpawnItem("clé", 1200, 700)
+
+	// À 3 tuiles : l'objet est signalé, pas encore ramassé
+	esm.updateItems(components.Vector2{X: 400 - 3*TileSize, Y: 300})
+	if !esm.miniMap.HasPing(EntityID(item.EntityID)) {
+		t.Fatal("un objet à moins de 5 tuiles doit être signalé")
+	}
+	if esm.miniMap.HasPing(EntityID(far.EntityID)) {
+		t.Fatal("un objet éloigné ne doit pas être signalé")
+	}
+
+	// Sur l'objet : ItemPickedUpEvent retire le signal
+	esm.updateItems(components.Vector2{X: 400, Y: 300})
+	if esm.miniMap.HasPing(EntityID(item.EntityID)) {
+		t.Error("le signal doit disparaître après le ramassage")
+	}
+	if len(esm.miniMap.GetPings()) != 0 {
+		t.Errorf("%d signaux restants, attendu 0", len(esm.miniMap.GetPings()))
+	}
+}
+
+func TestMinimapAddPingUpdatesExisting(t *testing.T) {
+	mm := NewMiniMap(1280, 1280, 720)
+	mm.AddPing(1, Vector2{X: 10, Y: 10}, PingItem)
+	mm.AddPing(2, Vector2{X: 20, Y: 20}, PingBoss)
+	mm.AddPing(1, Vector2{X: 30, Y: 30}, PingPortal)
+
+	pings := mm.GetPings()
+	if len(pings) != 2 {
+		t.Fatalf("%d signaux, attendu 2", len(pings))
+	}
+	if pings[0].WorldPos != (Vector2{X: 30, Y: 30}) || pings[0].Type != PingPortal {
+		t.Errorf("signal 1 = %+v, attendu déplacé en portail", pings[0])
+	}
+
+	mm.RemovePing(1)
+	if mm.HasPing(1) || !mm.HasPing(2) {
+		t.Error("RemovePing ne doit retirer que le signal demandé")
+	}
+}
+
+func TestMinimapPulseAlpha(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    uint8
+	}{
+		{"début", 0, 255},
+		{"quart de période", 250 * time.Millisecond, 128},
+		{"demi-période", 500 * time.Millisecond, 0},
+		{"période complète", time.Second, 255},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mm := NewMiniMap(1280, 1280, 720)
+			mm.Update(tt.elapsed)
+			if got := mm.PulseAlpha(); got != tt.want {
+				t.Errorf("PulseAlpha = %d, attendu %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPingTypeColors(t *testing.T) {
+	tests := []struct {
+		pingType PingType
+		want     Color
+	}{
+		{PingItem, Color{40, 220, 230, 255}},
+		{PingPortal, Color{60, 220, 90, 255}},
+		{PingBoss, Color{230, 40, 40, 255}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.pingType.Color(); got != tt.want {
+			t.Errorf("PingType(%d).Color = %+v, attendu %+v", tt.pingType, got, tt.want)
+		}
+	}
+}
+
+func TestMinimapMarkersClampedToEdge(t *testing.T) {
+	mm := NewMiniMap(1280, 1280, 720)
+	area := mm.bounds()
+
+	tests := []struct {
+		name  string
+		world Vector2
+		want  Vector2
+	}{
+		{"centre", Vector2{X: 640, Y: 360}, Vector2{X: area.X + area.Width/2, Y: area.Y + area.Height/2}},
+		{"à gauche du monde", Vector2{X: -500, Y: 360}, Vector2{X: area.X, Y: area.Y + area.Height/2}},
+		{"à droite du monde", Vector2{X: 5000, Y: 360}, Vector2{X: area.X + area.Width, Y: area.Y + area.Height/2}},
+		{"au-dessus du monde", Vector2{X: 640, Y: -10}, Vector2{X: area.X + area.Width/2, Y: area.Y}},
+		{"coin bas droit", Vector2{X: 9999, Y: 9999}, Vector2{X: area.X + area.Width, Y: area.Y + area.Height}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mm.WorldToMap(tt.world); !vectorsEqual(got, tt.want) {
+				t.Errorf("WorldToMap(%+v) = %+v, attendu %+v", tt.world, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinimapMarkerBlinksAtOneHertz(t *testing.T) {
+	mm := NewMiniMap(1280, 1280, 720)
+	const tick = 100 * time.Millisecond
+
+	for step := 0; step < 20; step++ {
+		// Visible pendant la première demi-seconde de chaque seconde
+		want := (step/5)%2 == 0
+		if mm.MarkerVisible() != want {
+			t.Errorf("à %d ms : visible = %t, attendu %t", step*100, mm.MarkerVisible(), want)
+		}
+		mm.Update(tick)
+	}
+}
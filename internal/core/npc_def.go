@@ -0,0 +1,23 @@
+// internal/core/npc_def.go - PNJ placés par la carte
+package core
+
+import "fmt"
+
+// DefaultNPCChatter table des phrases d'un PNJ quand la carte n'en précise pas
+const DefaultNPCChatter = "npc.chatter"
+
+// NPCDef PNJ de la carte. Ses phrases sont les chaînes Chatter.1, Chatter.2...
+// de la langue courante.
+type NPCDef struct {
+	Name     string  `yaml:"name"`
+	Position Vector2 `yaml:"position"`
+	Chatter  string  `yaml:"chatter"` // Préfixe des clés de phrases (défaut npc.chatter)
+}
+
+// Validate vérifie qu'une définition est exploitable
+func (nd NPCDef) Validate() error {
+	if nd.Name == "" {
+		return fmt.Errorf("PNJ sans nom en (%.0f, %.0f)", nd.Position.X, nd.Position.Y)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// internal/core/pause_menu.go - Menu de pause
+package core
+
+// PauseMenu menu affiché en pause : reprendre, sauvegarder, options et retour
+// au menu principal. Quitter demande confirmation dans une DialogBox.
+type PauseMenu struct {
+	Title   string
+	Buttons []*Button // Reprendre, Sauvegarder, Options, Quitter
+
+	// Actions des boutons (nil : bouton sans effet)
+	OnResume   func()
+	OnSave     func()
+	OnSettings func()
+	OnQuit     func() // Appelé seulement après confirmation
+
+	confirm *DialogBox
+}
+
+// Disposition du menu de pause
+const (
+	pauseButtonWidth   = 260.0
+	pauseButtonHeight  = 36.0
+	pauseButtonSpacing = 12.0
+)
+
+// PauseMenuLabels libellés du menu de pause
+type PauseMenuLabels struct {
+	Title, Resume, Save, Settings, Quit string
+	ConfirmQuit, Yes, No                string
+}
+
+// NewPauseMenu crée le menu centré à l'écran ; scale agrandit les boutons
+func NewPauseMenu(screenWidth, screenHeight int, scale float64, labels PauseMenuLabels) *PauseMenu {
+	if scale <= 0 {
+		scale = 1
+	}
+	pm := &PauseMenu{Title: labels.Title}
+
+	width := pauseButtonWidth * scale
+	height := pauseButtonHeight * scale
+	step := height + pauseButtonSpacing
+	x := (float64(screenWidth) - width) / 2
+	y := float64(screenHeight)/2 - 2*step + pauseButtonSpacing/2
+
+	actions := []struct {
+		text    string
+		onClick func()
+	}{
+		{labels.Resume, func() { pm.call(pm.OnResume) }},
+		{labels.Save, func() { pm.call(pm.OnSave) }},
+		{labels.Settings, func() { pm.call(pm.OnSettings) }},
+		{labels.Quit, pm.AskQuit},
+	}
+	for i, action := range actions {
+		pm.Buttons = append(pm.Buttons, NewButton(x, y+float64(i)*step, width, height, action.text, action.onClick))
+	}
+	quitBtn := pm.Buttons[len(pm.Buttons)-1]
+	quitBtn.NormalColor = Color{120, 50, 50, 255} // Rouge
+	quitBtn.HoverColor = Color{150, 70, 70, 255}
+
+	pm.confirm = NewDialogBox(screenWidth, screenHeight, labels.ConfirmQuit)
+	pm.confirm.AddButton(labels.Yes, func() { pm.call(pm.OnQuit) })
+	pm.confirm.AddButton(labels.No, nil)
+	return pm
+}
+
+// call appelle une action si elle est définie
+func (pm *PauseMenu) call(action func()) {
+	if action != nil {
+		action()
+	}
+}
+
+// AskQuit affiche la confirmation du retour au menu principal
+func (pm *PauseMenu) AskQuit() {
+	pm.confirm.Show()
+}
+
+// IsConfirming retourne si la confirmation de sortie est affichée
+func (pm *PauseMenu) IsConfirming() bool {
+	return pm.confirm.IsVisible()
+}
+
+// CancelConfirm ferme la confirmation sans quitter
+func (pm *PauseMenu) CancelConfirm() {
+	pm.confirm.Hide()
+}
+
+// Update met à jour la confirmation si elle est ouverte, sinon les boutons
+func (pm *PauseMenu) Update(mousePos Vector2, mousePressed bool) {
+	if pm.confirm.IsVisible() {
+		pm.confirm.Update(mousePos, mousePressed)
+		return
+	}
+	for _, button := range pm.Buttons {
+		button.Update(mousePos, mousePressed)
+	}
+}
+
+// Render dessine le titre, les boutons puis la confirmation par-dessus
+func (pm *PauseMenu) Render(renderer Renderer) {
+	if len(pm.Buttons) > 0 {
+		first := pm.Buttons[0].Bounds
+		titleX := first.X + first.Width/2 - float64(len(pm.Title)*7)/2
+		renderer.DrawText(pm.Title, Vector2{titleX, first.Y - 30}, ColorYellow)
+	}
+	for _, button := range pm.Buttons {
+		button.Render(renderer)
+	}
+	pm.confirm.Render(renderer)
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+// click appuie puis relâche la souris au centre d'un bouton
+func click(update func(Vector2, bool), button *Button) {
+	center := Vector2{X: button.Bounds.X + button.Bounds.Width/2, Y: button.Bounds.Y + button.Bounds.Height/2}
+	update(center, true)
+	update(center, false)
+}
+
+// pauseCalls compte les actions déclenchées par le menu de pause
+type pauseCalls struct {
+	resume, save, settings, quit int
+}
+
+func newCountingPauseMenu() (*PauseMenu, *pauseCalls) {
+	calls := &pauseCalls{}
+	pm := NewPauseMenu(1280, 720, 1, PauseMenuLabels{
+		Title: "Pause", Resume: "Reprendre", Save: "Sauvegarder", Settings: "Options", Quit: "Quitter",
+		ConfirmQuit: "Continuer ?", Yes: "Oui", No: "Non",
+	})
+	pm.OnResume = func() { calls.resume++ }
+	pm.OnSave = func() { calls.save++ }
+	pm.OnSettings = func() { calls.settings++ }
+	pm.OnQuit = func() { calls.quit++ }
+	return pm, calls
+}
+
+func TestPauseMenuButtons(t *testing.T) {
+	tests := []struct {
+		name   string
+		button int
+		want   pauseCalls
+	}{
+		{"reprendre", 0, pauseCalls{resume: 1}},
+		{"sauvegarder", 1, pauseCalls{save: 1}},
+		{"options", 2, pauseCalls{settings: 1}},
+		{"quitter demande confirmation", 3, pauseCalls{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pm, calls := newCountingPauseMenu()
+			click(pm.Update, pm.Buttons[tt.button])
+
+			if *calls != tt.want {
+				t.Errorf("actions = %+v, attendu %+v", *calls, tt.want)
+			}
+			if wantConfirm := tt.button == 3; pm.IsConfirming() != wantConfirm {
+				t.Errorf("IsConfirming = %t, attendu %t", pm.IsConfirming(), wantConfirm)
+			}
+		})
+	}
+}
+
+func TestPauseMenuQuitConfirmation(t *testing.T) {
+	tests := []struct {
+		name     string
+		answer   int // 0 : Oui, 1 : Non
+		wantQuit int
+	}{
+		{"oui", 0, 1},
+		{"non", 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pm, calls := newCountingPauseMenu()
+			pm.AskQuit()
+			click(pm.Update, pm.confirm.Buttons[tt.answer])
+
+			if calls.quit != tt.wantQuit {
+				t.Errorf("OnQuit appelé %d fois, attendu %d", calls.quit, tt.wantQuit)
+			}
+			if pm.IsConfirming() {
+				t.Error("la confirmation doit se fermer après la réponse")
+			}
+		})
+	}
+}
+
+func TestPauseMenuConfirmationBlocksButtons(t *testing.T) {
+	pm, calls := newCountingPauseMenu()
+	pm.AskQuit()
+
+	// Les boutons du menu sont inactifs tant que la confirmation est affichée
+	click(pm.Update, pm.Buttons[0])
+	if calls.resume != 0 {
+		t.Error("Reprendre ne doit pas répondre pendant la confirmation")
+	}
+}
+
+func TestPauseMenuWiring(t *testing.T) {
+	newPausedManager := func() *EnhancedBuiltinStateManager {
+		esm := NewEnhancedBuiltinStateManager(1280, 720)
+		esm.ChangeState(StateGameplay)
+		esm.TogglePause()
+		if esm.GetCurrentStateType() != StatePause {
+			t.Fatalf("état = %s, attendu %s", esm.GetCurrentStateType(), StatePause)
+		}
+		return esm
+	}
+
+	t.Run("reprendre", func(t *testing.T) {
+		esm := newPausedManager()
+		esm.pauseMenu.OnResume()
+		if esm.GetCurrentStateType() != StateGameplay {
+			t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), StateGameplay)
+		}
+	})
+
+	t.Run("options puis retour", func(t *testing.T) {
+		esm := newPausedManager()
+		esm.pauseMenu.OnSettings()
+		if esm.GetCurrentStateType() != StateSettings {
+			t.Fatalf("état = %s, attendu %s", esm.GetCurrentStateType(), StateSettings)
+		}
+		esm.GoBack()
+		if esm.GetCurrentStateType() != StatePause {
+			t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), StatePause)
+		}
+	})
+
+	t.Run("sauvegarder", func(t *testing.T) {
+		esm := newPausedManager()
+		saves := 0
+		esm.SetOnSave(func() error {
+			saves++
+			return errors.New("disque plein")
+		})
+		esm.pauseMenu.OnSave()
+		if saves != 1 {
+			t.Errorf("sauvegarde appelée %d fois, attendu 1", saves)
+		}
+	})
+
+	t.Run("quitter", func(t *testing.T) {
+		esm := newPausedManager()
+		esm.pauseMenu.OnQuit()
+		if esm.GetCurrentStateType() != StateMenu {
+			t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), StateMenu)
+		}
+		if esm.GoBack() {
+			t.Error("le retour au menu doit vider la pile des états")
+		}
+	})
+
+	t.Run("échap ferme la confirmation", func(t *testing.T) {
+		esm := newPausedManager()
+		esm.pauseMenu.AskQuit()
+		esm.TogglePause()
+		if esm.pauseMenu.IsConfirming() || esm.GetCurrentStateType() != StatePause {
+			t.Error("Échap doit fermer la confirmation sans quitter la pause")
+		}
+	})
+}
@@ -0,0 +1,328 @@
+// internal/core/quest_system.go - Quêtes à objectifs et marqueurs de la mini-carte
+package core
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultQuestsFile fichier des quêtes, relatif au dossier de données
+const DefaultQuestsFile = "quests.yaml"
+
+// Types d'objectifs
+const (
+	ObjectiveLocation = "location" // Se rendre à une position
+	ObjectiveNPC      = "npc"      // Trouver un PNJ
+)
+
+// Réglages des objectifs
+const (
+	defaultObjectiveRadius = 2 * TileSize // Distance à laquelle un objectif est atteint
+	questMarkerFirstID     = 20000        // Plage d'IDs réservée aux marqueurs
+)
+
+// IconType forme d'un marqueur d'objectif sur la mini-carte
+type IconType int
+
+const (
+	IconStar   IconType = iota // Lieu à atteindre
+	IconSkull                  // Lieu dangereux (boss, embuscade)
+	IconSpeech                 // PNJ à trouver
+)
+
+// ParseIconType convertit un nom de fichier de quêtes ("star", "skull", "speech")
+func ParseIconType(name string) (IconType, bool) {
+	switch name {
+	case "star":
+		return IconStar, true
+	case "skull":
+		return IconSkull, true
+	case "speech":
+		return IconSpeech, true
+	}
+	return IconStar, false
+}
+
+// Color retourne la couleur de l'icône
+func (it IconType) Color() Color {
+	switch it {
+	case IconSkull:
+		return Color{230, 60, 60, 255} // Rouge
+	case IconSpeech:
+		return Color{240, 240, 240, 255} // Blanc
+	default:
+		return Color{255, 215, 0, 255} // Or
+	}
+}
+
+// ObjectiveMarker marqueur d'un objectif actif sur la mini-carte
+type ObjectiveMarker struct {
+	EntityID EntityID
+	Icon     IconType
+	WorldPos Vector2
+	Blinking bool
+}
+
+// QuestObjective étape d'une quête
+type QuestObjective struct {
+	Description string  `yaml:"description"`
+	Type        string  `yaml:"type"`
+	Position    Vector2 `yaml:"position"` // Objectif "location"
+	NPC         string  `yaml:"npc"`      // Objectif "npc" : nom du PNJ
+	Radius      float64 `yaml:"radius"`   // 0 : deux tuiles
+	Icon        string  `yaml:"icon"`     // Vide : étoile pour un lieu, bulle pour un PNJ
+	Blinking    bool    `yaml:"blinking"`
+}
+
+// QuestDef quête définie en YAML : ses objectifs s'enchaînent dans l'ordre
+type QuestDef struct {
+	ID         string           `yaml:"id"`
+	Name       string           `yaml:"name"`
+	Objectives []QuestObjective `yaml:"objectives"`
+}
+
+// questsFile structure du fichier YAML
+type questsFile struct {
+	Quests []QuestDef `yaml:"quests"`
+}
+
+// LoadQuests charge les quêtes depuis un fichier YAML
+func LoadQuests(path string) ([]QuestDef, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossible de lire %s: %w", path, err)
+	}
+
+	var file questsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("quêtes invalides dans %s: %w", path, err)
+	}
+
+	for _, quest := range file.Quests {
+		if err := quest.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return file.Quests, nil
+}
+
+// Validate vérifie qu'une quête est exploitable
+func (qd QuestDef) Validate() error {
+	if qd.ID == "" {
+		return fmt.Errorf("quête sans identifiant")
+	}
+	for i, objective := range qd.Objectives {
+		switch objective.Type {
+		case ObjectiveLocation:
+		case ObjectiveNPC:
+			if objective.NPC == "" {
+				return fmt.Errorf("quête '%s', objectif %d: PNJ manquant", qd.ID, i)
+			}
+		default:
+			return fmt.Errorf("quête '%s', objectif %d: type inconnu '%s'", qd.ID, i, objective.Type)
+		}
+		if _, ok := ParseIconType(objective.Icon); objective.Icon != "" && !ok {
+			return fmt.Errorf("quête '%s', objectif %d: icône inconnue '%s'", qd.ID, i, objective.Icon)
+		}
+	}
+	return nil
+}
+
+// QuestState avancement d'une quête
+type QuestState struct {
+	Def     QuestDef
+	Current int // Index de l'objectif actif ; len(Objectives) une fois terminée
+
+	markerID EntityID // 0 tant que l'objectif actif n'a pas de marqueur
+}
+
+// Completed retourne si tous les objectifs sont remplis
+func (qs *QuestState) Completed() bool {
+	return qs.Current >= len(qs.Def.Objectives)
+}
+
+// Objective retourne l'objectif actif (nil une fois la quête terminée)
+func (qs *QuestState) Objective() *QuestObjective {
+	if qs.Completed() {
+		return nil
+	}
+	return &qs.Def.Objectives[qs.Current]
+}
+
+// QuestSystem suit les quêtes : l'objectif actif de chaque quête a un marqueur,
+// retiré quand le joueur l'atteint
+type QuestSystem struct {
+	quests  []*QuestState
+	markers []ObjectiveMarker
+
+	// Position d'un PNJ par son nom (objectifs "npc")
+	LocateNPC func(name string) (Vector2, bool)
+
+	// Notifications des marqueurs (mini-carte) et des objectifs remplis
+	OnMarkerAdded        func(marker ObjectiveMarker)
+	OnMarkerRemoved      func(entityID EntityID)
+	OnObjectiveCompleted func(quest *QuestState, objective *QuestObjective)
+
+	nextMarkerID EntityID
+}
+
+// NewQuestSystem crée un système de quêtes vide
+func NewQuestSystem() *QuestSystem {
+	return &QuestSystem{nextMarkerID: questMarkerFirstID}
+}
+
+// SetQuests remplace les quêtes suivies ; elles repartent de leur premier objectif
+func (qs *QuestSystem) SetQuests(defs []QuestDef) {
+	qs.clearMarkers()
+	qs.quests = make([]*QuestState, 0, len(defs))
+	for _, def := range defs {
+		qs.quests = append(qs.quests, &QuestState{Def: def})
+	}
+}
+
+// Reset fait repartir toutes les quêtes de leur premier objectif (nouvelle partie)
+func (qs *QuestSystem) Reset() {
+	qs.clearMarkers()
+	for _, quest := range qs.quests {
+		quest.Current = 0
+	}
+}
+
+// GetQuests retourne l'état des quêtes
+func (qs *QuestSystem) GetQuests() []*QuestState {
+	return qs.quests
+}
+
+// GetQuest retourne une quête par identifiant (nil si elle n'existe pas)
+func (qs *QuestSystem) GetQuest(id string) *QuestState {
+	for _, quest := range qs.quests {
+		if quest.Def.ID == id {
+			return quest
+		}
+	}
+	return nil
+}
+
+// Markers retourne les marqueurs des objectifs actifs
+func (qs *QuestSystem) Markers() []ObjectiveMarker {
+	return qs.markers
+}
+
+// Update place ou déplace le marqueur de chaque objectif actif et remplit
+// ceux que le joueur a atteints
+func (qs *QuestSystem) Update(playerPos Vector2) {
+	for _, quest := range qs.quests {
+		objective := quest.Objective()
+		if objective == nil {
+			continue
+		}
+
+		target, ok := qs.target(objective)
+		if !ok {
+			continue
+		}
+		qs.placeMarker(quest, objective, target)
+
+		radius := objective.Radius
+		if radius <= 0 {
+			radius = defaultObjectiveRadius
+		}
+		if playerPos.Distance(target) <= radius {
+			qs.complete(quest)
+		}
+	}
+}
+
+// CompleteObjective remplit l'objectif actif d'une quête (déclencheur externe)
+func (qs *QuestSystem) CompleteObjective(questID string) bool {
+	quest := qs.GetQuest(questID)
+	if quest == nil || quest.Completed() {
+		return false
+	}
+	qs.complete(quest)
+	return true
+}
+
+// target retourne la position visée par un objectif
+func (qs *QuestSystem) target(objective *QuestObjective) (Vector2, bool) {
+	if objective.Type == ObjectiveNPC {
+		if qs.LocateNPC == nil {
+			return Vector2{}, false
+		}
+		return qs.LocateNPC(objective.NPC)
+	}
+	return objective.Position, true
+}
+
+// placeMarker enregistre le marqueur de l'objectif actif, ou le déplace
+func (qs *QuestSystem) placeMarker(quest *QuestState, objective *QuestObjective, position Vector2) {
+	if quest.markerID != 0 {
+		for i := range qs.markers {
+			if qs.markers[i].EntityID == quest.markerID && qs.markers[i].WorldPos != position {
+				qs.markers[i].WorldPos = position
+				qs.notifyAdded(qs.markers[i])
+			}
+		}
+		return
+	}
+
+	icon, ok := ParseIconType(objective.Icon)
+	if !ok && objective.Type == ObjectiveNPC {
+		icon = IconSpeech
+	}
+	marker := ObjectiveMarker{EntityID: qs.nextMarkerID, Icon: icon, WorldPos: position, Blinking: objective.Blinking}
+	qs.nextMarkerID++
+	quest.markerID = marker.EntityID
+	qs.markers = append(qs.markers, marker)
+	qs.notifyAdded(marker)
+}
+
+// complete remplit l'objectif actif : son marqueur est retiré et le suivant
+// sera placé à la prochaine mise à jour
+func (qs *QuestSystem) complete(quest *QuestState) {
+	objective := quest.Objective()
+	qs.removeMarker(quest)
+	quest.Current++
+
+	fmt.Printf("✓ Objectif rempli (%s): %s\n", quest.Def.ID, objective.Description)
+	if quest.Completed() {
+		fmt.Printf("✓ Quête terminée: %s\n", quest.Def.Name)
+	}
+	if qs.OnObjectiveCompleted != nil {
+		qs.OnObjectiveCompleted(quest, objective)
+	}
+}
+
+// removeMarker retire le marqueur de l'objectif actif d'une quête
+func (qs *QuestSystem) removeMarker(quest *QuestState) {
+	if quest.markerID == 0 {
+		return
+	}
+	for i := range qs.markers {
+		if qs.markers[i].EntityID == quest.markerID {
+			qs.markers = append(qs.markers[:i], qs.markers[i+1:]...)
+			break
+		}
+	}
+	if qs.OnMarkerRemoved != nil {
+		qs.OnMarkerRemoved(quest.markerID)
+	}
+	quest.markerID = 0
+}
+
+// clearMarkers retire tous les marqueurs
+func (qs *QuestSystem) clearMarkers() {
+	for _, quest := range qs.quests {
+		qs.removeMarker(quest)
+	}
+	qs.markers = qs.markers[:0]
+}
+
+// notifyAdded signale un marqueur nouveau ou déplacé
+func (qs *QuestSystem) notifyAdded(marker ObjectiveMarker) {
+	if qs.OnMarkerAdded != nil {
+		qs.OnMarkerAdded(marker)
+	}
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// twoStepQuest quête : aller au pont, puis parler à l'ermite
+func twoStepQuest() QuestDef {
+	return QuestDef{
+		ID:   "ermite",
+		Name: "L'ermite du pont",
+		Objectives: []QuestObjective{
+			{Description: "Aller au pont", Type: ObjectiveLocation, Position: Vector2{X: 600, Y: 200}, Icon: "skull", Blinking: true},
+			{Description: "Parler à l'ermite", Type: ObjectiveNPC, NPC: "Ermite"},
+		},
+	}
+}
+
+// questOnMinimap relie un système de quêtes à une mini-carte, comme le jeu
+func questOnMinimap() (*QuestSystem, *MiniMap) {
+	qs := NewQuestSystem()
+	mm := NewMiniMap(1280, 1280, 720)
+	qs.OnMarkerAdded = mm.AddMarker
+	qs.OnMarkerRemoved = mm.RemoveMarker
+	qs.SetQuests([]QuestDef{twoStepQuest()})
+	return qs, mm
+}
+
+func TestQuestMarkerRemovedOnCompletion(t *testing.T) {
+	qs, mm := questOnMinimap()
+	hermit := Vector2{X: 100, Y: 600}
+	qs.LocateNPC = func(name string) (Vector2, bool) { return hermit, name == "Ermite" }
+	far := Vector2{X: 1200, Y: 700}
+
+	qs.Update(far)
+	markers := mm.GetMarkers()
+	if len(markers) != 1 {
+		t.Fatalf("%d marqueurs, attendu 1", len(markers))
+	}
+	if markers[0].Icon != IconSkull || !markers[0].Blinking || markers[0].WorldPos != (Vector2{X: 600, Y: 200}) {
+		t.Errorf("marqueur = %+v, attendu un crâne clignotant au pont", markers[0])
+	}
+	first := markers[0].EntityID
+
+	// Objectif atteint : le marqueur du pont laisse place à celui de l'ermite
+	qs.Update(Vector2{X: 600, Y: 210})
+	qs.Update(far)
+	markers = mm.GetMarkers()
+	if len(markers) != 1 || markers[0].EntityID == first {
+		t.Fatalf("marqueurs = %+v, attendu le seul marqueur de l'ermite", markers)
+	}
+	if markers[0].Icon != IconSpeech || markers[0].WorldPos != hermit {
+		t.Errorf("marqueur = %+v, attendu une bulle sur l'ermite", markers[0])
+	}
+
+	// Le marqueur suit le PNJ
+	hermit = Vector2{X: 150, Y: 600}
+	qs.Update(far)
+	if mm.GetMarkers()[0].WorldPos != hermit {
+		t.Errorf("marqueur en %+v, attendu %+v", mm.GetMarkers()[0].WorldPos, hermit)
+	}
+
+	// Quête terminée : plus aucun marqueur
+	qs.Update(hermit)
+	if !qs.GetQuest("ermite").Completed() {
+		t.Fatal("la quête devrait être terminée")
+	}
+	if len(mm.GetMarkers()) != 0 || len(qs.Markers()) != 0 {
+		t.Errorf("%d marqueurs restants sur la mini-carte, attendu 0", len(mm.GetMarkers()))
+	}
+}
+
+func TestQuestCompleteObjectiveRemovesMarker(t *testing.T) {
+	qs, mm := questOnMinimap()
+	qs.Update(Vector2{})
+
+	if !qs.CompleteObjective("ermite") {
+		t.Fatal("CompleteObjective a échoué")
+	}
+	if len(mm.GetMarkers()) != 0 {
+		t.Error("le marqueur doit être retiré à la fin de l'objectif")
+	}
+	if qs.CompleteObjective("inconnue") {
+		t.Error("CompleteObjective doit échouer pour une quête inconnue")
+	}
+}
+
+func TestQuestNPCMarkerWaitsForNPC(t *testing.T) {
+	qs := NewQuestSystem()
+	qs.SetQuests([]QuestDef{{ID: "pnj", Objectives: []QuestObjective{{Type: ObjectiveNPC, NPC: "Absent"}}}})
+
+	qs.Update(Vector2{})
+	if len(qs.Markers()) != 0 {
+		t.Error("un PNJ introuvable ne doit pas avoir de marqueur")
+	}
+}
+
+func TestLoadShippedQuests(t *testing.T) {
+	quests, err := LoadQuests(filepath.Join("..", "..", "assets", "data", DefaultQuestsFile))
+	if err != nil {
+		t.Fatalf("LoadQuests: %v", err)
+	}
+	if len(quests) == 0 {
+		t.Error("aucune quête chargée")
+	}
+}
@@ -0,0 +1,100 @@
+// internal/core/ringbuffer.go - File circulaire de taille fixe
+package core
+
+// RingBuffer est une file FIFO de capacité fixe. Une fois pleine, Push
+// écrase l'élément le plus ancien. Aucune allocation après la construction
+// (sauf ToSlice qui retourne une copie).
+type RingBuffer[T any] struct {
+	data  []T
+	head  int // Index de l'élément le plus ancien
+	count int
+}
+
+// NewRingBuffer crée une file circulaire de la capacité donnée
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBuffer[T]{
+		data: make([]T, capacity),
+	}
+}
+
+// Push ajoute un élément, en écrasant le plus ancien si la file est pleine
+func (rb *RingBuffer[T]) Push(value T) {
+	tail := (rb.head + rb.count) % len(rb.data)
+	rb.data[tail] = value
+
+	if rb.count < len(rb.data) {
+		rb.count++
+	} else {
+		rb.head = (rb.head + 1) % len(rb.data)
+	}
+}
+
+// Pop retire et retourne l'élément le plus ancien
+func (rb *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if rb.count == 0 {
+		return zero, false
+	}
+
+	value := rb.data[rb.head]
+	rb.data[rb.head] = zero // Libérer la référence
+	rb.head = (rb.head + 1) % len(rb.data)
+	rb.count--
+
+	return value, true
+}
+
+// Peek retourne l'élément le plus ancien sans le retirer
+func (rb *RingBuffer[T]) Peek() (T, bool) {
+	if rb.count == 0 {
+		var zero T
+		return zero, false
+	}
+	return rb.data[rb.head], true
+}
+
+// At retourne le i-ème élément, 0 étant le plus ancien
+func (rb *RingBuffer[T]) At(i int) (T, bool) {
+	if i < 0 || i >= rb.count {
+		var zero T
+		return zero, false
+	}
+	return rb.data[(rb.head+i)%len(rb.data)], true
+}
+
+// Len retourne le nombre d'éléments
+func (rb *RingBuffer[T]) Len() int {
+	return rb.count
+}
+
+// Cap retourne la capacité de la file
+func (rb *RingBuffer[T]) Cap() int {
+	return len(rb.data)
+}
+
+// IsFull retourne si la file est pleine
+func (rb *RingBuffer[T]) IsFull() bool {
+	return rb.count == len(rb.data)
+}
+
+// Clear vide la file
+func (rb *RingBuffer[T]) Clear() {
+	var zero T
+	for i := range rb.data {
+		rb.data[i] = zero
+	}
+	rb.head = 0
+	rb.count = 0
+}
+
+// ToSlice retourne une copie des éléments, du plus ancien au plus récent
+func (rb *RingBuffer[T]) ToSlice() []T {
+	result := make([]T, rb.count)
+	for i := 0; i < rb.count; i++ {
+		result[i] = rb.data[(rb.head+i)%len(rb.data)]
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRingBufferPopEmpty(t *testing.T) {
+	rb := NewRingBuffer[int](3)
+
+	if value, ok := rb.Pop(); ok || value != 0 {
+		t.Errorf("Pop sur une file vide = (%d, %t), attendu (0, false)", value, ok)
+	}
+	if value, ok := rb.Peek(); ok || value != 0 {
+		t.Errorf("Peek sur une file vide = (%d, %t), attendu (0, false)", value, ok)
+	}
+
+	rb.Push(1)
+	rb.Pop()
+	if _, ok := rb.Pop(); ok {
+		t.Error("Pop après avoir vidé la file doit échouer")
+	}
+}
+
+func TestRingBufferWrapAround(t *testing.T) {
+	tests := []struct {
+		name   string
+		pushes []int
+		pops   int
+		want   []int
+	}{
+		{"partielle", []int{1, 2}, 0, []int{1, 2}},
+		{"pleine", []int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{"écrase le plus ancien", []int{1, 2, 3, 4}, 0, []int{2, 3, 4}},
+		{"plusieurs tours", []int{1, 2, 3, 4, 5, 6, 7}, 0, []int{5, 6, 7}},
+		{"pop puis push après le bord", []int{1, 2, 3, 4}, 2, []int{4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := NewRingBuffer[int](3)
+			for _, value := range tt.pushes {
+				rb.Push(value)
+			}
+			for i := 0; i < tt.pops; i++ {
+				rb.Pop()
+			}
+
+			if got := rb.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice = %v, attendu %v", got, tt.want)
+			}
+			if rb.Len() != len(tt.want) {
+				t.Errorf("Len = %d, attendu %d", rb.Len(), len(tt.want))
+			}
+			if rb.Cap() != 3 {
+				t.Errorf("Cap = %d, attendu 3", rb.Cap())
+			}
+		})
+	}
+}
+
+func TestRingBufferPopOrderAfterWrap(t *testing.T) {
+	rb := NewRingBuffer[string](2)
+	rb.Push("a")
+	rb.Push("b")
+	rb.Push("c")
+
+	if !rb.IsFull() {
+		t.Error("la file doit être pleine")
+	}
+	for _, want := range []string{"b", "c"} {
+		if got, ok := rb.Pop(); !ok || got != want {
+			t.Errorf("Pop = (%q, %t), attendu (%q, true)", got, ok, want)
+		}
+	}
+	if rb.Len() != 0 {
+		t.Errorf("Len = %d après avoir tout retiré", rb.Len())
+	}
+}
+
+func TestRingBufferToSliceIsCopy(t *testing.T) {
+	rb := NewRingBuffer[int](3)
+	rb.Push(1)
+	rb.Push(2)
+
+	snapshot := rb.ToSlice()
+	snapshot[0] = 99
+	if value, _ := rb.At(0); value != 1 {
+		t.Errorf("modifier la copie a changé la file : At(0) = %d", value)
+	}
+}
+
+func TestRingBufferAtAndClear(t *testing.T) {
+	rb := NewRingBuffer[int](3)
+	for _, value := range []int{1, 2, 3, 4} {
+		rb.Push(value)
+	}
+
+	for i, want := range []int{2, 3, 4} {
+		if got, ok := rb.At(i); !ok || got != want {
+			t.Errorf("At(%d) = (%d, %t), attendu (%d, true)", i, got, ok, want)
+		}
+	}
+	if _, ok := rb.At(3); ok {
+		t.Error("At hors limites doit échouer")
+	}
+
+	rb.Clear()
+	if rb.Len() != 0 || len(rb.ToSlice()) != 0 {
+		t.Error("Clear doit vider la file")
+	}
+}
+
+func TestRingBufferPushDoesNotAllocate(t *testing.T) {
+	rb := NewRingBuffer[int](8)
+	allocs := testing.AllocsPerRun(100, func() {
+		rb.Push(1)
+		rb.Pop()
+	})
+	if allocs != 0 {
+		t.Errorf("%.1f allocation(s) par Push/Pop, attendu 0", allocs)
+	}
+}
@@ -0,0 +1,192 @@
+// internal/core/room_graph.go - Plan du donjon : salles et passages, pour la vue d'ensemble de la mini-carte
+package core
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RoomType nature d'une salle
+type RoomType string
+
+const (
+	RoomCombat   RoomType = "combat"
+	RoomTreasure RoomType = "treasure"
+	RoomBoss     RoomType = "boss"
+	RoomSafe     RoomType = "safe" // Feu de camp, aucun ennemi
+)
+
+// Color retourne la couleur de la salle sur la vue d'ensemble (alpha plein)
+func (rt RoomType) Color() Color {
+	switch rt {
+	case RoomCombat:
+		return Color{200, 120, 60, 255} // Orange
+	case RoomTreasure:
+		return Color{230, 200, 50, 255} // Or
+	case RoomBoss:
+		return Color{210, 40, 40, 255} // Rouge
+	default:
+		return Color{90, 170, 230, 255} // Bleu
+	}
+}
+
+// Points cardinaux des passages entre salles (Y vers le bas, comme l'écran)
+const (
+	CompassNorth = "N"
+	CompassSouth = "S"
+	CompassEast  = "E"
+	CompassWest  = "W"
+)
+
+// Compass retourne le point cardinal d'une direction (N/S/E/W) ; "" pour une
+// diagonale ou l'absence de direction
+func (d Direction) Compass() string {
+	switch d {
+	case DirectionUp:
+		return CompassNorth
+	case DirectionDown:
+		return CompassSouth
+	case DirectionRight:
+		return CompassEast
+	case DirectionLeft:
+		return CompassWest
+	}
+	return ""
+}
+
+// ParseCompass retourne la direction d'un point cardinal (N/S/E/W)
+func ParseCompass(compass string) (Direction, bool) {
+	switch compass {
+	case CompassNorth:
+		return DirectionUp, true
+	case CompassSouth:
+		return DirectionDown, true
+	case CompassEast:
+		return DirectionRight, true
+	case CompassWest:
+		return DirectionLeft, true
+	}
+	return DirectionNone, false
+}
+
+// RoomNode salle du plan, placée sur la grille de la mini-carte
+type RoomNode struct {
+	ID      string
+	GridX   int
+	GridY   int
+	Type    RoomType
+	Visited bool
+}
+
+// RoomEdge passage de From vers To, dans la direction Direction vu de From
+// (DirectionUp, DirectionDown, DirectionLeft ou DirectionRight)
+type RoomEdge struct {
+	From      string
+	To        string
+	Direction Direction
+}
+
+// RoomGraph plan du donjon ; Current est la salle où se trouve le joueur
+type RoomGraph struct {
+	Nodes   map[string]*RoomNode
+	Edges   []RoomEdge
+	Current string
+}
+
+// NewRoomGraph crée un plan vide
+func NewRoomGraph() *RoomGraph {
+	return &RoomGraph{Nodes: make(map[string]*RoomNode)}
+}
+
+// AddNode ajoute une salle ; erreur si l'identifiant est vide ou déjà pris
+func (rg *RoomGraph) AddNode(node RoomNode) error {
+	if node.ID == "" {
+		return fmt.Errorf("salle sans identifiant")
+	}
+	if _, exists := rg.Nodes[node.ID]; exists {
+		return fmt.Errorf("salle %s déjà présente dans le plan", node.ID)
+	}
+	rg.Nodes[node.ID] = &node
+	return nil
+}
+
+// AddEdge relie deux salles existantes ; erreur si l'une d'elles est inconnue
+func (rg *RoomGraph) AddEdge(from, to string, direction Direction) error {
+	if _, exists := rg.Nodes[from]; !exists {
+		return fmt.Errorf("passage depuis une salle inconnue: %s", from)
+	}
+	if _, exists := rg.Nodes[to]; !exists {
+		return fmt.Errorf("passage vers une salle inconnue: %s", to)
+	}
+	if direction.Compass() == "" {
+		return fmt.Errorf("direction de passage invalide: %s", direction)
+	}
+	rg.Edges = append(rg.Edges, RoomEdge{From: from, To: to, Direction: direction})
+	return nil
+}
+
+// Node retourne une salle du plan
+func (rg *RoomGraph) Node(id string) (*RoomNode, bool) {
+	node, exists := rg.Nodes[id]
+	return node, exists
+}
+
+// Visit place le joueur dans une salle et la marque comme visitée ;
+// retourne false si la salle est inconnue
+func (rg *RoomGraph) Visit(id string) bool {
+	node, exists := rg.Nodes[id]
+	if !exists {
+		return false
+	}
+	node.Visited = true
+	rg.Current = id
+	return true
+}
+
+// Neighbors retourne les salles reliées à id, dans les deux sens
+func (rg *RoomGraph) Neighbors(id string) []string {
+	var neighbors []string
+	for _, edge := range rg.Edges {
+		switch id {
+		case edge.From:
+			neighbors = append(neighbors, edge.To)
+		case edge.To:
+			neighbors = append(neighbors, edge.From)
+		}
+	}
+	return neighbors
+}
+
+// SortedNodes retourne les salles triées par identifiant (ordre stable pour
+// la sauvegarde et l'affichage)
+func (rg *RoomGraph) SortedNodes() []*RoomNode {
+	nodes := make([]*RoomNode, 0, len(rg.Nodes))
+	for _, node := range rg.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// GridBounds retourne l'étendue du plan sur la grille (false : plan vide)
+func (rg *RoomGraph) GridBounds() (minX, minY, maxX, maxY int, ok bool) {
+	for _, node := range rg.Nodes {
+		if !ok {
+			minX, minY, maxX, maxY, ok = node.GridX, node.GridY, node.GridX, node.GridY, true
+			continue
+		}
+		if node.GridX < minX {
+			minX = node.GridX
+		}
+		if node.GridY < minY {
+			minY = node.GridY
+		}
+		if node.GridX > maxX {
+			maxX = node.GridX
+		}
+		if node.GridY > maxY {
+			maxY = node.GridY
+		}
+	}
+	return minX, minY, maxX, maxY, ok
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+// dungeonGraph plan de test : entrée au centre, crypte au nord, boss à l'est
+func dungeonGraph(t *testing.T) *RoomGraph {
+	t.Helper()
+	graph := NewRoomGraph()
+	for _, node := range []RoomNode{
+		{ID: "entree", GridX: 0, GridY: 0, Type: RoomSafe},
+		{ID: "crypte", GridX: 0, GridY: -1, Type: RoomCombat},
+		{ID: "boss", GridX: 1, GridY: 0, Type: RoomBoss},
+	} {
+		if err := graph.AddNode(node); err != nil {
+			t.Fatalf("AddNode(%s): %v", node.ID, err)
+		}
+	}
+	if err := graph.AddEdge("entree", "crypte", DirectionUp); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+	if err := graph.AddEdge("entree", "boss", DirectionRight); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+	return graph
+}
+
+func TestRoomGraphAddEdgeErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  string
+		direction Direction
+	}{
+		{"départ inconnu", "grenier", "entree", DirectionDown},
+		{"arrivée inconnue", "entree", "grenier", DirectionLeft},
+		{"deux salles inconnues", "grenier", "cave", DirectionUp},
+		{"diagonale", "crypte", "boss", DirectionUpRight},
+		{"sans direction", "crypte", "boss", DirectionNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := dungeonGraph(t)
+			if err := graph.AddEdge(tt.from, tt.to, tt.direction); err == nil {
+				t.Error("AddEdge doit échouer")
+			}
+			if len(graph.Edges) != 2 {
+				t.Errorf("passages = %d, attendu 2 (aucun ajout)", len(graph.Edges))
+			}
+		})
+	}
+}
+
+func TestRoomGraphAddNodeErrors(t *testing.T) {
+	graph := dungeonGraph(t)
+	if err := graph.AddNode(RoomNode{ID: "crypte"}); err == nil {
+		t.Error("une salle en double doit être une erreur")
+	}
+	if err := graph.AddNode(RoomNode{}); err == nil {
+		t.Error("une salle sans identifiant doit être une erreur")
+	}
+}
+
+func TestRoomGraphVisit(t *testing.T) {
+	graph := dungeonGraph(t)
+
+	if graph.Visit("grenier") {
+		t.Error("Visit d'une salle inconnue doit échouer")
+	}
+	if !graph.Visit("crypte") {
+		t.Fatal("Visit(crypte) a échoué")
+	}
+	if node, _ := graph.Node("crypte"); !node.Visited || graph.Current != "crypte" {
+		t.Errorf("crypte visitée = %t, salle courante = %s", node.Visited, graph.Current)
+	}
+
+	// Les passages se parcourent dans les deux sens
+	if neighbors := graph.Neighbors("crypte"); !slices.Equal(neighbors, []string{"entree"}) {
+		t.Errorf("voisins de la crypte = %v, attendu [entree]", neighbors)
+	}
+	if neighbors := graph.Neighbors("entree"); !slices.Equal(neighbors, []string{"crypte", "boss"}) {
+		t.Errorf("voisins de l'entrée = %v, attendu [crypte boss]", neighbors)
+	}
+}
+
+func TestCompassRoundTrip(t *testing.T) {
+	for _, direction := range []Direction{DirectionUp, DirectionDown, DirectionLeft, DirectionRight} {
+		parsed, ok := ParseCompass(direction.Compass())
+		if !ok || parsed != direction {
+			t.Errorf("ParseCompass(%q) = %s, %t, attendu %s", direction.Compass(), parsed, ok, direction)
+		}
+	}
+	if _, ok := ParseCompass("NE"); ok {
+		t.Error("NE n'est pas un point cardinal de passage")
+	}
+}
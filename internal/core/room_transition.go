@@ -0,0 +1,101 @@
+// internal/core/room_transition.go - Fondu au noir du passage d'un portail
+package core
+
+import (
+	"time"
+)
+
+// Phases du passage d'un portail
+const (
+	roomTransitionIdle = iota
+	roomTransitionFadeOut
+	roomTransitionFadeIn
+)
+
+// RoomTransition assombrit l'écran pendant le passage d'un portail. Une fois
+// noir, l'écran le reste tant que la salle de destination n'est pas
+// préchargée : le fondu se prolonge jusqu'à ce qu'elle le soit.
+type RoomTransition struct {
+	FadeDuration time.Duration // Durée de chaque fondu (noir, puis retour)
+
+	Destination string // Salle vers laquelle le joueur passe
+
+	phase   int
+	elapsed time.Duration
+}
+
+// NewRoomTransition crée un fondu de passage de portail
+func NewRoomTransition() *RoomTransition {
+	return &RoomTransition{
+		FadeDuration: 400 * time.Millisecond,
+	}
+}
+
+// Start lance le fondu au noir vers une salle
+func (rt *RoomTransition) Start(destination string) {
+	rt.Destination = destination
+	rt.phase = roomTransitionFadeOut
+	rt.elapsed = 0
+}
+
+// Update fait avancer le fondu ; ready indique si la salle de destination est
+// préchargée. Retourne true à la frame où la salle doit basculer.
+func (rt *RoomTransition) Update(deltaTime time.Duration, ready bool) bool {
+	switch rt.phase {
+	case roomTransitionFadeOut:
+		rt.elapsed += deltaTime
+		if rt.elapsed < rt.FadeDuration || !ready {
+			return false
+		}
+		rt.phase = roomTransitionFadeIn
+		rt.elapsed = 0
+		return true
+
+	case roomTransitionFadeIn:
+		rt.elapsed += deltaTime
+		if rt.elapsed >= rt.FadeDuration {
+			rt.phase = roomTransitionIdle
+		}
+	}
+	return false
+}
+
+// IsActive retourne si un passage est en cours
+func (rt *RoomTransition) IsActive() bool {
+	return rt.phase != roomTransitionIdle
+}
+
+// IsWaiting retourne si l'écran est noir en attendant le préchargement
+func (rt *RoomTransition) IsWaiting() bool {
+	return rt.phase == roomTransitionFadeOut && rt.elapsed >= rt.FadeDuration
+}
+
+// Alpha retourne l'opacité du voile noir (0 à 1)
+func (rt *RoomTransition) Alpha() float64 {
+	if rt.FadeDuration <= 0 {
+		if rt.phase == roomTransitionFadeOut {
+			return 1
+		}
+		return 0
+	}
+
+	progress := Clamp(float64(rt.elapsed)/float64(rt.FadeDuration), 0, 1)
+	switch rt.phase {
+	case roomTransitionFadeOut:
+		return progress
+	case roomTransitionFadeIn:
+		return 1 - progress
+	default:
+		return 0
+	}
+}
+
+// Render dessine le voile noir par-dessus l'écran
+func (rt *RoomTransition) Render(renderer Renderer, screenWidth, screenHeight int) {
+	alpha := rt.Alpha()
+	if alpha <= 0 {
+		return
+	}
+	screen := Rectangle{X: 0, Y: 0, Width: float64(screenWidth), Height: float64(screenHeight)}
+	renderer.DrawRectangle(screen, Color{0, 0, 0, uint8(alpha * 255)}, true)
+}
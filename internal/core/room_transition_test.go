@@ -0,0 +1,120 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+func TestRoomTransitionWaitsForPreload(t *testing.T) {
+	const frame = 100 * time.Millisecond
+
+	tests := []struct {
+		name        string
+		readyAfter  time.Duration // Fin du préchargement depuis le début du fondu
+		wantSwitch  time.Duration
+		wantWaiting bool // Écran noir en attente avant la bascule
+	}{
+		{"préchargée dès le départ", 0, 400 * time.Millisecond, false},
+		{"préchargée pendant le fondu", 200 * time.Millisecond, 400 * time.Millisecond, false},
+		{"préchargée après le fondu", time.Second, time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := NewRoomTransition()
+			rt.Start("ruines")
+
+			var elapsed, switchedAt time.Duration
+			waited := false
+			for elapsed < 2*time.Second && switchedAt == 0 {
+				elapsed += frame
+				if rt.Update(frame, elapsed >= tt.readyAfter) {
+					switchedAt = elapsed
+				}
+				waited = waited || rt.IsWaiting()
+				if switchedAt == 0 && rt.IsWaiting() && rt.Alpha() != 1 {
+					t.Errorf("voile à %.2f pendant l'attente, attendu 1", rt.Alpha())
+				}
+			}
+
+			if switchedAt != tt.wantSwitch {
+				t.Errorf("bascule à %v, attendu %v", switchedAt, tt.wantSwitch)
+			}
+			if waited != tt.wantWaiting {
+				t.Errorf("attente = %t, attendu %t", waited, tt.wantWaiting)
+			}
+
+			// Retour du voile après la bascule
+			for i := 0; i < 4; i++ {
+				rt.Update(frame, true)
+			}
+			if rt.IsActive() || rt.Alpha() != 0 {
+				t.Errorf("après le retour : actif = %t, voile = %.2f", rt.IsActive(), rt.Alpha())
+			}
+		})
+	}
+}
+
+// playerOnPortal lance une partie et place le joueur sur un portail menant à
+// une salle dont le préchargement est piloté par ready
+func playerOnPortal(t *testing.T, ready *bool) (*EnhancedBuiltinStateManager, components.Vector2) {
+	t.Helper()
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	esm.RoomReady = func(roomID string) bool { return roomID == "ruines" && *ready }
+	esm.startNewGame()
+
+	arrival := components.Vector2{X: 120, Y: 660}
+	portal := esm.itemSystem.SpawnPortal("Portail", 900, 500)
+	portal.Destination = "ruines"
+	portal.Arrival = arrival
+
+	player := esm.playerSystem.GetPlayer()
+	player.Player.GodMode = true
+	player.Position.Position = portal.Position
+	return esm, arrival
+}
+
+func TestPortalSwitchesInstantlyWhenPreloaded(t *testing.T) {
+	ready := true
+	esm, arrival := playerOnPortal(t, &ready)
+
+	esm.Update(time.Second / 60)
+	if esm.roomTransition.IsActive() {
+		t.Error("une salle préchargée ne doit pas lancer de fondu")
+	}
+	if position := esm.playerSystem.GetPlayerPosition(); position.Distance(arrival) > 5 {
+		t.Errorf("joueur en %+v, attendu au point d'arrivée %+v", position, arrival)
+	}
+}
+
+func TestPortalFadeHoldsUntilPreloaded(t *testing.T) {
+	ready := false
+	esm, arrival := playerOnPortal(t, &ready)
+	start := esm.playerSystem.GetPlayerPosition()
+
+	// Deux secondes sans préchargement : l'écran reste noir, le joueur ne passe pas
+	for i := 0; i < 120; i++ {
+		esm.Update(time.Second / 60)
+	}
+	if !esm.roomTransition.IsWaiting() {
+		t.Fatal("le fondu doit attendre la fin du préchargement")
+	}
+	if position := esm.playerSystem.GetPlayerPosition(); position != start {
+		t.Errorf("joueur déplacé en %+v pendant l'attente", position)
+	}
+
+	ready = true
+	esm.Update(time.Second / 60)
+	if position := esm.playerSystem.GetPlayerPosition(); position != arrival {
+		t.Errorf("joueur en %+v, attendu au point d'arrivée %+v", position, arrival)
+	}
+
+	for i := 0; i < 60; i++ {
+		esm.Update(time.Second / 60)
+	}
+	if esm.roomTransition.IsActive() {
+		t.Error("le fondu doit se terminer après la bascule")
+	}
+}
@@ -0,0 +1,45 @@
+// internal/core/rope_def.go - Cordes et ponts placés par la carte
+package core
+
+import "fmt"
+
+// Types de cordes d'une carte
+const (
+	RopeKindChain  = "chain"
+	RopeKindVine   = "vine"
+	RopeKindBridge = "bridge"
+)
+
+// DefaultRopeSegments segments d'une corde quand la carte n'en précise pas
+const DefaultRopeSegments = 12
+
+// RopeDef corde suspendue (chaîne, liane) ou pont tendu entre deux points
+type RopeDef struct {
+	Kind     string  `yaml:"kind"` // chain, vine ou bridge
+	Start    Vector2 `yaml:"start"`
+	End      Vector2 `yaml:"end"`
+	Segments int     `yaml:"segments"`
+	Attached bool    `yaml:"attached"` // Chaînes et lianes : l'extrémité reste fixée sur End
+	Width    float64 `yaml:"width"`    // Ponts : écart entre les deux cordes
+	Slack    float64 `yaml:"slack"`    // Longueur de corde / distance start-end (1.2 : 20 % de mou)
+}
+
+// Validate vérifie qu'une définition est exploitable
+func (rd RopeDef) Validate() error {
+	switch rd.Kind {
+	case RopeKindChain, RopeKindVine:
+	case RopeKindBridge:
+		if rd.Width <= 0 {
+			return fmt.Errorf("pont sans largeur en (%.0f, %.0f)", rd.Start.X, rd.Start.Y)
+		}
+	default:
+		return fmt.Errorf("type de corde inconnu: %q", rd.Kind)
+	}
+	if rd.Slack < 0 {
+		return fmt.Errorf("%s avec un mou négatif en (%.0f, %.0f)", rd.Kind, rd.Start.X, rd.Start.Y)
+	}
+	if rd.Start == rd.End {
+		return fmt.Errorf("%s de longueur nulle en (%.0f, %.0f)", rd.Kind, rd.Start.X, rd.Start.Y)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+// internal/core/skill_tree_panel.go - Panneau de l'arbre de compétences
+package core
+
+import (
+	"fmt"
+	"math"
+	"zelda-souls-game/internal/localization"
+	"zelda-souls-game/internal/skilltree"
+)
+
+// SkillTreePanel affiche l'arbre en graphe : nœuds placés par une disposition
+// à forces, reliés à leurs prérequis. Vert : débloqué ; normal : débloquable ;
+// grisé : prérequis ou points manquants. Ouvert, il fige le jeu.
+type SkillTreePanel struct {
+	tree      *skilltree.SkillTree
+	localizer *localization.Localizer
+
+	buttons   []*Button // Un bouton par nœud, dans l'ordre de l'arbre
+	positions map[string]Vector2
+	hovered   *skilltree.SkillNode
+
+	// Appelé au clic sur un nœud débloquable
+	OnUnlock func(nodeID string)
+
+	screenWidth  int
+	screenHeight int
+	visible      bool
+}
+
+// Disposition du panneau
+const (
+	skillPanelMargin     = 60.0
+	skillNodeWidth       = 130.0
+	skillNodeHeight      = 30.0
+	skillLayoutSteps     = 300
+	skillLayoutEdgeScale = 0.9 // Longueur idéale d'une arête, relative à l'espacement moyen
+)
+
+// Couleurs des nœuds débloqués
+var (
+	skillUnlockedColor = Color{50, 140, 60, 255}
+	skillUnlockedHover = Color{70, 170, 80, 255}
+)
+
+// NewSkillTreePanel crée un panneau masqué et calcule la disposition du graphe
+func NewSkillTreePanel(screenWidth, screenHeight int, tree *skilltree.SkillTree, localizer *localization.Localizer) *SkillTreePanel {
+	panel := &SkillTreePanel{
+		tree:         tree,
+		localizer:    localizer,
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+	}
+
+	area := panel.graphArea()
+	panel.positions = layoutSkillGraph(tree.GetNodes(), area)
+	for _, node := range tree.GetNodes() {
+		id := node.ID
+		center := panel.positions[id]
+		panel.buttons = append(panel.buttons, NewButton(center.X-skillNodeWidth/2, center.Y-skillNodeHeight/2,
+			skillNodeWidth, skillNodeHeight, node.Name, func() {
+				if panel.OnUnlock != nil {
+					panel.OnUnlock(id)
+				}
+			}))
+	}
+	return panel
+}
+
+// bounds zone du panneau
+func (sp *SkillTreePanel) bounds() Rectangle {
+	return Rectangle{
+		X:      skillPanelMargin / 2,
+		Y:      skillPanelMargin / 2,
+		Width:  float64(sp.screenWidth) - skillPanelMargin,
+		Height: float64(sp.screenHeight) - skillPanelMargin,
+	}
+}
+
+// graphArea zone où les centres des nœuds sont placés (sous le titre, au-dessus
+// de la description)
+func (sp *SkillTreePanel) graphArea() Rectangle {
+	bounds := sp.bounds()
+	return Rectangle{
+		X:      bounds.X + skillNodeWidth/2 + 10,
+		Y:      bounds.Y + 50 + skillNodeHeight/2,
+		Width:  bounds.Width - skillNodeWidth - 20,
+		Height: bounds.Height - 110 - skillNodeHeight,
+	}
+}
+
+// Toggle ouvre ou ferme le panneau
+func (sp *SkillTreePanel) Toggle() {
+	sp.visible = !sp.visible
+}
+
+// IsVisible retourne si le panneau est ouvert
+func (sp *SkillTreePanel) IsVisible() bool {
+	return sp.visible
+}
+
+// Update colore les nœuds selon leur état et met à jour les boutons
+func (sp *SkillTreePanel) Update(availablePoints int, mousePos Vector2, mousePressed bool) {
+	if !sp.visible {
+		return
+	}
+
+	sp.hovered = nil
+	for i, node := range sp.tree.GetNodes() {
+		button := sp.buttons[i]
+		if sp.tree.IsUnlocked(node.ID) {
+			button.NormalColor = skillUnlockedColor
+			button.HoverColor = skillUnlockedHover
+			button.SetEnabled(true)
+		} else {
+			button.SetEnabled(sp.tree.CanUnlock(node.ID, availablePoints) == nil)
+		}
+		button.Update(mousePos, mousePressed)
+
+		if button.Bounds.Contains(mousePos) {
+			sp.hovered = node
+		}
+	}
+}
+
+// Render dessine le panneau, les arêtes vers les prérequis, les nœuds et la
+// description du nœud survolé
+func (sp *SkillTreePanel) Render(renderer Renderer, availablePoints int) {
+	if !sp.visible {
+		return
+	}
+
+	bounds := sp.bounds()
+	renderer.DrawRectangle(bounds, Color{20, 22, 28, 240}, true)
+	renderer.DrawRectangle(bounds, Color{200, 200, 200, 255}, false)
+	renderer.DrawText(sp.localizer.Get("ui.skills.title"), Vector2{bounds.X + 16, bounds.Y + 24}, ColorYellow)
+	renderer.DrawText(sp.localizer.Get("ui.skills.points", availablePoints),
+		Vector2{bounds.X + bounds.Width - 200, bounds.Y + 24}, ColorWhite)
+
+	for _, node := range sp.tree.GetNodes() {
+		for _, prerequisite := range node.Prerequisites {
+			color := Color{110, 110, 120, 255}
+			if sp.tree.IsUnlocked(prerequisite) {
+				color = skillUnlockedColor
+			}
+			drawSkillEdge(renderer, sp.positions[prerequisite], sp.positions[node.ID], color)
+		}
+	}
+
+	for _, button := range sp.buttons {
+		button.Render(renderer)
+	}
+
+	if node := sp.hovered; node != nil {
+		y := bounds.Y + bounds.Height - 40
+		renderer.DrawText(fmt.Sprintf("%s - %s", node.Name, sp.localizer.Get("ui.skills.cost", node.Cost)),
+			Vector2{bounds.X + 16, y}, ColorYellow)
+		renderer.DrawText(node.Description, Vector2{bounds.X + 16, y + 18}, ColorWhite)
+	}
+}
+
+// drawSkillEdge relie deux nœuds : trait si le renderer sait dessiner des
+// lignes, sinon pointillés de petits carrés
+func drawSkillEdge(renderer Renderer, from, to Vector2, color Color) {
+	if lineRenderer, ok := renderer.(interface {
+		DrawLine(start, end Vector2, color Color, thickness float32)
+	}); ok {
+		lineRenderer.DrawLine(from, to, color, 2)
+		return
+	}
+
+	distance := math.Hypot(to.X-from.X, to.Y-from.Y)
+	for d := 0.0; d < distance; d += 6 {
+		t := d / distance
+		renderer.DrawRectangle(Rectangle{X: from.X + (to.X-from.X)*t - 1, Y: from.Y + (to.Y-from.Y)*t - 1, Width: 2, Height: 2}, color, true)
+	}
+}
+
+// layoutSkillGraph place les nœuds dans area par une disposition à forces
+// (Fruchterman-Reingold) : les nœuds se repoussent, les arêtes vers les
+// prérequis les rapprochent. Départ sur un cercle pour un résultat stable ;
+// le graphe obtenu est ensuite étiré sur la zone.
+func layoutSkillGraph(nodes []*skilltree.SkillNode, area Rectangle) map[string]Vector2 {
+	positions := make(map[string]Vector2, len(nodes))
+	if len(nodes) == 0 {
+		return positions
+	}
+
+	center := Vector2{X: area.X + area.Width/2, Y: area.Y + area.Height/2}
+	radius := math.Min(area.Width, area.Height) / 2
+	for i, node := range nodes {
+		angle := 2 * math.Pi * float64(i) / float64(len(nodes))
+		positions[node.ID] = Vector2{X: center.X + radius*math.Cos(angle), Y: center.Y + radius*math.Sin(angle)}
+	}
+	if len(nodes) == 1 {
+		positions[nodes[0].ID] = center
+		return positions
+	}
+
+	// Distance idéale entre deux nœuds
+	k := skillLayoutEdgeScale * math.Sqrt(area.Width*area.Height/float64(len(nodes)))
+	temperature := area.Width / 10
+
+	for step := 0; step < skillLayoutSteps; step++ {
+		forces := make(map[string]Vector2, len(nodes))
+
+		// Répulsion entre toutes les paires
+		for i, a := range nodes {
+			for _, b := range nodes[i+1:] {
+				delta := Vector2{X: positions[a.ID].X - positions[b.ID].X, Y: positions[a.ID].Y - positions[b.ID].Y}
+				distance := math.Max(math.Hypot(delta.X, delta.Y), 0.01)
+				push := k * k / distance
+				fa, fb := forces[a.ID], forces[b.ID]
+				forces[a.ID] = Vector2{X: fa.X + delta.X/distance*push, Y: fa.Y + delta.Y/distance*push}
+				forces[b.ID] = Vector2{X: fb.X - delta.X/distance*push, Y: fb.Y - delta.Y/distance*push}
+			}
+		}
+
+		// Attraction le long des arêtes
+		for _, node := range nodes {
+			for _, prerequisite := range node.Prerequisites {
+				delta := Vector2{X: positions[node.ID].X - positions[prerequisite].X, Y: positions[node.ID].Y - positions[prerequisite].Y}
+				distance := math.Max(math.Hypot(delta.X, delta.Y), 0.01)
+				pull := distance * distance / k
+				fn, fp := forces[node.ID], forces[prerequisite]
+				forces[node.ID] = Vector2{X: fn.X - delta.X/distance*pull, Y: fn.Y - delta.Y/distance*pull}
+				forces[prerequisite] = Vector2{X: fp.X + delta.X/distance*pull, Y: fp.Y + delta.Y/distance*pull}
+			}
+		}
+
+		// Déplacement limité par la température
+		for _, node := range nodes {
+			force := forces[node.ID]
+			length := math.Hypot(force.X, force.Y)
+			if length == 0 {
+				continue
+			}
+			move := math.Min(length, temperature)
+			position := positions[node.ID]
+			positions[node.ID] = Vector2{X: position.X + force.X/length*move, Y: position.Y + force.Y/length*move}
+		}
+		temperature *= 0.98
+	}
+
+	fitToArea(positions, area)
+	return positions
+}
+
+// fitToArea étire le graphe pour qu'il occupe toute la zone, chaque axe
+// séparément (les nœuds sont plus larges que hauts)
+func fitToArea(positions map[string]Vector2, area Rectangle) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, position := range positions {
+		minX, maxX = math.Min(minX, position.X), math.Max(maxX, position.X)
+		minY, maxY = math.Min(minY, position.Y), math.Max(maxY, position.Y)
+	}
+
+	scale := func(value, low, high, start, size float64) float64 {
+		if high-low < 1e-6 {
+			return start + size/2
+		}
+		return start + (value-low)/(high-low)*size
+	}
+	for id, position := range positions {
+		positions[id] = Vector2{
+			X: scale(position.X, minX, maxX, area.X, area.Width),
+			Y: scale(position.Y, minY, maxY, area.Y, area.Height),
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// internal/core/spatial_grid.go - Grille spatiale uniforme pour la broad-phase des collisions
+package core
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultSpatialCellSize taille par défaut d'une cellule (pixels)
+const DefaultSpatialCellSize = 64.0
+
+// SpatialGrid répartit les entités par cellule pour ne tester que les voisines.
+// Une entité couvrant plusieurs cellules est référencée dans chacune d'elles.
+type SpatialGrid struct {
+	cellSize float64
+	cells    map[ChunkCoord]map[EntityID]struct{}
+	bounds   map[EntityID]Rectangle
+}
+
+// NewSpatialGrid crée une grille spatiale ; cellSize <= 0 utilise la taille par défaut
+func NewSpatialGrid(cellSize float64) *SpatialGrid {
+	if cellSize <= 0 {
+		cellSize = DefaultSpatialCellSize
+	}
+	return &SpatialGrid{
+		cellSize: cellSize,
+		cells:    make(map[ChunkCoord]map[EntityID]struct{}),
+		bounds:   make(map[EntityID]Rectangle),
+	}
+}
+
+// CellSize retourne la taille d'une cellule
+func (sg *SpatialGrid) CellSize() float64 {
+	return sg.cellSize
+}
+
+// Len retourne le nombre d'entités indexées
+func (sg *SpatialGrid) Len() int {
+	return len(sg.bounds)
+}
+
+// Insert indexe une entité ; si elle est déjà présente, sa position est mise à jour
+func (sg *SpatialGrid) Insert(id EntityID, bounds Rectangle) {
+	if _, exists := sg.bounds[id]; exists {
+		sg.Remove(id)
+	}
+
+	sg.bounds[id] = bounds
+	sg.forEachCell(bounds, func(coord ChunkCoord) {
+		cell, exists := sg.cells[coord]
+		if !exists {
+			cell = make(map[EntityID]struct{})
+			sg.cells[coord] = cell
+		}
+		cell[id] = struct{}{}
+	})
+}
+
+// Remove retire une entité de la grille
+func (sg *SpatialGrid) Remove(id EntityID) {
+	bounds, exists := sg.bounds[id]
+	if !exists {
+		return
+	}
+
+	sg.forEachCell(bounds, func(coord ChunkCoord) {
+		cell := sg.cells[coord]
+		delete(cell, id)
+		if len(cell) == 0 {
+			delete(sg.cells, coord)
+		}
+	})
+	delete(sg.bounds, id)
+}
+
+// QueryRegion retourne les entités des cellules touchées par la zone, triées par ID.
+// C'est une broad-phase : les candidats doivent encore être testés précisément.
+func (sg *SpatialGrid) QueryRegion(region Rectangle) []EntityID {
+	seen := make(map[EntityID]struct{})
+	sg.forEachCell(region, func(coord ChunkCoord) {
+		for id := range sg.cells[coord] {
+			seen[id] = struct{}{}
+		}
+	})
+
+	result := make([]EntityID, 0, len(seen))
+	for id := range seen {
+		result = append(result, id)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// Clear vide la grille
+func (sg *SpatialGrid) Clear() {
+	sg.cells = make(map[ChunkCoord]map[EntityID]struct{})
+	sg.bounds = make(map[EntityID]Rectangle)
+}
+
+// cellCoord retourne la cellule contenant un point
+func (sg *SpatialGrid) cellCoord(x, y float64) ChunkCoord {
+	return NewChunkCoord(int(math.Floor(x/sg.cellSize)), int(math.Floor(y/sg.cellSize)))
+}
+
+// forEachCell parcourt les cellules couvertes par un rectangle
+func (sg *SpatialGrid) forEachCell(bounds Rectangle, fn func(coord ChunkCoord)) {
+	min := sg.cellCoord(bounds.X, bounds.Y)
+	max := sg.cellCoord(bounds.X+bounds.Width, bounds.Y+bounds.Height)
+	for y := min.Y; y <= max.Y; y++ {
+		for x := min.X; x <= max.X; x++ {
+			fn(ChunkCoord{X: x, Y: y})
+		}
+	}
+}
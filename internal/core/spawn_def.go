@@ -0,0 +1,78 @@
+// internal/core/spawn_def.go - Entités placées par la carte au lancement du niveau
+package core
+
+import "fmt"
+
+// Types d'entités d'une carte
+const (
+	SpawnTypeEnemy   = "enemy"
+	SpawnTypeItem    = "item"
+	SpawnTypePortal  = "portal"
+	SpawnTypeBonfire = "bonfire"
+	SpawnTypeSign    = "sign"
+)
+
+// SpawnDef entité à placer au lancement du niveau
+type SpawnDef struct {
+	Type      string  `yaml:"type"`      // enemy, item, portal, bonfire ou sign
+	Archetype string  `yaml:"archetype"` // Ennemis : nom de l'archétype (enemies/archetypes.yaml)
+	Name      string  `yaml:"name"`      // Objets, portails et feux de camp : nom affiché
+	Position  Vector2 `yaml:"position"`
+
+	// Portails : salle de destination, préchargée quand le joueur approche, et
+	// point d'arrivée du joueur qui le traverse
+	Destination string  `yaml:"destination"`
+	Arrival     Vector2 `yaml:"arrival"`
+
+	// Ennemis : patrouille en ligne droite (remplace celle de l'archétype)
+	Patrol []Vector2 `yaml:"patrol"`
+	Loop   bool      `yaml:"loop"`
+
+	// Ennemis : objets lâchés à la mort
+	Drops []string `yaml:"drops"`
+
+	// Ennemis : formation rejointe ("" : l'ennemi agit seul). Les membres d'une
+	// même formation attaquent à tour de rôle au lieu de tous se ruer sur le joueur
+	Formation string `yaml:"formation"`
+
+	// Panneaux : clé de traduction ou texte du message, taille de police (0 : défaut)
+	Message  string `yaml:"message"`
+	FontSize int    `yaml:"font_size"`
+}
+
+// Validate vérifie qu'une définition est exploitable
+func (sd SpawnDef) Validate() error {
+	switch sd.Type {
+	case SpawnTypeEnemy:
+		if sd.Archetype == "" {
+			return fmt.Errorf("ennemi sans archétype en (%.0f, %.0f)", sd.Position.X, sd.Position.Y)
+		}
+	case SpawnTypeItem, SpawnTypePortal, SpawnTypeBonfire:
+		if sd.Name == "" {
+			return fmt.Errorf("%s sans nom en (%.0f, %.0f)", sd.Type, sd.Position.X, sd.Position.Y)
+		}
+	case SpawnTypeSign:
+		if sd.Message == "" {
+			return fmt.Errorf("panneau sans message en (%.0f, %.0f)", sd.Position.X, sd.Position.Y)
+		}
+	default:
+		return fmt.Errorf("type d'entité inconnu: %q", sd.Type)
+	}
+	return nil
+}
+
+// SpawnTableEntry entrée pondérée de la table d'apparition aléatoire d'une carte
+type SpawnTableEntry struct {
+	Type   string `yaml:"type"` // enemy ou item
+	ID     string `yaml:"id"`   // Archétype d'ennemi ou nom d'objet
+	Weight int    `yaml:"weight"`
+}
+
+// DensityRegion rectangle de tuiles de même densité d'apparition (0-255)
+type DensityRegion struct {
+	X       int `yaml:"x"`
+	Y       int `yaml:"y"`
+	Width   int `yaml:"width"`
+	Height  int `yaml:"height"`
+	Density int `yaml:"density"`
+}
@@ -0,0 +1,119 @@
+// internal/core/state_machine.go - Machine à états générique
+package core
+
+import "fmt"
+
+// stateHandlers actions d'un état ; chacune peut être nil
+type stateHandlers[E any] struct {
+	onEnter  func()
+	onExit   func()
+	onUpdate func(E)
+}
+
+// stateTransition passage de from à to sur un événement, si condition l'autorise
+type stateTransition[S comparable] struct {
+	from      S
+	event     string
+	to        S
+	condition func() bool // nil : toujours autorisée
+}
+
+// StateMachine machine à états générique : S identifie les états, E est la
+// donnée transmise à la mise à jour de l'état courant (durée de frame...).
+// Les transitions ne peuvent relier que des états déclarés : une erreur de
+// construction panique dès la déclaration plutôt qu'en cours de partie.
+type StateMachine[S comparable, E any] struct {
+	states      map[S]*stateHandlers[E]
+	transitions []stateTransition[S]
+	current     S
+
+	// Appelé après chaque changement d'état
+	OnTransition func(from, to S)
+}
+
+// NewStateMachine crée une machine dont l'état initial est initial ; il doit
+// être déclaré par AddState avant la première mise à jour
+func NewStateMachine[S comparable, E any](initial S) *StateMachine[S, E] {
+	return &StateMachine[S, E]{
+		states:  make(map[S]*stateHandlers[E]),
+		current: initial,
+	}
+}
+
+// AddState déclare un état et ses actions d'entrée, de sortie et de mise à jour
+func (sm *StateMachine[S, E]) AddState(id S, onEnter, onExit func(), onUpdate func(E)) *StateMachine[S, E] {
+	sm.states[id] = &stateHandlers[E]{onEnter: onEnter, onExit: onExit, onUpdate: onUpdate}
+	return sm
+}
+
+// Transition déclare le passage de from à to sur event ; panique si l'un des
+// deux états n'a pas été déclaré
+func (sm *StateMachine[S, E]) Transition(from S, event string, to S, condition func() bool) *StateMachine[S, E] {
+	if _, ok := sm.states[from]; !ok {
+		panic(fmt.Sprintf("transition '%s': état de départ %v non déclaré", event, from))
+	}
+	if _, ok := sm.states[to]; !ok {
+		panic(fmt.Sprintf("transition '%s': état d'arrivée %v non déclaré", event, to))
+	}
+	sm.transitions = append(sm.transitions, stateTransition[S]{from: from, event: event, to: to, condition: condition})
+	return sm
+}
+
+// Current retourne l'état courant
+func (sm *StateMachine[S, E]) Current() S {
+	return sm.current
+}
+
+// Has retourne si un état est déclaré
+func (sm *StateMachine[S, E]) Has(id S) bool {
+	_, ok := sm.states[id]
+	return ok
+}
+
+// Update met à jour l'état courant
+func (sm *StateMachine[S, E]) Update(event E) {
+	if state := sm.states[sm.current]; state != nil && state.onUpdate != nil {
+		state.onUpdate(event)
+	}
+}
+
+// Fire déclenche la première transition de l'état courant sur event dont la
+// condition est remplie ; false si aucune ne s'applique
+func (sm *StateMachine[S, E]) Fire(event string) bool {
+	for _, transition := range sm.transitions {
+		if transition.from != sm.current || transition.event != event {
+			continue
+		}
+		if transition.condition != nil && !transition.condition() {
+			continue
+		}
+		sm.enter(transition.to)
+		return true
+	}
+	return false
+}
+
+// ForceState passe directement à un état déclaré, sans transition (pile
+// d'états, changements demandés de l'extérieur) ; false si l'état est inconnu
+func (sm *StateMachine[S, E]) ForceState(id S) bool {
+	if _, ok := sm.states[id]; !ok {
+		return false
+	}
+	sm.enter(id)
+	return true
+}
+
+// enter quitte l'état courant et entre dans to
+func (sm *StateMachine[S, E]) enter(to S) {
+	from := sm.current
+	if state := sm.states[from]; state != nil && state.onExit != nil {
+		state.onExit()
+	}
+	sm.current = to
+	if state := sm.states[to]; state.onEnter != nil {
+		state.onEnter()
+	}
+	if sm.OnTransition != nil {
+		sm.OnTransition(from, to)
+	}
+}
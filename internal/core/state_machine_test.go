@@ -0,0 +1,166 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"zelda-souls-game/internal/crafting"
+)
+
+// doorMachine porte fermée/ouverte/verrouillée ; open n'aboutit que si unlocked
+func doorMachine(unlocked *bool, log *[]string) *StateMachine[string, int] {
+	record := func(entry string) func() {
+		return func() { *log = append(*log, entry) }
+	}
+	sm := NewStateMachine[string, int]("fermée")
+	sm.AddState("fermée", record("entrée fermée"), record("sortie fermée"), nil).
+		AddState("ouverte", record("entrée ouverte"), record("sortie ouverte"), func(n int) {
+			*log = append(*log, strings.Repeat("u", n))
+		}).
+		AddState("verrouillée", nil, nil, nil).
+		Transition("fermée", "ouvrir", "ouverte", func() bool { return *unlocked }).
+		Transition("fermée", "ouvrir", "verrouillée", nil).
+		Transition("ouverte", "fermer", "fermée", nil)
+	return sm
+}
+
+func TestStateMachineConditionGatedTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		unlocked bool
+		want     string
+	}{
+		{"condition remplie", true, "ouverte"},
+		// La première transition est refusée : la suivante sur le même événement s'applique
+		{"condition refusée", false, "verrouillée"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unlocked := tt.unlocked
+			var log []string
+			sm := doorMachine(&unlocked, &log)
+
+			if !sm.Fire("ouvrir") {
+				t.Fatal("Fire(ouvrir) a échoué")
+			}
+			if sm.Current() != tt.want {
+				t.Errorf("état = %q, attendu %q", sm.Current(), tt.want)
+			}
+		})
+	}
+}
+
+func TestStateMachineFireWithoutTransition(t *testing.T) {
+	unlocked := true
+	var log []string
+	sm := doorMachine(&unlocked, &log)
+
+	if sm.Fire("fermer") {
+		t.Error("aucune transition 'fermer' ne part de l'état fermé")
+	}
+	if sm.Fire("inconnu") {
+		t.Error("un événement inconnu ne doit rien déclencher")
+	}
+	if sm.Current() != "fermée" || len(log) != 0 {
+		t.Errorf("état = %q, actions = %v, attendu inchangé", sm.Current(), log)
+	}
+}
+
+func TestStateMachineEnterExitUpdateOrder(t *testing.T) {
+	unlocked := true
+	var log []string
+	sm := doorMachine(&unlocked, &log)
+
+	var transitions []string
+	sm.OnTransition = func(from, to string) { transitions = append(transitions, from+"→"+to) }
+
+	sm.Update(1) // L'état fermé n'a pas de mise à jour
+	sm.Fire("ouvrir")
+	sm.Update(2)
+	sm.Fire("fermer")
+
+	want := []string{"sortie fermée", "entrée ouverte", "uu", "sortie ouverte", "entrée fermée"}
+	if strings.Join(log, ",") != strings.Join(want, ",") {
+		t.Errorf("actions = %v, attendu %v", log, want)
+	}
+	if strings.Join(transitions, ",") != "fermée→ouverte,ouverte→fermée" {
+		t.Errorf("OnTransition = %v", transitions)
+	}
+}
+
+func TestStateMachineForceState(t *testing.T) {
+	unlocked := false
+	var log []string
+	sm := doorMachine(&unlocked, &log)
+
+	// ForceState ignore les transitions et leurs conditions
+	if !sm.ForceState("ouverte") || sm.Current() != "ouverte" {
+		t.Fatalf("ForceState(ouverte) : état = %q", sm.Current())
+	}
+	if sm.ForceState("murée") {
+		t.Error("ForceState doit refuser un état non déclaré")
+	}
+	if sm.Current() != "ouverte" {
+		t.Errorf("état = %q après un ForceState refusé, attendu ouverte", sm.Current())
+	}
+}
+
+func TestStateMachineInvalidTransitionPanics(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+	}{
+		{"départ non déclaré", "murée", "ouverte"},
+		{"arrivée non déclarée", "fermée", "murée"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := NewStateMachine[string, int]("fermée")
+			sm.AddState("fermée", nil, nil, nil).AddState("ouverte", nil, nil, nil)
+
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					t.Fatal("une transition vers un état non déclaré doit paniquer")
+				}
+				if message, _ := recovered.(string); !strings.Contains(message, "murée") {
+					t.Errorf("panique = %v, attendu le nom de l'état fautif", recovered)
+				}
+			}()
+			sm.Transition(tt.from, "ouvrir", tt.to, nil)
+		})
+	}
+}
+
+func TestStateManagerMachineIsValid(t *testing.T) {
+	// La construction du gestionnaire déclare toutes ses transitions : elle paniquerait sinon
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	for _, state := range []GameStateType{StateMenu, StateGameplay, StatePause, StateSettings} {
+		if !esm.states.Has(state) {
+			t.Errorf("état %s non déclaré", state)
+		}
+	}
+}
+
+func TestStateManagerPauseGatedByPanels(t *testing.T) {
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	esm.SetRecipes(crafting.NewRecipeSystem(nil))
+	esm.ChangeState(StateGameplay)
+	esm.craftingPanel.Toggle()
+
+	// Panneau ouvert : la transition vers la pause est refusée, Échap ferme le panneau
+	if esm.states.Fire(stateEventPause) {
+		t.Fatal("la pause ne doit pas s'ouvrir par-dessus un panneau")
+	}
+	esm.TogglePause()
+	if esm.craftingPanel.IsVisible() || esm.GetCurrentStateType() != StateGameplay {
+		t.Fatal("Échap doit d'abord fermer le panneau")
+	}
+
+	esm.TogglePause()
+	if esm.GetCurrentStateType() != StatePause {
+		t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), StatePause)
+	}
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// staminaRefillFrames compte les frames de 60 Hz nécessaires pour remplir la
+// stamina du joueur, vide au départ, sous l'échelle de temps scale
+func staminaRefillFrames(t *testing.T, scale float64) int {
+	t.Helper()
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	game, err := NewGame(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	game.SetStateManager(esm)
+	game.SetTimeScale(scale)
+	esm.startNewGame()
+	if esm.GetCurrentStateType() != StateGameplay {
+		t.Fatalf("état = %s, attendu %s", esm.GetCurrentStateType(), StateGameplay)
+	}
+
+	player := esm.playerSystem.GetPlayer()
+	player.Player.GodMode = true // Les ennemis ne doivent pas interrompre la mesure
+	player.Player.Stamina = 0
+
+	for frame := 1; frame <= 3600; frame++ {
+		if err := esm.Update(time.Second / 60); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if player.Player.Stamina >= player.Player.MaxStamina {
+			return frame
+		}
+	}
+	t.Fatalf("stamina = %.1f après une minute", player.Player.Stamina)
+	return 0
+}
+
+func TestTimeScaleSlowsStaminaRegen(t *testing.T) {
+	normal := staminaRefillFrames(t, 1)
+	slowed := staminaRefillFrames(t, 0.5)
+
+	// 100 points à 25 par seconde : 4 s, soit 240 frames
+	if normal < 238 || normal > 242 {
+		t.Errorf("remplissage à vitesse normale en %d frames, attendu 240", normal)
+	}
+	if slowed < 2*normal-2 || slowed > 2*normal+2 {
+		t.Errorf("remplissage au ralenti en %d frames, attendu le double de %d", slowed, normal)
+	}
+}
+
+func TestSetTimeScaleClamps(t *testing.T) {
+	tests := []struct {
+		name  string
+		scale float64
+		want  float64
+	}{
+		{"ralenti", 0.5, 0.5},
+		{"accéléré", 2, 2},
+		{"trop lent", 0.01, MinTimeScale},
+		{"négatif", -1, MinTimeScale},
+		{"trop rapide", 10, MaxTimeScale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, _ := NewGame(nil, nil, nil)
+			esm := NewEnhancedBuiltinStateManager(1280, 720)
+			game.SetStateManager(esm)
+
+			game.SetTimeScale(tt.scale)
+			if game.GetTimeScale() != tt.want {
+				t.Errorf("GetTimeScale = %.2f, attendu %.2f", game.GetTimeScale(), tt.want)
+			}
+			if esm.timeScale != tt.want {
+				t.Errorf("échelle du gestionnaire d'états = %.2f, attendu %.2f", esm.timeScale, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// internal/core/tooltip.go - Infobulles des éléments d'interface
+package core
+
+import (
+	"time"
+)
+
+// Métriques de la police par défaut (basicfont 7x13)
+const (
+	tooltipCharWidth  = 7.0
+	tooltipLineHeight = 14.0
+)
+
+// Tooltipped est implémenté par tout élément pouvant afficher une infobulle
+// (boutons, sliders, interrupteurs...)
+type Tooltipped interface {
+	Contains(point Vector2) bool
+	GetTooltip() string // "" : aucune infobulle
+}
+
+// TooltipManager affiche l'infobulle de l'élément survolé après un délai,
+// près du curseur
+type TooltipManager struct {
+	// Configuration
+	Delay    time.Duration // Temps de survol avant affichage
+	MaxWidth float64       // Largeur maximale du texte
+	Padding  float64
+
+	// Style
+	BackgroundColor Color
+	BorderColor     Color
+	TextColor       Color
+
+	// État
+	hovered   Tooltipped
+	hoverTime time.Duration
+	mousePos  Vector2
+}
+
+// NewTooltipManager crée un gestionnaire d'infobulles
+func NewTooltipManager() *TooltipManager {
+	return &TooltipManager{
+		Delay:    time.Millisecond * 600,
+		MaxWidth: 220.0,
+		Padding:  6.0,
+
+		BackgroundColor: Color{20, 20, 30, 230},
+		BorderColor:     Color{200, 200, 200, 255},
+		TextColor:       Color{255, 255, 255, 255},
+	}
+}
+
+// Update détecte l'élément survolé et fait avancer le délai d'affichage.
+// targets est dans l'ordre de rendu : le dernier est au-dessus des autres.
+func (tm *TooltipManager) Update(mousePos Vector2, targets []Tooltipped, deltaTime time.Duration) {
+	tm.mousePos = mousePos
+
+	var hovered Tooltipped
+	for i := len(targets) - 1; i >= 0; i-- {
+		if target := targets[i]; target.GetTooltip() != "" && target.Contains(mousePos) {
+			hovered = target
+			break
+		}
+	}
+
+	if hovered != tm.hovered {
+		tm.hovered = hovered
+		tm.hoverTime = 0
+		return
+	}
+
+	if tm.hovered != nil {
+		tm.hoverTime += deltaTime
+	}
+}
+
+// IsVisible retourne si une infobulle est affichée
+func (tm *TooltipManager) IsVisible() bool {
+	return tm.hovered != nil && tm.hoverTime >= tm.Delay
+}
+
+// Hide masque l'infobulle jusqu'au prochain survol
+func (tm *TooltipManager) Hide() {
+	tm.hovered = nil
+	tm.hoverTime = 0
+}
+
+// Render dessine l'infobulle près du curseur, sans sortir de l'écran
+func (tm *TooltipManager) Render(renderer Renderer, screenWidth, screenHeight int) {
+	if !tm.IsVisible() {
+		return
+	}
+
+	lines := wrapText(tm.hovered.GetTooltip(), int(tm.MaxWidth/tooltipCharWidth))
+	textWidth := 0.0
+	for _, line := range lines {
+		if width := float64(len([]rune(line))) * tooltipCharWidth; width > textWidth {
+			textWidth = width
+		}
+	}
+	textHeight := float64(len(lines)) * tooltipLineHeight
+
+	box := Rectangle{
+		X:      tm.mousePos.X + 16,
+		Y:      tm.mousePos.Y + 16,
+		Width:  textWidth + tm.Padding*2,
+		Height: textHeight + tm.Padding*2,
+	}
+
+	// Rester dans les limites de l'écran
+	if box.X+box.Width > float64(screenWidth) {
+		box.X = tm.mousePos.X - box.Width - 4
+	}
+	if box.Y+box.Height > float64(screenHeight) {
+		box.Y = tm.mousePos.Y - box.Height - 4
+	}
+	if box.X < 0 {
+		box.X = 0
+	}
+	if box.Y < 0 {
+		box.Y = 0
+	}
+
+	renderer.DrawRectangle(box, tm.BackgroundColor, true)
+	renderer.DrawRectangle(box, tm.BorderColor, false)
+
+	// DrawText place le texte sur sa ligne de base
+	for i, line := range lines {
+		position := Vector2{box.X + tm.Padding, box.Y + tm.Padding + tooltipLineHeight - 3 + float64(i)*tooltipLineHeight}
+		renderer.DrawText(line, position, tm.TextColor)
+	}
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// slider élément d'interface autre qu'un bouton, avec infobulle
+type slider struct {
+	Bounds  Rectangle
+	Tooltip string
+}
+
+func (s *slider) Contains(point Vector2) bool {
+	return point.X >= s.Bounds.X && point.X <= s.Bounds.X+s.Bounds.Width &&
+		point.Y >= s.Bounds.Y && point.Y <= s.Bounds.Y+s.Bounds.Height
+}
+
+func (s *slider) GetTooltip() string { return s.Tooltip }
+
+func TestTooltipManagerHover(t *testing.T) {
+	button := NewButton(0, 0, 100, 40, "Jouer", nil)
+	button.Tooltip = "Commencer une partie"
+	volume := &slider{Bounds: Rectangle{X: 50, Y: 0, Width: 100, Height: 40}, Tooltip: "Volume des effets"}
+	hidden := NewButton(200, 0, 100, 40, "Caché", nil)
+	hidden.Tooltip = "Invisible"
+	hidden.Visible = false
+	targets := []Tooltipped{button, volume, hidden}
+
+	tests := []struct {
+		name  string
+		mouse Vector2
+		hover time.Duration
+		want  Tooltipped
+	}{
+		{"bouton après le délai", Vector2{10, 10}, 600 * time.Millisecond, button},
+		{"slider après le délai", Vector2{140, 10}, 600 * time.Millisecond, volume},
+		{"chevauchement : le dernier dessiné", Vector2{75, 10}, 600 * time.Millisecond, volume},
+		{"avant le délai", Vector2{10, 10}, 500 * time.Millisecond, nil},
+		{"bouton masqué", Vector2{250, 10}, time.Second, nil},
+		{"hors des éléments", Vector2{500, 500}, time.Second, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := NewTooltipManager()
+			tm.Update(tt.mouse, targets, 0)
+			tm.Update(tt.mouse, targets, tt.hover)
+
+			if tm.IsVisible() != (tt.want != nil) {
+				t.Fatalf("IsVisible = %t, attendu %t", tm.IsVisible(), tt.want != nil)
+			}
+			if tt.want != nil && tm.hovered != tt.want {
+				t.Errorf("infobulle = %q, attendu %q", tm.hovered.GetTooltip(), tt.want.GetTooltip())
+			}
+		})
+	}
+}
+
+func TestTooltipManagerRestartsOnNewTarget(t *testing.T) {
+	button := NewButton(0, 0, 100, 40, "Jouer", nil)
+	button.Tooltip = "Commencer une partie"
+	volume := &slider{Bounds: Rectangle{X: 200, Y: 0, Width: 100, Height: 40}, Tooltip: "Volume des effets"}
+	targets := []Tooltipped{button, volume}
+
+	tm := NewTooltipManager()
+	tm.Update(Vector2{10, 10}, targets, 0)
+	tm.Update(Vector2{10, 10}, targets, time.Second)
+	if !tm.IsVisible() {
+		t.Fatal("l'infobulle du bouton doit s'afficher")
+	}
+
+	// Passer sur le slider relance le délai
+	tm.Update(Vector2{250, 10}, targets, time.Second)
+	if tm.IsVisible() {
+		t.Error("l'infobulle du slider ne doit pas s'afficher avant le délai")
+	}
+	tm.Update(Vector2{250, 10}, targets, 600*time.Millisecond)
+	if !tm.IsVisible() || tm.hovered != volume {
+		t.Error("l'infobulle du slider doit s'afficher après le délai")
+	}
+}
@@ -0,0 +1,14 @@
+// internal/core/treasure_room_def.go - Salle au trésor générée pour le niveau
+package core
+
+// TreasureRoomDef salle au trésor : quatre plaques de pression à couvrir
+// ensemble de rochers pour ouvrir le coffre central. Positions en pixels.
+type TreasureRoomDef struct {
+	Bounds   Rectangle
+	Walls    []Rectangle // Tuiles solides du pourtour
+	Door     Rectangle   // Porte renforcée de l'entrée
+	Chest    Vector2
+	Plates   []Vector2
+	Boulders []Vector2
+	Loot     []string // Objets rares contenus dans le coffre
+}
@@ -0,0 +1,131 @@
+// internal/core/tween.go - Courbes d'accélération et interpolations dans le temps
+package core
+
+import (
+	"math"
+	"time"
+)
+
+// EasingFunc courbe d'accélération : t de 0 à 1 vers une progression (0 en 0, 1 en 1)
+type EasingFunc func(t float64) float64
+
+// EaseLinear progression constante
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseInQuad démarrage lent
+func EaseInQuad(t float64) float64 {
+	return t * t
+}
+
+// EaseOutQuad arrivée lente
+func EaseOutQuad(t float64) float64 {
+	return t * (2 - t)
+}
+
+// EaseInOutQuad démarrage et arrivée lents
+func EaseInOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - 2*(1-t)*(1-t)
+}
+
+// EaseInCubic démarrage très lent
+func EaseInCubic(t float64) float64 {
+	return t * t * t
+}
+
+// EaseOutCubic arrivée très lente (caméra, barres)
+func EaseOutCubic(t float64) float64 {
+	u := 1 - t
+	return 1 - u*u*u
+}
+
+// EaseInOutCubic démarrage et arrivée très lents
+func EaseInOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	u := 1 - t
+	return 1 - 4*u*u*u
+}
+
+// EaseSmoothStep courbe en S d'Hermite (3t² - 2t³)
+func EaseSmoothStep(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// EaseOutBack arrivée qui dépasse légèrement la cible avant de s'y poser
+func EaseOutBack(t float64) float64 {
+	const overshoot = 1.70158
+	u := t - 1
+	return 1 + u*u*((overshoot+1)*u+overshoot)
+}
+
+// EaseOutElastic arrivée qui oscille autour de la cible
+func EaseOutElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return math.Pow(2, -10*t)*math.Sin((t*10-0.75)*2*math.Pi/3) + 1
+}
+
+// Tween interpolation de From vers To sur Duration, suivant Easing
+type Tween struct {
+	From     float64
+	To       float64
+	Duration time.Duration
+	Easing   EasingFunc // nil : EaseLinear
+
+	elapsed time.Duration
+}
+
+// NewTween crée une interpolation qui commence à From
+func NewTween(from, to float64, duration time.Duration, easing EasingFunc) *Tween {
+	return &Tween{From: from, To: to, Duration: duration, Easing: easing}
+}
+
+// Update fait avancer l'interpolation et retourne la valeur courante
+func (t *Tween) Update(deltaTime time.Duration) float64 {
+	t.elapsed += deltaTime
+	if t.elapsed > t.Duration {
+		t.elapsed = t.Duration
+	}
+	return t.Value()
+}
+
+// Progress retourne l'avancement dans le temps (0 à 1), avant la courbe
+func (t *Tween) Progress() float64 {
+	if t.Duration <= 0 {
+		return 1
+	}
+	return Clamp(float64(t.elapsed)/float64(t.Duration), 0, 1)
+}
+
+// Value retourne la valeur courante
+func (t *Tween) Value() float64 {
+	easing := t.Easing
+	if easing == nil {
+		easing = EaseLinear
+	}
+	return Lerp(t.From, t.To, easing(t.Progress()))
+}
+
+// Done retourne si la valeur a atteint To
+func (t *Tween) Done() bool {
+	return t.elapsed >= t.Duration
+}
+
+// Reset relance l'interpolation depuis From
+func (t *Tween) Reset() {
+	t.elapsed = 0
+}
+
+// Retarget repart de la valeur courante vers une nouvelle cible, sans saut
+func (t *Tween) Retarget(to float64) {
+	t.From = t.Value()
+	t.To = to
+	t.elapsed = 0
+}
@@ -6,6 +6,8 @@ import (
 	"image/color"
 	"math"
 	"time"
+
+	"zelda-souls-game/internal/ecs/components"
 )
 
 // ===============================
@@ -61,6 +63,37 @@ func (v Vector2) Dot(other Vector2) float64 {
 	return v.X*other.X + v.Y*other.Y
 }
 
+// Lerp interpole linéairement vers un autre vecteur (t=0 : v, t=1 : other)
+func (v Vector2) Lerp(other Vector2, t float64) Vector2 {
+	return Vector2{X: v.X + (other.X-v.X)*t, Y: v.Y + (other.Y-v.Y)*t}
+}
+
+// SmoothStep interpole vers un autre vecteur en accélérant puis ralentissant
+// (t=0 : v, t=1 : other, t borné à [0, 1])
+func (v Vector2) SmoothStep(other Vector2, t float64) Vector2 {
+	return v.Lerp(other, EaseSmoothStep(Clamp(t, 0, 1)))
+}
+
+// Rotate fait tourner le vecteur d'un angle en radians
+func (v Vector2) Rotate(radians float64) Vector2 {
+	sin, cos := math.Sincos(radians)
+	return Vector2{X: v.X*cos - v.Y*sin, Y: v.X*sin + v.Y*cos}
+}
+
+// Angle retourne l'angle du vecteur en radians depuis l'axe +X
+func (v Vector2) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// ClampLength limite la longueur du vecteur sans changer sa direction
+func (v Vector2) ClampLength(max float64) Vector2 {
+	length := v.Length()
+	if length <= max || length == 0 {
+		return v
+	}
+	return v.Mul(max / length)
+}
+
 // Rectangle représente un rectangle
 type Rectangle struct {
 	X, Y, Width, Height float64
@@ -93,6 +126,64 @@ func (r Rectangle) Center() Vector2 {
 	}
 }
 
+// Union retourne le plus petit rectangle contenant les deux rectangles
+func (r Rectangle) Union(other Rectangle) Rectangle {
+	minX := math.Min(r.X, other.X)
+	minY := math.Min(r.Y, other.Y)
+	maxX := math.Max(r.X+r.Width, other.X+other.Width)
+	maxY := math.Max(r.Y+r.Height, other.Y+other.Height)
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Inset réduit le rectangle de margin sur chaque bord (agrandit si margin < 0).
+// La taille ne descend pas sous zéro : le rectangle se réduit alors à son centre.
+func (r Rectangle) Inset(margin float64) Rectangle {
+	inset := Rectangle{
+		X:      r.X + margin,
+		Y:      r.Y + margin,
+		Width:  r.Width - margin*2,
+		Height: r.Height - margin*2,
+	}
+	if inset.Width < 0 {
+		inset.X = r.X + r.Width/2
+		inset.Width = 0
+	}
+	if inset.Height < 0 {
+		inset.Y = r.Y + r.Height/2
+		inset.Height = 0
+	}
+	return inset
+}
+
+// Overlap retourne la translation minimale à appliquer à r pour le sortir de other.
+// Seul l'axe de plus faible pénétration est non nul ; (0, 0) si les rectangles
+// ne se chevauchent pas (des bords qui se touchent ne comptent pas).
+func (r Rectangle) Overlap(other Rectangle) (dx, dy float64) {
+	if !r.Intersects(other) {
+		return 0, 0
+	}
+
+	// Pénétration vers la gauche/droite et le haut/bas
+	pushLeft := other.X - (r.X + r.Width)
+	pushRight := (other.X + other.Width) - r.X
+	pushUp := other.Y - (r.Y + r.Height)
+	pushDown := (other.Y + other.Height) - r.Y
+
+	dx = pushRight
+	if -pushLeft < pushRight {
+		dx = pushLeft
+	}
+	dy = pushDown
+	if -pushUp < pushDown {
+		dy = pushUp
+	}
+
+	if math.Abs(dx) < math.Abs(dy) {
+		return dx, 0
+	}
+	return 0, dy
+}
+
 // ===============================
 // GAME TYPES
 // ===============================
@@ -245,14 +336,17 @@ type SystemType string
 type GameStateType string
 
 const (
-	StateMenu      GameStateType = "menu"
-	StateGameplay  GameStateType = "gameplay"
-	StatePause     GameStateType = "pause"
-	StateInventory GameStateType = "inventory"
-	StateDialog    GameStateType = "dialog"
-	StateLoading   GameStateType = "loading"
-	StateSettings  GameStateType = "settings"
-	StateGameOver  GameStateType = "gameover"
+	StateMenu        GameStateType = "menu"
+	StateGameplay    GameStateType = "gameplay"
+	StatePause       GameStateType = "pause"
+	StateInventory   GameStateType = "inventory"
+	StateDialog      GameStateType = "dialog"
+	StateLoading     GameStateType = "loading"
+	StateSettings    GameStateType = "settings"
+	StateGameOver    GameStateType = "gameover"
+	StateSignRead    GameStateType = "sign_read"
+	StateLeaderboard GameStateType = "leaderboard"
+	StateIntro       GameStateType = "intro"
 )
 
 // ===============================
@@ -296,76 +390,13 @@ type MapID string
 // TIMER TYPES
 // ===============================
 
-// Timer représente un minuteur
-type Timer struct {
-	Duration   time.Duration
-	Elapsed    time.Duration
-	Running    bool
-	Loop       bool
-	OnComplete func()
-}
+// Timer représente un minuteur ; défini dans components pour que les
+// composants ECS (invulnérabilité, stun) puissent l'utiliser
+type Timer = components.Timer
 
 // NewTimer crée un nouveau Timer
 func NewTimer(duration time.Duration) *Timer {
-	return &Timer{
-		Duration: duration,
-		Running:  false,
-		Loop:     false,
-	}
-}
-
-// Start démarre le timer
-func (t *Timer) Start() {
-	t.Running = true
-	t.Elapsed = 0
-}
-
-// Stop arrête le timer
-func (t *Timer) Stop() {
-	t.Running = false
-}
-
-// Reset remet le timer à zéro
-func (t *Timer) Reset() {
-	t.Elapsed = 0
-}
-
-// Update met à jour le timer
-func (t *Timer) Update(dt time.Duration) {
-	if !t.Running {
-		return
-	}
-
-	t.Elapsed += dt
-
-	if t.Elapsed >= t.Duration {
-		if t.OnComplete != nil {
-			t.OnComplete()
-		}
-
-		if t.Loop {
-			t.Elapsed = 0
-		} else {
-			t.Running = false
-		}
-	}
-}
-
-// IsComplete retourne true si le timer est terminé
-func (t *Timer) IsComplete() bool {
-	return t.Elapsed >= t.Duration
-}
-
-// Progress retourne le progrès du timer (0.0 à 1.0)
-func (t *Timer) Progress() float64 {
-	if t.Duration == 0 {
-		return 1.0
-	}
-	progress := float64(t.Elapsed) / float64(t.Duration)
-	if progress > 1.0 {
-		return 1.0
-	}
-	return progress
+	return components.NewTimer(duration)
 }
 
 // ===============================
@@ -426,10 +457,20 @@ const (
 	DefaultFriction = 0.8
 
 	// Constantes de gameplay
-	DefaultPlayerSpeed   = 100.0 // pixels par seconde
+	DefaultPlayerSpeed   = 200.0 // pixels par seconde
 	DefaultPlayerHealth  = 100
 	DefaultPlayerStamina = 100.0
 
+	// Constantes de déplacement (vitesses en pixels/s, accélérations en pixels/s²)
+	DefaultPlayerMaxSpeed     = 250.0
+	DefaultPlayerAcceleration = 800.0  // Vitesse atteinte en 0.25s
+	DefaultPlayerFriction     = 1200.0 // Arrêt en ~0.17s
+	DefaultEnemySpeed         = 120.0
+	DefaultEnemyMaxSpeed      = 150.0
+	DefaultEnemyAcceleration  = 480.0
+	DefaultEnemyFriction      = 720.0
+	DefaultResponsiveness     = 1.0
+
 	// Constantes d'animation
 	DefaultAnimationFPS = 12
 )
@@ -486,9 +527,17 @@ func RadiansToDegrees(radians float64) float64 {
 	return radians * 180.0 / math.Pi
 }
 
-// Lerp effectue une interpolation linéaire entre a et b
+// Lerp effectue une interpolation linéaire entre a et b (components.Lerp,
+// partagée avec les composants qui ne peuvent pas importer core)
 func Lerp(a, b, t float64) float64 {
-	return a + (b-a)*t
+	return components.Lerp(a, b, t)
+}
+
+// SmoothStep interpole entre a et b en accélérant puis ralentissant
+// (courbe cubique 3t² - 2t³) ; t est borné à [0, 1] (components.SmoothStep,
+// partagée avec les composants qui ne peuvent pas importer core)
+func SmoothStep(a, b, t float64) float64 {
+	return components.SmoothStep(a, b, t)
 }
 
 // Clamp limite une valeur entre min et max
@@ -0,0 +1,244 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+const epsilon = 1e-9
+
+func vectorsEqual(a, b Vector2) bool {
+	return math.Abs(a.X-b.X) < epsilon && math.Abs(a.Y-b.Y) < epsilon
+}
+
+func TestVector2Lerp(t *testing.T) {
+	from := Vector2{X: 0, Y: 10}
+	to := Vector2{X: 100, Y: -10}
+
+	tests := []struct {
+		name string
+		t    float64
+		want Vector2
+	}{
+		{"début", 0, from},
+		{"quart", 0.25, Vector2{X: 25, Y: 5}},
+		{"milieu", 0.5, Vector2{X: 50, Y: 0}},
+		{"trois quarts", 0.75, Vector2{X: 75, Y: -5}},
+		{"fin", 1, to},
+		{"extrapolation", 1.5, Vector2{X: 150, Y: -20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := from.Lerp(to, tt.t); !vectorsEqual(got, tt.want) {
+				t.Errorf("Lerp(%.2f) = %+v, attendu %+v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2SmoothStep(t *testing.T) {
+	from := Vector2{X: 0, Y: 10}
+	to := Vector2{X: 100, Y: -10}
+
+	// 3t² - 2t³ : 0,15625 au quart, 0,84375 aux trois quarts
+	tests := []struct {
+		name string
+		t    float64
+		want Vector2
+	}{
+		{"début", 0, from},
+		{"quart", 0.25, Vector2{X: 15.625, Y: 6.875}},
+		{"milieu", 0.5, Vector2{X: 50, Y: 0}},
+		{"trois quarts", 0.75, Vector2{X: 84.375, Y: -6.875}},
+		{"fin", 1, to},
+		{"borné avant", -0.5, from},
+		{"borné après", 1.5, to},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := from.SmoothStep(to, tt.t); !vectorsEqual(got, tt.want) {
+				t.Errorf("SmoothStep(%.2f) = %+v, attendu %+v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSmoothStep(t *testing.T) {
+	tests := []struct {
+		t    float64
+		want float64
+	}{
+		{0, 10},
+		{0.25, 13.125},
+		{0.5, 20},
+		{0.75, 26.875},
+		{1, 30},
+		{-1, 10},
+		{2, 30},
+	}
+
+	for _, tt := range tests {
+		if got := SmoothStep(10, 30, tt.t); math.Abs(got-tt.want) > epsilon {
+			t.Errorf("SmoothStep(10, 30, %.2f) = %.4f, attendu %.4f", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestVector2Rotate(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Vector2
+		radians float64
+		want    Vector2
+	}{
+		{"quart de tour", Vector2{X: 1, Y: 0}, math.Pi / 2, Vector2{X: 0, Y: 1}},
+		{"demi-tour", Vector2{X: 3, Y: 4}, math.Pi, Vector2{X: -3, Y: -4}},
+		{"sens inverse", Vector2{X: 0, Y: 2}, -math.Pi / 2, Vector2{X: 2, Y: 0}},
+		{"sans rotation", Vector2{X: 5, Y: -2}, 0, Vector2{X: 5, Y: -2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v.Rotate(tt.radians)
+			if !vectorsEqual(got, tt.want) {
+				t.Errorf("Rotate = %+v, attendu %+v", got, tt.want)
+			}
+			if math.Abs(got.Length()-tt.v.Length()) > epsilon {
+				t.Errorf("la rotation a changé la longueur: %.3f, attendu %.3f", got.Length(), tt.v.Length())
+			}
+		})
+	}
+}
+
+func TestVector2Angle(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector2
+		want float64
+	}{
+		{"+X", Vector2{X: 1, Y: 0}, 0},
+		{"+Y (bas de l'écran)", Vector2{X: 0, Y: 1}, math.Pi / 2},
+		{"-X", Vector2{X: -1, Y: 0}, math.Pi},
+		{"-Y", Vector2{X: 0, Y: -1}, -math.Pi / 2},
+		{"diagonale", Vector2{X: 2, Y: 2}, math.Pi / 4},
+		{"nul", Vector2{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Angle(); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("Angle = %.3f, attendu %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2ClampLength(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector2
+		max  float64
+		want Vector2
+	}{
+		{"plus court", Vector2{X: 3, Y: 4}, 10, Vector2{X: 3, Y: 4}},
+		{"à la limite", Vector2{X: 3, Y: 4}, 5, Vector2{X: 3, Y: 4}},
+		{"trop long", Vector2{X: 30, Y: 40}, 5, Vector2{X: 3, Y: 4}},
+		{"nul", Vector2{}, 1, Vector2{}},
+		{"limite nulle", Vector2{X: 3, Y: 4}, 0, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.ClampLength(tt.max); !vectorsEqual(got, tt.want) {
+				t.Errorf("ClampLength(%.0f) = %+v, attendu %+v", tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRectangleOverlap(t *testing.T) {
+	wall := Rectangle{X: 100, Y: 100, Width: 100, Height: 100}
+
+	tests := []struct {
+		name   string
+		r      Rectangle
+		wantDX float64
+		wantDY float64
+	}{
+		{"séparés", Rectangle{X: 0, Y: 0, Width: 50, Height: 50}, 0, 0},
+		{"bord gauche touché", Rectangle{X: 80, Y: 120, Width: 20, Height: 20}, 0, 0},
+		{"coin touché", Rectangle{X: 80, Y: 80, Width: 20, Height: 20}, 0, 0},
+		{"entre par la gauche", Rectangle{X: 85, Y: 120, Width: 20, Height: 20}, -5, 0},
+		{"entre par la droite", Rectangle{X: 195, Y: 120, Width: 20, Height: 20}, 5, 0},
+		{"entre par le haut", Rectangle{X: 120, Y: 83, Width: 20, Height: 20}, 0, -3},
+		{"entre par le bas", Rectangle{X: 120, Y: 196, Width: 20, Height: 20}, 0, 4},
+		{"coin, axe X plus court", Rectangle{X: 82, Y: 85, Width: 20, Height: 20}, -2, 0},
+		{"coin, axe Y plus court", Rectangle{X: 190, Y: 198, Width: 20, Height: 20}, 0, 2},
+		{"à l'intérieur près du haut", Rectangle{X: 140, Y: 110, Width: 20, Height: 20}, 0, -30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dx, dy := tt.r.Overlap(wall)
+			if math.Abs(dx-tt.wantDX) > epsilon || math.Abs(dy-tt.wantDY) > epsilon {
+				t.Errorf("Overlap = (%.1f, %.1f), attendu (%.1f, %.1f)", dx, dy, tt.wantDX, tt.wantDY)
+			}
+
+			// La translation doit suffire à séparer les rectangles
+			moved := tt.r
+			moved.X += dx
+			moved.Y += dy
+			if moved.Intersects(wall) {
+				t.Errorf("toujours en collision après la translation: %+v", moved)
+			}
+		})
+	}
+}
+
+func TestRectangleUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Rectangle
+		want Rectangle
+	}{
+		{"disjoints", Rectangle{X: 0, Y: 0, Width: 10, Height: 10}, Rectangle{X: 20, Y: 30, Width: 10, Height: 10}, Rectangle{X: 0, Y: 0, Width: 30, Height: 40}},
+		{"imbriqués", Rectangle{X: 0, Y: 0, Width: 100, Height: 100}, Rectangle{X: 10, Y: 10, Width: 5, Height: 5}, Rectangle{X: 0, Y: 0, Width: 100, Height: 100}},
+		{"coordonnées négatives", Rectangle{X: -10, Y: 5, Width: 10, Height: 10}, Rectangle{X: 0, Y: -5, Width: 10, Height: 10}, Rectangle{X: -10, Y: -5, Width: 20, Height: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Union(tt.b); got != tt.want {
+				t.Errorf("Union = %+v, attendu %+v", got, tt.want)
+			}
+			if got := tt.b.Union(tt.a); got != tt.want {
+				t.Errorf("Union inversée = %+v, attendu %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRectangleInset(t *testing.T) {
+	r := Rectangle{X: 10, Y: 20, Width: 40, Height: 20}
+
+	tests := []struct {
+		name   string
+		margin float64
+		want   Rectangle
+	}{
+		{"réduction", 5, Rectangle{X: 15, Y: 25, Width: 30, Height: 10}},
+		{"agrandissement", -5, Rectangle{X: 5, Y: 15, Width: 50, Height: 30}},
+		{"hauteur épuisée", 15, Rectangle{X: 25, Y: 30, Width: 10, Height: 0}},
+		{"taille épuisée", 30, Rectangle{X: 30, Y: 30, Width: 0, Height: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Inset(tt.margin); got != tt.want {
+				t.Errorf("Inset(%.0f) = %+v, attendu %+v", tt.margin, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// internal/core/ui_manager.go - Widgets d'un écran : ordre d'affichage, focus et infobulles
+package core
+
+import (
+	"time"
+)
+
+// Widget est implémenté par tout élément géré par l'UIManager
+// (boutons, sliders, labels...)
+type Widget interface {
+	Update(mousePos Vector2, mousePressed bool)
+	Render(renderer Renderer)
+}
+
+// Focusable est implémenté par les widgets sélectionnables au clavier ou à
+// la manette
+type Focusable interface {
+	CanFocus() bool
+	Activate()
+}
+
+// hitTester est implémenté par les widgets qui interceptent la souris
+type hitTester interface {
+	Contains(point Vector2) bool
+}
+
+// offscreenPos est transmise aux widgets masqués par un widget au-dessus
+var offscreenPos = Vector2{-1e6, -1e6}
+
+// UIManager regroupe les widgets d'un écran. Seul le widget le plus haut sous
+// le curseur reçoit la souris ; un clic lui donne le focus.
+type UIManager struct {
+	// Widgets, du plus bas au plus haut (ordre de rendu)
+	widgets []Widget
+	focused Widget
+
+	// Infobulles (partagées entre écrans, nil : aucune)
+	tooltips *TooltipManager
+
+	wasPressed bool
+}
+
+// NewUIManager crée un gestionnaire de widgets
+func NewUIManager(tooltips *TooltipManager) *UIManager {
+	return &UIManager{
+		widgets:  make([]Widget, 0),
+		tooltips: tooltips,
+	}
+}
+
+// ===============================
+// GESTION DES WIDGETS
+// ===============================
+
+// AddWidget ajoute un widget au-dessus des autres
+func (ui *UIManager) AddWidget(widget Widget) {
+	if widget == nil || ui.indexOf(widget) >= 0 {
+		return
+	}
+	ui.widgets = append(ui.widgets, widget)
+}
+
+// RemoveWidget retire un widget
+func (ui *UIManager) RemoveWidget(widget Widget) {
+	index := ui.indexOf(widget)
+	if index < 0 {
+		return
+	}
+
+	ui.widgets = append(ui.widgets[:index], ui.widgets[index+1:]...)
+	if ui.focused == widget {
+		ui.focused = nil
+	}
+}
+
+// Clear retire tous les widgets
+func (ui *UIManager) Clear() {
+	ui.widgets = ui.widgets[:0]
+	ui.focused = nil
+}
+
+// GetWidgets retourne les widgets dans l'ordre de rendu
+func (ui *UIManager) GetWidgets() []Widget {
+	return ui.widgets
+}
+
+// BringToFront place un widget au-dessus des autres
+func (ui *UIManager) BringToFront(widget Widget) {
+	index := ui.indexOf(widget)
+	if index < 0 || index == len(ui.widgets)-1 {
+		return
+	}
+
+	ui.widgets = append(ui.widgets[:index], ui.widgets[index+1:]...)
+	ui.widgets = append(ui.widgets, widget)
+}
+
+// WidgetAt retourne le widget le plus haut sous un point (nil si aucun)
+func (ui *UIManager) WidgetAt(point Vector2) Widget {
+	for i := len(ui.widgets) - 1; i >= 0; i-- {
+		if hit, ok := ui.widgets[i].(hitTester); ok && hit.Contains(point) {
+			return ui.widgets[i]
+		}
+	}
+	return nil
+}
+
+func (ui *UIManager) indexOf(widget Widget) int {
+	for i, w := range ui.widgets {
+		if w == widget {
+			return i
+		}
+	}
+	return -1
+}
+
+// ===============================
+// FOCUS
+// ===============================
+
+// SetFocus donne le focus à un widget (nil pour le retirer)
+func (ui *UIManager) SetFocus(widget Widget) {
+	if widget != nil && ui.indexOf(widget) < 0 {
+		return
+	}
+	ui.focused = widget
+}
+
+// GetFocused retourne le widget ayant le focus
+func (ui *UIManager) GetFocused() Widget {
+	return ui.focused
+}
+
+// MoveFocus passe au widget sélectionnable suivant (step 1) ou précédent
+// (step -1), en bouclant. Sans focus, sélectionne le premier.
+func (ui *UIManager) MoveFocus(step int) {
+	count := len(ui.widgets)
+	index := ui.indexOf(ui.focused)
+	if index < 0 {
+		index, step = -1, 1
+	}
+
+	for i := 0; i < count; i++ {
+		index = ((index+step)%count + count) % count
+		if widget, ok := ui.widgets[index].(Focusable); ok && widget.CanFocus() {
+			ui.focused = ui.widgets[index]
+			return
+		}
+	}
+	ui.focused = nil
+}
+
+// ActivateFocused active le widget ayant le focus ; false s'il n'y en a pas
+// ou qu'il n'est plus sélectionnable
+func (ui *UIManager) ActivateFocused() bool {
+	widget, ok := ui.focused.(Focusable)
+	if !ok || !widget.CanFocus() {
+		return false
+	}
+	widget.Activate()
+	return true
+}
+
+// ===============================
+// MISE À JOUR ET RENDU
+// ===============================
+
+// Update transmet la souris aux widgets, du plus haut au plus bas
+func (ui *UIManager) Update(mousePos Vector2, mousePressed bool, deltaTime time.Duration) {
+	// Copie : un callback peut modifier la liste des widgets
+	widgets := make([]Widget, len(ui.widgets))
+	copy(widgets, ui.widgets)
+
+	hovered := ui.WidgetAt(mousePos)
+	for i := len(widgets) - 1; i >= 0; i-- {
+		widget := widgets[i]
+		if hovered != nil && widget != hovered {
+			widget.Update(offscreenPos, mousePressed)
+			continue
+		}
+		widget.Update(mousePos, mousePressed)
+	}
+
+	// Un clic donne le focus au widget survolé
+	if mousePressed && !ui.wasPressed {
+		ui.focused = hovered
+	}
+	ui.wasPressed = mousePressed
+
+	if ui.tooltips != nil {
+		ui.tooltips.Update(mousePos, ui.tooltipTargets(), deltaTime)
+	}
+}
+
+// Render dessine les widgets du plus bas au plus haut
+func (ui *UIManager) Render(renderer Renderer) {
+	for _, widget := range ui.widgets {
+		widget.Render(renderer)
+	}
+}
+
+// tooltipTargets retourne les widgets pouvant afficher une infobulle
+func (ui *UIManager) tooltipTargets() []Tooltipped {
+	targets := make([]Tooltipped, 0, len(ui.widgets))
+	for _, widget := range ui.widgets {
+		if target, ok := widget.(Tooltipped); ok {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// countingButtons crée des boutons qui comptent leurs clics, le n-ième
+// décalé de offset[n] pixels vers la droite
+func countingButtons(clicks []int, offsets ...float64) []*Button {
+	buttons := make([]*Button, len(offsets))
+	for i, offset := range offsets {
+		i := i
+		buttons[i] = NewButton(offset, 0, 100, 40, "", func() { clicks[i]++ })
+	}
+	return buttons
+}
+
+func TestUIManagerWidgetAt(t *testing.T) {
+	// Le bouton 1 chevauche la moitié droite du bouton 0
+	buttons := countingButtons(make([]int, 2), 0, 50)
+	ui := newButtonScreen(nil, buttons)
+
+	tests := []struct {
+		name  string
+		front int // Bouton passé au premier plan (-1 : ordre d'ajout)
+		point Vector2
+		want  Widget
+	}{
+		{"bouton seul", -1, Vector2{10, 10}, buttons[0]},
+		{"chevauchement : dernier ajouté", -1, Vector2{75, 10}, buttons[1]},
+		{"chevauchement après BringToFront", 0, Vector2{75, 10}, buttons[0]},
+		{"hors des boutons", -1, Vector2{500, 10}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ui := newButtonScreen(nil, buttons)
+			if tt.front >= 0 {
+				ui.BringToFront(buttons[tt.front])
+			}
+			if got := ui.WidgetAt(tt.point); got != tt.want {
+				t.Errorf("WidgetAt = %v, attendu %v", got, tt.want)
+			}
+		})
+	}
+
+	ui.RemoveWidget(buttons[1])
+	if got := ui.WidgetAt(Vector2{75, 10}); got != buttons[0] {
+		t.Errorf("après RemoveWidget, WidgetAt = %v, attendu le bouton 0", got)
+	}
+}
+
+func TestUIManagerClickReachesTopWidgetOnly(t *testing.T) {
+	clicks := make([]int, 2)
+	buttons := countingButtons(clicks, 0, 50)
+	ui := newButtonScreen(nil, buttons)
+
+	overlap := Vector2{75, 10}
+	ui.Update(overlap, true, time.Millisecond)
+	ui.Update(overlap, false, time.Millisecond)
+
+	if clicks[0] != 0 || clicks[1] != 1 {
+		t.Errorf("clics = %v, attendu [0 1]", clicks)
+	}
+	if ui.GetFocused() != buttons[1] {
+		t.Error("le clic doit donner le focus au bouton du dessus")
+	}
+
+	// Un clic dans le vide retire le focus
+	ui.Update(Vector2{500, 500}, true, time.Millisecond)
+	if ui.GetFocused() != nil {
+		t.Error("un clic hors des widgets doit retirer le focus")
+	}
+}
+
+func TestUIManagerMoveFocus(t *testing.T) {
+	tests := []struct {
+		name     string
+		disabled int // Bouton désactivé (-1 : aucun)
+		start    int // Bouton ayant le focus (-1 : aucun)
+		step     int
+		want     int // -1 : aucun focus
+	}{
+		{"premier sans focus", -1, -1, 1, 0},
+		{"premier sans focus, vers le haut", -1, -1, -1, 0},
+		{"suivant", -1, 0, 1, 1},
+		{"boucle vers le premier", -1, 2, 1, 0},
+		{"boucle vers le dernier", -1, 0, -1, 2},
+		{"saute un bouton désactivé", 1, 0, 1, 2},
+		{"premier désactivé", 0, -1, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buttons := countingButtons(make([]int, 3), 0, 200, 400)
+			if tt.disabled >= 0 {
+				buttons[tt.disabled].SetEnabled(false)
+			}
+			ui := newButtonScreen(nil, buttons)
+			if tt.start >= 0 {
+				ui.SetFocus(buttons[tt.start])
+			}
+
+			ui.MoveFocus(tt.step)
+			var want Widget
+			if tt.want >= 0 {
+				want = buttons[tt.want]
+			}
+			if ui.GetFocused() != want {
+				t.Errorf("focus = %v, attendu le bouton %d", ui.GetFocused(), tt.want)
+			}
+		})
+	}
+}
+
+func TestUIManagerActivateFocused(t *testing.T) {
+	clicks := make([]int, 2)
+	buttons := countingButtons(clicks, 0, 200)
+	ui := newButtonScreen(nil, buttons)
+
+	if ui.ActivateFocused() {
+		t.Error("sans focus, rien ne doit être activé")
+	}
+
+	ui.SetFocus(buttons[1])
+	if !ui.ActivateFocused() || clicks[1] != 1 {
+		t.Errorf("activation du focus : clics = %v, attendu [0 1]", clicks)
+	}
+
+	// Un bouton désactivé depuis garde le focus mais ne s'active plus
+	buttons[1].SetEnabled(false)
+	if ui.ActivateFocused() || clicks[1] != 1 {
+		t.Errorf("bouton désactivé activé : clics = %v", clicks)
+	}
+
+	// Retirer le widget retire son focus
+	ui.RemoveWidget(buttons[1])
+	if ui.GetFocused() != nil {
+		t.Error("RemoveWidget doit retirer le focus du widget")
+	}
+
+	// SetFocus ignore un widget absent
+	ui.SetFocus(buttons[1])
+	if ui.GetFocused() != nil {
+		t.Error("SetFocus ne doit pas accepter un widget absent")
+	}
+}
+
+func TestUIManagerTooltipFollowsTopWidget(t *testing.T) {
+	buttons := countingButtons(make([]int, 2), 0, 50)
+	buttons[0].Tooltip = "Dessous"
+	buttons[1].Tooltip = "Dessus"
+	tooltips := NewTooltipManager()
+	ui := newButtonScreen(tooltips, buttons)
+
+	overlap := Vector2{75, 10}
+	ui.Update(overlap, false, 0)
+	ui.Update(overlap, false, time.Second)
+	if !tooltips.IsVisible() || tooltips.hovered != buttons[1] {
+		t.Error("l'infobulle doit être celle du bouton du dessus")
+	}
+
+	ui.BringToFront(buttons[0])
+	ui.Update(overlap, false, 0)
+	ui.Update(overlap, false, time.Second)
+	if !tooltips.IsVisible() || tooltips.hovered != buttons[0] {
+		t.Error("après BringToFront, l'infobulle doit suivre le nouveau bouton du dessus")
+	}
+}
+
+// menuNavigator entrées factices de navigation des menus
+type menuNavigator struct {
+	step    int
+	confirm bool
+}
+
+func (mn *menuNavigator) IsActionPressedSystems(action int) bool { return false }
+func (mn *menuNavigator) IsKeyJustPressedSystems(key int) bool   { return false }
+
+func (mn *menuNavigator) MenuNavigation() (int, bool) {
+	step, confirm := mn.step, mn.confirm
+	mn.step, mn.confirm = 0, false
+	return step, confirm
+}
+
+func TestMainMenuKeyboardNavigation(t *testing.T) {
+	esm := NewEnhancedBuiltinStateManager(1280, 720)
+	navigator := &menuNavigator{}
+	esm.SetInputManager(navigator)
+
+	navigator.step = 1
+	esm.Update(time.Second / 60)
+	if esm.menuUI.GetFocused() != esm.buttons[0] {
+		t.Fatalf("focus = %v, attendu le bouton « Nouvelle partie »", esm.menuUI.GetFocused())
+	}
+
+	// Bouger la souris rend la main à la souris
+	esm.UpdateMouseInput(10, 10, false)
+	esm.Update(time.Second / 60)
+	if esm.menuUI.GetFocused() != nil {
+		t.Fatal("la souris doit retirer la sélection au clavier")
+	}
+
+	// Valider sans sélection choisit et active le premier bouton
+	navigator.confirm = true
+	esm.Update(time.Second / 60)
+	if esm.GetCurrentStateType() != StateGameplay {
+		t.Errorf("état = %s, attendu %s", esm.GetCurrentStateType(), StateGameplay)
+	}
+}
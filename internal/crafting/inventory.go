@@ -0,0 +1,74 @@
+// internal/crafting/inventory.go - Inventaire des objets collectés
+package crafting
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ItemStack quantité d'un objet
+type ItemStack struct {
+	ItemID string `yaml:"itemID"`
+	Qty    int    `yaml:"qty"`
+}
+
+// Inventory quantités possédées par identifiant d'objet
+type Inventory struct {
+	items map[string]int
+}
+
+// NewInventory crée un inventaire vide
+func NewInventory() *Inventory {
+	return &Inventory{items: make(map[string]int)}
+}
+
+// Add ajoute des exemplaires d'un objet
+func (inv *Inventory) Add(itemID string, qty int) {
+	if qty <= 0 || itemID == "" {
+		return
+	}
+	inv.items[itemID] += qty
+}
+
+// Remove retire des exemplaires ; échoue sans rien retirer s'il en manque
+func (inv *Inventory) Remove(itemID string, qty int) error {
+	if qty <= 0 {
+		return nil
+	}
+	owned := inv.items[itemID]
+	if owned < qty {
+		return fmt.Errorf("%s: %d requis, %d possédé(s)", itemID, qty, owned)
+	}
+
+	if owned == qty {
+		delete(inv.items, itemID)
+	} else {
+		inv.items[itemID] = owned - qty
+	}
+	return nil
+}
+
+// Count retourne le nombre d'exemplaires possédés
+func (inv *Inventory) Count(itemID string) int {
+	return inv.items[itemID]
+}
+
+// Has vérifie qu'au moins qty exemplaires sont possédés
+func (inv *Inventory) Has(itemID string, qty int) bool {
+	return inv.items[itemID] >= qty
+}
+
+// Items retourne le contenu de l'inventaire, trié par identifiant
+func (inv *Inventory) Items() []ItemStack {
+	stacks := make([]ItemStack, 0, len(inv.items))
+	for id, qty := range inv.items {
+		stacks = append(stacks, ItemStack{ItemID: id, Qty: qty})
+	}
+	sort.Slice(stacks, func(i, j int) bool { return stacks[i].ItemID < stacks[j].ItemID })
+	return stacks
+}
+
+// Clear vide l'inventaire
+func (inv *Inventory) Clear() {
+	inv.items = make(map[string]int)
+}
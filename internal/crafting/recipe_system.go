@@ -0,0 +1,164 @@
+// internal/crafting/recipe_system.go - Recettes de fabrication définies en YAML
+package crafting
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRecipesFile fichier des recettes, relatif au dossier de données
+const DefaultRecipesFile = "recipes.yaml"
+
+// WorkbenchBonfire établi fourni par un feu de camp à portée
+const WorkbenchBonfire = "bonfire"
+
+// Erreurs de fabrication
+var (
+	ErrUnknownRecipe     = errors.New("recette inconnue")
+	ErrMissingInputs     = errors.New("ingrédients manquants")
+	ErrWorkbenchRequired = errors.New("établi requis")
+)
+
+// Recipe recette : ingrédients consommés, objet produit et établi nécessaire
+type Recipe struct {
+	ID                string      `yaml:"id"`
+	Inputs            []ItemStack `yaml:"inputs"`
+	Output            ItemStack   `yaml:"output"`
+	RequiredWorkbench string      `yaml:"requiredWorkbench"` // Vide : fabricable partout
+	CraftTime         float64     `yaml:"craftTime"`         // en secondes (0 : immédiat)
+}
+
+// recipesFile structure du fichier YAML des recettes
+type recipesFile struct {
+	Recipes []*Recipe `yaml:"recipes"`
+}
+
+// RecipeSystem recettes connues et établi à portée du joueur
+type RecipeSystem struct {
+	recipes   []*Recipe
+	byID      map[string]*Recipe
+	workbench string // Établi à portée ("" : aucun)
+}
+
+// NewRecipeSystem crée un système à partir de recettes ; les doublons sont ignorés
+func NewRecipeSystem(recipes []*Recipe) *RecipeSystem {
+	rs := &RecipeSystem{
+		recipes: make([]*Recipe, 0, len(recipes)),
+		byID:    make(map[string]*Recipe, len(recipes)),
+	}
+	for _, recipe := range recipes {
+		if recipe == nil || recipe.ID == "" {
+			continue
+		}
+		if _, exists := rs.byID[recipe.ID]; exists {
+			fmt.Printf("⚠ Recette '%s' en double ignorée\n", recipe.ID)
+			continue
+		}
+		rs.recipes = append(rs.recipes, recipe)
+		rs.byID[recipe.ID] = recipe
+	}
+	return rs
+}
+
+// LoadRecipes charge les recettes d'un fichier YAML
+func LoadRecipes(path string) (*RecipeSystem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossible de lire %s: %w", path, err)
+	}
+
+	var file recipesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("recettes invalides %s: %w", path, err)
+	}
+
+	for i, recipe := range file.Recipes {
+		if err := recipe.validate(); err != nil {
+			return nil, fmt.Errorf("recette %d de %s: %w", i, path, err)
+		}
+	}
+	return NewRecipeSystem(file.Recipes), nil
+}
+
+// validate vérifie qu'une recette est exploitable
+func (r *Recipe) validate() error {
+	if r == nil || r.ID == "" {
+		return errors.New("identifiant manquant")
+	}
+	if r.Output.ItemID == "" || r.Output.Qty <= 0 {
+		return fmt.Errorf("'%s': objet produit invalide", r.ID)
+	}
+	for _, input := range r.Inputs {
+		if input.ItemID == "" || input.Qty <= 0 {
+			return fmt.Errorf("'%s': ingrédient invalide", r.ID)
+		}
+	}
+	return nil
+}
+
+// GetRecipes retourne les recettes dans l'ordre du fichier
+func (rs *RecipeSystem) GetRecipes() []*Recipe {
+	return rs.recipes
+}
+
+// GetRecipe retourne une recette par identifiant
+func (rs *RecipeSystem) GetRecipe(recipeID string) (*Recipe, bool) {
+	recipe, exists := rs.byID[recipeID]
+	return recipe, exists
+}
+
+// SetWorkbench définit l'établi à portée du joueur ("" : aucun)
+func (rs *RecipeSystem) SetWorkbench(workbench string) {
+	rs.workbench = workbench
+}
+
+// GetWorkbench retourne l'établi à portée du joueur
+func (rs *RecipeSystem) GetWorkbench() string {
+	return rs.workbench
+}
+
+// CanCraft vérifie l'établi puis les ingrédients ; retourne nil si la recette est fabricable
+func (rs *RecipeSystem) CanCraft(recipeID string, inventory *Inventory) error {
+	recipe, exists := rs.byID[recipeID]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrUnknownRecipe, recipeID)
+	}
+
+	if recipe.RequiredWorkbench != "" && recipe.RequiredWorkbench != rs.workbench {
+		return fmt.Errorf("%w: %s", ErrWorkbenchRequired, recipe.RequiredWorkbench)
+	}
+
+	missing := make([]string, 0)
+	for _, input := range recipe.Inputs {
+		if owned := inventory.Count(input.ItemID); owned < input.Qty {
+			missing = append(missing, fmt.Sprintf("%s %d/%d", input.ItemID, owned, input.Qty))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingInputs, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Craft consomme les ingrédients et ajoute l'objet produit à l'inventaire
+func (rs *RecipeSystem) Craft(recipeID string, inventory *Inventory) error {
+	if err := rs.CanCraft(recipeID, inventory); err != nil {
+		return err
+	}
+
+	recipe := rs.byID[recipeID]
+	for _, input := range recipe.Inputs {
+		if err := inventory.Remove(input.ItemID, input.Qty); err != nil {
+			// Impossible après CanCraft : les ingrédients ont été vérifiés
+			return fmt.Errorf("%w: %v", ErrMissingInputs, err)
+		}
+	}
+	inventory.Add(recipe.Output.ItemID, recipe.Output.Qty)
+
+	fmt.Printf("✓ Fabriqué: %d x %s\n", recipe.Output.Qty, recipe.Output.ItemID)
+	return nil
+}
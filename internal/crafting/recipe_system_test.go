@@ -0,0 +1,143 @@
+package crafting
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testRecipes une recette libre et une recette gardée par le feu de camp
+func testRecipes() *RecipeSystem {
+	return NewRecipeSystem([]*Recipe{
+		{
+			ID:     "baume",
+			Inputs: []ItemStack{{ItemID: "mousse", Qty: 2}},
+			Output: ItemStack{ItemID: "baume", Qty: 1},
+		},
+		{
+			ID:                "titanite",
+			Inputs:            []ItemStack{{ItemID: "éclat", Qty: 2}, {ItemID: "mousse", Qty: 1}},
+			Output:            ItemStack{ItemID: "titanite", Qty: 1},
+			RequiredWorkbench: WorkbenchBonfire,
+		},
+	})
+}
+
+func TestCraft(t *testing.T) {
+	tests := []struct {
+		name      string
+		recipe    string
+		workbench string
+		owned     map[string]int
+		wantErr   error
+		wantAfter map[string]int
+	}{
+		{
+			name:      "fabrication réussie",
+			recipe:    "baume",
+			owned:     map[string]int{"mousse": 3},
+			wantAfter: map[string]int{"mousse": 1, "baume": 1},
+		},
+		{
+			name:      "ingrédients manquants",
+			recipe:    "baume",
+			owned:     map[string]int{"mousse": 1},
+			wantErr:   ErrMissingInputs,
+			wantAfter: map[string]int{"mousse": 1, "baume": 0},
+		},
+		{
+			name:      "établi absent",
+			recipe:    "titanite",
+			owned:     map[string]int{"éclat": 2, "mousse": 1},
+			wantErr:   ErrWorkbenchRequired,
+			wantAfter: map[string]int{"éclat": 2, "mousse": 1, "titanite": 0},
+		},
+		{
+			name:      "mauvais établi",
+			recipe:    "titanite",
+			workbench: "enclume",
+			owned:     map[string]int{"éclat": 2, "mousse": 1},
+			wantErr:   ErrWorkbenchRequired,
+			wantAfter: map[string]int{"éclat": 2, "titanite": 0},
+		},
+		{
+			name:      "établi à portée",
+			recipe:    "titanite",
+			workbench: WorkbenchBonfire,
+			owned:     map[string]int{"éclat": 2, "mousse": 1},
+			wantAfter: map[string]int{"éclat": 0, "mousse": 0, "titanite": 1},
+		},
+		{
+			name:      "un seul ingrédient manquant ne consomme rien",
+			recipe:    "titanite",
+			workbench: WorkbenchBonfire,
+			owned:     map[string]int{"éclat": 2},
+			wantErr:   ErrMissingInputs,
+			wantAfter: map[string]int{"éclat": 2, "titanite": 0},
+		},
+		{
+			name:    "recette inconnue",
+			recipe:  "épée",
+			wantErr: ErrUnknownRecipe,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := testRecipes()
+			rs.SetWorkbench(tt.workbench)
+			inventory := NewInventory()
+			for id, qty := range tt.owned {
+				inventory.Add(id, qty)
+			}
+
+			err := rs.Craft(tt.recipe, inventory)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Craft = %v, attendu %v", err, tt.wantErr)
+			}
+			for id, want := range tt.wantAfter {
+				if got := inventory.Count(id); got != want {
+					t.Errorf("%s = %d, attendu %d", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadRecipes(t *testing.T) {
+	rs, err := LoadRecipes(filepath.Join("..", "..", "assets", "data", DefaultRecipesFile))
+	if err != nil {
+		t.Fatalf("LoadRecipes: %v", err)
+	}
+	if len(rs.GetRecipes()) == 0 {
+		t.Fatal("aucune recette chargée")
+	}
+	for _, recipe := range rs.GetRecipes() {
+		if err := recipe.validate(); err != nil {
+			t.Errorf("recette livrée invalide: %v", err)
+		}
+	}
+
+	invalid := filepath.Join(t.TempDir(), "recipes.yaml")
+	data := "recipes:\n  - id: vide\n    output: {itemID: rien, qty: 0}\n"
+	if err := os.WriteFile(invalid, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRecipes(invalid); err == nil {
+		t.Error("une recette sans objet produit doit être refusée")
+	}
+}
+
+func TestNewRecipeSystemIgnoresDuplicates(t *testing.T) {
+	first := &Recipe{ID: "baume", Output: ItemStack{ItemID: "baume", Qty: 1}}
+	second := &Recipe{ID: "baume", Output: ItemStack{ItemID: "autre", Qty: 1}}
+
+	rs := NewRecipeSystem([]*Recipe{first, nil, second})
+	if len(rs.GetRecipes()) != 1 {
+		t.Fatalf("%d recettes, attendu 1", len(rs.GetRecipes()))
+	}
+	if recipe, _ := rs.GetRecipe("baume"); recipe != first {
+		t.Error("la première définition doit être conservée")
+	}
+}
@@ -0,0 +1,79 @@
+// internal/ecs/components/challenge_components.go - Composants des salles de défi
+package components
+
+import (
+	"time"
+)
+
+// ===============================
+// COMPOSANT DE SALLE DE DÉFI
+// ===============================
+
+// ChallengeRoomComponent chronomètre une salle à nettoyer avant la fin du temps
+type ChallengeRoomComponent struct {
+	TimeLimit  time.Duration
+	Completed  bool
+	Failed     bool
+	BestTime   time.Duration // 0 si jamais terminée
+	BonusSouls int           // Récompense en cas de réussite
+
+	// Chronomètre
+	Active  bool
+	Elapsed time.Duration
+}
+
+// NewChallengeRoomComponent crée une salle de défi
+func NewChallengeRoomComponent(timeLimit time.Duration, bonusSouls int) *ChallengeRoomComponent {
+	return &ChallengeRoomComponent{
+		TimeLimit:  timeLimit,
+		BonusSouls: bonusSouls,
+	}
+}
+
+// Start démarre le compte à rebours
+func (crc *ChallengeRoomComponent) Start() {
+	crc.Active = true
+	crc.Elapsed = 0
+	crc.Completed = false
+	crc.Failed = false
+}
+
+// Remaining retourne le temps restant
+func (crc *ChallengeRoomComponent) Remaining() time.Duration {
+	remaining := crc.TimeLimit - crc.Elapsed
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// Update fait avancer le chronomètre et retourne true si le temps vient d'expirer
+func (crc *ChallengeRoomComponent) Update(deltaTime time.Duration) bool {
+	if !crc.Active {
+		return false
+	}
+
+	crc.Elapsed += deltaTime
+	if crc.Elapsed >= crc.TimeLimit {
+		crc.Elapsed = crc.TimeLimit
+		crc.Active = false
+		crc.Failed = true
+		return true
+	}
+	return false
+}
+
+// Complete termine le défi et retourne true si le temps est un nouveau record
+func (crc *ChallengeRoomComponent) Complete() bool {
+	if !crc.Active {
+		return false
+	}
+
+	crc.Active = false
+	crc.Completed = true
+	if crc.BestTime == 0 || crc.Elapsed < crc.BestTime {
+		crc.BestTime = crc.Elapsed
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package components
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChallengeCountdownExpiry(t *testing.T) {
+	tests := []struct {
+		name          string
+		steps         []time.Duration
+		wantExpired   bool
+		wantFailed    bool
+		wantRemaining time.Duration
+	}{
+		{"en cours", []time.Duration{4 * time.Second}, false, false, 6 * time.Second},
+		{"pile à la limite", []time.Duration{6 * time.Second, 4 * time.Second}, true, true, 0},
+		{"au-delà de la limite", []time.Duration{12 * time.Second}, true, true, 0},
+		{"juste avant la limite", []time.Duration{9999 * time.Millisecond}, false, false, time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challenge := NewChallengeRoomComponent(10*time.Second, 50)
+			challenge.Start()
+
+			expired := false
+			for _, step := range tt.steps {
+				expired = challenge.Update(step)
+			}
+
+			if expired != tt.wantExpired {
+				t.Errorf("Update = %t, attendu %t", expired, tt.wantExpired)
+			}
+			if challenge.Failed != tt.wantFailed {
+				t.Errorf("Failed = %t, attendu %t", challenge.Failed, tt.wantFailed)
+			}
+			if challenge.Remaining() != tt.wantRemaining {
+				t.Errorf("Remaining = %v, attendu %v", challenge.Remaining(), tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestChallengeExpiresOnce(t *testing.T) {
+	challenge := NewChallengeRoomComponent(time.Second, 0)
+	challenge.Start()
+
+	if !challenge.Update(2 * time.Second) {
+		t.Fatal("le chrono doit expirer")
+	}
+	if challenge.Update(time.Second) {
+		t.Error("l'expiration ne doit être signalée qu'une fois")
+	}
+	if challenge.Complete() {
+		t.Error("un défi échoué ne peut plus être réussi")
+	}
+	if challenge.Completed {
+		t.Error("Completed = true, attendu false après l'échec")
+	}
+}
+
+func TestChallengeBestTime(t *testing.T) {
+	challenge := NewChallengeRoomComponent(30*time.Second, 0)
+
+	runs := []struct {
+		elapsed    time.Duration
+		wantRecord bool
+		wantBest   time.Duration
+	}{
+		{20 * time.Second, true, 20 * time.Second},
+		{25 * time.Second, false, 20 * time.Second},
+		{15 * time.Second, true, 15 * time.Second},
+	}
+
+	for i, run := range runs {
+		challenge.Start()
+		challenge.Update(run.elapsed)
+		if record := challenge.Complete(); record != run.wantRecord {
+			t.Errorf("essai %d : record = %t, attendu %t", i, record, run.wantRecord)
+		}
+		if challenge.BestTime != run.wantBest {
+			t.Errorf("essai %d : BestTime = %v, attendu %v", i, challenge.BestTime, run.wantBest)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// internal/ecs/components/combat_components.go - Composants ECS de combat
+package components
+
+import (
+	"math"
+	"time"
+)
+
+// ===============================
+// COMPOSANT DE BLOCAGE
+// ===============================
+
+// Angles de blocage
+const (
+	BlockHalfAngle        = 60.0 * math.Pi / 180.0 // Cône de blocage (±60°)
+	PerfectBlockHalfAngle = 10.0 * math.Pi / 180.0 // Cône de blocage parfait (±10°)
+)
+
+// BlockResult résultat d'une attaque face à un blocage
+type BlockResult int
+
+const (
+	BlockNone    BlockResult = iota // Attaque non bloquée
+	BlockPartial                    // Dégâts réduits
+	BlockPerfect                    // Dégâts annulés, l'attaquant est déséquilibré
+)
+
+// BlockComponent gère la garde au bouclier
+type BlockComponent struct {
+	Active           bool
+	BlockAngle       float64 // Orientation de la garde en radians
+	DamageReduction  float64 // Fraction des dégâts absorbée (0.8 = 80%)
+	StaminaDrainRate float64 // Stamina consommée par seconde de garde
+
+	// Fenêtre de blocage parfait après la levée de la garde
+	PerfectWindow time.Duration
+	ActiveTime    time.Duration // Temps depuis la levée de la garde
+}
+
+// NewBlockComponent crée un nouveau composant de blocage
+func NewBlockComponent() *BlockComponent {
+	return &BlockComponent{
+		DamageReduction:  0.8,
+		StaminaDrainRate: 10.0,
+		PerfectWindow:    time.Millisecond * 200,
+	}
+}
+
+// SetActive lève ou baisse la garde
+func (bc *BlockComponent) SetActive(active bool) {
+	if active && !bc.Active {
+		bc.ActiveTime = 0
+	}
+	bc.Active = active
+}
+
+// Update fait avancer le temps de garde
+func (bc *BlockComponent) Update(deltaTime time.Duration) {
+	if bc.Active {
+		bc.ActiveTime += deltaTime
+	}
+}
+
+// Resolve détermine l'effet de la garde contre une attaque venant de attackAngle
+// (angle du défenseur vers l'attaquant, en radians)
+func (bc *BlockComponent) Resolve(attackAngle float64) BlockResult {
+	if !bc.Active {
+		return BlockNone
+	}
+
+	diff := math.Abs(AngleDifference(attackAngle, bc.BlockAngle))
+	if diff <= PerfectBlockHalfAngle && bc.ActiveTime <= bc.PerfectWindow {
+		return BlockPerfect
+	}
+	if diff <= BlockHalfAngle {
+		return BlockPartial
+	}
+	return BlockNone
+}
+
+// ApplyTo retourne les dégâts restants après la garde
+func (bc *BlockComponent) ApplyTo(damage int, result BlockResult) int {
+	switch result {
+	case BlockPerfect:
+		return 0
+	case BlockPartial:
+		return int(math.Round(float64(damage) * (1 - bc.DamageReduction)))
+	default:
+		return damage
+	}
+}
+
+// AngleDifference retourne la différence signée a-b ramenée dans [-π, π]
+func AngleDifference(a, b float64) float64 {
+	diff := math.Mod(a-b, 2*math.Pi)
+	if diff > math.Pi {
+		diff -= 2 * math.Pi
+	} else if diff < -math.Pi {
+		diff += 2 * math.Pi
+	}
+	return diff
+}
+
+// ===============================
+// ZONE DE FRAPPE
+// ===============================
+
+// HitboxComponent zone touchée par une attaque pendant ses frames actives
+type HitboxComponent struct {
+	Bounds          Rectangle
+	Heavy           bool // Attaque lourde
+	CanDestroyTiles bool // L'attaque brise les tuiles destructibles (espadon)
+}
+
+// NewSwingHitbox retourne la zone d'un coup porté depuis origin vers facing
+// (vecteur unitaire) : un carré de côté reach, posé devant l'attaquant
+func NewSwingHitbox(origin, facing Vector2, reach float64) HitboxComponent {
+	center := origin.Add(facing.Mul(reach / 2))
+	return HitboxComponent{
+		Bounds: Rectangle{X: center.X - reach/2, Y: center.Y - reach/2, Width: reach, Height: reach},
+	}
+}
@@ -0,0 +1,87 @@
+package components
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBlockResolveAngles(t *testing.T) {
+	deg := func(d float64) float64 { return d * math.Pi / 180 }
+
+	tests := []struct {
+		name       string
+		active     bool
+		angle      float64 // Écart entre l'attaque et la garde
+		activeTime time.Duration
+		want       BlockResult
+	}{
+		{"garde baissée", false, 0, 0, BlockNone},
+		{"face à face dans la fenêtre", true, 0, 100 * time.Millisecond, BlockPerfect},
+		{"limite du parfait", true, deg(9.9), 0, BlockPerfect},
+		{"parfait hors fenêtre", true, deg(5), 300 * time.Millisecond, BlockPartial},
+		{"juste hors du parfait", true, deg(11), 0, BlockPartial},
+		{"côté opposé du cône", true, deg(-45), 0, BlockPartial},
+		{"limite du cône", true, deg(59.9), time.Second, BlockPartial},
+		{"juste hors du cône", true, deg(61), 0, BlockNone},
+		{"dans le dos", true, deg(180), 0, BlockNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := NewBlockComponent()
+			block.BlockAngle = math.Pi / 2 // Garde vers le bas de l'écran
+			block.SetActive(tt.active)
+			block.Update(tt.activeTime)
+
+			if got := block.Resolve(block.BlockAngle + tt.angle); got != tt.want {
+				t.Errorf("Resolve = %d, attendu %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockResolveWrapsAngles(t *testing.T) {
+	block := NewBlockComponent()
+	block.BlockAngle = math.Pi - 0.05
+	block.SetActive(true)
+	block.Update(time.Second)
+
+	// -π + 0.05 est à 0.1 rad de la garde en passant par π
+	if got := block.Resolve(-math.Pi + 0.05); got != BlockPartial {
+		t.Errorf("Resolve = %d, attendu %d (BlockPartial)", got, BlockPartial)
+	}
+}
+
+func TestBlockApplyTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		result BlockResult
+		want   int
+	}{
+		{"non bloqué", BlockNone, 50},
+		{"bloqué", BlockPartial, 10},
+		{"parfait", BlockPerfect, 0},
+	}
+
+	block := NewBlockComponent()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := block.ApplyTo(50, tt.result); got != tt.want {
+				t.Errorf("ApplyTo(50) = %d, attendu %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockRaiseResetsPerfectWindow(t *testing.T) {
+	block := NewBlockComponent()
+	block.SetActive(true)
+	block.Update(time.Second)
+	block.SetActive(false)
+	block.SetActive(true)
+
+	if block.ActiveTime != 0 {
+		t.Errorf("ActiveTime = %v, attendu 0 après une nouvelle levée de garde", block.ActiveTime)
+	}
+}
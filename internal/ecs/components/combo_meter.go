@@ -0,0 +1,92 @@
+// internal/ecs/components/combo_meter.go - Compteur de coups enchaînés
+package components
+
+import (
+	"math"
+	"time"
+)
+
+// Réglages du combo
+const (
+	DefaultComboResetThreshold = 2 * time.Second
+	ComboMultiplierStep        = 0.1 // Bonus de récompense par coup enchaîné
+	MaxComboMultiplier         = 3.0
+)
+
+// ComboMilestones paliers de combo récompensés par un « COMBO BONUS »
+var ComboMilestones = []int{10, 25, 50}
+
+// ComboMeter compte les coups portés sans laisser passer plus de
+// ResetThreshold entre deux ; Multiplier s'applique aux âmes et à
+// l'expérience gagnées
+type ComboMeter struct {
+	Count          int
+	Timer          time.Duration // Temps écoulé depuis le dernier coup
+	ResetThreshold time.Duration
+	Multiplier     float64
+	Best           int // Meilleur combo depuis la création
+}
+
+// NewComboMeter crée un compteur vide
+func NewComboMeter() *ComboMeter {
+	return &ComboMeter{
+		ResetThreshold: DefaultComboResetThreshold,
+		Multiplier:     1,
+	}
+}
+
+// ComboMultiplier retourne le multiplicateur de récompense d'un combo de
+// count coups : 1 + count × 0,1, plafonné à MaxComboMultiplier
+func ComboMultiplier(count int) float64 {
+	return math.Min(1+float64(count)*ComboMultiplierStep, MaxComboMultiplier)
+}
+
+// IsComboMilestone retourne si count est un palier de ComboMilestones
+func IsComboMilestone(count int) bool {
+	for _, milestone := range ComboMilestones {
+		if count == milestone {
+			return true
+		}
+	}
+	return false
+}
+
+// Hit compte un coup porté ; retourne si le combo vient d'atteindre un palier
+func (cm *ComboMeter) Hit() bool {
+	cm.Count++
+	cm.Timer = 0
+	cm.Multiplier = ComboMultiplier(cm.Count)
+	if cm.Count > cm.Best {
+		cm.Best = cm.Count
+	}
+	return IsComboMilestone(cm.Count)
+}
+
+// Update fait avancer le délai depuis le dernier coup ; retourne si le combo
+// vient d'être perdu
+func (cm *ComboMeter) Update(deltaTime time.Duration) bool {
+	if cm.Count == 0 {
+		return false
+	}
+	cm.Timer += deltaTime
+	if cm.Timer < cm.ResetThreshold {
+		return false
+	}
+	cm.Reset()
+	return true
+}
+
+// Reset remet le combo à zéro
+func (cm *ComboMeter) Reset() {
+	cm.Count = 0
+	cm.Timer = 0
+	cm.Multiplier = 1
+}
+
+// Remaining retourne la fraction (0 à 1) du délai restant avant la perte du combo
+func (cm *ComboMeter) Remaining() float64 {
+	if cm.Count == 0 || cm.ResetThreshold <= 0 {
+		return 0
+	}
+	return Clamp(1-float64(cm.Timer)/float64(cm.ResetThreshold), 0, 1)
+}
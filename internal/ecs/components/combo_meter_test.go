@@ -0,0 +1,120 @@
+package components
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestComboMultiplier(t *testing.T) {
+	tests := []struct {
+		count int
+		want  float64
+	}{
+		{0, 1},
+		{1, 1.1},
+		{5, 1.5},
+		{19, 2.9},
+		{20, 3},
+		{21, 3},
+		{100, 3},
+	}
+
+	for _, tt := range tests {
+		if got := ComboMultiplier(tt.count); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ComboMultiplier(%d) = %.2f, attendu %.2f", tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestComboMeterMultiplierFollowsHits(t *testing.T) {
+	cm := NewComboMeter()
+	for i := 0; i < 25; i++ {
+		cm.Hit()
+	}
+	if cm.Multiplier != MaxComboMultiplier {
+		t.Errorf("Multiplier = %.2f, attendu %.2f", cm.Multiplier, MaxComboMultiplier)
+	}
+
+	cm.Reset()
+	if cm.Multiplier != 1 || cm.Best != 25 {
+		t.Errorf("après Reset : Multiplier = %.2f, Best = %d, attendu 1 et 25", cm.Multiplier, cm.Best)
+	}
+}
+
+func TestComboMeterResetTiming(t *testing.T) {
+	tests := []struct {
+		name      string
+		steps     []time.Duration // Délais successifs, un coup avant chacun
+		wantCount int
+		wantLost  bool
+	}{
+		{"juste sous le seuil", []time.Duration{1999 * time.Millisecond}, 1, false},
+		{"au seuil", []time.Duration{2 * time.Second}, 0, true},
+		{"coup qui relance le délai", []time.Duration{1500 * time.Millisecond, 1500 * time.Millisecond}, 2, false},
+		{"perdu après un enchaînement", []time.Duration{time.Second, 2500 * time.Millisecond}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := NewComboMeter()
+			lost := false
+			for _, step := range tt.steps {
+				cm.Hit()
+				lost = cm.Update(step)
+			}
+
+			if cm.Count != tt.wantCount {
+				t.Errorf("Count = %d, attendu %d", cm.Count, tt.wantCount)
+			}
+			if lost != tt.wantLost {
+				t.Errorf("perdu = %t, attendu %t", lost, tt.wantLost)
+			}
+		})
+	}
+}
+
+func TestComboMeterIdleUpdate(t *testing.T) {
+	cm := NewComboMeter()
+	if cm.Update(time.Minute) {
+		t.Error("un combo vide ne peut pas être perdu")
+	}
+	if cm.Remaining() != 0 {
+		t.Errorf("Remaining = %.2f, attendu 0", cm.Remaining())
+	}
+
+	cm.Hit()
+	cm.Update(500 * time.Millisecond)
+	if math.Abs(cm.Remaining()-0.75) > 1e-9 {
+		t.Errorf("Remaining = %.2f, attendu 0.75", cm.Remaining())
+	}
+}
+
+func TestComboMeterMilestones(t *testing.T) {
+	cm := NewComboMeter()
+	var milestones []int
+	for i := 0; i < 60; i++ {
+		if cm.Hit() {
+			milestones = append(milestones, cm.Count)
+		}
+	}
+
+	want := []int{10, 25, 50}
+	if len(milestones) != len(want) {
+		t.Fatalf("paliers = %v, attendu %v", milestones, want)
+	}
+	for i := range want {
+		if milestones[i] != want[i] {
+			t.Errorf("paliers = %v, attendu %v", milestones, want)
+		}
+	}
+
+	// Un palier se gagne à nouveau après la perte du combo
+	cm.Reset()
+	for i := 0; i < 9; i++ {
+		cm.Hit()
+	}
+	if !cm.Hit() {
+		t.Error("le palier 10 doit être signalé à nouveau après un Reset")
+	}
+}
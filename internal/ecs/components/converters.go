@@ -104,4 +104,16 @@ func Max(a, b float64) float64 {
 		return a
 	}
 	return b
-}
\ No newline at end of file
+}
+
+// Lerp effectue une interpolation linéaire entre a et b ; core.Lerp y renvoie
+func Lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// SmoothStep interpolation cubique 3t² - 2t³ entre a et b, t borné à [0, 1] ;
+// core.SmoothStep y renvoie
+func SmoothStep(a, b, t float64) float64 {
+	t = Clamp(t, 0, 1)
+	return Lerp(a, b, t*t*(3-2*t))
+}
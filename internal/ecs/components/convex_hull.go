@@ -0,0 +1,195 @@
+// internal/ecs/components/convex_hull.go - Collisions convexes (théorème des axes séparateurs)
+package components
+
+import "math"
+
+// ===============================
+// FORMES CONVEXES
+// ===============================
+
+// ConvexShape forme convexe en coordonnées monde, testée par SAT
+type ConvexShape interface {
+	WorldVertices() []Vector2
+}
+
+// Polygon sommets d'un polygone convexe en coordonnées monde, dans l'ordre du contour.
+// Un seul sommet représente un point, deux un segment.
+type Polygon []Vector2
+
+// WorldVertices retourne les sommets du polygone
+func (p Polygon) WorldVertices() []Vector2 {
+	return p
+}
+
+// RectanglePolygon convertit une boîte alignée sur les axes en polygone
+func RectanglePolygon(rect Rectangle) Polygon {
+	return Polygon{
+		{X: rect.X, Y: rect.Y},
+		{X: rect.X + rect.Width, Y: rect.Y},
+		{X: rect.X + rect.Width, Y: rect.Y + rect.Height},
+		{X: rect.X, Y: rect.Y + rect.Height},
+	}
+}
+
+// ===============================
+// COMPOSANT ENVELOPPE CONVEXE
+// ===============================
+
+// ConvexHullCollider zone de collision convexe pour les corps qui
+// remplissent mal une boîte (araignée, serpent...)
+type ConvexHullCollider struct {
+	Vertices  []Vector2 // Sommets en espace local, autour de la position de l'entité
+	Offset    Vector2
+	Layer     CollisionLayer
+	Mask      CollisionMask
+	IsTrigger bool
+	Enabled   bool
+}
+
+// NewConvexHullCollider crée une enveloppe à partir de sommets locaux convexes
+func NewConvexHullCollider(vertices []Vector2, layer CollisionLayer) *ConvexHullCollider {
+	return &ConvexHullCollider{
+		Vertices: vertices,
+		Layer:    layer,
+		Mask:     0xFFFFFFFF, // Collisionne avec tout par défaut
+		Enabled:  true,
+	}
+}
+
+// WorldShape retourne l'enveloppe placée à une position du monde
+func (hc *ConvexHullCollider) WorldShape(position Vector2) Polygon {
+	origin := position.Add(hc.Offset)
+	shape := make(Polygon, len(hc.Vertices))
+	for i, vertex := range hc.Vertices {
+		shape[i] = origin.Add(vertex)
+	}
+	return shape
+}
+
+// GetWorldBounds retourne la boîte englobante dans le monde (broad-phase)
+func (hc *ConvexHullCollider) GetWorldBounds(position Vector2) Rectangle {
+	if len(hc.Vertices) == 0 {
+		origin := position.Add(hc.Offset)
+		return Rectangle{X: origin.X, Y: origin.Y}
+	}
+
+	shape := hc.WorldShape(position)
+	minX, minY := shape[0].X, shape[0].Y
+	maxX, maxY := minX, minY
+	for _, vertex := range shape[1:] {
+		minX, maxX = math.Min(minX, vertex.X), math.Max(maxX, vertex.X)
+		minY, maxY = math.Min(minY, vertex.Y), math.Max(maxY, vertex.Y)
+	}
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// ===============================
+// THÉORÈME DES AXES SÉPARATEURS
+// ===============================
+
+// SAT teste le chevauchement de deux formes convexes.
+// En cas de collision, retourne le vecteur de pénétration minimal : sa direction
+// est la normale de collision (de a vers b), sa longueur la profondeur.
+// Déplacer a de -mtv (ou b de +mtv) sépare les formes.
+// Des formes qui se touchent seulement ne sont pas en collision.
+func SAT(a, b ConvexShape) (bool, Vector2) {
+	verticesA := a.WorldVertices()
+	verticesB := b.WorldVertices()
+	if len(verticesA) == 0 || len(verticesB) == 0 {
+		return false, Vector2{}
+	}
+
+	centerA := polygonCenter(verticesA)
+	centerB := polygonCenter(verticesB)
+
+	axes := append(edgeNormals(verticesA), edgeNormals(verticesB)...)
+	if len(axes) == 0 {
+		// Deux points : seul l'axe qui les relie peut les séparer
+		diff := centerB.Sub(centerA)
+		length := math.Hypot(diff.X, diff.Y)
+		if length == 0 {
+			return true, Vector2{}
+		}
+		axes = append(axes, diff.Mul(1/length))
+	}
+
+	bestDepth := math.Inf(1)
+	var bestAxis Vector2
+	for _, axis := range axes {
+		minA, maxA := project(verticesA, axis)
+		minB, maxB := project(verticesB, axis)
+
+		// Un contact sans épaisseur ne compte que pour un point ou un segment
+		// (projection de largeur nulle), sans quoi il ne serait jamais détecté
+		depth := math.Min(maxA, maxB) - math.Max(minA, minB)
+		if depth < 0 || (depth == 0 && maxA > minA && maxB > minB) {
+			return false, Vector2{}
+		}
+
+		// Une projection contenue dans l'autre : il faut sortir par le bord le plus proche
+		if (minA <= minB && maxA >= maxB) || (minB <= minA && maxB >= maxA) {
+			depth += math.Min(math.Abs(minA-minB), math.Abs(maxA-maxB))
+		}
+
+		if depth < bestDepth {
+			bestDepth = depth
+			bestAxis = axis
+		}
+	}
+
+	// La normale pointe de a vers b
+	if dot(centerB.Sub(centerA), bestAxis) < 0 {
+		bestAxis = bestAxis.Mul(-1)
+	}
+	return true, bestAxis.Mul(bestDepth)
+}
+
+// edgeNormals retourne les normales unitaires des arêtes d'un polygone
+func edgeNormals(vertices []Vector2) []Vector2 {
+	count := len(vertices)
+	if count < 2 {
+		return nil
+	}
+	// Un segment n'a qu'une arête
+	edges := count
+	if count == 2 {
+		edges = 1
+	}
+
+	normals := make([]Vector2, 0, edges)
+	for i := 0; i < edges; i++ {
+		edge := vertices[(i+1)%count].Sub(vertices[i])
+		length := math.Hypot(edge.X, edge.Y)
+		if length == 0 {
+			continue
+		}
+		normals = append(normals, Vector2{X: -edge.Y / length, Y: edge.X / length})
+	}
+	return normals
+}
+
+// project retourne l'intervalle de projection des sommets sur un axe
+func project(vertices []Vector2, axis Vector2) (float64, float64) {
+	minimum := dot(vertices[0], axis)
+	maximum := minimum
+	for _, vertex := range vertices[1:] {
+		value := dot(vertex, axis)
+		minimum = math.Min(minimum, value)
+		maximum = math.Max(maximum, value)
+	}
+	return minimum, maximum
+}
+
+// polygonCenter retourne la moyenne des sommets
+func polygonCenter(vertices []Vector2) Vector2 {
+	center := Vector2{}
+	for _, vertex := range vertices {
+		center = center.Add(vertex)
+	}
+	return center.Mul(1 / float64(len(vertices)))
+}
+
+// dot produit scalaire
+func dot(a, b Vector2) float64 {
+	return a.X*b.X + a.Y*b.Y
+}
@@ -0,0 +1,98 @@
+package components
+
+import (
+	"math"
+	"testing"
+)
+
+// diamond losange centré en (cx, cy), de demi-diagonale r
+func diamond(cx, cy, r float64) Polygon {
+	return Polygon{{X: cx, Y: cy - r}, {X: cx + r, Y: cy}, {X: cx, Y: cy + r}, {X: cx - r, Y: cy}}
+}
+
+func TestSATShapes(t *testing.T) {
+	square := RectanglePolygon(Rectangle{X: 0, Y: 0, Width: 10, Height: 10})
+
+	tests := []struct {
+		name    string
+		a, b    ConvexShape
+		wantHit bool
+		wantMTV Vector2
+	}{
+		{"carrés qui se chevauchent en X", square, RectanglePolygon(Rectangle{X: 8, Y: 1, Width: 10, Height: 10}), true, Vector2{X: 2, Y: 0}},
+		{"carrés qui se chevauchent en Y", square, RectanglePolygon(Rectangle{X: 1, Y: -7, Width: 10, Height: 10}), true, Vector2{X: 0, Y: -3}},
+		{"carrés séparés", square, RectanglePolygon(Rectangle{X: 20, Y: 0, Width: 10, Height: 10}), false, Vector2{}},
+		{"carrés qui se touchent", square, RectanglePolygon(Rectangle{X: 10, Y: 0, Width: 10, Height: 10}), false, Vector2{}},
+		{"losange séparé par la diagonale", square, diamond(16, 16, 10), false, Vector2{}},
+		{"losange qui mord le coin", square, diamond(14, 14, 10), true, Vector2{X: 1, Y: 1}}, // √2 le long de la diagonale
+		{"triangle contre boîte", Polygon{{X: 5, Y: 8}, {X: 15, Y: 20}, {X: -5, Y: 20}}, square, true, Vector2{X: 0, Y: -2}},
+		{"point dans l'enveloppe", Polygon{{X: 3, Y: 4}}, diamond(5, 5, 5), true, Vector2{}},
+		{"point hors de l'enveloppe", Polygon{{X: 1, Y: 1}}, diamond(5, 5, 5), false, Vector2{}},
+		{"enveloppe contre point", diamond(5, 5, 5), Polygon{{X: 5, Y: 5}}, true, Vector2{}},
+		{"points confondus", Polygon{{X: 2, Y: 2}}, Polygon{{X: 2, Y: 2}}, true, Vector2{}},
+		{"points distincts", Polygon{{X: 2, Y: 2}}, Polygon{{X: 3, Y: 2}}, false, Vector2{}},
+		{"forme vide", Polygon{}, square, false, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hit, mtv := SAT(tt.a, tt.b)
+			if hit != tt.wantHit {
+				t.Fatalf("SAT = %t, attendu %t", hit, tt.wantHit)
+			}
+			if !hit || tt.wantMTV == (Vector2{}) {
+				return
+			}
+			if math.Abs(mtv.X-tt.wantMTV.X) > 1e-9 || math.Abs(mtv.Y-tt.wantMTV.Y) > 1e-9 {
+				t.Errorf("pénétration = %+v, attendu %+v", mtv, tt.wantMTV)
+			}
+		})
+	}
+}
+
+func TestSATPointInsideHullDepth(t *testing.T) {
+	// Un point à l'intérieur doit sortir par le bord le plus proche
+	hull := RectanglePolygon(Rectangle{X: 0, Y: 0, Width: 20, Height: 10})
+	hit, mtv := SAT(Polygon{{X: 18, Y: 5}}, hull)
+
+	if !hit {
+		t.Fatal("le point est dans l'enveloppe")
+	}
+	if math.Abs(mtv.X+2) > 1e-9 || mtv.Y != 0 {
+		t.Errorf("pénétration = %+v, attendu {-2 0} (sortie par le bord droit)", mtv)
+	}
+}
+
+func TestSATMTVSeparates(t *testing.T) {
+	a := diamond(0, 0, 10)
+	b := RectanglePolygon(Rectangle{X: 4, Y: -3, Width: 12, Height: 6})
+
+	hit, mtv := SAT(a, b)
+	if !hit {
+		t.Fatal("les formes se chevauchent")
+	}
+
+	// Déplacer a de -mtv (plus une marge) doit les séparer
+	moved := make(Polygon, len(a))
+	for i, vertex := range a {
+		moved[i] = vertex.Sub(mtv.Mul(1.001))
+	}
+	if hit, _ := SAT(moved, b); hit {
+		t.Errorf("toujours en collision après un recul de %+v", mtv)
+	}
+}
+
+func TestConvexHullWorldShape(t *testing.T) {
+	hull := NewConvexHullCollider([]Vector2{{X: -10, Y: 0}, {X: 0, Y: -5}, {X: 10, Y: 0}, {X: 0, Y: 8}}, LayerEnemy)
+	hull.Offset = Vector2{X: 0, Y: 2}
+
+	shape := hull.WorldShape(Vector2{X: 100, Y: 50})
+	if shape[0] != (Vector2{X: 90, Y: 52}) {
+		t.Errorf("premier sommet = %+v, attendu {90 52}", shape[0])
+	}
+
+	want := Rectangle{X: 90, Y: 47, Width: 20, Height: 13}
+	if got := hull.GetWorldBounds(Vector2{X: 100, Y: 50}); got != want {
+		t.Errorf("GetWorldBounds = %+v, attendu %+v", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+// internal/ecs/components/critical.go - Coups critiques et attribut de dextérité
+package components
+
+// Réglages des coups critiques
+const (
+	BaseCriticalChance         = 0.05 // Chance de base (5%)
+	DexterityCritThreshold     = 10   // Dextérité à partir de laquelle la chance augmente
+	CriticalChancePerDexterity = 0.01 // Chance gagnée par point au-delà du seuil
+	MaxCriticalChance          = 0.5
+	DefaultCriticalMultiplier  = 2.0 // Multiplicateur de dégâts d'un critique
+)
+
+// CriticalChanceFor retourne la chance de critique pour une dextérité
+func CriticalChanceFor(dexterity int) float64 {
+	chance := BaseCriticalChance
+	if dexterity > DexterityCritThreshold {
+		chance += float64(dexterity-DexterityCritThreshold) * CriticalChancePerDexterity
+	}
+	if chance > MaxCriticalChance {
+		chance = MaxCriticalChance
+	}
+	return chance
+}
+
+// AllocateDexterity ajoute des points de dextérité et recalcule la chance de critique
+func (pc *PlayerComponent) AllocateDexterity(points int) {
+	if points <= 0 {
+		return
+	}
+	pc.Dexterity += points
+	pc.updateCriticalChance()
+}
+
+// AddCriticalBonus ajoute (ou retire) une chance de critique indépendante de la dextérité
+func (pc *PlayerComponent) AddCriticalBonus(bonus float64) {
+	pc.CriticalBonus += bonus
+	pc.updateCriticalChance()
+}
+
+// updateCriticalChance recalcule la chance de critique : dextérité puis bonus
+func (pc *PlayerComponent) updateCriticalChance() {
+	pc.CriticalChance = CriticalChanceFor(pc.Dexterity) + pc.CriticalBonus
+	if pc.CriticalChance > MaxCriticalChance {
+		pc.CriticalChance = MaxCriticalChance
+	}
+	if pc.CriticalChance < 0 {
+		pc.CriticalChance = 0
+	}
+}
@@ -0,0 +1,44 @@
+package components
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCriticalChanceForDexterity(t *testing.T) {
+	tests := []struct {
+		dexterity int
+		want      float64
+	}{
+		{0, 0.05},
+		{DexterityCritThreshold, 0.05},
+		{DexterityCritThreshold + 1, 0.06},
+		{DexterityCritThreshold + 15, 0.20},
+		{200, MaxCriticalChance},
+	}
+
+	for _, tt := range tests {
+		if got := CriticalChanceFor(tt.dexterity); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("CriticalChanceFor(%d) = %.3f, attendu %.3f", tt.dexterity, got, tt.want)
+		}
+	}
+}
+
+func TestAllocateDexterityRaisesCriticalChance(t *testing.T) {
+	player := NewPlayerComponent()
+	player.AllocateDexterity(5)
+
+	if math.Abs(player.CriticalChance-0.10) > 1e-9 {
+		t.Errorf("CriticalChance = %.3f, attendu 0.100", player.CriticalChance)
+	}
+
+	player.AddCriticalBonus(0.05)
+	if math.Abs(player.CriticalChance-0.15) > 1e-9 {
+		t.Errorf("CriticalChance = %.3f avec le bonus, attendu 0.150", player.CriticalChance)
+	}
+
+	player.AllocateDexterity(-3)
+	if player.Dexterity != DexterityCritThreshold+5 {
+		t.Errorf("Dexterity = %d, des points négatifs doivent être ignorés", player.Dexterity)
+	}
+}
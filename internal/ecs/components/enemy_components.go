@@ -0,0 +1,350 @@
+// internal/ecs/components/enemy_components.go - Composants ECS des ennemis
+package components
+
+import (
+	"time"
+)
+
+// ===============================
+// COMPOSANT ENNEMI
+// ===============================
+
+// EnemyAIState état du comportement d'un ennemi
+type EnemyAIState int
+
+const (
+	EnemyStatePatrol EnemyAIState = iota // Patrouille, ou attente au point d'apparition
+	EnemyStateChase                      // Poursuite de la cible repérée
+	EnemyStateAttack                     // Au contact de la cible
+	EnemyStateReturn                     // Cible perdue : retour à la patrouille
+)
+
+// String retourne la représentation string de l'état
+func (s EnemyAIState) String() string {
+	switch s {
+	case EnemyStateChase:
+		return "chase"
+	case EnemyStateAttack:
+		return "attack"
+	case EnemyStateReturn:
+		return "return"
+	default:
+		return "patrol"
+	}
+}
+
+// EnemyComponent contient les stats de base d'un ennemi
+type EnemyComponent struct {
+	// Stats de base
+	Health      int
+	MaxHealth   int
+	AttackPower int
+
+	// Attaque au contact
+	AttackRange    float64
+	AttackCooldown time.Duration
+	attackTimer    time.Duration
+
+	// États
+	Stunned  bool
+	StunTime time.Duration
+
+	// Équilibre : chaque coup l'entame ; sous zéro l'ennemi chancelle
+	Poise           float64
+	MaxPoise        float64
+	PoiseRegen      float64 // Points regagnés par seconde
+	StaggerDuration time.Duration
+	Staggered       bool // Étourdi par une rupture d'équilibre
+
+	// Enragé : vitesse et dégâts doublés
+	Enraged bool
+
+	// Détection
+	AggroRange float64
+	Aggroed    bool // L'ennemi a repéré sa cible
+
+	// Comportement courant (machine à états du système ennemi)
+	State EnemyAIState
+
+	// Boss
+	IsBoss bool
+	Name   string
+}
+
+// Équilibre par défaut des ennemis
+const (
+	DefaultEnemyPoise      = 30.0
+	DefaultEnemyPoiseRegen = 10.0
+	DefaultStaggerDuration = 800 * time.Millisecond
+)
+
+// NewEnemyComponent crée un nouveau composant ennemi
+func NewEnemyComponent(maxHealth, attackPower int) *EnemyComponent {
+	return &EnemyComponent{
+		Health:          maxHealth,
+		MaxHealth:       maxHealth,
+		AttackPower:     attackPower,
+		AttackRange:     36.0,
+		AttackCooldown:  time.Millisecond * 1200,
+		Stunned:         false,
+		StunTime:        0,
+		Poise:           DefaultEnemyPoise,
+		MaxPoise:        DefaultEnemyPoise,
+		PoiseRegen:      DefaultEnemyPoiseRegen,
+		StaggerDuration: DefaultStaggerDuration,
+		AggroRange:      250.0,
+	}
+}
+
+// IsAlive retourne si l'ennemi est vivant
+func (ec *EnemyComponent) IsAlive() bool {
+	return ec.Health > 0
+}
+
+// TakeDamage inflige des dégâts à l'ennemi
+func (ec *EnemyComponent) TakeDamage(damage int) {
+	ec.Health -= damage
+	if ec.Health < 0 {
+		ec.Health = 0
+	}
+}
+
+// UpdateAggro engage ou désengage l'ennemi selon la distance à sa cible.
+// L'engagement exige de voir la cible ; le désengagement se fait au double
+// de la portée pour éviter les oscillations.
+func (ec *EnemyComponent) UpdateAggro(distance float64, visible bool) {
+	if distance <= ec.AggroRange && visible {
+		ec.Aggroed = true
+	} else if distance > ec.AggroRange*2 {
+		ec.Aggroed = false
+	}
+}
+
+// CanAttack retourne si l'ennemi peut porter un coup
+func (ec *EnemyComponent) CanAttack() bool {
+	return !ec.Stunned && ec.attackTimer <= 0
+}
+
+// StartAttackCooldown relance le délai entre deux coups
+func (ec *EnemyComponent) StartAttackCooldown() {
+	ec.attackTimer = ec.AttackCooldown
+}
+
+// Enrage double les dégâts de l'ennemi (la vitesse est gérée par l'entité)
+func (ec *EnemyComponent) Enrage() bool {
+	if ec.Enraged {
+		return false
+	}
+	ec.Enraged = true
+	ec.AttackPower *= 2
+	return true
+}
+
+// Stun étourdit l'ennemi pendant une durée
+func (ec *EnemyComponent) Stun(duration time.Duration) {
+	ec.Stunned = true
+	if duration > ec.StunTime {
+		ec.StunTime = duration
+	}
+}
+
+// SetPoise règle l'équilibre maximal et sa régénération (valeurs nulles ignorées)
+func (ec *EnemyComponent) SetPoise(maxPoise, regen float64) {
+	if maxPoise > 0 {
+		ec.MaxPoise = maxPoise
+		ec.Poise = maxPoise
+	}
+	if regen > 0 {
+		ec.PoiseRegen = regen
+	}
+}
+
+// DamagePoise entame l'équilibre ; retourne true si l'ennemi se met à chanceler
+func (ec *EnemyComponent) DamagePoise(amount float64) bool {
+	if ec.Staggered || amount <= 0 {
+		return false
+	}
+
+	ec.Poise -= amount
+	if ec.Poise >= 0 {
+		return false
+	}
+
+	ec.Staggered = true
+	ec.Stun(ec.StaggerDuration)
+	return true
+}
+
+// Update met à jour les timers de l'ennemi
+func (ec *EnemyComponent) Update(deltaTime time.Duration) {
+	if ec.attackTimer > 0 {
+		ec.attackTimer -= deltaTime
+	}
+
+	if ec.Stunned && ec.StunTime > 0 {
+		ec.StunTime -= deltaTime
+		if ec.StunTime <= 0 {
+			ec.Stunned = false
+			ec.StunTime = 0
+		}
+	}
+
+	// Fin du chancellement : l'équilibre revient entier
+	if ec.Staggered && !ec.Stunned {
+		ec.Staggered = false
+		ec.Poise = ec.MaxPoise
+	}
+
+	if !ec.Staggered && ec.Poise < ec.MaxPoise {
+		ec.Poise += ec.PoiseRegen * deltaTime.Seconds()
+		if ec.Poise > ec.MaxPoise {
+			ec.Poise = ec.MaxPoise
+		}
+	}
+}
+
+// ===============================
+// COMPOSANT DE FORMATION
+// ===============================
+
+// FormationRole représente le rôle d'un ennemi dans sa formation
+type FormationRole int
+
+const (
+	FormationRoleNone FormationRole = iota
+	FormationRoleAttacker
+	FormationRoleSupporter
+)
+
+// String retourne la représentation string du rôle
+func (r FormationRole) String() string {
+	switch r {
+	case FormationRoleAttacker:
+		return "attacker"
+	case FormationRoleSupporter:
+		return "supporter"
+	default:
+		return "none"
+	}
+}
+
+// FormationComponent place un ennemi dans une formation coordonnée
+type FormationComponent struct {
+	FormationID     string
+	SlotIndex       int     // 0 = attaquant, 1..N = soutiens
+	FormationCenter Vector2 // Centre de la formation (le joueur)
+
+	// Calculé par le FormationSystem
+	Role           FormationRole
+	TargetPosition Vector2 // Position désirée pour ce slot
+	CanAttack      bool    // Autorisé à engager la cible
+}
+
+// NewFormationComponent crée un nouveau composant de formation
+func NewFormationComponent(formationID string) *FormationComponent {
+	return &FormationComponent{
+		FormationID: formationID,
+		SlotIndex:   -1, // Non assigné
+		Role:        FormationRoleNone,
+	}
+}
+
+// ===============================
+// COMPOSANT DE PATROUILLE
+// ===============================
+
+// PatrolComponent fait suivre à un ennemi non engagé une suite de points de passage
+type PatrolComponent struct {
+	Waypoints   []Vector2
+	Current     int
+	Loop        bool    // Reprend au premier point, sinon fait demi-tour
+	ReachRadius float64 // Distance à laquelle un point est considéré atteint
+	forward     bool
+}
+
+// NewPatrolComponent crée un composant de patrouille
+func NewPatrolComponent(waypoints []Vector2, loop bool) *PatrolComponent {
+	return &PatrolComponent{
+		Waypoints:   waypoints,
+		Loop:        loop,
+		ReachRadius: 6.0,
+		forward:     true,
+	}
+}
+
+// Target retourne le point de passage visé
+func (pc *PatrolComponent) Target() (Vector2, bool) {
+	if len(pc.Waypoints) == 0 {
+		return Vector2{}, false
+	}
+	return pc.Waypoints[pc.Current], true
+}
+
+// Advance passe au point de passage suivant (boucle ou aller-retour)
+func (pc *PatrolComponent) Advance() {
+	count := len(pc.Waypoints)
+	if count < 2 {
+		return
+	}
+
+	if pc.Loop {
+		pc.Current = (pc.Current + 1) % count
+		return
+	}
+
+	if pc.forward && pc.Current == count-1 {
+		pc.forward = false
+	} else if !pc.forward && pc.Current == 0 {
+		pc.forward = true
+	}
+	if pc.forward {
+		pc.Current++
+	} else {
+		pc.Current--
+	}
+}
+
+// ===============================
+// COMPOSANT DE CHEMIN
+// ===============================
+
+// PathComponent chemin calculé vers la cible, recalculé périodiquement
+type PathComponent struct {
+	Waypoints     []Vector2
+	Current       int
+	RecomputeTime time.Duration // Temps restant avant le prochain calcul
+	ReachRadius   float64
+}
+
+// NewPathComponent crée un composant de chemin vide
+func NewPathComponent() *PathComponent {
+	return &PathComponent{ReachRadius: 6.0}
+}
+
+// SetWaypoints remplace le chemin suivi
+func (pc *PathComponent) SetWaypoints(waypoints []Vector2) {
+	pc.Waypoints = waypoints
+	pc.Current = 0
+}
+
+// Next retourne le point de passage courant en sautant ceux déjà atteints
+func (pc *PathComponent) Next(position Vector2) (Vector2, bool) {
+	for pc.Current < len(pc.Waypoints) {
+		waypoint := pc.Waypoints[pc.Current]
+		dx, dy := waypoint.X-position.X, waypoint.Y-position.Y
+		// Le dernier point (la cible) n'est jamais sauté
+		if pc.Current == len(pc.Waypoints)-1 || dx*dx+dy*dy > pc.ReachRadius*pc.ReachRadius {
+			return waypoint, true
+		}
+		pc.Current++
+	}
+	return Vector2{}, false
+}
+
+// Remaining retourne les points de passage restant à parcourir
+func (pc *PathComponent) Remaining() []Vector2 {
+	if pc.Current >= len(pc.Waypoints) {
+		return nil
+	}
+	return pc.Waypoints[pc.Current:]
+}
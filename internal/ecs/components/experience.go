@@ -0,0 +1,33 @@
+// internal/ecs/components/experience.go - Expérience, niveaux et points de compétence
+package components
+
+import "math"
+
+// Réglages de la progression
+const (
+	DefaultExperiencePerKill = 25
+	ExperienceGrowth         = 1.25 // Expérience requise d'un niveau au suivant
+	SkillPointsPerLevel      = 1
+)
+
+// AddExperience ajoute de l'expérience ; chaque palier franchi fait monter
+// d'un niveau et donne SkillPointsPerLevel points. Retourne les niveaux gagnés.
+func (pc *PlayerComponent) AddExperience(amount int) int {
+	if amount <= 0 {
+		return 0
+	}
+	if pc.ExperienceToNext <= 0 {
+		pc.ExperienceToNext = 100
+	}
+
+	pc.Experience += amount
+	levels := 0
+	for pc.Experience >= pc.ExperienceToNext {
+		pc.Experience -= pc.ExperienceToNext
+		pc.ExperienceToNext = int(math.Round(float64(pc.ExperienceToNext) * ExperienceGrowth))
+		pc.Level++
+		pc.SkillPoints += SkillPointsPerLevel
+		levels++
+	}
+	return levels
+}
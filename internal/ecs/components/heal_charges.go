@@ -0,0 +1,33 @@
+// internal/ecs/components/heal_charges.go - Fioles de soin limitées, remplies aux feux de camp
+package components
+
+// Réglages par défaut des fioles de soin
+const (
+	DefaultMaxHealCharges = 5
+	DefaultHealAmount     = 40
+)
+
+// UseHealCharge boit une fiole : soigne et consomme une charge.
+// Retourne false, sans effet, s'il ne reste aucune charge.
+func (pc *PlayerComponent) UseHealCharge() bool {
+	if pc.HealCharges <= 0 || !pc.IsAlive() {
+		return false
+	}
+	pc.HealCharges--
+	pc.Heal(pc.HealAmount)
+	return true
+}
+
+// RefillHealCharges remplit toutes les fioles (repos à un feu de camp)
+func (pc *PlayerComponent) RefillHealCharges() {
+	pc.HealCharges = pc.MaxHealCharges
+}
+
+// SetMaxHealCharges définit le nombre de fioles et les remplit
+func (pc *PlayerComponent) SetMaxHealCharges(maxCharges int) {
+	if maxCharges < 0 {
+		maxCharges = 0
+	}
+	pc.MaxHealCharges = maxCharges
+	pc.HealCharges = maxCharges
+}
@@ -0,0 +1,62 @@
+// internal/ecs/components/physics_object.go - Objets lourds que le joueur peut pousser
+package components
+
+import "math"
+
+// PlayerPushMass masse du joueur face aux objets qu'il pousse
+const PlayerPushMass = 1.0
+
+// PhysicsObjectComponent objet pesant : plus sa masse est grande, plus le
+// joueur le pousse lentement ; la friction (pixels/s²) l'arrête une fois lâché
+type PhysicsObjectComponent struct {
+	Mass     float64
+	Friction float64
+	Velocity Vector2
+}
+
+// NewPhysicsObjectComponent crée un objet immobile
+func NewPhysicsObjectComponent(mass, friction float64) *PhysicsObjectComponent {
+	if mass <= 0 {
+		mass = 1
+	}
+	return &PhysicsObjectComponent{
+		Mass:     mass,
+		Friction: friction,
+	}
+}
+
+// PushShare part d'un chevauchement absorbée par l'objet poussé ; le reste
+// repousse le pousseur
+func (po *PhysicsObjectComponent) PushShare(pusherMass float64) float64 {
+	return pusherMass / (pusherMass + po.Mass)
+}
+
+// Push applique un déplacement imposé sur dt secondes : l'objet garde l'élan correspondant
+func (po *PhysicsObjectComponent) Push(displacement Vector2, dt float64) {
+	if dt <= 0 {
+		return
+	}
+	po.Velocity = displacement.Mul(1 / dt)
+}
+
+// Update freine l'objet et retourne son déplacement sur dt secondes
+func (po *PhysicsObjectComponent) Update(dt float64) Vector2 {
+	speed := math.Hypot(po.Velocity.X, po.Velocity.Y)
+	if speed == 0 {
+		return Vector2{}
+	}
+
+	braked := speed - po.Friction*dt
+	if braked <= 0 {
+		po.Velocity = Vector2{}
+		return Vector2{}
+	}
+
+	po.Velocity = po.Velocity.Mul(braked / speed)
+	return po.Velocity.Mul(dt)
+}
+
+// Stop immobilise l'objet (contre un mur)
+func (po *PhysicsObjectComponent) Stop() {
+	po.Velocity = Vector2{}
+}
@@ -2,6 +2,7 @@
 package components
 
 import (
+	"math"
 	"time"
 )
 
@@ -118,6 +119,45 @@ func (d Direction) ToVector2() Vector2 {
 	}
 }
 
+// DirectionFromVector retourne la direction (8 secteurs de 45°) la plus proche d'un vecteur
+func DirectionFromVector(v Vector2) Direction {
+	if v.X == 0 && v.Y == 0 {
+		return DirectionNone
+	}
+
+	// Secteurs dans le sens horaire à partir de la droite (Y vers le bas)
+	sectors := [8]Direction{
+		DirectionRight, DirectionDownRight, DirectionDown, DirectionDownLeft,
+		DirectionLeft, DirectionUpLeft, DirectionUp, DirectionUpRight,
+	}
+	angle := math.Atan2(v.Y, v.X)
+	index := int(math.Round(angle/(math.Pi/4))+8) % 8
+	return sectors[index]
+}
+
+// IsDiagonal retourne si la direction est une diagonale
+func (d Direction) IsDiagonal() bool {
+	switch d {
+	case DirectionUpLeft, DirectionUpRight, DirectionDownLeft, DirectionDownRight:
+		return true
+	default:
+		return false
+	}
+}
+
+// Cardinal retourne la direction cardinale la plus proche (les diagonales
+// se rabattent sur haut/bas, comme le rendu 4 directions)
+func (d Direction) Cardinal() Direction {
+	switch d {
+	case DirectionUpLeft, DirectionUpRight:
+		return DirectionUp
+	case DirectionDownLeft, DirectionDownRight:
+		return DirectionDown
+	default:
+		return d
+	}
+}
+
 // CollisionLayer représente les couches de collision
 type CollisionLayer int
 
@@ -155,7 +195,7 @@ var (
 // PositionComponent représente la position dans le monde
 type PositionComponent struct {
 	Position     Vector2
-	LastPosition Vector2 // Pour calculer la vélocité
+	LastPosition Vector2 // Position au début du tick (interpolation du rendu)
 }
 
 // NewPositionComponent crée un nouveau composant de position
@@ -167,32 +207,132 @@ func NewPositionComponent(x, y float64) *PositionComponent {
 	}
 }
 
+// Au-delà de cette distance (pixels) en un tick, le déplacement est un saut
+// (téléportation, réapparition) : il n'est pas interpolé
+const maxInterpolatedStep = 64.0
+
+// BeginStep mémorise la position au début d'un tick logique
+func (pc *PositionComponent) BeginStep() {
+	pc.LastPosition = pc.Position
+}
+
+// RenderPosition position de rendu entre celle du tick précédent (alpha 0)
+// et celle du tick courant (alpha 1), pour un mouvement fluide quand l'écran
+// rafraîchit plus vite que la logique
+func (pc *PositionComponent) RenderPosition(alpha float64) Vector2 {
+	step := pc.Position.Sub(pc.LastPosition)
+	if alpha >= 1 || step.Length() > maxInterpolatedStep {
+		return pc.Position
+	}
+	if alpha <= 0 {
+		return pc.LastPosition
+	}
+	return pc.LastPosition.Add(step.Mul(alpha))
+}
+
 // MovementComponent gère le mouvement
 type MovementComponent struct {
-	Velocity      Vector2
-	Speed         float64
-	MaxSpeed      float64
-	Acceleration  float64
-	Friction      float64
-	IsMoving      bool
-	Direction     Direction
-	FacingDir     Direction // Direction vers laquelle regarde l'entité
+	Velocity       Vector2
+	Speed          float64 // Vitesse de croisière (pixels/s)
+	MaxSpeed       float64 // Vitesse maximale (pixels/s)
+	Acceleration   float64 // Accélération vers la vitesse cible (pixels/s²)
+	Friction       float64 // Décélération sans input (pixels/s²)
+	Responsiveness float64 // Courbe d'accélération : 1 = linéaire, proche de 0 = démarrage lourd
+	IsMoving       bool
+	Direction      Direction
+	FacingDir      Direction // Direction vers laquelle regarde l'entité
 }
 
 // NewMovementComponent crée un nouveau composant de mouvement
 func NewMovementComponent(speed, maxSpeed float64) *MovementComponent {
 	return &MovementComponent{
-		Velocity:     Vector2{X: 0, Y: 0},
-		Speed:        speed,
-		MaxSpeed:     maxSpeed,
-		Acceleration: speed * 4, // Accélération rapide
-		Friction:     speed * 6, // Friction plus élevée pour un arrêt rapide
-		IsMoving:     false,
-		Direction:    DirectionNone,
-		FacingDir:    DirectionDown, // Direction par défaut
+		Velocity:       Vector2{X: 0, Y: 0},
+		Speed:          speed,
+		MaxSpeed:       maxSpeed,
+		Acceleration:   speed * 4, // Vitesse atteinte en 0.25s
+		Friction:       speed * 6, // Friction plus élevée pour un arrêt rapide
+		Responsiveness: 1.0,
+		IsMoving:       false,
+		Direction:      DirectionNone,
+		FacingDir:      DirectionDown, // Direction par défaut
 	}
 }
 
+// MovementProfile réglages de déplacement d'un type d'entité (valeurs nulles ignorées)
+type MovementProfile struct {
+	Speed          float64 // pixels/s
+	MaxSpeed       float64 // pixels/s
+	Acceleration   float64 // pixels/s²
+	Friction       float64 // pixels/s²
+	Responsiveness float64 // 0..1
+}
+
+// ApplyProfile applique les valeurs non nulles d'un profil de déplacement
+func (mc *MovementComponent) ApplyProfile(profile MovementProfile) {
+	if profile.Speed > 0 {
+		mc.Speed = profile.Speed
+	}
+	if profile.MaxSpeed > 0 {
+		mc.MaxSpeed = profile.MaxSpeed
+	}
+	if profile.Acceleration > 0 {
+		mc.Acceleration = profile.Acceleration
+	}
+	if profile.Friction > 0 {
+		mc.Friction = profile.Friction
+	}
+	if profile.Responsiveness > 0 {
+		mc.Responsiveness = math.Min(profile.Responsiveness, 1.0)
+	}
+}
+
+// minResponsiveness évite qu'une entité lourde ne démarre jamais
+const minResponsiveness = 0.05
+
+// Accelerate rapproche la vélocité de la vélocité cible d'au plus
+// Acceleration*dt. Avec une réactivité < 1, l'accélération démarre à
+// Acceleration*Responsiveness et atteint Acceleration à pleine vitesse.
+func (mc *MovementComponent) Accelerate(target Vector2, dt float64) {
+	diff := target.Sub(mc.Velocity)
+	distance := math.Hypot(diff.X, diff.Y)
+	if distance == 0 {
+		return
+	}
+
+	factor := 1.0
+	if targetSpeed := math.Hypot(target.X, target.Y); targetSpeed > 0 {
+		ratio := Clamp(math.Hypot(mc.Velocity.X, mc.Velocity.Y)/targetSpeed, 0, 1)
+		factor = Lerp(math.Max(mc.Responsiveness, minResponsiveness), 1.0, ratio)
+	}
+
+	step := mc.Acceleration * factor * dt
+	if step >= distance {
+		mc.Velocity = target
+	} else {
+		mc.Velocity = mc.Velocity.Add(diff.Mul(step / distance))
+	}
+
+	// Limiter à la vitesse maximale
+	if speed := math.Hypot(mc.Velocity.X, mc.Velocity.Y); speed > mc.MaxSpeed {
+		mc.Velocity = mc.Velocity.Mul(mc.MaxSpeed / speed)
+	}
+}
+
+// Decelerate freine l'entité de Friction*dt jusqu'à l'arrêt
+func (mc *MovementComponent) Decelerate(dt float64) {
+	speed := math.Hypot(mc.Velocity.X, mc.Velocity.Y)
+	if speed == 0 {
+		return
+	}
+
+	braking := mc.Friction * dt
+	if braking >= speed {
+		mc.Velocity = Vector2{X: 0, Y: 0}
+		return
+	}
+	mc.Velocity = mc.Velocity.Mul((speed - braking) / speed)
+}
+
 // SpriteComponent gère l'affichage graphique
 type SpriteComponent struct {
 	TextureID    string
@@ -206,6 +346,25 @@ type SpriteComponent struct {
 	Visible      bool      // Visibilité
 	Layer        int       // Couche de rendu (plus haut = devant)
 	Offset       Vector2   // Décalage par rapport à la position
+	SortAnchor   SortAnchor // Point de tri en profondeur (pieds par défaut)
+}
+
+// SortAnchor point du sprite utilisé pour le tri en profondeur : le sprite est
+// centré sur la position, ses pieds sont en bas
+type SortAnchor int
+
+const (
+	SortAnchorFeet   SortAnchor = iota // Bas du sprite
+	SortAnchorCenter                   // Centre du sprite
+)
+
+// SortY retourne l'ordonnée de tri du sprite placé à position
+func (sc *SpriteComponent) SortY(position Vector2) float64 {
+	center := position.Y + sc.Offset.Y
+	if sc.SortAnchor == SortAnchorCenter {
+		return center
+	}
+	return center + sc.Size.Y/2
 }
 
 // NewSpriteComponent crée un nouveau composant de sprite
@@ -359,16 +518,28 @@ type PlayerComponent struct {
 	AttackPower     int
 	Defense         int
 	CriticalChance  float64
+	Dexterity       int // Au-delà du seuil, augmente CriticalChance
+	CriticalBonus   float64 // Chance de critique ajoutée par l'arbre de compétences
+	Weapon          Weapon // Arme équipée
+	
+	// Fioles de soin (remplies aux feux de camp)
+	HealCharges     int
+	MaxHealCharges  int
+	HealAmount      int
 	
 	// Progression
 	Level           int
 	Experience      int
 	ExperienceToNext int
+	Souls           int // Monnaie gagnée sur les ennemis et les défis
+	SkillPoints     int // Gagnés en montant de niveau, dépensés dans l'arbre de compétences
 	
 	// États
-	InvulnTime      time.Duration // Temps d'invulnérabilité restant
+	InvulnTimer     *Timer        // Invulnérabilité (après un coup, pendant une roulade)
+	GodMode         bool          // Debug : aucun dégât ni coût de stamina
+	StaminaRegenPaused bool       // Pas de régénération (garde levée)
 	Stunned         bool
-	StunTime        time.Duration
+	StunTimer       *Timer
 	
 	// Statistiques de jeu
 	PlayTime        time.Duration
@@ -387,12 +558,17 @@ func NewPlayerComponent() *PlayerComponent {
 		AttackPower:      10,
 		Defense:          5,
 		CriticalChance:   0.05, // 5%
+		Dexterity:        DexterityCritThreshold,
+		Weapon:           DefaultWeapon(),
+		HealCharges:      DefaultMaxHealCharges,
+		MaxHealCharges:   DefaultMaxHealCharges,
+		HealAmount:       DefaultHealAmount,
 		Level:            1,
 		Experience:       0,
 		ExperienceToNext: 100,
-		InvulnTime:       0,
+		InvulnTimer:      NewTimer(0),
 		Stunned:          false,
-		StunTime:         0,
+		StunTimer:        NewTimer(0),
 		PlayTime:         0,
 		EnemiesKilled:    0,
 		ItemsCollected:   0,
@@ -406,7 +582,10 @@ func (pc *PlayerComponent) IsAlive() bool {
 
 // TakeDamage inflige des dégâts au joueur
 func (pc *PlayerComponent) TakeDamage(damage int) bool {
-	if pc.InvulnTime > 0 {
+	if pc.GodMode {
+		return false
+	}
+	if pc.IsInvulnerable() {
 		return false // Invulnérable
 	}
 	
@@ -421,11 +600,35 @@ func (pc *PlayerComponent) TakeDamage(damage int) bool {
 	}
 	
 	// Temps d'invulnérabilité après dégâts
-	pc.InvulnTime = time.Millisecond * 1000 // 1 seconde
+	pc.StartInvulnerability(time.Millisecond * 1000) // 1 seconde
 	
 	return true
 }
 
+// IsInvulnerable retourne si le joueur est invulnérable
+func (pc *PlayerComponent) IsInvulnerable() bool {
+	return pc.InvulnTimer.IsActive()
+}
+
+// StartInvulnerability rend le joueur invulnérable pendant duration
+// (remplace l'invulnérabilité en cours)
+func (pc *PlayerComponent) StartInvulnerability(duration time.Duration) {
+	pc.InvulnTimer.StartWith(duration)
+}
+
+// Stun étourdit le joueur pendant duration
+func (pc *PlayerComponent) Stun(duration time.Duration) {
+	pc.Stunned = true
+	pc.StunTimer.StartWith(duration)
+}
+
+// AddSouls ajoute des âmes au joueur
+func (pc *PlayerComponent) AddSouls(amount int) {
+	if amount > 0 {
+		pc.Souls += amount
+	}
+}
+
 // Heal soigne le joueur
 func (pc *PlayerComponent) Heal(amount int) {
 	pc.Health += amount
@@ -436,6 +639,9 @@ func (pc *PlayerComponent) Heal(amount int) {
 
 // UseStamina consomme de la stamina
 func (pc *PlayerComponent) UseStamina(amount float64) bool {
+	if pc.GodMode {
+		return true
+	}
 	if pc.Stamina >= amount {
 		pc.Stamina -= amount
 		return true
@@ -443,8 +649,22 @@ func (pc *PlayerComponent) UseStamina(amount float64) bool {
 	return false
 }
 
+// DrainStamina consomme de la stamina en continu, jusqu'à zéro
+func (pc *PlayerComponent) DrainStamina(amount float64) {
+	if pc.GodMode {
+		return
+	}
+	pc.Stamina -= amount
+	if pc.Stamina < 0 {
+		pc.Stamina = 0
+	}
+}
+
 // RegenerateStamina régénère la stamina
 func (pc *PlayerComponent) RegenerateStamina(deltaTime time.Duration) {
+	if pc.StaminaRegenPaused {
+		return
+	}
 	if pc.Stamina < pc.MaxStamina {
 		regen := pc.StaminaRegen * deltaTime.Seconds()
 		pc.Stamina += regen
@@ -457,20 +677,12 @@ func (pc *PlayerComponent) RegenerateStamina(deltaTime time.Duration) {
 // Update met à jour les timers du joueur
 func (pc *PlayerComponent) Update(deltaTime time.Duration) {
 	// Réduire le temps d'invulnérabilité
-	if pc.InvulnTime > 0 {
-		pc.InvulnTime -= deltaTime
-		if pc.InvulnTime < 0 {
-			pc.InvulnTime = 0
-		}
-	}
+	pc.InvulnTimer.Update(deltaTime)
 	
 	// Réduire le temps de stun
-	if pc.Stunned && pc.StunTime > 0 {
-		pc.StunTime -= deltaTime
-		if pc.StunTime <= 0 {
-			pc.Stunned = false
-			pc.StunTime = 0
-		}
+	pc.StunTimer.Update(deltaTime)
+	if pc.Stunned && !pc.StunTimer.IsActive() {
+		pc.Stunned = false
 	}
 	
 	// Régénération de stamina
@@ -506,6 +718,9 @@ type InputComponent struct {
 	RollJustPressed     bool
 	InteractJustPressed bool
 	UseItemJustPressed  bool
+	CastJustPressed     bool
+	FocusJustPressed    bool
+	HeavyAttackJustPressed bool
 }
 
 // NewInputComponent crée un nouveau composant d'entrée
@@ -534,6 +749,9 @@ func (ic *InputComponent) Reset() {
 	ic.RollJustPressed = false
 	ic.InteractJustPressed = false
 	ic.UseItemJustPressed = false
+	ic.CastJustPressed = false
+	ic.FocusJustPressed = false
+	ic.HeavyAttackJustPressed = false
 }
 
 // GetMovementVector retourne le vecteur de mouvement normalisé
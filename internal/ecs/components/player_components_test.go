@@ -0,0 +1,231 @@
+package components
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGodModeBlocksDamage(t *testing.T) {
+	tests := []struct {
+		name       string
+		godMode    bool
+		wantHit    bool
+		wantHealth int
+	}{
+		{"god mode", true, false, 100},
+		{"normal", false, true, 75}, // 30 - 5 de défense
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := NewPlayerComponent()
+			player.GodMode = tt.godMode
+
+			if hit := player.TakeDamage(30); hit != tt.wantHit {
+				t.Errorf("TakeDamage = %t, attendu %t", hit, tt.wantHit)
+			}
+			if player.Health != tt.wantHealth {
+				t.Errorf("Health = %d, attendu %d", player.Health, tt.wantHealth)
+			}
+		})
+	}
+}
+
+func TestGodModeIgnoresStaminaCost(t *testing.T) {
+	tests := []struct {
+		name        string
+		godMode     bool
+		stamina     float64
+		cost        float64
+		wantOK      bool
+		wantStamina float64
+	}{
+		{"god mode", true, 100, 30, true, 100},
+		{"god mode sans stamina", true, 0, 30, true, 0},
+		{"normal", false, 100, 30, true, 70},
+		{"normal sans stamina", false, 10, 30, false, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := NewPlayerComponent()
+			player.GodMode = tt.godMode
+			player.Stamina = tt.stamina
+
+			if ok := player.UseStamina(tt.cost); ok != tt.wantOK {
+				t.Errorf("UseStamina = %t, attendu %t", ok, tt.wantOK)
+			}
+			if player.Stamina != tt.wantStamina {
+				t.Errorf("Stamina = %.1f, attendu %.1f", player.Stamina, tt.wantStamina)
+			}
+		})
+	}
+}
+
+func TestGodModeIgnoresStaminaDrain(t *testing.T) {
+	player := NewPlayerComponent()
+	player.GodMode = true
+	player.DrainStamina(50)
+
+	if player.Stamina != player.MaxStamina {
+		t.Errorf("Stamina = %.1f, attendu %.1f", player.Stamina, player.MaxStamina)
+	}
+}
+
+// timeToTopSpeed simule l'accélération par frames de 1/60 s et retourne le
+// temps mis pour atteindre la vitesse cible
+func timeToTopSpeed(mc *MovementComponent) float64 {
+	const dt = 1.0 / 60
+	target := Vector2{X: mc.Speed, Y: 0}
+	for frame := 1; frame <= 600; frame++ {
+		mc.Accelerate(target, dt)
+		if mc.Velocity == target {
+			return float64(frame) * dt
+		}
+	}
+	return math.Inf(1)
+}
+
+func TestAccelerationResponsiveness(t *testing.T) {
+	tests := []struct {
+		name           string
+		responsiveness float64
+		minTime        float64
+		maxTime        float64
+	}{
+		// 200 px/s à 800 px/s² : 0.25 s
+		{"linéaire", 1.0, 0.24, 0.27},
+		// dv/dt = A(r + (1-r)v/V) : V/(A(1-r))·ln(1/r) ≈ 0.46 s
+		{"démarrage lourd", 0.25, 0.45, 0.5},
+		// Bornée à minResponsiveness (0.05) : ≈ 0.79 s au lieu de ne jamais démarrer
+		{"réactivité nulle bornée", 0, 0.75, 0.85},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := NewMovementComponent(200, 250)
+			mc.Responsiveness = tt.responsiveness
+
+			if got := timeToTopSpeed(mc); got < tt.minTime || got > tt.maxTime {
+				t.Errorf("vitesse atteinte en %.3f s, attendu entre %.2f et %.2f s", got, tt.minTime, tt.maxTime)
+			}
+		})
+	}
+}
+
+func TestAccelerateCapsAtMaxSpeed(t *testing.T) {
+	mc := NewMovementComponent(200, 250)
+	for i := 0; i < 60; i++ {
+		mc.Accelerate(Vector2{X: 400, Y: 0}, 1.0/60)
+	}
+
+	if speed := math.Hypot(mc.Velocity.X, mc.Velocity.Y); math.Abs(speed-250) > 1e-9 {
+		t.Errorf("vitesse = %.1f px/s, attendu 250 (MaxSpeed)", speed)
+	}
+}
+
+func TestDecelerateStopsWithFriction(t *testing.T) {
+	mc := NewMovementComponent(200, 250) // Friction 1200 px/s²
+	mc.Velocity = Vector2{X: 200, Y: 0}
+
+	mc.Decelerate(0.1)
+	if math.Abs(mc.Velocity.X-80) > 1e-9 {
+		t.Errorf("vitesse = %.1f px/s après 0.1 s, attendu 80", mc.Velocity.X)
+	}
+
+	mc.Decelerate(0.1)
+	if mc.Velocity != (Vector2{}) {
+		t.Errorf("vitesse = %+v, attendu l'arrêt complet", mc.Velocity)
+	}
+}
+
+func TestApplyProfileIgnoresZeroValues(t *testing.T) {
+	mc := NewMovementComponent(200, 250)
+	mc.ApplyProfile(MovementProfile{Speed: 150, Responsiveness: 2})
+
+	if mc.Speed != 150 {
+		t.Errorf("Speed = %.0f, attendu 150", mc.Speed)
+	}
+	if mc.MaxSpeed != 250 || mc.Acceleration != 800 || mc.Friction != 1200 {
+		t.Errorf("valeurs non renseignées modifiées: max %.0f, accel %.0f, friction %.0f", mc.MaxSpeed, mc.Acceleration, mc.Friction)
+	}
+	if mc.Responsiveness != 1 {
+		t.Errorf("Responsiveness = %.2f, attendu 1 (bornée)", mc.Responsiveness)
+	}
+}
+
+func TestInvulnerabilityCountdown(t *testing.T) {
+	tests := []struct {
+		name          string
+		elapsed       time.Duration
+		wantInvuln    bool
+		wantRemaining time.Duration
+	}{
+		{"au coup", 0, true, time.Second},
+		{"à mi-parcours", 400 * time.Millisecond, true, 600 * time.Millisecond},
+		{"juste avant la fin", 999 * time.Millisecond, true, time.Millisecond},
+		{"terminée", time.Second, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := NewPlayerComponent()
+			player.TakeDamage(10)
+			player.Update(tt.elapsed)
+
+			if player.IsInvulnerable() != tt.wantInvuln {
+				t.Errorf("IsInvulnerable = %t, attendu %t", player.IsInvulnerable(), tt.wantInvuln)
+			}
+			if remaining := player.InvulnTimer.Remaining(); remaining != tt.wantRemaining {
+				t.Errorf("Remaining = %v, attendu %v", remaining, tt.wantRemaining)
+			}
+			// Un coup pendant l'invulnérabilité est ignoré
+			if hit := player.TakeDamage(10); hit == tt.wantInvuln {
+				t.Errorf("TakeDamage = %t, attendu %t", hit, !tt.wantInvuln)
+			}
+		})
+	}
+}
+
+func TestTimerCompletionCallbacks(t *testing.T) {
+	player := NewPlayerComponent()
+	invulnEnds, stunEnds := 0, 0
+	player.InvulnTimer.OnComplete = func() { invulnEnds++ }
+	player.StunTimer.OnComplete = func() { stunEnds++ }
+
+	player.StartInvulnerability(500 * time.Millisecond)
+	player.Stun(300 * time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		player.Update(100 * time.Millisecond)
+
+		wantStunned := i < 2
+		if player.Stunned != wantStunned {
+			t.Errorf("après %d ms : Stunned = %t, attendu %t", (i+1)*100, player.Stunned, wantStunned)
+		}
+	}
+
+	// Chaque fin de minuteur n'est signalée qu'une fois
+	if invulnEnds != 1 || stunEnds != 1 {
+		t.Errorf("fins signalées : invulnérabilité %d, étourdissement %d, attendu 1 et 1", invulnEnds, stunEnds)
+	}
+	if progress := player.StunTimer.Progress(); progress != 1 {
+		t.Errorf("progression de l'étourdissement = %.2f, attendu 1", progress)
+	}
+}
+
+func TestStunRestartExtends(t *testing.T) {
+	player := NewPlayerComponent()
+	player.Stun(300 * time.Millisecond)
+	player.Update(200 * time.Millisecond)
+	player.Stun(300 * time.Millisecond)
+	player.Update(200 * time.Millisecond)
+
+	if !player.Stunned {
+		t.Error("un nouvel étourdissement doit relancer le minuteur")
+	}
+	if remaining := player.StunTimer.Remaining(); remaining != 100*time.Millisecond {
+		t.Errorf("Remaining = %v, attendu 100ms", remaining)
+	}
+}
@@ -0,0 +1,61 @@
+// internal/ecs/components/prop_components.go - Composants des décors (piliers, arbres...)
+package components
+
+import (
+	"time"
+)
+
+// ===============================
+// COMPOSANT D'OCCULTATION
+// ===============================
+
+// OccludableComponent rend un décor transparent quand il masque le joueur
+type OccludableComponent struct {
+	FadeRadius   float64       // Marge autour du rectangle du décor
+	FadedAlpha   uint8         // Opacité quand le joueur est derrière
+	FadeDuration time.Duration // Durée du fondu dans un sens
+
+	// Avancement du fondu : 0 = opaque, 1 = estompé
+	fadeProgress float64
+}
+
+// NewOccludableComponent crée un composant d'occultation
+func NewOccludableComponent(fadeRadius float64, fadedAlpha uint8) *OccludableComponent {
+	return &OccludableComponent{
+		FadeRadius:   fadeRadius,
+		FadedAlpha:   fadedAlpha,
+		FadeDuration: 200 * time.Millisecond,
+	}
+}
+
+// Occludes vérifie si le joueur est dans la zone (rectangle élargi de FadeRadius)
+func (oc *OccludableComponent) Occludes(bounds Rectangle, playerPos Vector2) bool {
+	return playerPos.X >= bounds.X-oc.FadeRadius && playerPos.X <= bounds.X+bounds.Width+oc.FadeRadius &&
+		playerPos.Y >= bounds.Y-oc.FadeRadius && playerPos.Y <= bounds.Y+bounds.Height+oc.FadeRadius
+}
+
+// Update fait avancer le fondu vers l'état visé et retourne l'opacité courante
+func (oc *OccludableComponent) Update(occluding bool, deltaTime time.Duration) uint8 {
+	step := 1.0
+	if oc.FadeDuration > 0 {
+		step = float64(deltaTime) / float64(oc.FadeDuration)
+	}
+
+	if occluding {
+		oc.fadeProgress = Clamp(oc.fadeProgress+step, 0, 1)
+	} else {
+		oc.fadeProgress = Clamp(oc.fadeProgress-step, 0, 1)
+	}
+
+	return oc.Alpha()
+}
+
+// Alpha retourne l'opacité correspondant à l'avancement du fondu
+func (oc *OccludableComponent) Alpha() uint8 {
+	return uint8(Lerp(255, float64(oc.FadedAlpha), oc.fadeProgress) + 0.5)
+}
+
+// IsFading retourne si le décor n'est pas complètement opaque
+func (oc *OccludableComponent) IsFading() bool {
+	return oc.fadeProgress > 0
+}
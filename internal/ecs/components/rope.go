@@ -0,0 +1,135 @@
+// internal/ecs/components/rope.go - Cordes simulées (chaînes, ponts, lianes)
+package components
+
+import "math"
+
+// Réglages par défaut des cordes
+const (
+	DefaultRopeStiffness  = 1.0  // 0..1, part de l'écart corrigée à chaque itération
+	DefaultRopeDamping    = 0.02 // 0..1, vitesse perdue à chaque pas
+	DefaultRopeIterations = 12   // Passes de contraintes de distance par pas
+	DefaultRopeGravity    = 600.0
+)
+
+// RopeSegment point de la corde ; un segment verrouillé ne bouge pas
+type RopeSegment struct {
+	Position     Vector2
+	PrevPosition Vector2
+	Locked       bool
+}
+
+// RopeComponent corde intégrée par Verlet : chaque segment garde sa vitesse
+// implicite (Position - PrevPosition), puis des contraintes de distance
+// ramènent les segments voisins à SegmentLength
+type RopeComponent struct {
+	Segments      []RopeSegment
+	SegmentLength float64
+	Stiffness     float64
+	Damping       float64
+	Iterations    int
+	Gravity       Vector2 // pixels/s²
+}
+
+// NewRopeComponent crée une corde tendue de start à end en count segments,
+// le premier verrouillé sur son ancrage
+func NewRopeComponent(start, end Vector2, count int) *RopeComponent {
+	if count < 2 {
+		count = 2
+	}
+
+	rope := &RopeComponent{
+		Segments:   make([]RopeSegment, count),
+		Stiffness:  DefaultRopeStiffness,
+		Damping:    DefaultRopeDamping,
+		Iterations: DefaultRopeIterations,
+		Gravity:    Vector2{X: 0, Y: DefaultRopeGravity},
+	}
+	step := end.Sub(start).Mul(1 / float64(count-1))
+	for i := range rope.Segments {
+		position := start.Add(step.Mul(float64(i)))
+		rope.Segments[i] = RopeSegment{Position: position, PrevPosition: position}
+	}
+	rope.SegmentLength = math.Hypot(step.X, step.Y)
+	rope.Segments[0].Locked = true
+	return rope
+}
+
+// Anchor verrouille le premier segment sur un point
+func (rc *RopeComponent) Anchor(position Vector2) {
+	rc.lock(0, position)
+}
+
+// Attach verrouille le dernier segment sur un objet suspendu
+func (rc *RopeComponent) Attach(position Vector2) {
+	rc.lock(len(rc.Segments)-1, position)
+}
+
+// lock fixe un segment à une position
+func (rc *RopeComponent) lock(index int, position Vector2) {
+	if index < 0 || index >= len(rc.Segments) {
+		return
+	}
+	rc.Segments[index] = RopeSegment{Position: position, PrevPosition: position, Locked: true}
+}
+
+// Update avance la simulation de dt secondes
+func (rc *RopeComponent) Update(dt float64) {
+	if dt <= 0 {
+		return
+	}
+
+	// Intégration de Verlet
+	keep := 1 - rc.Damping
+	acceleration := rc.Gravity.Mul(dt * dt)
+	for i := range rc.Segments {
+		segment := &rc.Segments[i]
+		if segment.Locked {
+			continue
+		}
+		velocity := segment.Position.Sub(segment.PrevPosition).Mul(keep)
+		segment.PrevPosition = segment.Position
+		segment.Position = segment.Position.Add(velocity).Add(acceleration)
+	}
+
+	for iteration := 0; iteration < rc.Iterations; iteration++ {
+		rc.applyConstraints()
+	}
+}
+
+// applyConstraints ramène chaque paire de segments voisins à SegmentLength ;
+// un segment verrouillé reporte toute la correction sur son voisin
+func (rc *RopeComponent) applyConstraints() {
+	for i := 0; i+1 < len(rc.Segments); i++ {
+		a, b := &rc.Segments[i], &rc.Segments[i+1]
+		if a.Locked && b.Locked {
+			continue
+		}
+
+		delta := b.Position.Sub(a.Position)
+		distance := math.Hypot(delta.X, delta.Y)
+		if distance == 0 {
+			continue
+		}
+		correction := delta.Mul((distance - rc.SegmentLength) / distance * rc.Stiffness)
+
+		switch {
+		case a.Locked:
+			b.Position = b.Position.Sub(correction)
+		case b.Locked:
+			a.Position = a.Position.Add(correction)
+		default:
+			a.Position = a.Position.Add(correction.Mul(0.5))
+			b.Position = b.Position.Sub(correction.Mul(0.5))
+		}
+	}
+}
+
+// Length retourne la longueur actuelle de la corde
+func (rc *RopeComponent) Length() float64 {
+	length := 0.0
+	for i := 0; i+1 < len(rc.Segments); i++ {
+		delta := rc.Segments[i+1].Position.Sub(rc.Segments[i].Position)
+		length += math.Hypot(delta.X, delta.Y)
+	}
+	return length
+}
@@ -0,0 +1,101 @@
+package components
+
+import (
+	"math"
+	"testing"
+)
+
+// catenaryParameter résout L = 2a·sinh(D / 2a) par dichotomie : paramètre de
+// la chaînette de longueur L tendue entre deux points à la même hauteur, distants de D
+func catenaryParameter(length, span float64) float64 {
+	low, high := 1e-3, 1e6
+	for i := 0; i < 200; i++ {
+		a := (low + high) / 2
+		if 2*a*math.Sinh(span/(2*a)) > length {
+			low = a
+		} else {
+			high = a
+		}
+	}
+	return (low + high) / 2
+}
+
+// settle fait tourner la simulation jusqu'à l'équilibre
+func settle(rope *RopeComponent, seconds float64) {
+	const dt = 1.0 / 60
+	for t := 0.0; t < seconds; t += dt {
+		rope.Update(dt)
+	}
+}
+
+func TestRopeHangingChainConvergesToCatenary(t *testing.T) {
+	const (
+		span     = 200.0
+		length   = 300.0
+		segments = 31
+	)
+
+	// Corde de 300 px dont l'extrémité est rapprochée à 200 px de l'ancrage
+	rope := NewRopeComponent(Vector2{X: 0, Y: 0}, Vector2{X: length, Y: 0}, segments)
+	rope.Iterations = 100
+	rope.Attach(Vector2{X: span, Y: 0})
+	settle(rope, 10)
+
+	if math.Abs(rope.Length()-length) > length*0.01 {
+		t.Errorf("longueur = %.1f, attendu %.1f (±1%%)", rope.Length(), length)
+	}
+
+	// y(x) = a·cosh((D/2)/a) - a·cosh((x - D/2)/a), axe Y vers le bas
+	a := catenaryParameter(length, span)
+	sag := a*math.Cosh(span/(2*a)) - a
+	maxError := 0.0
+	for i, segment := range rope.Segments {
+		x := segment.Position.X
+		want := a*math.Cosh(span/(2*a)) - a*math.Cosh((x-span/2)/a)
+		if err := math.Abs(segment.Position.Y - want); err > maxError {
+			maxError = err
+		}
+		if i > 0 && segment.Position.X < rope.Segments[i-1].Position.X {
+			t.Errorf("segment %d revient en arrière (x = %.1f)", i, x)
+		}
+	}
+	if maxError > sag*0.03 {
+		t.Errorf("écart maximal à la chaînette = %.2f px, attendu moins de %.2f px (3%% de la flèche %.1f px)", maxError, sag*0.03, sag)
+	}
+
+	// Le point le plus bas est au milieu de la portée
+	middle := rope.Segments[segments/2].Position
+	if math.Abs(middle.X-span/2) > 1 || math.Abs(middle.Y-sag) > sag*0.03 {
+		t.Errorf("milieu en %+v, attendu {%.1f %.1f}", middle, span/2, sag)
+	}
+}
+
+func TestRopeFreeEndHangsStraight(t *testing.T) {
+	rope := NewRopeComponent(Vector2{X: 0, Y: 0}, Vector2{X: 100, Y: 0}, 11)
+	rope.Iterations = 50
+	settle(rope, 10)
+
+	end := rope.Segments[len(rope.Segments)-1].Position
+	if math.Abs(end.X) > 1 || math.Abs(end.Y-100) > 1 {
+		t.Errorf("extrémité libre en %+v, attendu à la verticale de l'ancrage {0 100}", end)
+	}
+	if rope.Segments[0].Position != (Vector2{}) {
+		t.Errorf("ancrage déplacé en %+v", rope.Segments[0].Position)
+	}
+}
+
+func TestRopeLockedSegmentsStay(t *testing.T) {
+	rope := NewRopeComponent(Vector2{X: 10, Y: 10}, Vector2{X: 90, Y: 10}, 5)
+	rope.Anchor(Vector2{X: 0, Y: 0})
+	rope.Attach(Vector2{X: 80, Y: 0})
+	settle(rope, 1)
+
+	if rope.Segments[0].Position != (Vector2{}) || rope.Segments[4].Position != (Vector2{X: 80}) {
+		t.Errorf("extrémités en %+v et %+v, attendu verrouillées", rope.Segments[0].Position, rope.Segments[4].Position)
+	}
+	for i := 1; i < 4; i++ {
+		if rope.Segments[i].Position.Y <= 0 {
+			t.Errorf("segment %d en %+v, attendu sous les ancrages", i, rope.Segments[i].Position)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// internal/ecs/components/sign.go - Panneaux lisibles
+package components
+
+// DefaultSignFontSize hauteur de ligne de la police des panneaux (police 7x13)
+const DefaultSignFontSize = 13
+
+// SignComponent message fixe affiché quand le joueur lit un panneau
+type SignComponent struct {
+	Message  string
+	FontSize int // Hauteur de ligne de la police, en pixels
+}
+
+// NewSignComponent crée un panneau ; une taille nulle prend la police par défaut
+func NewSignComponent(message string, fontSize int) *SignComponent {
+	if fontSize <= 0 {
+		fontSize = DefaultSignFontSize
+	}
+	return &SignComponent{
+		Message:  message,
+		FontSize: fontSize,
+	}
+}
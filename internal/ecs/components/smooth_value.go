@@ -0,0 +1,43 @@
+// internal/ecs/components/smooth_value.go - Valeur affichée lissée (barres de vie, stamina)
+package components
+
+import (
+	"math"
+	"time"
+)
+
+// SmoothValue fait tendre une valeur affichée vers la valeur réelle,
+// pour que les dégâts et les soins se lisent comme une animation
+type SmoothValue struct {
+	Displayed float64
+	Speed     float64 // Plus la valeur est grande, plus la transition est rapide
+
+	initialized bool
+}
+
+// NewSmoothValue crée une valeur lissée
+func NewSmoothValue(speed float64) *SmoothValue {
+	return &SmoothValue{Speed: speed}
+}
+
+// Update rapproche la valeur affichée de la valeur réelle
+func (sv *SmoothValue) Update(actual float64, deltaTime time.Duration) {
+	if !sv.initialized {
+		sv.Snap(actual)
+		return
+	}
+
+	// Facteur indépendant du framerate
+	t := 1 - math.Exp(-sv.Speed*deltaTime.Seconds())
+	sv.Displayed = Lerp(sv.Displayed, actual, t)
+
+	if Abs(sv.Displayed-actual) < 0.01 {
+		sv.Displayed = actual
+	}
+}
+
+// Snap place immédiatement la valeur affichée sur la valeur réelle
+func (sv *SmoothValue) Snap(actual float64) {
+	sv.Displayed = actual
+	sv.initialized = true
+}
@@ -0,0 +1,86 @@
+// internal/ecs/components/speech_bubble.go - Bulles de paroles des PNJ
+package components
+
+import "time"
+
+// Disposition des bulles, en pixels (police 7x13)
+const (
+	SpeechBubbleCharWidth  = 7.0
+	SpeechBubbleLineHeight = 13.0
+	SpeechBubblePadding    = 5.0
+	SpeechBubbleRadius     = 3.0 // Coins arrondis
+	SpeechBubbleTailHeight = 6.0 // Pointe vers la tête du PNJ
+	SpeechBubbleGap        = 2.0 // Entre la pointe et la tête
+)
+
+// SpeechBubble courte phrase affichée au-dessus d'un PNJ, sans dialogue ;
+// elle se masque seule après Duration
+type SpeechBubble struct {
+	Text     string
+	Duration time.Duration
+	Visible  bool
+
+	elapsed time.Duration
+}
+
+// Show affiche une phrase pendant duration
+func (sb *SpeechBubble) Show(text string, duration time.Duration) {
+	sb.Text = text
+	sb.Duration = duration
+	sb.Visible = duration > 0 && text != ""
+	sb.elapsed = 0
+}
+
+// Hide masque la bulle
+func (sb *SpeechBubble) Hide() {
+	sb.Visible = false
+	sb.elapsed = 0
+}
+
+// Update avance le temps d'affichage et masque la bulle une fois Duration écoulée
+func (sb *SpeechBubble) Update(deltaTime time.Duration) {
+	if !sb.Visible {
+		return
+	}
+	sb.elapsed += deltaTime
+	if sb.elapsed >= sb.Duration {
+		sb.Hide()
+	}
+}
+
+// SpeechBubbleLayout place la bulle d'un texte au-dessus de la tête d'un PNJ,
+// centrée sur lui puis ramenée dans world pour ne jamais dépasser du bord.
+// tip est la pointe de la queue : sous la bulle, le plus près possible de la tête.
+func SpeechBubbleLayout(text string, head Vector2, world Rectangle) (bubble Rectangle, tip Vector2) {
+	bubble.Width = float64(len([]rune(text)))*SpeechBubbleCharWidth + 2*SpeechBubblePadding
+	bubble.Height = SpeechBubbleLineHeight + 2*SpeechBubblePadding
+	bubble.X = head.X - bubble.Width/2
+	bubble.Y = head.Y - SpeechBubbleGap - SpeechBubbleTailHeight - bubble.Height
+
+	// Bords du monde (une bulle plus large que le monde reste collée à gauche) ;
+	// un monde vide ne borne rien
+	if world.Width > 0 {
+		if bubble.X+bubble.Width > world.X+world.Width {
+			bubble.X = world.X + world.Width - bubble.Width
+		}
+		if bubble.X < world.X {
+			bubble.X = world.X
+		}
+	}
+	if world.Height > 0 && bubble.Y < world.Y {
+		bubble.Y = world.Y
+	}
+
+	// La queue suit la tête sans quitter le bas arrondi de la bulle
+	tipX := head.X
+	minX := bubble.X + SpeechBubbleRadius + SpeechBubbleTailHeight
+	maxX := bubble.X + bubble.Width - SpeechBubbleRadius - SpeechBubbleTailHeight
+	if tipX < minX {
+		tipX = minX
+	}
+	if tipX > maxX {
+		tipX = maxX
+	}
+	tip = Vector2{X: tipX, Y: bubble.Y + bubble.Height + SpeechBubbleTailHeight}
+	return bubble, tip
+}
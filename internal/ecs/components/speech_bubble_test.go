@@ -0,0 +1,101 @@
+package components
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpeechBubbleHidesAfterDuration(t *testing.T) {
+	const tick = 100 * time.Millisecond
+
+	tests := []struct {
+		name     string
+		duration time.Duration
+		ticks    int // Ticks avant disparition
+	}{
+		{"1 tick", tick, 1},
+		{"10 ticks", 10 * tick, 10},
+		{"durée non multiple", 10*tick + tick/2, 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bubble SpeechBubble
+			bubble.Show("Bonjour", tt.duration)
+
+			for i := 1; i < tt.ticks; i++ {
+				bubble.Update(tick)
+				if !bubble.Visible {
+					t.Fatalf("bulle masquée après %d ticks, attendu %d", i, tt.ticks)
+				}
+			}
+			bubble.Update(tick)
+			if bubble.Visible {
+				t.Errorf("bulle encore visible après %d ticks", tt.ticks)
+			}
+		})
+	}
+}
+
+func TestSpeechBubbleShowRejectsEmpty(t *testing.T) {
+	var bubble SpeechBubble
+	if bubble.Show("", time.Second); bubble.Visible {
+		t.Error("une bulle sans texte ne doit pas s'afficher")
+	}
+	if bubble.Show("Bonjour", 0); bubble.Visible {
+		t.Error("une bulle sans durée ne doit pas s'afficher")
+	}
+}
+
+func TestSpeechBubbleLayoutStaysInWorld(t *testing.T) {
+	world := Rectangle{X: 0, Y: 0, Width: 400, Height: 300}
+	const text = "Les cloches sonnent..." // 22 caractères : bulle de 164 px
+
+	tests := []struct {
+		name     string
+		head     Vector2
+		world    Rectangle
+		text     string
+		centered bool // La bulle reste centrée sur la tête
+	}{
+		{"au milieu", Vector2{X: 200, Y: 150}, world, text, true},
+		{"bord gauche", Vector2{X: 5, Y: 150}, world, text, false},
+		{"bord droit", Vector2{X: 398, Y: 150}, world, text, false},
+		{"bord haut", Vector2{X: 200, Y: 4}, world, text, true},
+		{"coin haut gauche", Vector2{X: 0, Y: 0}, world, text, false},
+		{"monde décalé", Vector2{X: 1010, Y: 520}, Rectangle{X: 1000, Y: 500, Width: 400, Height: 300}, text, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bubble, tip := SpeechBubbleLayout(tt.text, tt.head, tt.world)
+
+			if bubble.X < tt.world.X || bubble.X+bubble.Width > tt.world.X+tt.world.Width || bubble.Y < tt.world.Y {
+				t.Errorf("bulle %+v hors du monde %+v", bubble, tt.world)
+			}
+			if tt.centered && bubble.X+bubble.Width/2 != tt.head.X {
+				t.Errorf("centre de la bulle = %.1f, attendu %.1f", bubble.X+bubble.Width/2, tt.head.X)
+			}
+
+			// La pointe reste sous la bulle, entre ses coins arrondis
+			if tip.Y != bubble.Y+bubble.Height+SpeechBubbleTailHeight {
+				t.Errorf("pointe à y = %.1f, attendu sous la bulle", tip.Y)
+			}
+			if tip.X < bubble.X+SpeechBubbleRadius || tip.X > bubble.X+bubble.Width-SpeechBubbleRadius {
+				t.Errorf("pointe à x = %.1f hors de la bulle [%.1f, %.1f]", tip.X, bubble.X, bubble.X+bubble.Width)
+			}
+		})
+	}
+}
+
+func TestSpeechBubbleLayoutAboveHead(t *testing.T) {
+	head := Vector2{X: 200, Y: 150}
+	bubble, tip := SpeechBubbleLayout("Salut", head, Rectangle{})
+
+	if want := 5*SpeechBubbleCharWidth + 2*SpeechBubblePadding; bubble.Width != want {
+		t.Errorf("largeur = %.1f, attendu %.1f", bubble.Width, want)
+	}
+	if want := (Vector2{X: head.X, Y: head.Y - SpeechBubbleGap}); tip != want {
+		t.Errorf("pointe en %+v, attendu %+v", tip, want)
+	}
+}
@@ -32,6 +32,14 @@ type SpriteRendererComponent struct {
 	LastDirection    string
 	IsAttacking      bool
 	AttackTime       float64
+	AttackDuration   float64 // Durée d'une attaque en secondes (selon l'arme)
+
+	// Fondu entre deux animations
+	pendingAnim   *SpriteAnimationData
+	pendingFrame  int
+	pendingTime   float64
+	blendProgress float64 // 0.0 = animation actuelle, 1.0 = nouvelle animation
+	blendDuration float64 // Durée du fondu en secondes
 }
 
 // SpriteAnimationData représente une animation de sprite
@@ -52,6 +60,7 @@ func NewSpriteRendererComponent() *SpriteRendererComponent {
 		IsPlaying:     true,
 		LastDirection: "down",
 		IsAttacking:   false,
+		AttackDuration: DefaultAttackDuration.Seconds(),
 	}
 }
 
@@ -62,26 +71,32 @@ func (src *SpriteRendererComponent) SetAnimation(animation *SpriteAnimationData)
 		src.CurrentFrame = 0
 		src.AnimationTime = 0
 		src.IsPlaying = true
+		if animation != nil && len(animation.Frames) > 0 {
+			src.SourceRect = animation.Frames[0]
+		}
 	}
 }
 
 // Update met à jour l'animation
 func (src *SpriteRendererComponent) Update(deltaTime time.Duration) {
-	if !src.IsPlaying || src.CurrentAnimation == nil || len(src.CurrentAnimation.Frames) == 0 {
-		return
-	}
-	
 	dt := deltaTime.Seconds()
-	
-	// Mettre à jour le temps d'attaque
+
+	// Faire avancer le fondu en cours
+	src.updateBlend(dt)
+
+	// Mettre à jour le temps d'attaque, même sans animation chargée :
+	// il rythme l'engagement du joueur et les frames actives du coup
 	if src.IsAttacking {
 		src.AttackTime += dt
-		// L'attaque dure 0.3 secondes
-		if src.AttackTime >= 0.3 {
+		if src.AttackTime >= src.AttackDuration {
 			src.IsAttacking = false
 			src.AttackTime = 0
 		}
 	}
+
+	if !src.IsPlaying || src.CurrentAnimation == nil || len(src.CurrentAnimation.Frames) == 0 {
+		return
+	}
 	
 	// Mettre à jour l'animation
 	src.AnimationTime += dt
@@ -97,11 +112,6 @@ func (src *SpriteRendererComponent) Update(deltaTime time.Duration) {
 			} else {
 				src.CurrentFrame = len(src.CurrentAnimation.Frames) - 1
 				src.IsPlaying = false
-				
-				// Si c'était une attaque, revenir à idle
-				if src.IsAttacking {
-					src.IsAttacking = false
-				}
 			}
 		}
 	}
@@ -118,6 +128,11 @@ func (src *SpriteRendererComponent) StartAttack() {
 	src.AttackTime = 0
 }
 
+// AttackElapsed retourne le temps écoulé depuis le début de l'attaque en cours
+func (src *SpriteRendererComponent) AttackElapsed() time.Duration {
+	return time.Duration(src.AttackTime * float64(time.Second))
+}
+
 // GetCurrentAnimationName retourne le nom de l'animation actuelle basée sur l'état
 func (src *SpriteRendererComponent) GetCurrentAnimationName() string {
 	if src.IsAttacking {
@@ -132,4 +147,99 @@ func (src *SpriteRendererComponent) SetDirection(direction string, isMoving bool
 	
 	// L'animation sera mise à jour par le système de rendu
 	// en fonction de l'état actuel (attaque ou idle/mouvement)
-}
\ No newline at end of file
+}
+
+// BlendTo démarre un fondu enchaîné vers une nouvelle animation
+func (src *SpriteRendererComponent) BlendTo(newAnim *SpriteAnimationData, blendTime float64) {
+	if newAnim == nil || newAnim == src.pendingAnim {
+		return
+	}
+
+	// Retour à l'animation actuelle avant la fin du fondu : il est abandonné
+	if newAnim == src.CurrentAnimation {
+		src.cancelBlend()
+		return
+	}
+
+	// Sans animation de départ ou sans durée, pas de fondu possible
+	if src.CurrentAnimation == nil || blendTime <= 0 {
+		src.cancelBlend()
+		src.SetAnimation(newAnim)
+		return
+	}
+
+	src.pendingAnim = newAnim
+	src.pendingFrame = 0
+	src.pendingTime = 0
+	src.blendProgress = 0
+	src.blendDuration = blendTime
+}
+
+// updateBlend fait progresser le fondu et termine la transition à 100%
+func (src *SpriteRendererComponent) updateBlend(dt float64) {
+	if src.pendingAnim == nil {
+		return
+	}
+
+	src.blendProgress += dt / src.blendDuration
+
+	// La nouvelle animation joue déjà pendant le fondu
+	if len(src.pendingAnim.Frames) > 0 && src.pendingAnim.FrameDuration > 0 {
+		src.pendingTime += dt
+		if src.pendingTime >= src.pendingAnim.FrameDuration {
+			src.pendingTime = 0
+			src.pendingFrame++
+			if src.pendingFrame >= len(src.pendingAnim.Frames) {
+				if src.pendingAnim.Loop {
+					src.pendingFrame = 0
+				} else {
+					src.pendingFrame = len(src.pendingAnim.Frames) - 1
+				}
+			}
+		}
+	}
+
+	if src.blendProgress >= 1.0 {
+		src.CurrentAnimation = src.pendingAnim
+		src.CurrentFrame = src.pendingFrame
+		src.AnimationTime = src.pendingTime
+		src.IsPlaying = true
+		if src.CurrentFrame < len(src.CurrentAnimation.Frames) {
+			src.SourceRect = src.CurrentAnimation.Frames[src.CurrentFrame]
+		}
+		src.cancelBlend()
+	}
+}
+
+// cancelBlend abandonne le fondu en cours
+func (src *SpriteRendererComponent) cancelBlend() {
+	src.pendingAnim = nil
+	src.pendingFrame = 0
+	src.pendingTime = 0
+	src.blendProgress = 0
+	src.blendDuration = 0
+}
+
+// PendingSourceRect retourne le rectangle source de la frame de l'animation
+// suivante pendant un fondu
+func (src *SpriteRendererComponent) PendingSourceRect() (Rectangle, bool) {
+	if src.pendingAnim == nil || src.pendingFrame >= len(src.pendingAnim.Frames) {
+		return Rectangle{}, false
+	}
+	return src.pendingAnim.Frames[src.pendingFrame], true
+}
+
+// IsBlending retourne si un fondu est en cours
+func (src *SpriteRendererComponent) IsBlending() bool {
+	return src.pendingAnim != nil
+}
+
+// BlendAlphas retourne l'opacité de l'animation actuelle (1→0) et de la
+// suivante (0→1), lissées en début et fin de fondu
+func (src *SpriteRendererComponent) BlendAlphas() (float64, float64) {
+	if src.pendingAnim == nil {
+		return 1.0, 0.0
+	}
+	progress := SmoothStep(0, 1, src.blendProgress)
+	return 1.0 - progress, progress
+}
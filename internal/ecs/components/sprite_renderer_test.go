@@ -0,0 +1,108 @@
+package components
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func newBlendAnimations() (*SpriteAnimationData, *SpriteAnimationData) {
+	run := &SpriteAnimationData{
+		Name:          "run",
+		Frames:        []Rectangle{{X: 0, Y: 0, Width: 16, Height: 16}},
+		FrameDuration: 0.1,
+		Loop:          true,
+	}
+	idle := &SpriteAnimationData{
+		Name:          "idle",
+		Frames:        []Rectangle{{X: 16, Y: 0, Width: 16, Height: 16}},
+		FrameDuration: 0.5,
+		Loop:          true,
+	}
+	return run, idle
+}
+
+func TestBlendToAlphas(t *testing.T) {
+	const blendTime = 0.2
+
+	tests := []struct {
+		name        string
+		elapsed     float64 // Fraction de blendTime écoulée
+		wantCurrent float64
+		wantNext    float64
+		wantBlend   bool
+	}{
+		{"0%", 0, 1, 0, true},
+		{"50%", 0.5, 0.5, 0.5, true},
+		{"100%", 1, 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run, idle := newBlendAnimations()
+			src := NewSpriteRendererComponent()
+			src.SetAnimation(run)
+			src.BlendTo(idle, blendTime)
+
+			if tt.elapsed > 0 {
+				src.Update(time.Duration(tt.elapsed * blendTime * float64(time.Second)))
+			}
+
+			current, next := src.BlendAlphas()
+			if math.Abs(current-tt.wantCurrent) > 1e-9 || math.Abs(next-tt.wantNext) > 1e-9 {
+				t.Errorf("alphas = (%.3f, %.3f), attendu (%.3f, %.3f)", current, next, tt.wantCurrent, tt.wantNext)
+			}
+			if src.IsBlending() != tt.wantBlend {
+				t.Errorf("IsBlending = %t, attendu %t", src.IsBlending(), tt.wantBlend)
+			}
+		})
+	}
+}
+
+func TestBlendToCompletesOnNewAnimation(t *testing.T) {
+	run, idle := newBlendAnimations()
+	src := NewSpriteRendererComponent()
+	src.SetAnimation(run)
+	src.BlendTo(idle, 0.2)
+
+	if _, ok := src.PendingSourceRect(); !ok {
+		t.Fatal("pas de frame entrante pendant le fondu")
+	}
+
+	src.Update(250 * time.Millisecond)
+	if src.CurrentAnimation != idle {
+		t.Fatalf("animation courante = %q, attendu %q", src.CurrentAnimation.Name, idle.Name)
+	}
+	if src.SourceRect != idle.Frames[0] {
+		t.Errorf("SourceRect = %+v, attendu %+v", src.SourceRect, idle.Frames[0])
+	}
+	if _, ok := src.PendingSourceRect(); ok {
+		t.Error("frame entrante encore présente après le fondu")
+	}
+}
+
+func TestBlendToCurrentAnimationCancels(t *testing.T) {
+	run, idle := newBlendAnimations()
+	src := NewSpriteRendererComponent()
+	src.SetAnimation(run)
+	src.BlendTo(idle, 0.2)
+	src.BlendTo(run, 0.2)
+
+	if src.IsBlending() {
+		t.Error("le retour à l'animation courante doit annuler le fondu")
+	}
+	if src.CurrentAnimation != run {
+		t.Errorf("animation courante = %q, attendu %q", src.CurrentAnimation.Name, run.Name)
+	}
+}
+
+func TestBlendToWithoutDurationSnaps(t *testing.T) {
+	run, idle := newBlendAnimations()
+	src := NewSpriteRendererComponent()
+	src.SetAnimation(run)
+	src.BlendTo(idle, 0)
+
+	if src.IsBlending() || src.CurrentAnimation != idle {
+		t.Error("un fondu de durée nulle doit passer directement à la nouvelle animation")
+	}
+}
@@ -0,0 +1,126 @@
+// internal/ecs/components/status_effects.go - Altérations d'état : poison
+package components
+
+import (
+	"math"
+	"time"
+)
+
+// Réglages par défaut du poison
+const (
+	DefaultPoisonDamagePerStack = 2.0             // Points de vie perdus par seconde et par cumul
+	DefaultPoisonMaxStacks      = 5               // Cumuls au plus
+	DefaultPoisonEscalation     = 3 * time.Second // Exposition continue avant aggravation
+	DefaultPoisonStackInterval  = time.Second     // Puis un cumul de plus à chaque intervalle
+	DefaultPoisonLinger         = 4 * time.Second // Le poison persiste après avoir quitté le sol
+)
+
+// PoisonDoT dégâts sur la durée d'un empoisonnement : chaque cumul (stack)
+// retire DamagePerStack points de vie par seconde. Se tenir sur un sol
+// empoisonné pose un cumul ; au-delà de Escalation d'exposition continue, un
+// cumul s'ajoute chaque StackInterval. Hors du sol, le poison se dissipe
+// après Linger ; un antidote le guérit aussitôt.
+type PoisonDoT struct {
+	DamagePerStack float64
+	MaxStacks      int
+	Escalation     time.Duration
+	StackInterval  time.Duration
+	Linger         time.Duration
+
+	Stacks    int
+	exposure  time.Duration // Exposition continue au sol empoisonné
+	remaining time.Duration // Temps restant avant dissipation, hors du sol
+	pending   float64       // Dégâts fractionnaires pas encore appliqués
+}
+
+// NewPoisonDoT crée un empoisonnement aux réglages par défaut, sans cumul
+func NewPoisonDoT() PoisonDoT {
+	return PoisonDoT{
+		DamagePerStack: DefaultPoisonDamagePerStack,
+		MaxStacks:      DefaultPoisonMaxStacks,
+		Escalation:     DefaultPoisonEscalation,
+		StackInterval:  DefaultPoisonStackInterval,
+		Linger:         DefaultPoisonLinger,
+	}
+}
+
+// IsActive retourne si le poison agit encore
+func (p *PoisonDoT) IsActive() bool {
+	return p.Stacks > 0
+}
+
+// Rate retourne les points de vie perdus par seconde
+func (p *PoisonDoT) Rate() float64 {
+	return float64(p.Stacks) * p.DamagePerStack
+}
+
+// Exposure retourne la durée d'exposition continue au sol empoisonné
+func (p *PoisonDoT) Exposure() time.Duration {
+	return p.exposure
+}
+
+// Tick fait avancer le poison de deltaTime et retourne les points de vie à
+// retirer. exposed indique que le joueur se tient sur un sol empoisonné :
+// l'exposition se cumule, sinon elle retombe à zéro et le poison se dissipe.
+func (p *PoisonDoT) Tick(deltaTime time.Duration, exposed bool) int {
+	if exposed {
+		p.expose(deltaTime)
+	} else {
+		p.exposure = 0
+	}
+	if p.Stacks == 0 {
+		return 0
+	}
+
+	p.pending += p.Rate() * deltaTime.Seconds()
+	damage := int(math.Floor(p.pending))
+	p.pending -= float64(damage)
+
+	if !exposed {
+		p.remaining -= deltaTime
+		if p.remaining <= 0 {
+			p.Cure()
+		}
+	}
+	return damage
+}
+
+// expose cumule l'exposition : un cumul dès l'entrée, puis un de plus par
+// StackInterval une fois Escalation dépassée
+func (p *PoisonDoT) expose(deltaTime time.Duration) {
+	p.exposure += deltaTime
+	p.remaining = p.Linger
+
+	stacks := 1
+	if p.exposure > p.Escalation && p.StackInterval > 0 {
+		stacks += 1 + int((p.exposure-p.Escalation-1)/p.StackInterval)
+	}
+	if p.MaxStacks > 0 && stacks > p.MaxStacks {
+		stacks = p.MaxStacks
+	}
+	if stacks > p.Stacks {
+		p.Stacks = stacks
+	}
+}
+
+// Cure retire tous les cumuls (antidote) et retourne leur nombre
+func (p *PoisonDoT) Cure() int {
+	stacks := p.Stacks
+	p.Stacks = 0
+	p.exposure = 0
+	p.remaining = 0
+	p.pending = 0
+	return stacks
+}
+
+// DrainHealth retire des points de vie sans passer par la défense ni
+// l'invulnérabilité (dégâts sur la durée). Sans effet en mode dieu.
+func (pc *PlayerComponent) DrainHealth(amount int) {
+	if pc.GodMode || amount <= 0 {
+		return
+	}
+	pc.Health -= amount
+	if pc.Health < 0 {
+		pc.Health = 0
+	}
+}
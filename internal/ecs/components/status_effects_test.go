@@ -0,0 +1,117 @@
+package components
+
+import (
+	"testing"
+	"time"
+)
+
+// tickPoison fait avancer le poison par pas de 100 ms pendant duration et
+// retourne les dégâts cumulés
+func tickPoison(p *PoisonDoT, duration time.Duration, exposed bool) int {
+	const step = 100 * time.Millisecond
+	damage := 0
+	for elapsed := time.Duration(0); elapsed < duration; elapsed += step {
+		damage += p.Tick(step, exposed)
+	}
+	return damage
+}
+
+func TestPoisonEscalation(t *testing.T) {
+	// Un cumul dès l'entrée, puis un de plus par seconde au-delà de 3 s
+	tests := []struct {
+		name       string
+		exposure   time.Duration
+		wantStacks int
+	}{
+		{"entrée sur le sol", 100 * time.Millisecond, 1},
+		{"juste avant l'aggravation", 3 * time.Second, 1},
+		{"aggravation", 3500 * time.Millisecond, 2},
+		{"une seconde de plus", 4500 * time.Millisecond, 3},
+		{"six secondes", 6 * time.Second, 4},
+		{"plafond des cumuls", 20 * time.Second, DefaultPoisonMaxStacks},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poison := NewPoisonDoT()
+			tickPoison(&poison, tt.exposure, true)
+
+			if poison.Stacks != tt.wantStacks {
+				t.Errorf("cumuls = %d, attendu %d", poison.Stacks, tt.wantStacks)
+			}
+			if want := float64(tt.wantStacks) * DefaultPoisonDamagePerStack; poison.Rate() != want {
+				t.Errorf("Rate = %.1f PV/s, attendu %.1f", poison.Rate(), want)
+			}
+		})
+	}
+}
+
+func TestPoisonEscalationNeedsContinuousExposure(t *testing.T) {
+	poison := NewPoisonDoT()
+	tickPoison(&poison, 2500*time.Millisecond, true)
+	tickPoison(&poison, time.Second, false) // Un pas hors du sol
+	tickPoison(&poison, 2500*time.Millisecond, true)
+
+	if poison.Stacks != 1 {
+		t.Errorf("cumuls = %d, attendu 1 (l'exposition repart de zéro)", poison.Stacks)
+	}
+	if poison.Exposure() != 2500*time.Millisecond {
+		t.Errorf("Exposure = %v, attendu 2.5s", poison.Exposure())
+	}
+}
+
+func TestPoisonDamageAndLinger(t *testing.T) {
+	poison := NewPoisonDoT()
+
+	// 1 cumul pendant 1 s : 2 PV, appliqués au fil des pas de 100 ms
+	if damage := tickPoison(&poison, time.Second, true); damage != 2 {
+		t.Errorf("dégâts sur le sol = %d, attendu 2", damage)
+	}
+
+	// Hors du sol, le poison agit encore pendant Linger puis se dissipe
+	if damage := tickPoison(&poison, DefaultPoisonLinger-100*time.Millisecond, false); damage < 7 || damage > 8 {
+		t.Errorf("dégâts hors du sol = %d, attendu 7 à 8", damage)
+	}
+	if !poison.IsActive() {
+		t.Fatal("le poison doit persister jusqu'à la fin de Linger")
+	}
+	tickPoison(&poison, 100*time.Millisecond, false)
+	if poison.IsActive() {
+		t.Error("le poison doit se dissiper après Linger")
+	}
+}
+
+func TestPoisonCureClearsAllStacks(t *testing.T) {
+	tests := []struct {
+		name     string
+		exposure time.Duration
+	}{
+		{"un cumul", time.Second},
+		{"cumuls aggravés", 6 * time.Second},
+		{"plafond", 20 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poison := NewPoisonDoT()
+			tickPoison(&poison, tt.exposure, true)
+			stacks := poison.Stacks
+
+			if cured := poison.Cure(); cured != stacks {
+				t.Errorf("Cure = %d, attendu %d", cured, stacks)
+			}
+			if poison.IsActive() || poison.Rate() != 0 || poison.Exposure() != 0 {
+				t.Errorf("après Cure : cumuls = %d, exposition = %v", poison.Stacks, poison.Exposure())
+			}
+			if damage := tickPoison(&poison, time.Second, false); damage != 0 {
+				t.Errorf("dégâts après l'antidote = %d, attendu 0", damage)
+			}
+
+			// De retour sur le sol, l'aggravation repart de zéro
+			tickPoison(&poison, time.Second, true)
+			if poison.Stacks != 1 {
+				t.Errorf("cumuls après retour sur le sol = %d, attendu 1", poison.Stacks)
+			}
+		})
+	}
+}
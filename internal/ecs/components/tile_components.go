@@ -0,0 +1,128 @@
+// internal/ecs/components/tile_components.go - Composants des tuiles du monde
+package components
+
+import "math"
+
+// ===============================
+// MATÉRIAUX
+// ===============================
+
+// TileMaterial matériau de surface d'une tuile
+type TileMaterial int
+
+const (
+	MaterialStone TileMaterial = iota
+	MaterialGrass
+	MaterialWood
+	MaterialMetal
+	MaterialWater
+	MaterialPoison // PoisonTile : sol empoisonné, qui empoisonne qui s'y tient
+)
+
+// String retourne la représentation string du matériau
+func (m TileMaterial) String() string {
+	switch m {
+	case MaterialGrass:
+		return "grass"
+	case MaterialWood:
+		return "wood"
+	case MaterialMetal:
+		return "metal"
+	case MaterialWater:
+		return "water"
+	case MaterialPoison:
+		return "poison"
+	default:
+		return "stone"
+	}
+}
+
+// IsPoison retourne si la tuile empoisonne qui s'y tient
+func (m TileMaterial) IsPoison() bool {
+	return m == MaterialPoison
+}
+
+// FootstepSFX retourne l'identifiant du son de pas sur ce matériau
+func (m TileMaterial) FootstepSFX() string {
+	return "sfx_footstep_" + m.String()
+}
+
+// ===============================
+// COMPOSANT DE TUILE
+// ===============================
+
+// ParseTileMaterial retrouve un matériau depuis son nom ; false si inconnu
+func ParseTileMaterial(name string) (TileMaterial, bool) {
+	for _, material := range []TileMaterial{MaterialStone, MaterialGrass, MaterialWood, MaterialMetal, MaterialWater, MaterialPoison} {
+		if material.String() == name {
+			return material, true
+		}
+	}
+	return MaterialStone, false
+}
+
+// TileComponent propriétés d'une tuile de la grille de collision. Une tuile
+// destructible perd un point de Health par coup capable de briser les murs ;
+// à zéro, elle n'est plus solide et prend le matériau DestroyedTileID
+// (gravats ou sol).
+type TileComponent struct {
+	Material TileMaterial
+	Solid    bool
+
+	Destructible    bool
+	Health          int
+	DestroyedTileID TileMaterial
+}
+
+// ===============================
+// TUILES EN PENTE
+// ===============================
+
+// SlopedTile tuile solide coupée par une pente passant par son centre : seule
+// la partie sous la pente bloque. Gradient est le dénivelé par unité de
+// largeur (0 : plat, 1 : 45° montant vers la droite, négatif : descendant).
+type SlopedTile struct {
+	Gradient float64
+}
+
+// ComputeSurfaceNormal retourne la normale unitaire de la pente, orientée
+// vers l'extérieur (Y vers le bas : (0, -1) pour une pente nulle)
+func ComputeSurfaceNormal(tile SlopedTile) Vector2 {
+	length := math.Hypot(tile.Gradient, 1)
+	return Vector2{X: -tile.Gradient / length, Y: -1 / length}
+}
+
+// Polygon retourne la partie solide de la tuile bounds : la tuile coupée par
+// la pente, du côté opposé à la normale
+func (st SlopedTile) Polygon(bounds Rectangle) Polygon {
+	normal := ComputeSurfaceNormal(st)
+	center := Vector2{X: bounds.X + bounds.Width/2, Y: bounds.Y + bounds.Height/2}
+	side := func(point Vector2) float64 {
+		return dot(point.Sub(center), normal)
+	}
+
+	// Découpe du carré par le demi-plan (Sutherland-Hodgman, un seul plan)
+	corners := RectanglePolygon(bounds)
+	solid := make(Polygon, 0, len(corners)+1)
+	for i, current := range corners {
+		next := corners[(i+1)%len(corners)]
+		currentSide, nextSide := side(current), side(next)
+		if currentSide <= 0 {
+			solid = append(solid, current)
+		}
+		if (currentSide < 0 && nextSide > 0) || (currentSide > 0 && nextSide < 0) {
+			t := currentSide / (currentSide - nextSide)
+			solid = append(solid, current.Add(next.Sub(current).Mul(t)))
+		}
+	}
+	return solid
+}
+
+// ProjectOnSurface retire d'une vitesse sa composante qui s'enfonce dans la
+// surface de normale normal : le mouvement glisse le long de la pente
+func ProjectOnSurface(velocity, normal Vector2) Vector2 {
+	if into := dot(velocity, normal); into < 0 {
+		return velocity.Sub(normal.Mul(into))
+	}
+	return velocity
+}
@@ -0,0 +1,115 @@
+package components
+
+import (
+	"math"
+	"testing"
+)
+
+func vectorsClose(a, b Vector2, tolerance float64) bool {
+	return math.Abs(a.X-b.X) < tolerance && math.Abs(a.Y-b.Y) < tolerance
+}
+
+// polygonArea aire d'un polygone (formule du lacet)
+func polygonArea(polygon Polygon) float64 {
+	area := 0.0
+	for i, current := range polygon {
+		next := polygon[(i+1)%len(polygon)]
+		area += current.X*next.Y - next.X*current.Y
+	}
+	return math.Abs(area) / 2
+}
+
+func hasVertex(polygon Polygon, vertex Vector2) bool {
+	for _, candidate := range polygon {
+		if vectorsClose(candidate, vertex, 1e-9) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestComputeSurfaceNormal(t *testing.T) {
+	tests := []struct {
+		name     string
+		gradient float64
+		want     Vector2
+	}{
+		{"plat", 0, Vector2{X: 0, Y: -1}},
+		{"45° montant", 1, Vector2{X: -0.707, Y: -0.707}},
+		{"45° descendant", -1, Vector2{X: 0.707, Y: -0.707}},
+		{"pente douce", 0.5, Vector2{X: -0.447, Y: -0.894}},
+		{"pente douce descendante", -0.5, Vector2{X: 0.447, Y: -0.894}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normal := ComputeSurfaceNormal(SlopedTile{Gradient: tt.gradient})
+			if !vectorsClose(normal, tt.want, 1e-3) {
+				t.Errorf("normale = %+v, attendu %+v", normal, tt.want)
+			}
+			if length := math.Hypot(normal.X, normal.Y); math.Abs(length-1) > 1e-9 {
+				t.Errorf("longueur = %.6f, attendu 1", length)
+			}
+		})
+	}
+}
+
+func TestProjectOnSurface(t *testing.T) {
+	slope := ComputeSurfaceNormal(SlopedTile{Gradient: 1})
+
+	tests := []struct {
+		name     string
+		velocity Vector2
+		normal   Vector2
+		want     Vector2
+	}{
+		{"chute sur le sol", Vector2{X: 30, Y: 100}, Vector2{Y: -1}, Vector2{X: 30}},
+		{"s'éloigne du sol", Vector2{X: 30, Y: -100}, Vector2{Y: -1}, Vector2{X: 30, Y: -100}},
+		{"chute sur la pente", Vector2{Y: 100}, slope, Vector2{X: -50, Y: 50}},
+		{"le long de la pente", Vector2{X: 40, Y: -40}, slope, Vector2{X: 40, Y: -40}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProjectOnSurface(tt.velocity, tt.normal); !vectorsClose(got, tt.want, 1e-9) {
+				t.Errorf("ProjectOnSurface = %+v, attendu %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlopedTilePolygon(t *testing.T) {
+	tile := Rectangle{X: 0, Y: 0, Width: 32, Height: 32}
+
+	tests := []struct {
+		name     string
+		gradient float64
+		solid    []Vector2 // Coins de la tuile dans la partie solide
+		empty    []Vector2 // Coins hors de la partie solide
+	}{
+		{"plat", 0, []Vector2{{X: 0, Y: 32}, {X: 32, Y: 32}}, []Vector2{{X: 0, Y: 0}, {X: 32, Y: 0}}},
+		{"45° montant", 1, []Vector2{{X: 32, Y: 32}, {X: 32, Y: 0}, {X: 0, Y: 32}}, []Vector2{{X: 0, Y: 0}}},
+		{"45° descendant", -1, []Vector2{{X: 0, Y: 32}, {X: 0, Y: 0}, {X: 32, Y: 32}}, []Vector2{{X: 32, Y: 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polygon := SlopedTile{Gradient: tt.gradient}.Polygon(tile)
+
+			// La pente passe par le centre : la moitié de la tuile est solide
+			if area := polygonArea(polygon); math.Abs(area-512) > 1e-6 {
+				t.Errorf("aire = %.1f, attendu 512", area)
+			}
+			for _, corner := range tt.solid {
+				if !hasVertex(polygon, corner) {
+					t.Errorf("coin %+v absent de la partie solide %+v", corner, polygon)
+				}
+			}
+			for _, corner := range tt.empty {
+				if hasVertex(polygon, corner) {
+					t.Errorf("coin %+v dans la partie solide %+v", corner, polygon)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// internal/ecs/components/timer.go - Minuteur partagé par les composants et le moteur (core.Timer)
+package components
+
+import "time"
+
+// Timer représente un minuteur
+type Timer struct {
+	Duration   time.Duration
+	Elapsed    time.Duration
+	Running    bool
+	Loop       bool
+	OnComplete func()
+}
+
+// NewTimer crée un nouveau Timer
+func NewTimer(duration time.Duration) *Timer {
+	return &Timer{
+		Duration: duration,
+		Running:  false,
+		Loop:     false,
+	}
+}
+
+// Start démarre le timer
+func (t *Timer) Start() {
+	t.Running = true
+	t.Elapsed = 0
+}
+
+// StartWith démarre le timer pour une nouvelle durée
+func (t *Timer) StartWith(duration time.Duration) {
+	t.Duration = duration
+	t.Start()
+}
+
+// Stop arrête le timer
+func (t *Timer) Stop() {
+	t.Running = false
+}
+
+// Reset remet le timer à zéro
+func (t *Timer) Reset() {
+	t.Elapsed = 0
+}
+
+// Update met à jour le timer
+func (t *Timer) Update(dt time.Duration) {
+	if !t.Running {
+		return
+	}
+
+	t.Elapsed += dt
+
+	if t.Elapsed >= t.Duration {
+		if t.OnComplete != nil {
+			t.OnComplete()
+		}
+
+		if t.Loop {
+			t.Elapsed = 0
+		} else {
+			t.Running = false
+		}
+	}
+}
+
+// IsComplete retourne true si le timer est terminé
+func (t *Timer) IsComplete() bool {
+	return t.Elapsed >= t.Duration
+}
+
+// IsActive retourne true si le timer tourne et n'a pas atteint sa durée
+func (t *Timer) IsActive() bool {
+	return t.Running && t.Elapsed < t.Duration
+}
+
+// Remaining retourne le temps restant (0 si le timer est arrêté ou terminé)
+func (t *Timer) Remaining() time.Duration {
+	if !t.IsActive() {
+		return 0
+	}
+	return t.Duration - t.Elapsed
+}
+
+// Progress retourne le progrès du timer (0.0 à 1.0)
+func (t *Timer) Progress() float64 {
+	if t.Duration == 0 {
+		return 1.0
+	}
+	progress := float64(t.Elapsed) / float64(t.Duration)
+	if progress > 1.0 {
+		return 1.0
+	}
+	return progress
+}
@@ -0,0 +1,51 @@
+// internal/ecs/components/wall_run.go - Course le long des murs et saut mural
+package components
+
+import "time"
+
+// Réglages de la course murale
+const (
+	WallRunDuration = 500 * time.Millisecond // Durée d'une course le long d'un mur
+	WallJumpSpeed   = 450.0                  // Élan du saut mural, en pixels/s
+)
+
+// WallRunComponent course le long d'un mur, déclenchée par une roulade contre
+// celui-ci : le joueur longe la paroi à la vitesse de la roulade, sans
+// friction, jusqu'à expiration de Duration
+type WallRunComponent struct {
+	Active     bool
+	WallNormal Vector2       // Normale du mur, orientée vers le joueur
+	Duration   time.Duration // Temps de course restant
+}
+
+// NewWallRunComponent crée un composant de course murale inactif
+func NewWallRunComponent() *WallRunComponent {
+	return &WallRunComponent{}
+}
+
+// Start lance une course le long du mur de normale donnée
+func (wr *WallRunComponent) Start(normal Vector2, duration time.Duration) {
+	wr.Active = true
+	wr.WallNormal = normal
+	wr.Duration = duration
+}
+
+// Stop interrompt la course en cours
+func (wr *WallRunComponent) Stop() {
+	wr.Active = false
+	wr.Duration = 0
+}
+
+// Update décompte la course ; retourne true à la frame où elle expire
+func (wr *WallRunComponent) Update(deltaTime time.Duration) bool {
+	if !wr.Active {
+		return false
+	}
+
+	wr.Duration -= deltaTime
+	if wr.Duration > 0 {
+		return false
+	}
+	wr.Stop()
+	return true
+}
@@ -0,0 +1,123 @@
+// internal/ecs/components/weapon.go - Armes : caractéristiques et rythme des attaques
+package components
+
+import "time"
+
+// Weapon arme équipée par le joueur. Ses caractéristiques remplacent les
+// valeurs fixes de l'attaque ; son rythme engage le joueur pendant
+// CommitDuration, et le coup ne touche que pendant les frames actives
+// [ActiveStart, ActiveEnd). AttackSpeed accélère (> 1) ou ralentit tout le rythme.
+type Weapon struct {
+	ID   string
+	Name string
+
+	BaseDamage  int     // Ajouté à la puissance d'attaque du joueur
+	StaminaCost float64 // Coût d'une attaque légère (double pour une attaque lourde)
+	AttackSpeed float64 // Multiplicateur de vitesse (1 : rythme nominal)
+	Range       float64 // Portée du coup, en pixels
+	PoiseDamage float64 // Équilibre entamé par coup léger
+	Knockback   float64 // Recul infligé à l'ennemi touché, en pixels
+
+	CanDestroyTiles bool // Ses coups brisent les murs destructibles (espadon)
+
+	CommitDuration time.Duration
+	ActiveStart    time.Duration // Depuis le début de l'attaque
+	ActiveEnd      time.Duration
+	MoveFactor     float64 // Part du déplacement conservée pendant l'attaque (0 : immobile)
+}
+
+// Arme de départ : épée courte
+const (
+	DefaultWeaponID          = "epee_courte"
+	DefaultAttackDuration    = 300 * time.Millisecond
+	DefaultAttackActiveStart = 100 * time.Millisecond
+	DefaultAttackActiveEnd   = 200 * time.Millisecond
+	DefaultAttackMoveFactor  = 0.2
+	DefaultAttackStaminaCost = 15.0
+	DefaultAttackRange       = 48.0
+	DefaultPoiseDamage       = 12.0
+)
+
+// DefaultWeapon retourne l'arme de départ
+func DefaultWeapon() Weapon {
+	return Weapon{
+		ID:             DefaultWeaponID,
+		Name:           "Épée courte",
+		BaseDamage:     0,
+		StaminaCost:    DefaultAttackStaminaCost,
+		AttackSpeed:    1.0,
+		Range:          DefaultAttackRange,
+		PoiseDamage:    DefaultPoiseDamage,
+		Knockback:      6,
+		CommitDuration: DefaultAttackDuration,
+		ActiveStart:    DefaultAttackActiveStart,
+		ActiveEnd:      DefaultAttackActiveEnd,
+		MoveFactor:     DefaultAttackMoveFactor,
+	}
+}
+
+// Normalized complète les valeurs absentes par celles de l'arme de départ et
+// borne la fenêtre active dans la durée de l'attaque
+func (w Weapon) Normalized() Weapon {
+	defaults := DefaultWeapon()
+	if w.ID == "" {
+		w.ID = defaults.ID
+	}
+	if w.Name == "" {
+		w.Name = w.ID
+	}
+	if w.BaseDamage < 0 {
+		w.BaseDamage = 0
+	}
+	if w.StaminaCost <= 0 {
+		w.StaminaCost = defaults.StaminaCost
+	}
+	if w.AttackSpeed <= 0 {
+		w.AttackSpeed = defaults.AttackSpeed
+	}
+	if w.Range <= 0 {
+		w.Range = defaults.Range
+	}
+	if w.PoiseDamage < 0 {
+		w.PoiseDamage = 0
+	}
+	if w.Knockback < 0 {
+		w.Knockback = 0
+	}
+
+	if w.CommitDuration <= 0 {
+		w.CommitDuration = defaults.CommitDuration
+		w.ActiveStart = defaults.ActiveStart
+		w.ActiveEnd = defaults.ActiveEnd
+	}
+	if w.ActiveEnd <= 0 || w.ActiveEnd > w.CommitDuration {
+		w.ActiveEnd = w.CommitDuration
+	}
+	if w.ActiveStart < 0 || w.ActiveStart >= w.ActiveEnd {
+		w.ActiveStart = 0
+	}
+	if w.MoveFactor < 0 {
+		w.MoveFactor = 0
+	} else if w.MoveFactor > 1 {
+		w.MoveFactor = 1
+	}
+	return w
+}
+
+// scaled applique la vitesse d'attaque à une durée du rythme nominal
+func (w Weapon) scaled(duration time.Duration) time.Duration {
+	if w.AttackSpeed <= 0 {
+		return duration
+	}
+	return time.Duration(float64(duration) / w.AttackSpeed)
+}
+
+// AttackDuration retourne la durée réelle d'une attaque
+func (w Weapon) AttackDuration() time.Duration {
+	return w.scaled(w.CommitDuration)
+}
+
+// IsActive retourne si le coup touche à cet instant de l'attaque
+func (w Weapon) IsActive(elapsed time.Duration) bool {
+	return elapsed >= w.scaled(w.ActiveStart) && elapsed < w.scaled(w.ActiveEnd)
+}
@@ -0,0 +1,109 @@
+// internal/ecs/systems/bloodstain_system.go - Âmes perdues à la mort et récupérables
+package systems
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Bloodstain tache de sang laissée à la mort, qui garde les âmes du joueur
+type Bloodstain struct {
+	Position components.Vector2
+	Souls    int
+}
+
+// BloodstainSystem gère la seule tache de sang en attente : mourir à nouveau
+// avant de l'avoir touchée la remplace, et ses âmes sont perdues.
+type BloodstainSystem struct {
+	stain *Bloodstain
+
+	// Distance (pixels) à laquelle le joueur récupère ses âmes
+	RecoverRadius float64
+
+	// Appelé quand le joueur récupère ses âmes
+	OnRecovered func(stain *Bloodstain)
+
+	pulseTime time.Duration
+}
+
+// NewBloodstainSystem crée un nouveau système de taches de sang
+func NewBloodstainSystem() *BloodstainSystem {
+	return &BloodstainSystem{
+		RecoverRadius: 24,
+	}
+}
+
+// Drop laisse les âmes du joueur à sa position de mort ; la tache précédente est perdue
+func (bs *BloodstainSystem) Drop(player *PlayerEntity) {
+	if player == nil {
+		return
+	}
+
+	if bs.stain != nil {
+		fmt.Printf("⚠ %d âmes perdues définitivement\n", bs.stain.Souls)
+		bs.stain = nil
+	}
+
+	souls := player.Player.Souls
+	player.Player.Souls = 0
+	if souls <= 0 {
+		return
+	}
+
+	bs.stain = &Bloodstain{Position: player.Position.Position, Souls: souls}
+	fmt.Printf("%d âmes laissées en (%.0f, %.0f)\n", souls, bs.stain.Position.X, bs.stain.Position.Y)
+}
+
+// GetBloodstain retourne la tache en attente (nil si aucune)
+func (bs *BloodstainSystem) GetBloodstain() *Bloodstain {
+	return bs.stain
+}
+
+// Restore remet une tache chargée depuis une sauvegarde
+func (bs *BloodstainSystem) Restore(stain *Bloodstain) {
+	bs.stain = stain
+}
+
+// Clear supprime la tache en attente
+func (bs *BloodstainSystem) Clear() {
+	bs.stain = nil
+}
+
+// Update rend ses âmes au joueur vivant qui touche la tache
+func (bs *BloodstainSystem) Update(deltaTime time.Duration, player *PlayerEntity) {
+	bs.pulseTime += deltaTime
+	if bs.stain == nil || player == nil || !player.Player.IsAlive() {
+		return
+	}
+
+	pos := player.Position.Position
+	if math.Hypot(pos.X-bs.stain.Position.X, pos.Y-bs.stain.Position.Y) > bs.RecoverRadius {
+		return
+	}
+
+	stain := bs.stain
+	bs.stain = nil
+	player.Player.AddSouls(stain.Souls)
+	fmt.Printf("✓ %d âmes récupérées\n", stain.Souls)
+	if bs.OnRecovered != nil {
+		bs.OnRecovered(stain)
+	}
+}
+
+// Render dessine la tache, qui pulse pour attirer l'œil
+func (bs *BloodstainSystem) Render(renderer Renderer) {
+	if bs.stain == nil {
+		return
+	}
+
+	pulse := 0.5 + 0.5*math.Sin(bs.pulseTime.Seconds()*4)
+	size := 16 + 4*pulse
+	renderer.DrawRectangle(components.Rectangle{
+		X:      bs.stain.Position.X - size/2,
+		Y:      bs.stain.Position.Y - size/2,
+		Width:  size,
+		Height: size,
+	}, components.Color{R: 150, G: 10, B: 20, A: uint8(160 + 80*pulse)}, true)
+}
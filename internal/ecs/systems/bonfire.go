@@ -0,0 +1,81 @@
+// internal/ecs/systems/bonfire.go - Feux de camp : repos, sauvegarde et point de réapparition
+package systems
+
+import (
+	"fmt"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Bonfire feu de camp : s'y reposer rend la vie, la stamina et les fioles, sauvegarde la
+// partie et ramène les ennemis (hors boss). Le joueur réapparaît au dernier feu.
+type Bonfire struct {
+	Name     string
+	Position components.Vector2
+	Radius   float64 // Portée d'interaction (pixels)
+	Lit      bool    // Allumé au premier repos
+
+	// Appelé après chaque repos (sauvegarde, réapparition des ennemis)
+	OnRest func(bonfire *Bonfire, player *PlayerEntity)
+}
+
+// Taille d'un feu de camp à l'écran
+const bonfireSize = 20.0
+
+// NewBonfire crée un feu de camp éteint
+func NewBonfire(name string, x, y float64) *Bonfire {
+	return &Bonfire{
+		Name:     name,
+		Position: components.Vector2{X: x, Y: y},
+		Radius:   40,
+	}
+}
+
+// InteractionPosition implémente Interactable
+func (b *Bonfire) InteractionPosition() components.Vector2 {
+	return b.Position
+}
+
+// InteractionRadius implémente Interactable
+func (b *Bonfire) InteractionRadius() float64 {
+	return b.Radius
+}
+
+// Interact implémente Interactable : le joueur se repose
+func (b *Bonfire) Interact(player *PlayerEntity) {
+	if player == nil || !player.Player.IsAlive() {
+		return
+	}
+
+	if !b.Lit {
+		b.Lit = true
+		fmt.Printf("✓ Feu de camp '%s' allumé\n", b.Name)
+	}
+
+	player.Player.Health = player.Player.MaxHealth
+	player.Player.Stamina = player.Player.MaxStamina
+	player.Player.RefillHealCharges()
+	fmt.Printf("Repos au feu de camp '%s'\n", b.Name)
+
+	if b.OnRest != nil {
+		b.OnRest(b, player)
+	}
+}
+
+// Bounds retourne la zone occupée par le feu de camp
+func (b *Bonfire) Bounds() components.Rectangle {
+	return components.Rectangle{
+		X:      b.Position.X - bonfireSize/2,
+		Y:      b.Position.Y - bonfireSize/2,
+		Width:  bonfireSize,
+		Height: bonfireSize,
+	}
+}
+
+// Render dessine le feu de camp : braises éteintes ou flamme
+func (b *Bonfire) Render(renderer Renderer) {
+	color := components.Color{R: 90, G: 80, B: 70, A: 255}
+	if b.Lit {
+		color = components.Color{R: 250, G: 150, B: 40, A: 255}
+	}
+	renderer.DrawRectangle(b.Bounds(), color, true)
+}
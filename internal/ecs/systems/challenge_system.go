@@ -0,0 +1,170 @@
+// internal/ecs/systems/challenge_system.go - Salles de défi chronométrées
+package systems
+
+import (
+	"fmt"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// ChallengeRoom salle à nettoyer de ses ennemis avant la fin du chrono
+type ChallengeRoom struct {
+	ID          string
+	Bounds      components.Rectangle
+	SpawnPoints []components.Vector2
+	Challenge   *components.ChallengeRoomComponent
+
+	enemies []*EnemyEntity
+}
+
+// Contains vérifie si une position est dans la salle
+func (cr *ChallengeRoom) Contains(position components.Vector2) bool {
+	return position.X >= cr.Bounds.X && position.X <= cr.Bounds.X+cr.Bounds.Width &&
+		position.Y >= cr.Bounds.Y && position.Y <= cr.Bounds.Y+cr.Bounds.Height
+}
+
+// remainingEnemies compte les ennemis de la salle encore en vie
+func (cr *ChallengeRoom) remainingEnemies() int {
+	count := 0
+	for _, enemy := range cr.enemies {
+		if enemy.Active && enemy.Enemy.IsAlive() {
+			count++
+		}
+	}
+	return count
+}
+
+// ChallengeSystem démarre, chronomètre et résout les salles de défi
+type ChallengeSystem struct {
+	rooms  []*ChallengeRoom
+	active *ChallengeRoom
+	stats  *StatTracker
+
+	// Appelé quand un nouveau record est établi
+	OnNewBestTime func(roomID string, elapsed time.Duration)
+}
+
+// NewChallengeSystem crée un système de défis branché sur un tracker de stats
+func NewChallengeSystem(stats *StatTracker) *ChallengeSystem {
+	return &ChallengeSystem{
+		rooms: make([]*ChallengeRoom, 0),
+		stats: stats,
+	}
+}
+
+// AddRoom déclare une salle de défi
+func (cs *ChallengeSystem) AddRoom(id string, bounds components.Rectangle, timeLimit time.Duration, bonusSouls int, spawnPoints []components.Vector2) *ChallengeRoom {
+	room := &ChallengeRoom{
+		ID:          id,
+		Bounds:      bounds,
+		SpawnPoints: spawnPoints,
+		Challenge:   components.NewChallengeRoomComponent(timeLimit, bonusSouls),
+	}
+	room.Challenge.BestTime = cs.stats.GetChallengeBestTime(id)
+	cs.rooms = append(cs.rooms, room)
+	return room
+}
+
+// GetRooms retourne les salles de défi
+func (cs *ChallengeSystem) GetRooms() []*ChallengeRoom {
+	return cs.rooms
+}
+
+// GetActiveRoom retourne la salle en cours, ou nil
+func (cs *ChallengeSystem) GetActiveRoom() *ChallengeRoom {
+	return cs.active
+}
+
+// Clear supprime toutes les salles
+func (cs *ChallengeSystem) Clear() {
+	cs.rooms = cs.rooms[:0]
+	cs.active = nil
+}
+
+// Update démarre les défis à l'entrée du joueur et résout le défi en cours
+func (cs *ChallengeSystem) Update(deltaTime time.Duration, player *PlayerEntity, enemySystem *EnemySystem) {
+	if player == nil || !player.Active {
+		return
+	}
+
+	if cs.active == nil {
+		cs.tryStart(player.Position.Position, enemySystem)
+		return
+	}
+
+	room := cs.active
+	if room.remainingEnemies() == 0 {
+		cs.complete(room, player)
+		return
+	}
+
+	if room.Challenge.Update(deltaTime) {
+		cs.fail(room)
+	}
+}
+
+// tryStart démarre le défi de la salle où entre le joueur
+func (cs *ChallengeSystem) tryStart(position components.Vector2, enemySystem *EnemySystem) {
+	for _, room := range cs.rooms {
+		challenge := room.Challenge
+		if challenge.Completed || challenge.Failed || !room.Contains(position) {
+			continue
+		}
+
+		room.enemies = room.enemies[:0]
+		for _, spawn := range room.SpawnPoints {
+			room.enemies = append(room.enemies, enemySystem.SpawnEnemy(spawn.X, spawn.Y))
+		}
+
+		challenge.Start()
+		cs.active = room
+		fmt.Printf("Défi %s commencé: %d ennemis, %s\n", room.ID, len(room.enemies), challenge.TimeLimit)
+		return
+	}
+}
+
+// complete enregistre le temps et récompense le joueur
+func (cs *ChallengeSystem) complete(room *ChallengeRoom, player *PlayerEntity) {
+	challenge := room.Challenge
+	challenge.Complete()
+	cs.active = nil
+
+	player.Player.AddSouls(challenge.BonusSouls)
+	fmt.Printf("Défi %s réussi en %s (+%d âmes)\n", room.ID, challenge.Elapsed, challenge.BonusSouls)
+
+	if cs.stats.RecordChallengeTime(room.ID, challenge.Elapsed) {
+		challenge.BestTime = challenge.Elapsed
+		fmt.Printf("✓ Nouveau record pour %s: %s\n", room.ID, challenge.Elapsed)
+		if cs.OnNewBestTime != nil {
+			cs.OnNewBestTime(room.ID, challenge.Elapsed)
+		}
+	}
+}
+
+// fail enrage les ennemis restants quand le temps est écoulé
+func (cs *ChallengeSystem) fail(room *ChallengeRoom) {
+	cs.active = nil
+
+	enraged := 0
+	for _, enemy := range room.enemies {
+		if enemy.Active && enemy.Enemy.IsAlive() {
+			enemy.Enrage()
+			enraged++
+		}
+	}
+	fmt.Printf("Défi %s échoué: %d ennemis enragés\n", room.ID, enraged)
+}
+
+// Render dessine le contour des salles de défi
+func (cs *ChallengeSystem) Render(renderer Renderer) {
+	for _, room := range cs.rooms {
+		color := components.Color{R: 200, G: 170, B: 40, A: 255}
+		switch {
+		case room.Challenge.Completed:
+			color = components.ColorGreen
+		case room.Challenge.Failed:
+			color = components.ColorRed
+		}
+		renderer.DrawRectangle(room.Bounds, color, false)
+	}
+}
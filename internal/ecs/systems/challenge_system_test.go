@@ -0,0 +1,84 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// enterChallengeRoom crée une salle de défi à deux ennemis et y place le joueur
+func enterChallengeRoom(t *testing.T) (*ChallengeSystem, *ChallengeRoom, *PlayerEntity, *EnemySystem, *StatTracker) {
+	t.Helper()
+	stats := NewStatTracker()
+	cs := NewChallengeSystem(stats)
+	room := cs.AddRoom("arene", components.Rectangle{X: 0, Y: 0, Width: 400, Height: 400}, 10*time.Second, 50,
+		[]components.Vector2{{X: 100, Y: 100}, {X: 300, Y: 300}})
+
+	player := NewPlayerEntity(200, 200)
+	es := NewEnemySystem()
+	cs.Update(time.Second/60, player, es)
+
+	if cs.GetActiveRoom() != room {
+		t.Fatal("le défi doit démarrer à l'entrée du joueur")
+	}
+	return cs, room, player, es, stats
+}
+
+func TestChallengeExpiryEnragesEnemies(t *testing.T) {
+	cs, room, player, es, _ := enterChallengeRoom(t)
+
+	enemies := es.GetEnemies()
+	speed := enemies[0].Movement.Speed
+	power := enemies[0].Enemy.AttackPower
+
+	cs.Update(9*time.Second, player, es)
+	if enemies[0].Enemy.Enraged {
+		t.Fatal("les ennemis ne doivent pas s'enrager avant la fin du chrono")
+	}
+
+	cs.Update(time.Second, player, es)
+	if !room.Challenge.Failed || cs.GetActiveRoom() != nil {
+		t.Fatal("le défi doit échouer à la fin du chrono")
+	}
+	for i, enemy := range enemies {
+		if enemy.Movement.Speed != speed*2 || enemy.Enemy.AttackPower != power*2 {
+			t.Errorf("ennemi %d : vitesse %.0f, dégâts %d, attendu %.0f et %d",
+				i, enemy.Movement.Speed, enemy.Enemy.AttackPower, speed*2, power*2)
+		}
+	}
+	if player.Player.Souls != 0 {
+		t.Errorf("Souls = %d, attendu 0 après un échec", player.Player.Souls)
+	}
+
+	// Le joueur est toujours dans la salle : un défi échoué ne redémarre pas
+	cs.Update(time.Second, player, es)
+	if cs.GetActiveRoom() != nil || len(es.GetEnemies()) != len(enemies) {
+		t.Error("un défi échoué ne doit pas redémarrer")
+	}
+}
+
+func TestChallengeClearedBeforeExpiry(t *testing.T) {
+	cs, room, player, es, stats := enterChallengeRoom(t)
+
+	var recorded time.Duration
+	cs.OnNewBestTime = func(roomID string, elapsed time.Duration) {
+		recorded = elapsed
+	}
+
+	cs.Update(4*time.Second, player, es)
+	for _, enemy := range es.GetEnemies() {
+		enemy.Enemy.TakeDamage(enemy.Enemy.MaxHealth)
+	}
+	cs.Update(time.Second/60, player, es)
+
+	if !room.Challenge.Completed {
+		t.Fatal("le défi doit être réussi une fois la salle nettoyée")
+	}
+	if player.Player.Souls != 50 {
+		t.Errorf("Souls = %d, attendu 50", player.Player.Souls)
+	}
+	if got := stats.GetChallengeBestTime("arene"); got != 4*time.Second || recorded != got {
+		t.Errorf("record = %v (événement %v), attendu 4s", got, recorded)
+	}
+}
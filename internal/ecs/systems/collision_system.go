@@ -0,0 +1,190 @@
+// internal/ecs/systems/collision_system.go - Collisions du joueur avec les ennemis et les murs
+package systems
+
+import (
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// WallGrid grille des tuiles solides qui bloquent le joueur
+type WallGrid interface {
+	GetTileSize() float64
+	IsSolid(tx, ty int) bool
+}
+
+// SlopeGrid grille dont certaines tuiles solides sont en pente (optionnel
+// pour une WallGrid)
+type SlopeGrid interface {
+	Slope(tx, ty int) (components.SlopedTile, bool)
+}
+
+// CollisionResponse résultat d'une collision avec les murs
+type CollisionResponse struct {
+	Hit         bool
+	Normal      components.Vector2 // Normale unitaire du mur, orientée vers le joueur
+	Penetration components.Vector2 // Correction appliquée à la position du joueur
+}
+
+// CollisionSystem empêche le joueur de traverser le corps des ennemis et les murs.
+// Broad-phase par boîtes englobantes, narrow-phase par SAT (enveloppes convexes).
+type CollisionSystem struct {
+	Enabled bool
+
+	walls WallGrid
+}
+
+// NewCollisionSystem crée un nouveau système de collision
+func NewCollisionSystem() *CollisionSystem {
+	return &CollisionSystem{Enabled: true}
+}
+
+// BodyShape retourne la forme du corps de l'ennemi dans le monde :
+// son enveloppe convexe, sinon la boîte de son collider
+func (ee *EnemyEntity) BodyShape() components.ConvexShape {
+	if ee.Hull != nil && ee.Hull.Enabled {
+		return ee.Hull.WorldShape(ee.Position.Position)
+	}
+	return components.RectanglePolygon(ee.Collider.GetWorldBounds(ee.Position.Position))
+}
+
+// BodyBounds retourne la boîte englobante du corps de l'ennemi
+func (ee *EnemyEntity) BodyBounds() components.Rectangle {
+	if ee.Hull != nil && ee.Hull.Enabled {
+		return ee.Hull.GetWorldBounds(ee.Position.Position)
+	}
+	return ee.Collider.GetWorldBounds(ee.Position.Position)
+}
+
+// Overlap teste le chevauchement de deux formes (voir components.SAT)
+func (cs *CollisionSystem) Overlap(a, b components.ConvexShape) (bool, components.Vector2) {
+	return components.SAT(a, b)
+}
+
+// ResolvePlayer repousse le joueur hors des corps ennemis et retourne le nombre de contacts
+func (cs *CollisionSystem) ResolvePlayer(player *PlayerEntity, enemies []*EnemyEntity) int {
+	if !cs.Enabled || player == nil || !player.Active || !player.Collider.Enabled {
+		return 0
+	}
+
+	contacts := 0
+	for _, enemy := range enemies {
+		if !enemy.Active || !enemy.Enemy.IsAlive() {
+			continue
+		}
+
+		playerBounds := player.Collider.GetWorldBounds(player.Position.Position)
+		if !boundsIntersect(playerBounds, enemy.BodyBounds()) {
+			continue
+		}
+
+		hit, penetration := cs.Overlap(components.RectanglePolygon(playerBounds), enemy.BodyShape())
+		if !hit {
+			continue
+		}
+
+		// La pénétration pointe du joueur vers l'ennemi : le joueur recule
+		player.Position.Position = player.Position.Position.Sub(penetration)
+		contacts++
+	}
+	return contacts
+}
+
+// SetWalls définit la grille des murs (nil : aucun mur)
+func (cs *CollisionSystem) SetWalls(walls WallGrid) {
+	cs.walls = walls
+}
+
+// ResolveWalls repousse le joueur hors des tuiles solides, sur l'axe de
+// moindre pénétration de chaque tuile, et retourne la normale du contact.
+// Contre une tuile en pente, la vitesse du joueur est projetée le long de la
+// pente : il glisse au lieu de s'arrêter.
+func (cs *CollisionSystem) ResolveWalls(player *PlayerEntity) CollisionResponse {
+	if !cs.Enabled || cs.walls == nil || player == nil || !player.Active || !player.Collider.Enabled {
+		return CollisionResponse{}
+	}
+
+	tileSize := cs.walls.GetTileSize()
+	if tileSize <= 0 {
+		return CollisionResponse{}
+	}
+
+	slopes, _ := cs.walls.(SlopeGrid)
+
+	var response CollisionResponse
+	bounds := player.Collider.GetWorldBounds(player.Position.Position)
+	minTX, minTY := int(math.Floor(bounds.X/tileSize)), int(math.Floor(bounds.Y/tileSize))
+	maxTX, maxTY := int(math.Floor((bounds.X+bounds.Width)/tileSize)), int(math.Floor((bounds.Y+bounds.Height)/tileSize))
+
+	for ty := minTY; ty <= maxTY; ty++ {
+		for tx := minTX; tx <= maxTX; tx++ {
+			if !cs.walls.IsSolid(tx, ty) {
+				continue
+			}
+
+			tile := components.Rectangle{X: float64(tx) * tileSize, Y: float64(ty) * tileSize, Width: tileSize, Height: tileSize}
+			if !boundsIntersect(bounds, tile) {
+				continue
+			}
+
+			var push, normal components.Vector2
+			if slope, sloped := cs.slopedTile(slopes, tx, ty); sloped {
+				hit, penetration := cs.Overlap(components.RectanglePolygon(bounds), slope.Polygon(tile))
+				depth := math.Hypot(penetration.X, penetration.Y)
+				if !hit || depth == 0 {
+					continue
+				}
+				push = penetration.Mul(-1)
+				normal = push.Mul(1 / depth)
+				player.Movement.Velocity = components.ProjectOnSurface(player.Movement.Velocity, normal)
+			} else {
+				push, normal = separateAxis(bounds, tile)
+			}
+			player.Position.Position = player.Position.Position.Add(push)
+			bounds.X += push.X
+			bounds.Y += push.Y
+
+			response.Hit = true
+			response.Normal = response.Normal.Add(normal)
+			response.Penetration = response.Penetration.Add(push)
+		}
+	}
+
+	// Contact en coin : normale moyenne des deux faces
+	if length := math.Hypot(response.Normal.X, response.Normal.Y); length > 0 {
+		response.Normal = response.Normal.Mul(1 / length)
+	}
+	return response
+}
+
+// slopedTile retourne la pente d'une tuile solide (false : tuile carrée)
+func (cs *CollisionSystem) slopedTile(slopes SlopeGrid, tx, ty int) (components.SlopedTile, bool) {
+	if slopes == nil {
+		return components.SlopedTile{}, false
+	}
+	return slopes.Slope(tx, ty)
+}
+
+// separateAxis retourne le déplacement minimal sortant a de b et la normale de la face touchée
+func separateAxis(a, b components.Rectangle) (components.Vector2, components.Vector2) {
+	left := a.X + a.Width - b.X
+	right := b.X + b.Width - a.X
+	up := a.Y + a.Height - b.Y
+	down := b.Y + b.Height - a.Y
+
+	switch math.Min(math.Min(left, right), math.Min(up, down)) {
+	case left:
+		return components.Vector2{X: -left}, components.Vector2{X: -1}
+	case right:
+		return components.Vector2{X: right}, components.Vector2{X: 1}
+	case up:
+		return components.Vector2{Y: -up}, components.Vector2{Y: -1}
+	default:
+		return components.Vector2{Y: down}, components.Vector2{Y: 1}
+	}
+}
+
+// boundsIntersect teste le chevauchement strict de deux boîtes (broad-phase)
+func boundsIntersect(a, b components.Rectangle) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width &&
+		a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
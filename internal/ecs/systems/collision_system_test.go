@@ -0,0 +1,120 @@
+package systems
+
+import (
+	"math"
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// wallTiles grille de murs listés par coordonnées de tuile
+type wallTiles map[[2]int]bool
+
+func (w wallTiles) GetTileSize() float64    { return 32 }
+func (w wallTiles) IsSolid(tx, ty int) bool { return w[[2]int{tx, ty}] }
+
+func TestResolveWallsNormal(t *testing.T) {
+	// Mur sur la tuile (5, 5), soit [160, 192[ sur chaque axe ; le collider
+	// du joueur (24×24) est décalé de 4 px vers le bas
+	walls := wallTiles{{5, 5}: true}
+
+	tests := []struct {
+		name       string
+		position   components.Vector2
+		wantHit    bool
+		wantNormal components.Vector2
+	}{
+		{"face gauche", components.Vector2{X: 150, Y: 176}, true, components.Vector2{X: -1}},
+		{"face droite", components.Vector2{X: 202, Y: 176}, true, components.Vector2{X: 1}},
+		{"face haute", components.Vector2{X: 176, Y: 150}, true, components.Vector2{Y: -1}},
+		{"face basse", components.Vector2{X: 176, Y: 198}, true, components.Vector2{Y: 1}},
+		{"hors du mur", components.Vector2{X: 100, Y: 100}, false, components.Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewCollisionSystem()
+			cs.SetWalls(walls)
+			player := NewPlayerEntity(tt.position.X, tt.position.Y)
+
+			response := cs.ResolveWalls(player)
+			if response.Hit != tt.wantHit {
+				t.Fatalf("Hit = %t, attendu %t", response.Hit, tt.wantHit)
+			}
+			if response.Normal != tt.wantNormal {
+				t.Errorf("normale = %+v, attendu %+v", response.Normal, tt.wantNormal)
+			}
+
+			// Le joueur est sorti du mur
+			bounds := player.Collider.GetWorldBounds(player.Position.Position)
+			tile := components.Rectangle{X: 160, Y: 160, Width: 32, Height: 32}
+			if boundsIntersect(bounds, tile) {
+				t.Errorf("joueur encore dans le mur : %+v", bounds)
+			}
+		})
+	}
+}
+
+// slopedWalls murs dont certaines tuiles sont en pente
+type slopedWalls struct {
+	wallTiles
+	slopes map[[2]int]components.SlopedTile
+}
+
+func (w slopedWalls) Slope(tx, ty int) (components.SlopedTile, bool) {
+	slope, exists := w.slopes[[2]int{tx, ty}]
+	return slope, exists
+}
+
+func TestResolveWallsSlidesOnSlope(t *testing.T) {
+	// Pente à 45° montant vers la droite sur la tuile (5, 5) : seule la
+	// moitié basse-droite, sous la diagonale x + y = 352, est solide
+	walls := slopedWalls{
+		wallTiles: wallTiles{{5, 5}: true},
+		slopes:    map[[2]int]components.SlopedTile{{5, 5}: {Gradient: 1}},
+	}
+
+	tests := []struct {
+		name         string
+		position     components.Vector2
+		velocity     components.Vector2
+		wantHit      bool
+		wantVelocity components.Vector2
+	}{
+		// Coin bas-droit du collider en (176, 188), 12 px sous la diagonale
+		{"chute sur la pente", components.Vector2{X: 164, Y: 172}, components.Vector2{Y: 100}, true, components.Vector2{X: -50, Y: 50}},
+		{"dans le vide au-dessus", components.Vector2{X: 150, Y: 160}, components.Vector2{Y: 100}, false, components.Vector2{Y: 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewCollisionSystem()
+			cs.SetWalls(walls)
+			player := NewPlayerEntity(tt.position.X, tt.position.Y)
+			player.Movement.Velocity = tt.velocity
+
+			response := cs.ResolveWalls(player)
+			if response.Hit != tt.wantHit {
+				t.Fatalf("Hit = %t, attendu %t", response.Hit, tt.wantHit)
+			}
+
+			velocity := player.Movement.Velocity
+			if math.Abs(velocity.X-tt.wantVelocity.X) > 1e-6 || math.Abs(velocity.Y-tt.wantVelocity.Y) > 1e-6 {
+				t.Errorf("vitesse = %+v, attendu %+v", velocity, tt.wantVelocity)
+			}
+			if !tt.wantHit {
+				return
+			}
+
+			// Le joueur est repoussé le long de la normale de la pente
+			normal := components.ComputeSurfaceNormal(components.SlopedTile{Gradient: 1})
+			if math.Abs(response.Normal.X-normal.X) > 1e-6 || math.Abs(response.Normal.Y-normal.Y) > 1e-6 {
+				t.Errorf("normale = %+v, attendu %+v", response.Normal, normal)
+			}
+			bounds := player.Collider.GetWorldBounds(player.Position.Position)
+			if corner := bounds.X + bounds.Width + bounds.Y + bounds.Height; corner > 352+1e-6 {
+				t.Errorf("coin bas-droit encore sous la pente : x + y = %.2f", corner)
+			}
+		})
+	}
+}
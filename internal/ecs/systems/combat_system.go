@@ -0,0 +1,283 @@
+// internal/ecs/systems/combat_system.go - Résolution des coups et du blocage
+package systems
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// PerfectBlockEvent est émis quand le joueur pare parfaitement un coup
+type PerfectBlockEvent struct {
+	Attacker *EnemyEntity
+	Position components.Vector2 // Position du joueur au moment de la parade
+}
+
+// CriticalHitEvent est émis quand l'attaque du joueur est un coup critique
+type CriticalHitEvent struct {
+	Target   *EnemyEntity
+	Position components.Vector2
+	Damage   int // Dégâts après multiplicateur
+}
+
+// CombatSystem résout les coups du joueur et ceux des ennemis (garde comprise)
+type CombatSystem struct {
+	// Demi-angle de l'attaque du joueur (la portée vient de son arme)
+	PlayerAttackHalfAngle float64
+
+	// Âmes et expérience gagnées par ennemi vaincu, multipliées par le combo
+	// en cours (nil : sans bonus)
+	SoulsPerKill      int
+	ExperiencePerKill int
+	Combo             *components.ComboMeter
+
+	// Durée du déséquilibre infligé à l'attaquant après un blocage parfait
+	PerfectBlockStagger time.Duration
+
+	// Appelé à chaque blocage parfait
+	OnPerfectBlock func(event PerfectBlockEvent)
+
+	// Appelé quand l'attaque du joueur touche un ennemi
+	OnEnemyHit func(enemy *EnemyEntity, position components.Vector2)
+
+	// Coups critiques : tirage contre PlayerComponent.CriticalChance
+	CriticalMultiplier float64
+	rng                *rand.Rand
+
+	// Appelé à chaque coup critique du joueur
+	OnCriticalHit func(event CriticalHitEvent)
+
+	// L'attaque lourde multiplie les dégâts et l'équilibre entamé par l'arme
+	HeavyDamageMultiplier float64
+	HeavyPoiseMultiplier  float64
+
+	// Appelé quand un coup du joueur fait chanceler un ennemi
+	OnStagger func(enemy *EnemyEntity)
+
+	// Attaque du joueur en cours : chaque ennemi n'est touché qu'une fois
+	// pendant les frames actives
+	swingHeavy bool
+	swingHits  []uint32
+}
+
+// NewCombatSystem crée un nouveau système de combat
+func NewCombatSystem() *CombatSystem {
+	return &CombatSystem{
+		PlayerAttackHalfAngle: components.BlockHalfAngle,
+		SoulsPerKill:          10,
+		ExperiencePerKill:     components.DefaultExperiencePerKill,
+		PerfectBlockStagger:   time.Millisecond * 1500,
+		CriticalMultiplier:    components.DefaultCriticalMultiplier,
+		rng:                   rand.New(rand.NewSource(time.Now().UnixNano())),
+		HeavyDamageMultiplier: 1.5,
+		HeavyPoiseMultiplier:  2.5,
+	}
+}
+
+// SetRandomSource remplace le générateur des coups critiques (tirages reproductibles)
+func (cs *CombatSystem) SetRandomSource(rng *rand.Rand) {
+	if rng != nil {
+		cs.rng = rng
+	}
+}
+
+// RollDamage tire un coup critique : les dégâts sont multipliés si le tirage
+// est inférieur à la chance de critique
+func (cs *CombatSystem) RollDamage(damage int, criticalChance float64) (int, bool) {
+	if cs.rng.Float64() >= criticalChance {
+		return damage, false
+	}
+	return int(math.Round(float64(damage) * cs.CriticalMultiplier)), true
+}
+
+// Update résout les attaques au contact des ennemis
+func (cs *CombatSystem) Update(player *PlayerEntity, enemies []*EnemyEntity) {
+	if player == nil || !player.Active || !player.Player.IsAlive() {
+		return
+	}
+
+	for _, enemy := range enemies {
+		if !cs.canStrike(enemy, player) {
+			continue
+		}
+
+		enemy.Enemy.StartAttackCooldown()
+		cs.resolveHit(player, enemy)
+	}
+}
+
+// canStrike vérifie si un ennemi peut frapper le joueur cette frame
+func (cs *CombatSystem) canStrike(enemy *EnemyEntity, player *PlayerEntity) bool {
+	if !enemy.Active || !enemy.Enemy.Aggroed || !enemy.Enemy.CanAttack() {
+		return false
+	}
+
+	// Dans une formation, seul l'attaquant désigné engage
+	if enemy.Formation != nil && enemy.Formation.Role != components.FormationRoleNone && !enemy.Formation.CanAttack {
+		return false
+	}
+
+	diff := player.Position.Position.Sub(enemy.Position.Position)
+	reach := enemy.Enemy.AttackRange + enemy.Collider.Bounds.Width/2
+	return math.Hypot(diff.X, diff.Y) <= reach
+}
+
+// resolveHit applique un coup au joueur après passage par sa garde
+func (cs *CombatSystem) resolveHit(player *PlayerEntity, enemy *EnemyEntity) {
+	damage := enemy.Enemy.AttackPower
+	result := components.BlockNone
+
+	if player.Block != nil {
+		result = player.Block.Resolve(AttackAngle(player.Position.Position, enemy.Position.Position))
+		damage = player.Block.ApplyTo(damage, result)
+	}
+
+	switch result {
+	case components.BlockPerfect:
+		enemy.Enemy.Stun(cs.PerfectBlockStagger)
+		fmt.Printf("Blocage parfait ! Ennemi %d déséquilibré\n", enemy.EntityID)
+		if cs.OnPerfectBlock != nil {
+			cs.OnPerfectBlock(PerfectBlockEvent{Attacker: enemy, Position: player.Position.Position})
+		}
+		return
+	case components.BlockPartial:
+		fmt.Printf("Coup bloqué: %d dégâts\n", damage)
+	}
+
+	if damage > 0 {
+		player.Player.TakeDamage(damage)
+	}
+}
+
+// PlayerAttack applique l'attaque du joueur aux ennemis devant lui et retourne le nombre de victimes
+func (cs *CombatSystem) PlayerAttack(player *PlayerEntity, enemies []*EnemyEntity) int {
+	cs.BeginSwing(false)
+	return cs.Swing(player, enemies)
+}
+
+// PlayerHeavyAttack résout une attaque lourde : plus de dégâts, et surtout
+// plus d'équilibre entamé
+func (cs *CombatSystem) PlayerHeavyAttack(player *PlayerEntity, enemies []*EnemyEntity) int {
+	cs.BeginSwing(true)
+	return cs.Swing(player, enemies)
+}
+
+// BeginSwing démarre une nouvelle attaque du joueur : les ennemis pourront
+// de nouveau être touchés
+func (cs *CombatSystem) BeginSwing(heavy bool) {
+	cs.swingHeavy = heavy
+	cs.swingHits = cs.swingHits[:0]
+}
+
+// Swing applique l'attaque en cours aux ennemis dans l'arc du joueur qu'elle
+// n'a pas encore touchés ; appelé à chaque frame active de l'attaque
+func (cs *CombatSystem) Swing(player *PlayerEntity, enemies []*EnemyEntity) int {
+	return cs.playerStrike(player, enemies, cs.swingHeavy)
+}
+
+// SwingHitbox retourne la zone de frappe de l'attaque en cours du joueur :
+// devant lui, à la portée de son arme. Seules les armes qui brisent les murs
+// (l'espadon) reçoivent CanDestroyTiles.
+func (cs *CombatSystem) SwingHitbox(player *PlayerEntity) components.HitboxComponent {
+	if player == nil || !player.Active {
+		return components.HitboxComponent{}
+	}
+	facing := player.Movement.FacingDir.ToVector2()
+	if facing.X == 0 && facing.Y == 0 {
+		facing = components.DirectionDown.ToVector2()
+	}
+	weapon := player.Player.Weapon
+
+	hitbox := components.NewSwingHitbox(player.Position.Position, facing.Normalize(), weapon.Range)
+	hitbox.Heavy = cs.swingHeavy
+	hitbox.CanDestroyTiles = weapon.CanDestroyTiles
+	return hitbox
+}
+
+// playerStrike applique le coup du joueur aux ennemis dans son arc d'attaque
+func (cs *CombatSystem) playerStrike(player *PlayerEntity, enemies []*EnemyEntity, heavy bool) int {
+	if player == nil || !player.Active {
+		return 0
+	}
+
+	facing := player.Movement.FacingDir.ToVector2()
+	if facing.X == 0 && facing.Y == 0 {
+		facing = components.DirectionDown.ToVector2()
+	}
+	facingAngle := math.Atan2(facing.Y, facing.X)
+	origin := player.Position.Position
+	weapon := player.Player.Weapon
+
+	kills := 0
+	for _, enemy := range enemies {
+		if !enemy.Active || !enemy.Enemy.IsAlive() || containsID(cs.swingHits, enemy.EntityID) {
+			continue
+		}
+
+		diff := enemy.Position.Position.Sub(origin)
+		reach := weapon.Range + enemy.Collider.Bounds.Width/2
+		if math.Hypot(diff.X, diff.Y) > reach {
+			continue
+		}
+		if math.Abs(components.AngleDifference(AttackAngle(origin, enemy.Position.Position), facingAngle)) > cs.PlayerAttackHalfAngle {
+			continue
+		}
+
+		cs.swingHits = append(cs.swingHits, enemy.EntityID)
+		attackPower := player.Player.AttackPower + weapon.BaseDamage
+		poiseDamage := weapon.PoiseDamage
+		if heavy {
+			attackPower = int(math.Round(float64(attackPower) * cs.HeavyDamageMultiplier))
+			poiseDamage *= cs.HeavyPoiseMultiplier
+		}
+
+		damage, critical := cs.RollDamage(attackPower, player.Player.CriticalChance)
+		enemy.Enemy.TakeDamage(damage)
+		if critical {
+			fmt.Printf("Coup critique ! %d dégâts à l'ennemi %d\n", damage, enemy.EntityID)
+			if cs.OnCriticalHit != nil {
+				cs.OnCriticalHit(CriticalHitEvent{Target: enemy, Position: enemy.Position.Position, Damage: damage})
+			}
+		}
+		if cs.OnEnemyHit != nil {
+			cs.OnEnemyHit(enemy, enemy.Position.Position)
+		}
+		if enemy.Enemy.IsAlive() && weapon.Knockback > 0 {
+			// Recul dans la direction du coup
+			if distance := math.Hypot(diff.X, diff.Y); distance > 0 {
+				enemy.Position.Position = enemy.Position.Position.Add(diff.Mul(weapon.Knockback / distance))
+			}
+		}
+		if enemy.Enemy.IsAlive() && enemy.Enemy.DamagePoise(poiseDamage) {
+			fmt.Printf("Ennemi %d chancelle\n", enemy.EntityID)
+			if cs.OnStagger != nil {
+				cs.OnStagger(enemy)
+			}
+		}
+		if !enemy.Enemy.IsAlive() {
+			kills++
+			player.Player.EnemiesKilled++
+			multiplier := cs.rewardMultiplier()
+			player.Player.AddSouls(int(math.Round(float64(cs.SoulsPerKill) * multiplier)))
+			if levels := player.Player.AddExperience(int(math.Round(float64(cs.ExperiencePerKill) * multiplier))); levels > 0 {
+				fmt.Printf("✓ Niveau %d atteint (%d point(s) de compétence)\n", player.Player.Level, player.Player.SkillPoints)
+			}
+		}
+	}
+	return kills
+}
+
+// rewardMultiplier retourne le multiplicateur des récompenses (combo en cours)
+func (cs *CombatSystem) rewardMultiplier() float64 {
+	if cs.Combo == nil {
+		return 1
+	}
+	return cs.Combo.Multiplier
+}
+
+// AttackAngle retourne l'angle (radians) du défenseur vers l'attaquant
+func AttackAngle(defender, attacker components.Vector2) float64 {
+	return math.Atan2(attacker.Y-defender.Y, attacker.X-defender.X)
+}
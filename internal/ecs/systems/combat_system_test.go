@@ -0,0 +1,135 @@
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+func TestResolveHitThroughBlock(t *testing.T) {
+	tests := []struct {
+		name        string
+		blocking    bool
+		raisedFor   time.Duration
+		enemyX      float64 // Ennemi à droite (garde vers la droite) ou à gauche
+		wantHealth  int
+		wantStunned bool
+		wantEvent   bool
+	}{
+		{"sans garde", false, 0, 130, 55, false, false},             // 50 - 5 de défense
+		{"garde de face", true, time.Second, 130, 95, false, false}, // 80 % absorbés puis défense
+		{"garde dans le dos", true, time.Second, 70, 55, false, false},
+		{"blocage parfait", true, 0, 130, 100, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := NewPlayerEntity(100, 100)
+			player.Block.BlockAngle = 0 // Vers la droite
+			player.Block.SetActive(tt.blocking)
+			player.Block.Update(tt.raisedFor)
+
+			enemy := NewEnemyEntity(1, tt.enemyX, 100)
+			enemy.Enemy.AttackPower = 50
+
+			cs := NewCombatSystem()
+			events := 0
+			cs.OnPerfectBlock = func(event PerfectBlockEvent) {
+				events++
+				if event.Attacker != enemy {
+					t.Error("l'événement doit désigner l'attaquant")
+				}
+			}
+
+			cs.resolveHit(player, enemy)
+
+			if player.Player.Health != tt.wantHealth {
+				t.Errorf("Health = %d, attendu %d", player.Player.Health, tt.wantHealth)
+			}
+			if enemy.Enemy.Stunned != tt.wantStunned {
+				t.Errorf("ennemi étourdi = %t, attendu %t", enemy.Enemy.Stunned, tt.wantStunned)
+			}
+			if (events == 1) != tt.wantEvent || events > 1 {
+				t.Errorf("%d PerfectBlockEvent, attendu %t", events, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestAttackAngle(t *testing.T) {
+	defender := NewPlayerEntity(0, 0).Position.Position
+	tests := []struct {
+		name   string
+		dx, dy float64
+		want   float64
+	}{
+		{"droite", 10, 0, 0},
+		{"bas", 0, 10, math.Pi / 2},
+		{"gauche", -10, 0, math.Pi},
+		{"haut", 0, -10, -math.Pi / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attacker := defender
+			attacker.X += tt.dx
+			attacker.Y += tt.dy
+			if got := AttackAngle(defender, attacker); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("AttackAngle = %.3f, attendu %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollDamageCriticalRate(t *testing.T) {
+	const trials = 10000
+
+	tests := []struct {
+		name   string
+		chance float64
+		seed   int64
+	}{
+		{"chance de base", components.BaseCriticalChance, 1},
+		{"chance de base, autre graine", components.BaseCriticalChance, 42},
+		{"dextérité élevée", components.CriticalChanceFor(25), 7},
+		{"jamais", 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewCombatSystem()
+			cs.SetRandomSource(rand.New(rand.NewSource(tt.seed)))
+
+			criticals := 0
+			for i := 0; i < trials; i++ {
+				damage, critical := cs.RollDamage(10, tt.chance)
+				if critical {
+					criticals++
+					if damage != 20 {
+						t.Fatalf("dégâts critiques = %d, attendu 20", damage)
+					}
+				} else if damage != 10 {
+					t.Fatalf("dégâts normaux = %d, attendu 10", damage)
+				}
+			}
+
+			// Écart type ≈ 0.22 % pour 5 % sur 10000 tirages : marge de ±1 %
+			rate := float64(criticals) / trials
+			if math.Abs(rate-tt.chance) > 0.01 {
+				t.Errorf("taux de critiques = %.2f %%, attendu %.2f %%", rate*100, tt.chance*100)
+			}
+		})
+	}
+}
+
+func TestRollDamageMultiplier(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.CriticalMultiplier = 1.5
+
+	if damage, critical := cs.RollDamage(15, 1); !critical || damage != 23 {
+		t.Errorf("RollDamage = (%d, %t), attendu (23, true)", damage, critical)
+	}
+}
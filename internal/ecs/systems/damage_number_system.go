@@ -0,0 +1,76 @@
+// internal/ecs/systems/damage_number_system.go - Dégâts affichés au-dessus des ennemis touchés
+package systems
+
+import (
+	"fmt"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// DamageNumber nombre flottant qui monte puis s'efface
+type DamageNumber struct {
+	Position components.Vector2
+	Amount   int
+	Color    components.Color
+	Age      time.Duration
+}
+
+// DamageNumberSystem gère les nombres de dégâts flottants
+type DamageNumberSystem struct {
+	numbers []*DamageNumber
+
+	Lifetime  time.Duration // Durée d'affichage d'un nombre
+	RiseSpeed float64       // Vitesse de montée (pixels/s)
+}
+
+// Couleurs des nombres de dégâts
+var (
+	DamageColorNormal   = components.Color{R: 255, G: 255, B: 255, A: 255}
+	DamageColorCritical = components.Color{R: 255, G: 220, B: 40, A: 255}
+)
+
+// NewDamageNumberSystem crée un nouveau système de nombres de dégâts
+func NewDamageNumberSystem() *DamageNumberSystem {
+	return &DamageNumberSystem{
+		numbers:   make([]*DamageNumber, 0, 8),
+		Lifetime:  800 * time.Millisecond,
+		RiseSpeed: 40,
+	}
+}
+
+// Spawn affiche un nombre de dégâts au-dessus d'une position
+func (ds *DamageNumberSystem) Spawn(position components.Vector2, amount int, color components.Color) {
+	ds.numbers = append(ds.numbers, &DamageNumber{
+		Position: components.Vector2{X: position.X, Y: position.Y - 20},
+		Amount:   amount,
+		Color:    color,
+	})
+}
+
+// Clear supprime tous les nombres
+func (ds *DamageNumberSystem) Clear() {
+	ds.numbers = ds.numbers[:0]
+}
+
+// Update fait monter les nombres et retire ceux expirés
+func (ds *DamageNumberSystem) Update(deltaTime time.Duration) {
+	alive := ds.numbers[:0]
+	for _, number := range ds.numbers {
+		number.Age += deltaTime
+		if number.Age >= ds.Lifetime {
+			continue
+		}
+		number.Position.Y -= ds.RiseSpeed * deltaTime.Seconds()
+		alive = append(alive, number)
+	}
+	ds.numbers = alive
+}
+
+// Render dessine les nombres, de plus en plus transparents
+func (ds *DamageNumberSystem) Render(renderer Renderer) {
+	for _, number := range ds.numbers {
+		color := number.Color
+		color.A = uint8(float64(color.A) * (1 - float64(number.Age)/float64(ds.Lifetime)))
+		renderer.DrawText(fmt.Sprintf("%d", number.Amount), number.Position, color)
+	}
+}
@@ -0,0 +1,232 @@
+// internal/ecs/systems/decal_system.go - Décalques au sol (empreintes, sang, brûlures)
+package systems
+
+import (
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// ===============================
+// DÉCALQUES
+// ===============================
+
+// Textures des décalques
+const (
+	DecalFootprintLeft  = "decal_footprint_left"
+	DecalFootprintRight = "decal_footprint_right"
+	DecalBlood          = "decal_blood"
+	DecalScorch         = "decal_scorch"
+)
+
+const (
+	// MaxDecals taille du pool ; au-delà, le plus ancien décalque est recyclé
+	MaxDecals = 200
+
+	// FootprintSpacing distance parcourue (pixels) entre deux empreintes
+	FootprintSpacing = 16.0
+
+	// footprintOffset écart latéral (pixels) de chaque pied par rapport au centre
+	footprintOffset = 4.0
+)
+
+// Decal marque posée au sol entre les tuiles et les entités
+type Decal struct {
+	TextureID string
+	Position  components.Vector2
+	Rotation  float64 // radians
+	Alpha     uint8
+	DecayRate float64 // Alpha perdu par seconde
+}
+
+// DecalRenderer rendu d'un décalque sur la couche dédiée
+type DecalRenderer interface {
+	DrawDecal(textureID string, position components.Vector2, rotation float64, alpha uint8)
+}
+
+// decalSlot emplacement du pool
+type decalSlot struct {
+	decal  Decal
+	alpha  float64 // Alpha précis, Decal.Alpha en est l'arrondi
+	serial uint64  // Ordre d'apparition, pour recycler le plus ancien
+	active bool
+}
+
+// DecalSystem gère un pool fixe de décalques qui s'estompent avec le temps
+type DecalSystem struct {
+	slots      [MaxDecals]decalSlot
+	nextSerial uint64
+
+	// Empreintes du joueur
+	footprintDistance float64
+	lastFootPosition  components.Vector2
+	hasLastFoot       bool
+	leftFoot          bool
+}
+
+// NewDecalSystem crée un nouveau système de décalques
+func NewDecalSystem() *DecalSystem {
+	return &DecalSystem{leftFoot: true}
+}
+
+// Spawn ajoute un décalque ; si le pool est plein, le plus ancien est remplacé
+func (ds *DecalSystem) Spawn(decal Decal) {
+	index := ds.freeSlot()
+	ds.slots[index] = decalSlot{
+		decal:  decal,
+		alpha:  float64(decal.Alpha),
+		serial: ds.nextSerial,
+		active: true,
+	}
+	ds.nextSerial++
+}
+
+// freeSlot retourne un emplacement libre, ou celui du décalque le plus ancien
+func (ds *DecalSystem) freeSlot() int {
+	oldest := 0
+	for i := range ds.slots {
+		if !ds.slots[i].active {
+			return i
+		}
+		if ds.slots[i].serial < ds.slots[oldest].serial {
+			oldest = i
+		}
+	}
+	return oldest
+}
+
+// Count retourne le nombre de décalques visibles
+func (ds *DecalSystem) Count() int {
+	count := 0
+	for i := range ds.slots {
+		if ds.slots[i].active {
+			count++
+		}
+	}
+	return count
+}
+
+// GetDecals retourne les décalques visibles, du plus ancien au plus récent
+func (ds *DecalSystem) GetDecals() []Decal {
+	decals := make([]Decal, 0, MaxDecals)
+	for _, slot := range ds.sortedSlots() {
+		decals = append(decals, slot.decal)
+	}
+	return decals
+}
+
+// sortedSlots retourne les emplacements actifs par ordre d'apparition
+func (ds *DecalSystem) sortedSlots() []*decalSlot {
+	slots := make([]*decalSlot, 0, MaxDecals)
+	for i := range ds.slots {
+		if !ds.slots[i].active {
+			continue
+		}
+		// Insertion triée : le pool est petit et presque toujours déjà ordonné
+		slot := &ds.slots[i]
+		position := len(slots)
+		for position > 0 && slots[position-1].serial > slot.serial {
+			position--
+		}
+		slots = append(slots, nil)
+		copy(slots[position+1:], slots[position:])
+		slots[position] = slot
+	}
+	return slots
+}
+
+// Clear supprime tous les décalques
+func (ds *DecalSystem) Clear() {
+	ds.slots = [MaxDecals]decalSlot{}
+	ds.hasLastFoot = false
+	ds.footprintDistance = 0
+}
+
+// Update estompe les décalques et recycle ceux devenus invisibles
+func (ds *DecalSystem) Update(deltaTime time.Duration) {
+	dt := deltaTime.Seconds()
+	for i := range ds.slots {
+		slot := &ds.slots[i]
+		if !slot.active {
+			continue
+		}
+
+		slot.alpha -= slot.decal.DecayRate * dt
+		if slot.alpha <= 0 {
+			slot.active = false
+			continue
+		}
+		slot.decal.Alpha = uint8(math.Round(slot.alpha))
+	}
+}
+
+// UpdateFootprints pose une empreinte tous les FootprintSpacing pixels parcourus, en alternant les pieds
+func (ds *DecalSystem) UpdateFootprints(player *PlayerEntity) {
+	if player == nil || !player.Active {
+		return
+	}
+
+	position := player.Position.Position
+	if !ds.hasLastFoot {
+		ds.lastFootPosition = position
+		ds.hasLastFoot = true
+		return
+	}
+
+	step := position.Sub(ds.lastFootPosition)
+	ds.footprintDistance += math.Hypot(step.X, step.Y)
+	ds.lastFootPosition = position
+
+	if ds.footprintDistance < FootprintSpacing {
+		return
+	}
+	ds.footprintDistance = math.Mod(ds.footprintDistance, FootprintSpacing)
+
+	// Orientation de la marche et décalage perpendiculaire du pied
+	heading := math.Atan2(step.Y, step.X)
+	side := footprintOffset
+	textureID := DecalFootprintRight
+	if ds.leftFoot {
+		side = -footprintOffset
+		textureID = DecalFootprintLeft
+	}
+	ds.leftFoot = !ds.leftFoot
+
+	offset := components.Vector2{X: -math.Sin(heading) * side, Y: math.Cos(heading) * side}
+	ds.Spawn(Decal{
+		TextureID: textureID,
+		Position:  position.Add(offset),
+		Rotation:  heading,
+		Alpha:     140,
+		DecayRate: 35,
+	})
+}
+
+// SpawnBlood pose une tache de sang à la position d'un coup
+func (ds *DecalSystem) SpawnBlood(position components.Vector2) {
+	ds.Spawn(Decal{
+		TextureID: DecalBlood,
+		Position:  position,
+		Rotation:  float64(ds.nextSerial%8) * math.Pi / 4, // Variation sans aléatoire
+		Alpha:     220,
+		DecayRate: 12,
+	})
+}
+
+// SpawnScorch pose une trace de brûlure
+func (ds *DecalSystem) SpawnScorch(position components.Vector2) {
+	ds.Spawn(Decal{
+		TextureID: DecalScorch,
+		Position:  position,
+		Alpha:     200,
+		DecayRate: 8,
+	})
+}
+
+// Render redessine tous les décalques (la couche est vidée à chaque frame)
+func (ds *DecalSystem) Render(renderer DecalRenderer) {
+	for _, slot := range ds.sortedSlots() {
+		decal := slot.decal
+		renderer.DrawDecal(decal.TextureID, decal.Position, decal.Rotation, decal.Alpha)
+	}
+}
@@ -0,0 +1,117 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// numberedDecal décalque identifiable par sa position X
+func numberedDecal(n int) Decal {
+	return Decal{TextureID: DecalBlood, Position: components.Vector2{X: float64(n)}, Alpha: 200, DecayRate: 10}
+}
+
+func TestDecalPoolEvictsOldest(t *testing.T) {
+	tests := []struct {
+		name      string
+		spawned   int
+		wantFirst int // Plus ancien décalque conservé
+		wantLast  int
+	}{
+		{"pool incomplet", 50, 0, 49},
+		{"pool plein", MaxDecals, 0, MaxDecals - 1},
+		{"un de trop", MaxDecals + 1, 1, MaxDecals},
+		{"deux tours", MaxDecals*2 + 10, MaxDecals + 10, MaxDecals*2 + 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := NewDecalSystem()
+			for i := 0; i < tt.spawned; i++ {
+				ds.Spawn(numberedDecal(i))
+			}
+
+			decals := ds.GetDecals()
+			wantCount := tt.wantLast - tt.wantFirst + 1
+			if len(decals) != wantCount || ds.Count() != wantCount {
+				t.Fatalf("%d décalques (Count %d), attendu %d", len(decals), ds.Count(), wantCount)
+			}
+			for i, decal := range decals {
+				if want := float64(tt.wantFirst + i); decal.Position.X != want {
+					t.Fatalf("décalque %d = n°%.0f, attendu n°%.0f (ordre d'apparition)", i, decal.Position.X, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecalRecycledSlotsReusedBeforeEviction(t *testing.T) {
+	ds := NewDecalSystem()
+	for i := 0; i < MaxDecals; i++ {
+		decal := numberedDecal(i)
+		if i == 10 {
+			decal.DecayRate = 20000 // S'efface en une frame
+		}
+		ds.Spawn(decal)
+	}
+
+	ds.Update(time.Second / 60)
+	if ds.Count() != MaxDecals-1 {
+		t.Fatalf("Count = %d, attendu %d après l'effacement d'un décalque", ds.Count(), MaxDecals-1)
+	}
+
+	// L'emplacement libéré est réutilisé : le plus ancien n'est pas évincé
+	ds.Spawn(numberedDecal(MaxDecals))
+	decals := ds.GetDecals()
+	if decals[0].Position.X != 0 {
+		t.Errorf("plus ancien = n°%.0f, attendu n°0 (un emplacement était libre)", decals[0].Position.X)
+	}
+	if last := decals[len(decals)-1].Position.X; last != MaxDecals {
+		t.Errorf("plus récent = n°%.0f, attendu n°%d", last, MaxDecals)
+	}
+}
+
+func TestDecalDecay(t *testing.T) {
+	ds := NewDecalSystem()
+	ds.Spawn(Decal{TextureID: DecalScorch, Alpha: 100, DecayRate: 40})
+
+	ds.Update(time.Second)
+	if alpha := ds.GetDecals()[0].Alpha; alpha != 60 {
+		t.Errorf("Alpha = %d après 1 s, attendu 60", alpha)
+	}
+
+	ds.Update(2 * time.Second)
+	if ds.Count() != 0 {
+		t.Errorf("Count = %d, attendu 0 une fois l'alpha épuisé", ds.Count())
+	}
+}
+
+func TestDecalFootprintsAlternate(t *testing.T) {
+	ds := NewDecalSystem()
+	player := NewPlayerEntity(100, 100)
+
+	// 4 pixels par frame vers la droite : une empreinte toutes les 4 frames
+	for frame := 0; frame <= 16; frame++ {
+		ds.UpdateFootprints(player)
+		player.Position.Position.X += 4
+	}
+
+	decals := ds.GetDecals()
+	if len(decals) != 4 {
+		t.Fatalf("%d empreintes pour 64 px, attendu 4", len(decals))
+	}
+	for i, decal := range decals {
+		want := DecalFootprintLeft
+		if i%2 == 1 {
+			want = DecalFootprintRight
+		}
+		if decal.TextureID != want {
+			t.Errorf("empreinte %d = %s, attendu %s", i, decal.TextureID, want)
+		}
+	}
+	// Pied gauche au-dessus de la trajectoire en marchant vers la droite
+	if decals[0].Position.Y >= 100 || decals[1].Position.Y <= 100 {
+		t.Errorf("décalage des pieds: gauche Y=%.1f, droit Y=%.1f", decals[0].Position.Y, decals[1].Position.Y)
+	}
+}
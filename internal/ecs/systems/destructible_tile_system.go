@@ -0,0 +1,139 @@
+// internal/ecs/systems/destructible_tile_system.go - Murs brisés par les coups lourds
+package systems
+
+import (
+	"fmt"
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Effets sonores des murs destructibles
+const (
+	SFXTileHit   = "sfx_wall_hit"
+	SFXTileBreak = "sfx_wall_break"
+)
+
+// DestructibleTileGrid grille du monde dont les murs destructibles cèdent aux
+// coups (world.TileMap) : DamageTile retire des points de vie au mur et,
+// à zéro, libère la tuile et marque son chunk modifié
+type DestructibleTileGrid interface {
+	GetTileSize() float64
+	DamageTile(tx, ty, damage int) (hit, destroyed bool)
+}
+
+// TileDestroyedEvent est émis quand un mur destructible cède
+type TileDestroyedEvent struct {
+	TileX, TileY int
+	Position     components.Vector2 // Centre de la tuile
+}
+
+// DestructibleTileSystem applique les coups capables de briser les murs
+// (HitboxComponent.CanDestroyTiles) aux tuiles destructibles qu'ils
+// recouvrent. Chaque tuile n'est touchée qu'une fois par attaque.
+type DestructibleTileSystem struct {
+	Damage int // Points de vie retirés par coup
+
+	// Appelé quand un mur cède
+	OnTileDestroyed func(event TileDestroyedEvent)
+
+	grid      DestructibleTileGrid
+	particles *ParticleSystem
+	sounds    SoundPlayer
+
+	swingTiles [][2]int // Tuiles déjà touchées par l'attaque en cours
+}
+
+// NewDestructibleTileSystem crée le système des murs destructibles
+func NewDestructibleTileSystem(particles *ParticleSystem) *DestructibleTileSystem {
+	return &DestructibleTileSystem{
+		Damage:    1,
+		particles: particles,
+	}
+}
+
+// SetGrid définit la grille des murs destructibles
+func (ds *DestructibleTileSystem) SetGrid(grid DestructibleTileGrid) {
+	ds.grid = grid
+}
+
+// SetSoundPlayer définit la sortie sonore
+func (ds *DestructibleTileSystem) SetSoundPlayer(sounds SoundPlayer) {
+	ds.sounds = sounds
+}
+
+// BeginSwing démarre une nouvelle attaque : les tuiles pourront de nouveau
+// être touchées
+func (ds *DestructibleTileSystem) BeginSwing() {
+	ds.swingTiles = ds.swingTiles[:0]
+}
+
+// Strike applique une zone de frappe aux tuiles qu'elle recouvre et retourne
+// le nombre de murs brisés ; sans CanDestroyTiles, le coup rebondit sur les murs
+func (ds *DestructibleTileSystem) Strike(hitbox components.HitboxComponent) int {
+	if ds.grid == nil || !hitbox.CanDestroyTiles {
+		return 0
+	}
+	tileSize := ds.grid.GetTileSize()
+	if tileSize <= 0 || hitbox.Bounds.Width <= 0 || hitbox.Bounds.Height <= 0 {
+		return 0
+	}
+
+	// Tuiles recouvertes, bord droit et bas exclus
+	bounds := hitbox.Bounds
+	minX := int(math.Floor(bounds.X / tileSize))
+	minY := int(math.Floor(bounds.Y / tileSize))
+	maxX := int(math.Ceil((bounds.X+bounds.Width)/tileSize)) - 1
+	maxY := int(math.Ceil((bounds.Y+bounds.Height)/tileSize)) - 1
+
+	destroyed := 0
+	for ty := minY; ty <= maxY; ty++ {
+		for tx := minX; tx <= maxX; tx++ {
+			if ds.alreadyHit(tx, ty) {
+				continue
+			}
+			hit, broken := ds.grid.DamageTile(tx, ty, ds.Damage)
+			if !hit {
+				continue
+			}
+			ds.swingTiles = append(ds.swingTiles, [2]int{tx, ty})
+
+			center := components.Vector2{X: (float64(tx) + 0.5) * tileSize, Y: (float64(ty) + 0.5) * tileSize}
+			if broken {
+				destroyed++
+				ds.breakTile(tx, ty, center)
+			} else {
+				ds.emit(center, DustBurst, SFXTileHit)
+			}
+		}
+	}
+	return destroyed
+}
+
+// alreadyHit vérifie si l'attaque en cours a déjà touché une tuile
+func (ds *DestructibleTileSystem) alreadyHit(tx, ty int) bool {
+	for _, tile := range ds.swingTiles {
+		if tile[0] == tx && tile[1] == ty {
+			return true
+		}
+	}
+	return false
+}
+
+// breakTile joue les gravats et le son d'un mur qui cède
+func (ds *DestructibleTileSystem) breakTile(tx, ty int, center components.Vector2) {
+	fmt.Printf("Mur brisé en (%d, %d)\n", tx, ty)
+	ds.emit(center, DebrisBurst, SFXTileBreak)
+	if ds.OnTileDestroyed != nil {
+		ds.OnTileDestroyed(TileDestroyedEvent{TileX: tx, TileY: ty, Position: center})
+	}
+}
+
+// emit émet une gerbe de particules et un son au centre d'une tuile
+func (ds *DestructibleTileSystem) emit(center components.Vector2, burst BurstConfig, sfxID string) {
+	if ds.particles != nil {
+		ds.particles.EmitBurst(center, burst)
+	}
+	if ds.sounds != nil {
+		ds.sounds.Play(sfxID, 1.0, SoundAt(center))
+	}
+}
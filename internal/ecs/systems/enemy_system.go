@@ -0,0 +1,671 @@
+// internal/ecs/systems/enemy_system.go - Système des ennemis
+package systems
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+	"zelda-souls-game/internal/pathfinding"
+)
+
+// ===============================
+// ENTITÉ ENNEMI
+// ===============================
+
+// EnemyEntity représente une entité ennemie
+type EnemyEntity struct {
+	// Composants
+	Position  *components.PositionComponent
+	Movement  *components.MovementComponent
+	Sprite    *components.SpriteComponent
+	Collider  *components.ColliderComponent
+	Hull      *components.ConvexHullCollider // Corps non rectangulaire (nil : boîte du Collider)
+	Enemy     *components.EnemyComponent
+	Formation *components.FormationComponent // nil si l'ennemi agit seul
+	Patrol    *components.PatrolComponent    // nil si l'ennemi reste en place
+	Path      *components.PathComponent      // Chemin A* vers la cible (nil sans grille)
+
+	// Point d'apparition, rejoint après une poursuite si l'ennemi ne patrouille pas
+	Home components.Vector2
+
+	// Objets lâchés à la mort (noms d'objets)
+	Drops []string
+
+	// État interne
+	EntityID uint32
+	Active   bool
+}
+
+// NewEnemyEntity crée une nouvelle entité ennemie
+func NewEnemyEntity(id uint32, x, y float64) *EnemyEntity {
+	entity := &EnemyEntity{
+		Position: components.NewPositionComponent(x, y),
+		Movement: components.NewMovementComponent(120.0, 150.0),
+		Sprite:   components.NewSpriteComponent("enemy", 28, 28),
+		Collider: components.NewColliderComponent(24, 24, components.LayerEnemy),
+		Enemy:    components.NewEnemyComponent(30, 10),
+		Home:     components.Vector2{X: x, Y: y},
+		EntityID: id,
+		Active:   true,
+	}
+
+	entity.Sprite.Layer = EntityLayer
+	entity.Sprite.Color = components.Color{R: 200, G: 60, B: 60, A: 255}
+
+	return entity
+}
+
+// GetPosition implémente l'interface Positionable pour EnemyEntity
+func (ee *EnemyEntity) GetPosition() components.Vector2 {
+	return ee.Position.Position
+}
+
+// IsTargetable retourne si l'ennemi peut encore être verrouillé
+func (ee *EnemyEntity) IsTargetable() bool {
+	return ee.Active && ee.Enemy.IsAlive()
+}
+
+// Enrage rend l'ennemi plus dangereux : vitesse et dégâts doublés
+func (ee *EnemyEntity) Enrage() {
+	if !ee.Enemy.Enrage() {
+		return
+	}
+	ee.Movement.Speed *= 2
+	ee.Movement.MaxSpeed *= 2
+	ee.Sprite.Color = components.Color{R: 255, G: 90, B: 20, A: 255}
+}
+
+// ===============================
+// SYSTÈME ENNEMI
+// ===============================
+
+// LineOfSight source de ligne de vue (grille de tuiles solides du monde)
+type LineOfSight interface {
+	HasLineOfSight(from, to components.Vector2) bool
+}
+
+// Réglages de la machine à états des ennemis
+const (
+	attackLeaveMargin = 1.25 // L'attaque cesse au-delà de portée x marge
+	returnReachRadius = 8.0  // Distance (pixels) à laquelle le point de retour est atteint
+)
+
+// NeighborIndex index spatial pour les requêtes de voisinage (core.SpatialGrid)
+type NeighborIndex interface {
+	Clear()
+	Insert(id uint32, bounds components.Rectangle)
+	QueryRegion(region components.Rectangle) []uint32
+}
+
+// EnemySystem gère la logique et le rendu des ennemis
+type EnemySystem struct {
+	enemies    []*EnemyEntity
+	formations *FormationSystem
+	patrols    *PatrolSystem
+	nextID     uint32
+
+	// Réglages de déplacement appliqués aux nouveaux ennemis
+	movementProfile components.MovementProfile
+
+	// Murs bloquant la vue (nil : vue dégagée partout)
+	lineOfSight LineOfSight
+
+	// Recherche de chemin A* (nil : déplacement en ligne droite)
+	pathGrid              pathfinding.Grid
+	PathRecomputeInterval time.Duration // Délai entre deux calculs de chemin par ennemi
+	MaxPathNodes          int           // Tuiles explorées au plus par calcul
+	ShowPathDebug         bool
+
+	// Séparation : les ennemis proches se repoussent pour ne pas s'empiler
+	neighbors        NeighborIndex           // nil : tous les ennemis sont testés
+	byID             map[uint32]*EnemyEntity // Ennemis indexés ce tick
+	SeparationRadius float64                 // Distance (pixels) en dessous de laquelle on se repousse
+	SeparationWeight float64                 // Poids de la répulsion face à la poursuite
+
+	// Boss actuellement engagé (nil si aucun)
+	activeBoss *EnemyEntity
+
+	// Appelé une fois à la mort d'un ennemi (butin, statistiques)
+	OnEnemyDefeated func(enemy *EnemyEntity)
+
+	// Avancement (0 à 1) entre deux ticks au moment du rendu
+	interpolationAlpha float64
+}
+
+// NewEnemySystem crée un nouveau système ennemi
+func NewEnemySystem() *EnemySystem {
+	return &EnemySystem{
+		enemies:    make([]*EnemyEntity, 0),
+		formations: NewFormationSystem(),
+		patrols:    NewPatrolSystem(),
+		nextID:     100, // Les IDs bas sont réservés au joueur
+
+		PathRecomputeInterval: 500 * time.Millisecond,
+		MaxPathNodes:          pathfinding.DefaultMaxSearchNodes,
+
+		byID:             make(map[uint32]*EnemyEntity),
+		SeparationRadius: 40.0,
+		SeparationWeight: 1.5,
+
+		interpolationAlpha: 1,
+	}
+}
+
+// SetInterpolationAlpha règle l'avancement entre deux ticks utilisé au rendu
+func (es *EnemySystem) SetInterpolationAlpha(alpha float64) {
+	es.interpolationAlpha = alpha
+}
+
+// SpawnEnemy crée un ennemi isolé à une position
+func (es *EnemySystem) SpawnEnemy(x, y float64) *EnemyEntity {
+	enemy := NewEnemyEntity(es.nextID, x, y)
+	enemy.Movement.ApplyProfile(es.movementProfile)
+	es.nextID++
+	es.enemies = append(es.enemies, enemy)
+	return enemy
+}
+
+// SpawnPatrolling crée un ennemi qui patrouille en ligne droite entre des points
+// (deux points : il fait les cent pas, loop : il reprend au premier point)
+func (es *EnemySystem) SpawnPatrolling(x, y float64, waypoints []components.Vector2, loop bool) *EnemyEntity {
+	enemy := es.SpawnEnemy(x, y)
+	es.patrols.AssignWaypoints(enemy, waypoints, loop)
+	return enemy
+}
+
+// SpawnInFormation crée un ennemi rattaché à une formation
+func (es *EnemySystem) SpawnInFormation(formationID string, x, y float64) *EnemyEntity {
+	enemy := es.SpawnEnemy(x, y)
+	enemy.Formation = components.NewFormationComponent(formationID)
+	es.formations.AddMember(enemy)
+	return enemy
+}
+
+// SpawnBoss crée un boss nommé
+func (es *EnemySystem) SpawnBoss(name string, x, y float64, maxHealth int) *EnemyEntity {
+	boss := es.SpawnEnemy(x, y)
+	boss.Enemy = components.NewEnemyComponent(maxHealth, 25)
+	boss.Enemy.IsBoss = true
+	boss.Enemy.Name = name
+	boss.Enemy.AggroRange = 350.0
+
+	boss.Sprite.Size = components.Vector2{X: 56, Y: 56}
+	boss.Sprite.Color = components.Color{R: 120, G: 30, B: 160, A: 255}
+	boss.Collider.Bounds.Width = 48
+	boss.Collider.Bounds.Height = 48
+
+	return boss
+}
+
+// SetMovementProfile définit les réglages de déplacement des ennemis
+func (es *EnemySystem) SetMovementProfile(profile components.MovementProfile) {
+	es.movementProfile = profile
+	for _, enemy := range es.enemies {
+		enemy.Movement.ApplyProfile(profile)
+	}
+}
+
+// SetLineOfSight définit la source de ligne de vue utilisée pour l'aggro
+func (es *EnemySystem) SetLineOfSight(lineOfSight LineOfSight) {
+	es.lineOfSight = lineOfSight
+}
+
+// SetPathGrid définit la grille utilisée pour contourner les murs
+func (es *EnemySystem) SetPathGrid(grid pathfinding.Grid) {
+	es.pathGrid = grid
+}
+
+// SetNeighborIndex définit l'index spatial utilisé pour trouver les ennemis voisins
+func (es *EnemySystem) SetNeighborIndex(index NeighborIndex) {
+	es.neighbors = index
+}
+
+// canSee vérifie qu'aucun mur ne sépare l'ennemi de sa cible
+func (es *EnemySystem) canSee(enemy *EnemyEntity, target components.Vector2) bool {
+	if es.lineOfSight == nil {
+		return true
+	}
+	return es.lineOfSight.HasLineOfSight(enemy.Position.Position, target)
+}
+
+// GetActiveBoss retourne le boss engagé, ou nil
+func (es *EnemySystem) GetActiveBoss() *EnemyEntity {
+	return es.activeBoss
+}
+
+// GetEnemies retourne tous les ennemis
+func (es *EnemySystem) GetEnemies() []*EnemyEntity {
+	return es.enemies
+}
+
+// GetPatrolSystem retourne le système de patrouille
+func (es *EnemySystem) GetPatrolSystem() *PatrolSystem {
+	return es.patrols
+}
+
+// GetFormationSystem retourne le système de formation
+func (es *EnemySystem) GetFormationSystem() *FormationSystem {
+	return es.formations
+}
+
+// Clear supprime tous les ennemis
+func (es *EnemySystem) Clear() {
+	es.enemies = es.enemies[:0]
+	es.formations = NewFormationSystem()
+	es.patrols.Clear()
+	es.activeBoss = nil
+}
+
+// ClearNonBoss supprime les ennemis ordinaires ; les boss restent en place
+func (es *EnemySystem) ClearNonBoss() {
+	bosses := es.enemies[:0]
+	for _, enemy := range es.enemies {
+		if enemy.Enemy.IsBoss {
+			bosses = append(bosses, enemy)
+		}
+	}
+	es.enemies = bosses
+	es.formations = NewFormationSystem()
+	es.patrols.Clear()
+}
+
+// Update met à jour les ennemis vers la cible (le joueur)
+func (es *EnemySystem) Update(deltaTime time.Duration, target components.Vector2) {
+	// Coordination des groupes avant le mouvement
+	es.formations.Update(target)
+	es.indexNeighbors()
+
+	alive := es.enemies[:0]
+	for _, enemy := range es.enemies {
+		if !enemy.Active {
+			continue
+		}
+		enemy.Position.BeginStep()
+
+		enemy.Enemy.Update(deltaTime)
+		if !enemy.Enemy.IsAlive() {
+			enemy.Active = false
+			fmt.Printf("Ennemi %d vaincu\n", enemy.EntityID)
+			if es.OnEnemyDefeated != nil {
+				es.OnEnemyDefeated(enemy)
+			}
+			continue
+		}
+
+		diff := target.Sub(enemy.Position.Position)
+		distance := math.Hypot(diff.X, diff.Y)
+
+		// La ligne de vue n'est testée que si la cible est à portée
+		visible := distance <= enemy.Enemy.AggroRange && es.canSee(enemy, target)
+		enemy.Enemy.UpdateAggro(distance, visible)
+
+		es.updateState(enemy, distance)
+		es.updateMovement(enemy, deltaTime, target)
+		alive = append(alive, enemy)
+	}
+	es.enemies = alive
+
+	es.updateActiveBoss()
+}
+
+// updateActiveBoss désigne le boss engagé le plus proche dans la liste
+func (es *EnemySystem) updateActiveBoss() {
+	if es.activeBoss != nil && es.activeBoss.Active && es.activeBoss.Enemy.Aggroed {
+		return
+	}
+
+	es.activeBoss = nil
+	for _, enemy := range es.enemies {
+		if enemy.Enemy.IsBoss && enemy.Enemy.Aggroed {
+			es.activeBoss = enemy
+			return
+		}
+	}
+}
+
+// updateState fait évoluer la machine à états Patrouille/Poursuite/Attaque/Retour.
+// distance : distance entre l'ennemi et sa cible.
+func (es *EnemySystem) updateState(enemy *EnemyEntity, distance float64) {
+	ai := enemy.Enemy
+	reach := ai.AttackRange + enemy.Collider.Bounds.Width/2
+
+	switch ai.State {
+	case components.EnemyStatePatrol:
+		if ai.Aggroed {
+			es.setState(enemy, components.EnemyStateChase)
+		}
+	case components.EnemyStateChase:
+		if !ai.Aggroed {
+			es.setState(enemy, components.EnemyStateReturn)
+		} else if distance <= reach {
+			es.setState(enemy, components.EnemyStateAttack)
+		}
+	case components.EnemyStateAttack:
+		// Marge de sortie pour ne pas osciller au bord de la portée
+		if !ai.Aggroed {
+			es.setState(enemy, components.EnemyStateReturn)
+		} else if distance > reach*attackLeaveMargin {
+			es.setState(enemy, components.EnemyStateChase)
+		}
+	case components.EnemyStateReturn:
+		if ai.Aggroed {
+			es.setState(enemy, components.EnemyStateChase)
+		} else if es.distanceTo(enemy, es.returnPoint(enemy)) <= returnReachRadius {
+			es.setState(enemy, components.EnemyStatePatrol)
+		}
+	}
+}
+
+// setState change l'état d'un ennemi ; le chemin A* est recalculé vers la nouvelle destination
+func (es *EnemySystem) setState(enemy *EnemyEntity, state components.EnemyAIState) {
+	if enemy.Enemy.State == state {
+		return
+	}
+	enemy.Enemy.State = state
+	if enemy.Path != nil {
+		enemy.Path.RecomputeTime = 0
+	}
+}
+
+// returnPoint retourne le point à rejoindre après une poursuite :
+// le point de patrouille courant, sinon le point d'apparition
+func (es *EnemySystem) returnPoint(enemy *EnemyEntity) components.Vector2 {
+	if enemy.Patrol != nil {
+		if target, ok := enemy.Patrol.Target(); ok {
+			return target
+		}
+	}
+	return enemy.Home
+}
+
+// distanceTo distance entre un ennemi et un point
+func (es *EnemySystem) distanceTo(enemy *EnemyEntity, point components.Vector2) float64 {
+	diff := point.Sub(enemy.Position.Position)
+	return math.Hypot(diff.X, diff.Y)
+}
+
+// updateMovement déplace un ennemi selon son état
+func (es *EnemySystem) updateMovement(enemy *EnemyEntity, deltaTime time.Duration, target components.Vector2) {
+	movement := enemy.Movement
+	position := enemy.Position
+
+	if enemy.Enemy.Stunned {
+		movement.Velocity = components.Vector2{X: 0, Y: 0}
+		movement.IsMoving = false
+		return
+	}
+
+	switch enemy.Enemy.State {
+	case components.EnemyStatePatrol:
+		// Un ennemi sans patrouille attend sur place
+		if enemy.Patrol != nil {
+			es.patrols.Move(enemy, deltaTime)
+		} else {
+			es.walkTo(enemy, position.Position, deltaTime)
+		}
+		return
+	case components.EnemyStateReturn:
+		es.walkTo(enemy, es.returnPoint(enemy), deltaTime)
+		return
+	}
+
+	destination := target
+	if enemy.Formation != nil && enemy.Formation.Role != components.FormationRoleNone {
+		destination = enemy.Formation.TargetPosition
+	}
+
+	// Les ennemis s'arrêtent au contact plutôt que de se superposer à la cible
+	stopDistance := enemy.Collider.Bounds.Width
+	diff := destination.Sub(position.Position)
+	distance := math.Hypot(diff.X, diff.Y)
+
+	dt := deltaTime.Seconds()
+	separation := es.separation(enemy).Mul(movement.Speed * es.SeparationWeight)
+	if distance <= stopDistance {
+		// Au contact, seule la répulsion déplace encore l'ennemi
+		if separation.X == 0 && separation.Y == 0 {
+			movement.Decelerate(dt)
+			movement.IsMoving = false
+		} else {
+			movement.Accelerate(separation, dt)
+			movement.IsMoving = true
+		}
+	} else {
+		// Se diriger vers le prochain point du chemin plutôt qu'en ligne droite
+		steer := es.followPath(enemy, destination, deltaTime).Sub(position.Position)
+		if steerDistance := math.Hypot(steer.X, steer.Y); steerDistance > 0 {
+			diff, distance = steer, steerDistance
+		}
+		direction := diff.Mul(1.0 / distance)
+		movement.Accelerate(direction.Mul(movement.Speed).Add(separation), dt)
+		movement.IsMoving = true
+	}
+
+	position.Position = position.Position.Add(movement.Velocity.Mul(dt))
+}
+
+// walkTo rapproche un ennemi d'un point à vitesse de marche (chemin A* compris)
+func (es *EnemySystem) walkTo(enemy *EnemyEntity, point components.Vector2, deltaTime time.Duration) {
+	movement := enemy.Movement
+	position := enemy.Position
+	dt := deltaTime.Seconds()
+
+	steer := point.Sub(position.Position)
+	if math.Hypot(steer.X, steer.Y) > returnReachRadius {
+		steer = es.followPath(enemy, point, deltaTime).Sub(position.Position)
+	}
+
+	if distance := math.Hypot(steer.X, steer.Y); distance > returnReachRadius {
+		speed := movement.Speed * es.patrols.SpeedFactor
+		movement.Accelerate(steer.Mul(speed/distance), dt)
+		movement.IsMoving = true
+	} else {
+		movement.Decelerate(dt)
+		movement.IsMoving = false
+	}
+
+	position.Position = position.Position.Add(movement.Velocity.Mul(dt))
+}
+
+// indexNeighbors indexe les ennemis actifs pour les requêtes de voisinage du tick
+func (es *EnemySystem) indexNeighbors() {
+	clear(es.byID)
+	if es.neighbors != nil {
+		es.neighbors.Clear()
+	}
+
+	for _, enemy := range es.enemies {
+		if !enemy.Active {
+			continue
+		}
+		es.byID[enemy.EntityID] = enemy
+		if es.neighbors != nil {
+			es.neighbors.Insert(enemy.EntityID, enemy.Collider.GetWorldBounds(enemy.Position.Position))
+		}
+	}
+}
+
+// separation retourne la répulsion exercée par les ennemis voisins : chaque voisin
+// à moins de SeparationRadius repousse d'autant plus qu'il est proche.
+// La norme du résultat est bornée à 1.
+func (es *EnemySystem) separation(enemy *EnemyEntity) components.Vector2 {
+	radius := es.SeparationRadius
+	if radius <= 0 || es.SeparationWeight <= 0 {
+		return components.Vector2{}
+	}
+
+	position := enemy.Position.Position
+	push := components.Vector2{}
+	for _, other := range es.neighborsOf(position, radius) {
+		if other == enemy || !other.Active {
+			continue
+		}
+
+		away := position.Sub(other.Position.Position)
+		distance := math.Hypot(away.X, away.Y)
+		if distance >= radius {
+			continue
+		}
+		if distance == 0 {
+			// Ennemis superposés : direction arbitraire mais stable selon l'ID
+			angle := float64(enemy.EntityID) * 2.399963
+			away, distance = components.Vector2{X: math.Cos(angle), Y: math.Sin(angle)}, 1
+		}
+
+		closeness := (radius - distance) / radius
+		push = push.Add(away.Mul(closeness / distance))
+	}
+
+	if length := math.Hypot(push.X, push.Y); length > 1 {
+		push = push.Mul(1 / length)
+	}
+	return push
+}
+
+// neighborsOf retourne les ennemis candidats autour d'une position
+func (es *EnemySystem) neighborsOf(position components.Vector2, radius float64) []*EnemyEntity {
+	if es.neighbors == nil {
+		return es.enemies
+	}
+
+	region := components.Rectangle{X: position.X - radius, Y: position.Y - radius, Width: radius * 2, Height: radius * 2}
+	ids := es.neighbors.QueryRegion(region)
+	candidates := make([]*EnemyEntity, 0, len(ids))
+	for _, id := range ids {
+		if enemy, exists := es.byID[id]; exists {
+			candidates = append(candidates, enemy)
+		}
+	}
+	return candidates
+}
+
+// followPath recalcule périodiquement le chemin A* et retourne le point à viser
+func (es *EnemySystem) followPath(enemy *EnemyEntity, destination components.Vector2, deltaTime time.Duration) components.Vector2 {
+	if es.pathGrid == nil {
+		return destination
+	}
+	if enemy.Path == nil {
+		enemy.Path = components.NewPathComponent()
+	}
+
+	path := enemy.Path
+	path.RecomputeTime -= deltaTime
+	if path.RecomputeTime <= 0 {
+		path.RecomputeTime = es.PathRecomputeInterval
+		path.SetWaypoints(pathfinding.FindPath(es.pathGrid, enemy.Position.Position, destination, es.MaxPathNodes))
+	}
+
+	// Sans chemin (cible inaccessible), on tente la ligne droite
+	if waypoint, ok := path.Next(enemy.Position.Position); ok {
+		return waypoint
+	}
+	return destination
+}
+
+// RenderPathDebug trace les chemins A* des ennemis engagés
+func (es *EnemySystem) RenderPathDebug(renderer LineRenderer) {
+	if !es.ShowPathDebug {
+		return
+	}
+
+	pathColor := components.Color{R: 255, G: 140, B: 0, A: 220}
+	for _, enemy := range es.enemies {
+		if !enemy.Active || enemy.Enemy.State == components.EnemyStatePatrol || enemy.Path == nil {
+			continue
+		}
+
+		previous := enemy.Position.Position
+		for _, waypoint := range enemy.Path.Remaining() {
+			renderer.DrawLine(previous, waypoint, pathColor, 1)
+			previous = waypoint
+		}
+	}
+}
+
+// Render rend les ennemis sous forme de rectangles, dans l'ordre de la liste
+func (es *EnemySystem) Render(renderer Renderer) {
+	for _, enemy := range es.enemies {
+		if !enemy.Active || !enemy.Sprite.Visible {
+			continue
+		}
+		es.renderEnemy(renderer, enemy)
+	}
+}
+
+// Submit soumet les ennemis visibles à la file de rendu, triés selon l'ancre de leur sprite
+func (es *EnemySystem) Submit(queue *RenderQueue) {
+	for _, enemy := range es.enemies {
+		if !enemy.Active || !enemy.Sprite.Visible {
+			continue
+		}
+		enemy := enemy
+		queue.Submit(enemy.Sprite.Layer, enemy.Sprite.SortY(enemy.Position.Position), func(renderer Renderer) {
+			es.renderEnemy(renderer, enemy)
+		})
+	}
+}
+
+// renderEnemy dessine un ennemi et sa barre de vie
+func (es *EnemySystem) renderEnemy(renderer Renderer, enemy *EnemyEntity) {
+	position := enemy.Position.RenderPosition(es.interpolationAlpha)
+	size := enemy.Sprite.Size
+
+	rect := components.Rectangle{
+		X:      position.X - size.X/2,
+		Y:      position.Y - size.Y/2,
+		Width:  size.X,
+		Height: size.Y,
+	}
+
+	color := enemy.Sprite.Color
+	if enemy.Enemy.Staggered {
+		rect.X += staggerShake(enemy.Enemy.StunTime)
+		color = staggerFlash(enemy.Enemy.StunTime, color)
+	} else if enemy.Enemy.Stunned {
+		color = components.ColorGray
+	}
+	renderer.DrawRectangle(rect, color, true)
+
+	// Bordure jaune pour l'attaquant actif de la formation
+	borderColor := components.ColorWhite
+	if enemy.Formation != nil && enemy.Formation.Role == components.FormationRoleAttacker {
+		borderColor = components.ColorYellow
+	}
+	renderer.DrawRectangle(rect, borderColor, false)
+
+	es.renderHealthBar(renderer, enemy)
+}
+
+// staggerShake décalage horizontal de l'ennemi qui chancelle (va-et-vient amorti)
+func staggerShake(remaining time.Duration) float64 {
+	amplitude := math.Min(remaining.Seconds()*6, 3)
+	return amplitude * math.Sin(remaining.Seconds()*40)
+}
+
+// staggerFlash fait clignoter en blanc l'ennemi qui chancelle
+func staggerFlash(remaining time.Duration, color components.Color) components.Color {
+	if (remaining.Milliseconds()/80)%2 == 0 {
+		return components.ColorWhite
+	}
+	return color
+}
+
+// renderHealthBar dessine la barre de vie d'un ennemi
+func (es *EnemySystem) renderHealthBar(renderer Renderer, enemy *EnemyEntity) {
+	position := enemy.Position.RenderPosition(es.interpolationAlpha)
+
+	barWidth := 28.0
+	barHeight := 3.0
+	barX := position.X - barWidth/2
+	barY := position.Y - enemy.Sprite.Size.Y/2 - 6
+
+	bgRect := components.Rectangle{X: barX, Y: barY, Width: barWidth, Height: barHeight}
+	renderer.DrawRectangle(bgRect, components.ColorBlack, true)
+
+	healthPercent := float64(enemy.Enemy.Health) / float64(enemy.Enemy.MaxHealth)
+	if healthPercent > 0 {
+		healthRect := components.Rectangle{X: barX, Y: barY, Width: barWidth * healthPercent, Height: barHeight}
+		renderer.DrawRectangle(healthRect, components.ColorRed, true)
+	}
+}
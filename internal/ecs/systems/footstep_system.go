@@ -0,0 +1,119 @@
+// internal/ecs/systems/footstep_system.go - Bruits de pas selon le matériau du sol
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"zelda-souls-game/internal/audio"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// DefaultStepInterval distance (pixels) parcourue entre deux pas
+const DefaultStepInterval = 24.0
+
+// TileCollisionGrid grille du monde donnant la tuile sous une position
+type TileCollisionGrid interface {
+	GetTileAt(position components.Vector2) (components.TileComponent, bool)
+}
+
+// SFXRolloffDistance distance (pixels) à laquelle un effet du monde devient inaudible
+const SFXRolloffDistance = 480.0
+
+// SoundPlayer joue un effet sonore par identifiant depuis une source du monde
+type SoundPlayer interface {
+	Play(sfxID string, pitch float64, source audio.SoundSource) bool
+}
+
+// SoundAt retourne la source d'un effet joué à une position du monde
+func SoundAt(position components.Vector2) audio.SoundSource {
+	return audio.SoundSource{
+		Position:        audio.Vector2{X: position.X, Y: position.Y},
+		RolloffDistance: SFXRolloffDistance,
+	}
+}
+
+// FootstepSystem joue un bruit de pas tous les StepInterval pixels parcourus par le joueur
+type FootstepSystem struct {
+	StepInterval   float64 // pixels
+	PitchVariation float64 // Variation aléatoire de hauteur (±)
+
+	grid   TileCollisionGrid
+	sounds SoundPlayer
+	random *rand.Rand
+
+	lastPosition components.Vector2
+	hasLast      bool
+	distance     float64
+}
+
+// NewFootstepSystem crée un système de bruits de pas
+func NewFootstepSystem() *FootstepSystem {
+	return &FootstepSystem{
+		StepInterval:   DefaultStepInterval,
+		PitchVariation: 0.08,
+		random:         rand.New(rand.NewSource(1)),
+	}
+}
+
+// SetGrid définit la grille de tuiles interrogée pour le matériau
+func (fs *FootstepSystem) SetGrid(grid TileCollisionGrid) {
+	fs.grid = grid
+}
+
+// SetSoundPlayer définit la sortie sonore
+func (fs *FootstepSystem) SetSoundPlayer(sounds SoundPlayer) {
+	fs.sounds = sounds
+}
+
+// Reset oublie la dernière position (téléportation, nouvelle partie)
+func (fs *FootstepSystem) Reset() {
+	fs.hasLast = false
+	fs.distance = 0
+}
+
+// MaterialAt retourne le matériau sous une position (pierre hors de la grille)
+func (fs *FootstepSystem) MaterialAt(position components.Vector2) components.TileMaterial {
+	if fs.grid == nil {
+		return components.MaterialStone
+	}
+	tile, ok := fs.grid.GetTileAt(position)
+	if !ok {
+		return components.MaterialStone
+	}
+	return tile.Material
+}
+
+// Update cumule la distance parcourue et retourne le nombre de pas joués
+func (fs *FootstepSystem) Update(position components.Vector2) int {
+	if !fs.hasLast {
+		fs.lastPosition = position
+		fs.hasLast = true
+		return 0
+	}
+
+	step := position.Sub(fs.lastPosition)
+	fs.lastPosition = position
+	fs.distance += math.Hypot(step.X, step.Y)
+
+	interval := fs.StepInterval
+	if interval <= 0 {
+		interval = DefaultStepInterval
+	}
+
+	steps := 0
+	for fs.distance >= interval {
+		fs.distance -= interval
+		steps++
+		fs.playStep(position)
+	}
+	return steps
+}
+
+// playStep joue le son de pas du matériau sous le joueur
+func (fs *FootstepSystem) playStep(position components.Vector2) {
+	if fs.sounds == nil {
+		return
+	}
+	pitch := 1.0 + (fs.random.Float64()*2-1)*fs.PitchVariation
+	fs.sounds.Play(fs.MaterialAt(position).FootstepSFX(), pitch, SoundAt(position))
+}
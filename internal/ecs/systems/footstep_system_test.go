@@ -0,0 +1,153 @@
+package systems
+
+import (
+	"testing"
+
+	"zelda-souls-game/internal/audio"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// stripGrid grille en bandes verticales de 32 px, un matériau par bande
+type stripGrid []components.TileMaterial
+
+func (g stripGrid) GetTileAt(position components.Vector2) (components.TileComponent, bool) {
+	column := int(position.X) / 32
+	if position.X < 0 || column >= len(g) {
+		return components.TileComponent{}, false
+	}
+	return components.TileComponent{Material: g[column]}, true
+}
+
+// recordedSounds sortie sonore retenant les effets joués
+type recordedSounds struct {
+	played  []string
+	pitches []float64
+	sources []audio.SoundSource
+}
+
+func (r *recordedSounds) Play(sfxID string, pitch float64, source audio.SoundSource) bool {
+	r.played = append(r.played, sfxID)
+	r.pitches = append(r.pitches, pitch)
+	r.sources = append(r.sources, source)
+	return true
+}
+
+func TestFootstepMaterialSFX(t *testing.T) {
+	grid := stripGrid{
+		components.MaterialStone,
+		components.MaterialGrass,
+		components.MaterialWood,
+		components.MaterialMetal,
+		components.MaterialWater,
+	}
+
+	tests := []struct {
+		name string
+		x    float64
+		want string
+	}{
+		{"pierre", 10, "sfx_footstep_stone"},
+		{"herbe", 40, "sfx_footstep_grass"},
+		{"bois", 70, "sfx_footstep_wood"},
+		{"métal", 100, "sfx_footstep_metal"},
+		{"eau", 140, "sfx_footstep_water"},
+		{"hors de la grille", 500, "sfx_footstep_stone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sounds := &recordedSounds{}
+			fs := NewFootstepSystem()
+			fs.SetGrid(grid)
+			fs.SetSoundPlayer(sounds)
+
+			// Un pas complet qui se termine sur la tuile testée
+			fs.Update(components.Vector2{X: tt.x, Y: 100 - fs.StepInterval})
+			fs.Update(components.Vector2{X: tt.x, Y: 100})
+
+			if len(sounds.played) != 1 || sounds.played[0] != tt.want {
+				t.Fatalf("sons joués %v, attendu [%s]", sounds.played, tt.want)
+			}
+			// Le pas est positionné sous le joueur
+			if source := sounds.sources[0]; source != SoundAt(components.Vector2{X: tt.x, Y: 100}) {
+				t.Errorf("source = %+v, attendu la position du joueur", source)
+			}
+		})
+	}
+}
+
+func TestFootstepInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		interval  float64
+		stride    float64 // Déplacement par frame
+		frames    int
+		wantSteps int
+	}{
+		{"intervalle par défaut", 0, 4, 60, 10}, // 240 px / 24
+		{"juste avant un pas", 24, 1, 23, 0},    // 23 px
+		{"pile sur un pas", 24, 1, 24, 1},       // 24 px
+		{"intervalle configuré", 10, 3, 20, 6},  // 60 px / 10
+		{"grande enjambée", 24, 50, 2, 4},       // 100 px en deux frames
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sounds := &recordedSounds{}
+			fs := NewFootstepSystem()
+			if tt.interval > 0 {
+				fs.StepInterval = tt.interval
+			}
+			fs.SetSoundPlayer(sounds)
+
+			position := components.Vector2{X: 0, Y: 0}
+			fs.Update(position)
+			steps := 0
+			for i := 0; i < tt.frames; i++ {
+				position.X += tt.stride
+				steps += fs.Update(position)
+			}
+
+			if steps != tt.wantSteps || len(sounds.played) != tt.wantSteps {
+				t.Errorf("%d pas (%d sons), attendu %d", steps, len(sounds.played), tt.wantSteps)
+			}
+		})
+	}
+}
+
+func TestFootstepPitchVariation(t *testing.T) {
+	sounds := &recordedSounds{}
+	fs := NewFootstepSystem()
+	fs.SetSoundPlayer(sounds)
+
+	position := components.Vector2{}
+	fs.Update(position)
+	for i := 0; i < 50; i++ {
+		position.X += fs.StepInterval
+		fs.Update(position)
+	}
+
+	varied := false
+	for _, pitch := range sounds.pitches {
+		if pitch < 1-fs.PitchVariation || pitch > 1+fs.PitchVariation {
+			t.Fatalf("hauteur %.3f hors de 1 ± %.2f", pitch, fs.PitchVariation)
+		}
+		if pitch != sounds.pitches[0] {
+			varied = true
+		}
+	}
+	if !varied {
+		t.Error("la hauteur des pas doit varier")
+	}
+}
+
+func TestFootstepResetForgetsPosition(t *testing.T) {
+	fs := NewFootstepSystem()
+	fs.Update(components.Vector2{X: 0})
+	fs.Reset()
+
+	// Téléportation : le saut ne compte pas comme des pas
+	if steps := fs.Update(components.Vector2{X: 1000}); steps != 0 {
+		t.Errorf("%d pas après Reset, attendu 0", steps)
+	}
+}
@@ -0,0 +1,142 @@
+// internal/ecs/systems/formation_system.go - Coordination des groupes d'ennemis
+package systems
+
+import (
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// FormationSystem répartit les ennemis d'un groupe en slots autour du joueur :
+// un seul attaquant engage, les soutiens attendent en cercle à distance
+type FormationSystem struct {
+	formations map[string][]*EnemyEntity
+
+	// Paramètres de placement
+	SupportRadius float64 // Distance de sécurité des soutiens autour du centre
+}
+
+// NewFormationSystem crée un nouveau système de formation
+func NewFormationSystem() *FormationSystem {
+	return &FormationSystem{
+		formations:    make(map[string][]*EnemyEntity),
+		SupportRadius: 140.0,
+	}
+}
+
+// AddMember ajoute un ennemi à une formation
+func (fs *FormationSystem) AddMember(enemy *EnemyEntity) {
+	if enemy == nil || enemy.Formation == nil {
+		return
+	}
+
+	id := enemy.Formation.FormationID
+	fs.formations[id] = append(fs.formations[id], enemy)
+	fs.assignSlots(id)
+}
+
+// RemoveMember retire un ennemi de sa formation
+func (fs *FormationSystem) RemoveMember(enemy *EnemyEntity) {
+	if enemy == nil || enemy.Formation == nil {
+		return
+	}
+
+	id := enemy.Formation.FormationID
+	members := fs.formations[id]
+	for i, member := range members {
+		if member == enemy {
+			fs.formations[id] = append(members[:i], members[i+1:]...)
+			break
+		}
+	}
+
+	enemy.Formation.SlotIndex = -1
+	enemy.Formation.Role = components.FormationRoleNone
+	enemy.Formation.CanAttack = false
+
+	if len(fs.formations[id]) == 0 {
+		delete(fs.formations, id)
+	} else {
+		fs.assignSlots(id)
+	}
+}
+
+// GetMembers retourne les membres d'une formation, dans l'ordre des slots
+func (fs *FormationSystem) GetMembers(formationID string) []*EnemyEntity {
+	return fs.formations[formationID]
+}
+
+// Update recalcule les slots et les positions désirées autour du centre
+func (fs *FormationSystem) Update(center components.Vector2) {
+	for id := range fs.formations {
+		fs.removeDeadMembers(id)
+
+		members := fs.formations[id]
+		if len(members) == 0 {
+			delete(fs.formations, id)
+			continue
+		}
+
+		fs.updatePositions(members, center)
+	}
+}
+
+// removeDeadMembers retire les ennemis morts et réassigne les slots si besoin
+func (fs *FormationSystem) removeDeadMembers(formationID string) {
+	members := fs.formations[formationID]
+	alive := members[:0]
+	changed := false
+
+	for _, member := range members {
+		if member.Active && member.Enemy.IsAlive() {
+			alive = append(alive, member)
+		} else {
+			member.Formation.SlotIndex = -1
+			member.Formation.Role = components.FormationRoleNone
+			member.Formation.CanAttack = false
+			changed = true
+		}
+	}
+
+	fs.formations[formationID] = alive
+	if changed {
+		fs.assignSlots(formationID)
+	}
+}
+
+// assignSlots attribue les slots dans l'ordre : le premier membre attaque
+func (fs *FormationSystem) assignSlots(formationID string) {
+	for i, member := range fs.formations[formationID] {
+		member.Formation.SlotIndex = i
+		if i == 0 {
+			member.Formation.Role = components.FormationRoleAttacker
+		} else {
+			member.Formation.Role = components.FormationRoleSupporter
+		}
+	}
+}
+
+// updatePositions calcule la position désirée de chaque slot
+func (fs *FormationSystem) updatePositions(members []*EnemyEntity, center components.Vector2) {
+	// Les soutiens n'engagent que si l'attaquant est étourdi
+	attackerStunned := members[0].Enemy.Stunned
+
+	supporters := len(members) - 1
+	for _, member := range members {
+		formation := member.Formation
+		formation.FormationCenter = center
+
+		if formation.Role == components.FormationRoleAttacker || attackerStunned {
+			formation.TargetPosition = center
+			formation.CanAttack = !member.Enemy.Stunned
+			continue
+		}
+
+		// Répartition régulière des soutiens sur le cercle
+		angle := 2 * math.Pi * float64(formation.SlotIndex-1) / float64(supporters)
+		formation.TargetPosition = components.Vector2{
+			X: center.X + math.Cos(angle)*fs.SupportRadius,
+			Y: center.Y + math.Sin(angle)*fs.SupportRadius,
+		}
+		formation.CanAttack = false
+	}
+}
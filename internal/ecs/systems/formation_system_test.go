@@ -0,0 +1,84 @@
+package systems
+
+import (
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+func spawnFormation(es *EnemySystem, id string, count int) []*EnemyEntity {
+	members := make([]*EnemyEntity, count)
+	for i := range members {
+		members[i] = es.SpawnInFormation(id, float64(i)*40, 0)
+	}
+	return members
+}
+
+func TestFormationAssignsOneAttacker(t *testing.T) {
+	es := NewEnemySystem()
+	members := spawnFormation(es, "meute", 3)
+	es.GetFormationSystem().Update(components.Vector2{X: 500, Y: 500})
+
+	for i, member := range members {
+		want := components.FormationRoleSupporter
+		if i == 0 {
+			want = components.FormationRoleAttacker
+		}
+		if member.Formation.Role != want {
+			t.Errorf("membre %d : rôle %s, attendu %s", i, member.Formation.Role, want)
+		}
+		if member.Formation.SlotIndex != i {
+			t.Errorf("membre %d : slot %d, attendu %d", i, member.Formation.SlotIndex, i)
+		}
+	}
+	if !members[0].Formation.CanAttack {
+		t.Error("l'attaquant doit pouvoir attaquer")
+	}
+	for _, supporter := range members[1:] {
+		if supporter.Formation.CanAttack {
+			t.Error("un soutien ne doit pas attaquer tant que l'attaquant tient debout")
+		}
+	}
+}
+
+func TestFormationReassignsWhenAttackerDies(t *testing.T) {
+	es := NewEnemySystem()
+	members := spawnFormation(es, "meute", 3)
+	formations := es.GetFormationSystem()
+	center := components.Vector2{X: 500, Y: 500}
+	formations.Update(center)
+
+	attacker := members[0]
+	attacker.Enemy.TakeDamage(attacker.Enemy.Health)
+	formations.Update(center)
+
+	if attacker.Formation.Role != components.FormationRoleNone || attacker.Formation.SlotIndex != -1 {
+		t.Errorf("l'attaquant mort garde le rôle %s (slot %d)", attacker.Formation.Role, attacker.Formation.SlotIndex)
+	}
+	if got := len(formations.GetMembers("meute")); got != 2 {
+		t.Fatalf("%d membres restants, attendu 2", got)
+	}
+	if members[1].Formation.Role != components.FormationRoleAttacker || members[1].Formation.SlotIndex != 0 {
+		t.Errorf("le premier soutien devient %s (slot %d), attendu attaquant au slot 0",
+			members[1].Formation.Role, members[1].Formation.SlotIndex)
+	}
+	if members[2].Formation.Role != components.FormationRoleSupporter || members[2].Formation.SlotIndex != 1 {
+		t.Errorf("le second soutien devient %s (slot %d), attendu soutien au slot 1",
+			members[2].Formation.Role, members[2].Formation.SlotIndex)
+	}
+}
+
+func TestFormationSupportersEngageWhenAttackerStunned(t *testing.T) {
+	es := NewEnemySystem()
+	members := spawnFormation(es, "meute", 3)
+	center := components.Vector2{X: 500, Y: 500}
+
+	members[0].Enemy.Stunned = true
+	es.GetFormationSystem().Update(center)
+
+	for _, supporter := range members[1:] {
+		if !supporter.Formation.CanAttack || supporter.Formation.TargetPosition != center {
+			t.Error("les soutiens doivent engager quand l'attaquant est étourdi")
+		}
+	}
+}
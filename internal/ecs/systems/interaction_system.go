@@ -0,0 +1,70 @@
+// internal/ecs/systems/interaction_system.go - Éléments du décor activés par la touche d'interaction
+package systems
+
+import (
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Interactable élément du monde activable par le joueur à proximité
+type Interactable interface {
+	InteractionPosition() components.Vector2
+	InteractionRadius() float64
+	Interact(player *PlayerEntity)
+}
+
+// InteractionSystem résout les interactions du joueur avec le décor
+type InteractionSystem struct {
+	interactables []Interactable
+}
+
+// NewInteractionSystem crée un nouveau système d'interaction
+func NewInteractionSystem() *InteractionSystem {
+	return &InteractionSystem{
+		interactables: make([]Interactable, 0, 4),
+	}
+}
+
+// Register ajoute un élément interactif
+func (is *InteractionSystem) Register(interactable Interactable) {
+	is.interactables = append(is.interactables, interactable)
+}
+
+// GetInteractables retourne les éléments interactifs
+func (is *InteractionSystem) GetInteractables() []Interactable {
+	return is.interactables
+}
+
+// Clear supprime tous les éléments interactifs
+func (is *InteractionSystem) Clear() {
+	is.interactables = is.interactables[:0]
+}
+
+// Nearest retourne l'élément le plus proche à portée de position (nil si aucun)
+func (is *InteractionSystem) Nearest(position components.Vector2) Interactable {
+	var nearest Interactable
+	best := math.MaxFloat64
+	for _, interactable := range is.interactables {
+		target := interactable.InteractionPosition()
+		dist := math.Hypot(target.X-position.X, target.Y-position.Y)
+		if dist <= interactable.InteractionRadius() && dist < best {
+			nearest = interactable
+			best = dist
+		}
+	}
+	return nearest
+}
+
+// TryInteract active l'élément le plus proche du joueur ; retourne false si aucun n'est à portée
+func (is *InteractionSystem) TryInteract(player *PlayerEntity) bool {
+	if player == nil || !player.Active {
+		return false
+	}
+
+	interactable := is.Nearest(player.Position.Position)
+	if interactable == nil {
+		return false
+	}
+	interactable.Interact(player)
+	return true
+}
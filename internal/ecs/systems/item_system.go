@@ -0,0 +1,193 @@
+// internal/ecs/systems/item_system.go - Objets au sol et portails à découvrir
+package systems
+
+import (
+	"fmt"
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// ItemKind nature d'un objet placé dans le monde
+type ItemKind int
+
+const (
+	ItemKindItem   ItemKind = iota // Objet ramassable
+	ItemKindPortal                 // Portail (découvert mais jamais ramassé)
+)
+
+// ItemEntity objet ou portail placé dans le monde
+type ItemEntity struct {
+	EntityID uint32
+	Name     string
+	Kind     ItemKind
+	Position components.Vector2
+	Size     float64
+
+	// Portails : salle de destination (assets/rooms/<id>), vide si aucune, et
+	// point d'arrivée du joueur qui le traverse (zéro : il reste sur place)
+	Destination string
+	Arrival     components.Vector2
+
+	// Découvert : ramassé ou volontairement ignoré ("marquer comme vu")
+	Discovered bool
+	Active     bool
+}
+
+// Bounds retourne la zone occupée par l'objet
+func (ie *ItemEntity) Bounds() components.Rectangle {
+	return components.Rectangle{
+		X:      ie.Position.X - ie.Size/2,
+		Y:      ie.Position.Y - ie.Size/2,
+		Width:  ie.Size,
+		Height: ie.Size,
+	}
+}
+
+// ItemPickedUpEvent émis quand le joueur ramasse un objet
+type ItemPickedUpEvent struct {
+	Item     *ItemEntity
+	Position components.Vector2
+}
+
+// ItemSystem gère les objets au sol, leur ramassage et leur découverte
+type ItemSystem struct {
+	items  []*ItemEntity
+	nextID uint32
+
+	// Distance (pixels) en dessous de laquelle le joueur ramasse un objet
+	PickupRadius float64
+
+	// Appelé à chaque objet ramassé
+	OnItemPickedUp func(event ItemPickedUpEvent)
+}
+
+// NewItemSystem crée un nouveau système d'objets
+func NewItemSystem() *ItemSystem {
+	return &ItemSystem{
+		items:        make([]*ItemEntity, 0),
+		nextID:       1,
+		PickupRadius: 20.0,
+	}
+}
+
+// SpawnItem place un objet ramassable
+func (is *ItemSystem) SpawnItem(name string, x, y float64) *ItemEntity {
+	return is.spawn(name, ItemKindItem, x, y, 12.0)
+}
+
+// SpawnPortal place un portail
+func (is *ItemSystem) SpawnPortal(name string, x, y float64) *ItemEntity {
+	return is.spawn(name, ItemKindPortal, x, y, 28.0)
+}
+
+func (is *ItemSystem) spawn(name string, kind ItemKind, x, y, size float64) *ItemEntity {
+	item := &ItemEntity{
+		EntityID: is.nextID,
+		Name:     name,
+		Kind:     kind,
+		Position: components.Vector2{X: x, Y: y},
+		Size:     size,
+		Active:   true,
+	}
+	is.nextID++
+	is.items = append(is.items, item)
+	return item
+}
+
+// GetItems retourne les objets du monde
+func (is *ItemSystem) GetItems() []*ItemEntity {
+	return is.items
+}
+
+// Clear retire tous les objets (nouvelle partie)
+func (is *ItemSystem) Clear() {
+	is.items = is.items[:0]
+	is.nextID = 1
+}
+
+// Update ramasse les objets touchés par le joueur
+func (is *ItemSystem) Update(playerPos components.Vector2) {
+	for _, item := range is.items {
+		if !item.Active || item.Kind != ItemKindItem {
+			continue
+		}
+		if itemDistance(item.Position, playerPos) > is.PickupRadius {
+			continue
+		}
+		is.PickUp(item)
+	}
+}
+
+// PickUp retire un objet du monde et émet ItemPickedUpEvent
+func (is *ItemSystem) PickUp(item *ItemEntity) {
+	if !item.Active {
+		return
+	}
+
+	item.Active = false
+	item.Discovered = true
+	fmt.Printf("Objet ramassé: %s\n", item.Name)
+
+	if is.OnItemPickedUp != nil {
+		is.OnItemPickedUp(ItemPickedUpEvent{Item: item, Position: item.Position})
+	}
+}
+
+// Undiscovered retourne les objets actifs non découverts à moins de radius pixels
+func (is *ItemSystem) Undiscovered(position components.Vector2, radius float64) []*ItemEntity {
+	nearby := make([]*ItemEntity, 0)
+	for _, item := range is.items {
+		if item.Active && !item.Discovered && itemDistance(item.Position, position) <= radius {
+			nearby = append(nearby, item)
+		}
+	}
+	return nearby
+}
+
+// NearestPortal retourne le portail actif menant à une salle le plus proche
+// à moins de radius pixels, nil s'il n'y en a pas
+func (is *ItemSystem) NearestPortal(position components.Vector2, radius float64) *ItemEntity {
+	var nearest *ItemEntity
+	best := radius
+	for _, item := range is.items {
+		if !item.Active || item.Kind != ItemKindPortal || item.Destination == "" {
+			continue
+		}
+		if distance := itemDistance(item.Position, position); distance <= best {
+			nearest, best = item, distance
+		}
+	}
+	return nearest
+}
+
+// MarkSeen marque comme découverts les objets à moins de radius pixels
+// et retourne ceux qui viennent de l'être
+func (is *ItemSystem) MarkSeen(position components.Vector2, radius float64) []*ItemEntity {
+	seen := is.Undiscovered(position, radius)
+	for _, item := range seen {
+		item.Discovered = true
+	}
+	return seen
+}
+
+// Render dessine les objets actifs
+func (is *ItemSystem) Render(renderer Renderer) {
+	itemColor := components.Color{R: 90, G: 220, B: 230, A: 255}
+	portalColor := components.Color{R: 80, G: 200, B: 110, A: 200}
+
+	for _, item := range is.items {
+		if !item.Active {
+			continue
+		}
+		color := itemColor
+		if item.Kind == ItemKindPortal {
+			color = portalColor
+		}
+		renderer.DrawRectangle(item.Bounds(), color, true)
+	}
+}
+
+// itemDistance distance entre deux points du monde
+func itemDistance(a, b components.Vector2) float64 {
+	return math.Hypot(a.X-b.X, a.Y-b.Y)
+}
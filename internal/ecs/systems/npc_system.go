@@ -0,0 +1,164 @@
+// internal/ecs/systems/npc_system.go - PNJ et leurs bavardages
+package systems
+
+import (
+	"math/rand"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Réglages par défaut des PNJ
+const (
+	npcSize                      = 24.0
+	DefaultNPCChatterMinInterval = 6 * time.Second
+	DefaultNPCChatterMaxInterval = 14 * time.Second
+	DefaultNPCBubbleDuration     = 3 * time.Second
+)
+
+// NPC personnage non joueur qui lance de temps en temps une de ses phrases
+type NPC struct {
+	Name     string
+	Position components.Vector2 // Centre du PNJ
+	Size     float64
+	Phrases  []string
+	Bubble   components.SpeechBubble
+
+	nextChatter time.Duration // Temps restant avant la prochaine phrase
+}
+
+// Head retourne le haut de la tête du PNJ
+func (n *NPC) Head() components.Vector2 {
+	return components.Vector2{X: n.Position.X, Y: n.Position.Y - n.Size/2}
+}
+
+// NPCSystem gère les PNJ : une phrase au hasard à intervalle aléatoire dans
+// [MinInterval, MaxInterval], affichée BubbleDuration dans une bulle
+type NPCSystem struct {
+	npcs []*NPC
+
+	MinInterval    time.Duration
+	MaxInterval    time.Duration
+	BubbleDuration time.Duration
+	WorldBounds    components.Rectangle // Les bulles restent dans le monde
+
+	rng *rand.Rand
+}
+
+// NewNPCSystem crée le système des PNJ
+func NewNPCSystem() *NPCSystem {
+	return &NPCSystem{
+		MinInterval:    DefaultNPCChatterMinInterval,
+		MaxInterval:    DefaultNPCChatterMaxInterval,
+		BubbleDuration: DefaultNPCBubbleDuration,
+		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// AddNPC ajoute un PNJ ; sa première phrase viendra après un intervalle aléatoire
+func (ns *NPCSystem) AddNPC(name string, position components.Vector2, phrases []string) *NPC {
+	npc := &NPC{Name: name, Position: position, Size: npcSize, Phrases: phrases}
+	npc.nextChatter = ns.randomInterval()
+	ns.npcs = append(ns.npcs, npc)
+	return npc
+}
+
+// GetNPCs retourne les PNJ
+func (ns *NPCSystem) GetNPCs() []*NPC {
+	return ns.npcs
+}
+
+// Clear retire tous les PNJ
+func (ns *NPCSystem) Clear() {
+	ns.npcs = ns.npcs[:0]
+}
+
+// randomInterval tire le délai avant la prochaine phrase
+func (ns *NPCSystem) randomInterval() time.Duration {
+	if ns.MaxInterval <= ns.MinInterval {
+		return ns.MinInterval
+	}
+	return ns.MinInterval + time.Duration(ns.rng.Int63n(int64(ns.MaxInterval-ns.MinInterval)))
+}
+
+// Update masque les bulles expirées et fait parler les PNJ dont le délai est écoulé
+func (ns *NPCSystem) Update(deltaTime time.Duration) {
+	for _, npc := range ns.npcs {
+		npc.Bubble.Update(deltaTime)
+		if len(npc.Phrases) == 0 {
+			continue
+		}
+
+		npc.nextChatter -= deltaTime
+		if npc.nextChatter > 0 {
+			continue
+		}
+		npc.Bubble.Show(npc.Phrases[ns.rng.Intn(len(npc.Phrases))], ns.BubbleDuration)
+		npc.nextChatter = ns.BubbleDuration + ns.randomInterval()
+	}
+}
+
+// Render dessine les PNJ puis leurs bulles, par-dessus
+func (ns *NPCSystem) Render(renderer Renderer) {
+	for _, npc := range ns.npcs {
+		ns.renderBody(renderer, npc)
+	}
+	for _, npc := range ns.npcs {
+		if npc.Bubble.Visible {
+			ns.renderBubble(renderer, npc)
+		}
+	}
+}
+
+// Submit soumet les PNJ à la file de rendu, triés avec le joueur par
+// profondeur, et leurs bulles sur la couche des bulles
+func (ns *NPCSystem) Submit(queue *RenderQueue) {
+	for _, npc := range ns.npcs {
+		npc := npc
+		queue.Submit(NPCLayer, npc.Position.Y+npc.Size/2, func(renderer Renderer) {
+			ns.renderBody(renderer, npc)
+		})
+		if npc.Bubble.Visible {
+			queue.Submit(SpeechBubbleLayer, npc.Position.Y, func(renderer Renderer) {
+				ns.renderBubble(renderer, npc)
+			})
+		}
+	}
+}
+
+// renderBody dessine le corps d'un PNJ
+func (ns *NPCSystem) renderBody(renderer Renderer, npc *NPC) {
+	renderer.DrawRectangle(components.Rectangle{
+		X: npc.Position.X - npc.Size/2, Y: npc.Position.Y - npc.Size/2, Width: npc.Size, Height: npc.Size,
+	}, components.Color{R: 90, G: 150, B: 200, A: 255}, true)
+}
+
+// renderBubble dessine une bulle blanche aux coins arrondis, sa queue pointée
+// vers la tête du PNJ et le texte à l'intérieur
+func (ns *NPCSystem) renderBubble(renderer Renderer, npc *NPC) {
+	bubble, tip := components.SpeechBubbleLayout(npc.Bubble.Text, npc.Head(), ns.WorldBounds)
+	white := components.Color{R: 255, G: 255, B: 255, A: 235}
+	radius := components.SpeechBubbleRadius
+
+	// Coins arrondis : une bande horizontale et une verticale en croix
+	renderer.DrawRectangle(components.Rectangle{
+		X: bubble.X, Y: bubble.Y + radius, Width: bubble.Width, Height: bubble.Height - 2*radius,
+	}, white, true)
+	renderer.DrawRectangle(components.Rectangle{
+		X: bubble.X + radius, Y: bubble.Y, Width: bubble.Width - 2*radius, Height: bubble.Height,
+	}, white, true)
+
+	// Queue : lignes de plus en plus étroites jusqu'à la pointe
+	top := bubble.Y + bubble.Height
+	for row := 0.0; row < components.SpeechBubbleTailHeight; row++ {
+		halfWidth := components.SpeechBubbleTailHeight - row
+		renderer.DrawRectangle(components.Rectangle{
+			X: tip.X - halfWidth, Y: top + row, Width: 2 * halfWidth, Height: 1,
+		}, white, true)
+	}
+
+	// Le texte est placé sur sa ligne de base (descente de 2 pixels)
+	renderer.DrawText(npc.Bubble.Text, components.Vector2{
+		X: bubble.X + components.SpeechBubblePadding,
+		Y: bubble.Y + bubble.Height - components.SpeechBubblePadding - 2,
+	}, components.Color{R: 20, G: 20, B: 20, A: 255})
+}
@@ -0,0 +1,48 @@
+package systems
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+func TestNPCChatterInterval(t *testing.T) {
+	ns := NewNPCSystem()
+	ns.MinInterval = time.Second
+	ns.MaxInterval = time.Second
+	ns.BubbleDuration = 500 * time.Millisecond
+	ns.rng = rand.New(rand.NewSource(1))
+
+	phrases := []string{"Bonjour", "Belle journée"}
+	npc := ns.AddNPC("Garde", components.Vector2{X: 100, Y: 100}, phrases)
+	mute := ns.AddNPC("Statue", components.Vector2{X: 200, Y: 100}, nil)
+
+	const tick = 100 * time.Millisecond
+	shown := 0
+	for elapsed := tick; elapsed <= 3*time.Second; elapsed += tick {
+		wasVisible := npc.Bubble.Visible
+		ns.Update(tick)
+		if npc.Bubble.Visible && !wasVisible {
+			shown++
+			if npc.Bubble.Text != phrases[0] && npc.Bubble.Text != phrases[1] {
+				t.Errorf("phrase = %q, attendu une phrase du PNJ", npc.Bubble.Text)
+			}
+		}
+
+		// Première phrase à 1 s, affichée 0,5 s, puis 1 s de silence
+		wantVisible := (elapsed >= time.Second && elapsed < 1500*time.Millisecond) ||
+			(elapsed >= 2500*time.Millisecond && elapsed < 3*time.Second)
+		if npc.Bubble.Visible != wantVisible {
+			t.Fatalf("à %v : bulle visible = %t, attendu %t", elapsed, npc.Bubble.Visible, wantVisible)
+		}
+	}
+
+	if shown != 2 {
+		t.Errorf("%d phrases en 3 s, attendu 2", shown)
+	}
+	if mute.Bubble.Visible {
+		t.Error("un PNJ sans phrases ne doit pas parler")
+	}
+}
@@ -0,0 +1,185 @@
+// internal/ecs/systems/particle_system.go - Particules : poussière, sang, étincelles
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Particle particule en mouvement, qui rétrécit et s'efface avec l'âge
+type Particle struct {
+	Position components.Vector2
+	Velocity components.Vector2 // pixels/s
+	Lifetime time.Duration
+	Age      time.Duration
+	Color    components.Color
+	Size     float64 // Côté, en pixels
+	Gravity  float64 // Accélération verticale (pixels/s²)
+}
+
+// BurstConfig réglages d'une gerbe de particules : vitesses tirées dans
+// [MinSpeed, MaxSpeed], directions dans un cône de Spread radians autour de
+// Direction (2π : toutes les directions)
+type BurstConfig struct {
+	Count       int
+	Direction   float64 // Angle central, en radians
+	Spread      float64
+	MinSpeed    float64
+	MaxSpeed    float64
+	MinLifetime time.Duration
+	MaxLifetime time.Duration
+	Color       components.Color
+	Size        float64
+	Gravity     float64
+}
+
+// WithDirection retourne une copie de la gerbe orientée selon angle
+func (bc BurstConfig) WithDirection(angle float64) BurstConfig {
+	bc.Direction = angle
+	return bc
+}
+
+// Gerbes du gameplay
+var (
+	// Poussière soulevée par une roulade, à l'opposé du mouvement
+	DustBurst = BurstConfig{
+		Count: 10, Spread: math.Pi / 2, MinSpeed: 20, MaxSpeed: 60,
+		MinLifetime: 300 * time.Millisecond, MaxLifetime: 600 * time.Millisecond,
+		Color: components.Color{R: 150, G: 130, B: 100, A: 200}, Size: 4, Gravity: -20,
+	}
+	// Sang d'un ennemi touché
+	BloodBurst = BurstConfig{
+		Count: 14, Spread: 2 * math.Pi, MinSpeed: 60, MaxSpeed: 160,
+		MinLifetime: 250 * time.Millisecond, MaxLifetime: 500 * time.Millisecond,
+		Color: components.Color{R: 170, G: 15, B: 15, A: 255}, Size: 3, Gravity: 400,
+	}
+	// Étincelles d'un blocage parfait
+	SparkBurst = BurstConfig{
+		Count: 18, Spread: 2 * math.Pi, MinSpeed: 120, MaxSpeed: 260,
+		MinLifetime: 150 * time.Millisecond, MaxLifetime: 350 * time.Millisecond,
+		Color: components.Color{R: 255, G: 220, B: 90, A: 255}, Size: 2, Gravity: 150,
+	}
+	// Gravats d'un mur brisé
+	DebrisBurst = BurstConfig{
+		Count: 24, Spread: 2 * math.Pi, MinSpeed: 40, MaxSpeed: 180,
+		MinLifetime: 400 * time.Millisecond, MaxLifetime: 800 * time.Millisecond,
+		Color: components.Color{R: 120, G: 110, B: 100, A: 255}, Size: 5, Gravity: 350,
+	}
+)
+
+// Nombre maximal de particules vivantes selon ParticleQuality
+var particleCaps = map[string]int{
+	"low":    150,
+	"medium": 500,
+	"high":   1500,
+}
+
+// DefaultMaxParticles plafond sans qualité reconnue
+const DefaultMaxParticles = 500
+
+// ParticleSystem fait vivre les particules émises par gerbes ; au-delà de
+// MaxParticles, les nouvelles particules sont ignorées
+type ParticleSystem struct {
+	particles []Particle
+
+	Enabled      bool
+	MaxParticles int
+
+	rng *rand.Rand
+}
+
+// NewParticleSystem crée un système de particules actif, qualité moyenne
+func NewParticleSystem() *ParticleSystem {
+	return &ParticleSystem{
+		particles:    make([]Particle, 0, DefaultMaxParticles),
+		Enabled:      true,
+		MaxParticles: DefaultMaxParticles,
+		rng:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// SetQuality plafonne les particules selon la qualité ("low", "medium", "high") ;
+// une qualité inconnue est ignorée
+func (ps *ParticleSystem) SetQuality(quality string) {
+	if limit, ok := particleCaps[quality]; ok {
+		ps.MaxParticles = limit
+	}
+}
+
+// EmitBurst émet une gerbe de particules en position ; retourne le nombre émis
+func (ps *ParticleSystem) EmitBurst(position components.Vector2, config BurstConfig) int {
+	if !ps.Enabled {
+		return 0
+	}
+
+	emitted := 0
+	for i := 0; i < config.Count && len(ps.particles) < ps.MaxParticles; i++ {
+		angle := config.Direction + (ps.rng.Float64()-0.5)*config.Spread
+		speed := config.MinSpeed + ps.rng.Float64()*(config.MaxSpeed-config.MinSpeed)
+		lifetime := config.MinLifetime
+		if config.MaxLifetime > config.MinLifetime {
+			lifetime += time.Duration(ps.rng.Int63n(int64(config.MaxLifetime - config.MinLifetime)))
+		}
+
+		ps.particles = append(ps.particles, Particle{
+			Position: position,
+			Velocity: components.Vector2{X: math.Cos(angle) * speed, Y: math.Sin(angle) * speed},
+			Lifetime: lifetime,
+			Color:    config.Color,
+			Size:     config.Size,
+			Gravity:  config.Gravity,
+		})
+		emitted++
+	}
+	return emitted
+}
+
+// Count retourne le nombre de particules vivantes
+func (ps *ParticleSystem) Count() int {
+	return len(ps.particles)
+}
+
+// Clear supprime toutes les particules
+func (ps *ParticleSystem) Clear() {
+	ps.particles = ps.particles[:0]
+}
+
+// Update déplace les particules et retire celles expirées
+func (ps *ParticleSystem) Update(deltaTime time.Duration) {
+	dt := deltaTime.Seconds()
+	alive := ps.particles[:0]
+	for _, particle := range ps.particles {
+		particle.Age += deltaTime
+		if particle.Age >= particle.Lifetime {
+			continue
+		}
+		particle.Velocity.Y += particle.Gravity * dt
+		particle.Position.X += particle.Velocity.X * dt
+		particle.Position.Y += particle.Velocity.Y * dt
+		alive = append(alive, particle)
+	}
+	ps.particles = alive
+}
+
+// Submit soumet les particules à la file de rendu, sur leur propre couche
+func (ps *ParticleSystem) Submit(queue *RenderQueue) {
+	if len(ps.particles) > 0 {
+		queue.Submit(ParticleLayer, 0, ps.Render)
+	}
+}
+
+// Render dessine les particules, plus petites et transparentes avec l'âge
+func (ps *ParticleSystem) Render(renderer Renderer) {
+	for _, particle := range ps.particles {
+		remaining := 1 - float64(particle.Age)/float64(particle.Lifetime)
+		size := math.Max(1, particle.Size*(0.5+0.5*remaining))
+		color := particle.Color
+		color.A = uint8(float64(color.A) * remaining)
+
+		renderer.DrawRectangle(components.Rectangle{
+			X: particle.Position.X - size/2, Y: particle.Position.Y - size/2, Width: size, Height: size,
+		}, color, true)
+	}
+}
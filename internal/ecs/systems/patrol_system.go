@@ -0,0 +1,123 @@
+// internal/ecs/systems/patrol_system.go - Patrouilles des ennemis le long de courbes de Bézier
+package systems
+
+import (
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+	"zelda-souls-game/internal/pathfinding"
+)
+
+// debugCurveSamples nombre de points échantillonnés pour dessiner une courbe
+const debugCurveSamples = 100
+
+// LineRenderer renderer capable de tracer des lignes (visualisation de debug)
+type LineRenderer interface {
+	DrawLine(start, end components.Vector2, color components.Color, thickness float32)
+}
+
+// PatrolSystem discrétise les chemins de patrouille et y fait circuler les ennemis
+type PatrolSystem struct {
+	// Espacement (pixels) des points de passage le long de la courbe
+	StepSize float64
+
+	// Fraction de la vitesse de l'ennemi utilisée en patrouille
+	SpeedFactor float64
+
+	// Courbes et tracés rectilignes visualisés quand ShowDebug est actif
+	ShowDebug bool
+	paths     []*pathfinding.BezierPath
+	routes    [][]components.Vector2
+}
+
+// NewPatrolSystem crée un nouveau système de patrouille
+func NewPatrolSystem() *PatrolSystem {
+	return &PatrolSystem{
+		StepSize:    16.0,
+		SpeedFactor: 0.5,
+		paths:       make([]*pathfinding.BezierPath, 0),
+	}
+}
+
+// Assign fait patrouiller un ennemi le long d'une courbe
+func (ps *PatrolSystem) Assign(enemy *EnemyEntity, path *pathfinding.BezierPath, loop bool) {
+	waypoints := path.Waypoints(ps.StepSize)
+	if len(waypoints) == 0 {
+		return
+	}
+
+	enemy.Patrol = components.NewPatrolComponent(waypoints, loop)
+	ps.paths = append(ps.paths, path)
+}
+
+// AssignWaypoints fait patrouiller un ennemi en ligne droite entre des points
+// (boucle si loop, sinon aller-retour)
+func (ps *PatrolSystem) AssignWaypoints(enemy *EnemyEntity, waypoints []components.Vector2, loop bool) {
+	if len(waypoints) == 0 {
+		return
+	}
+
+	enemy.Patrol = components.NewPatrolComponent(waypoints, loop)
+	route := waypoints
+	if loop && len(waypoints) > 2 {
+		route = append(append([]components.Vector2{}, waypoints...), waypoints[0])
+	}
+	ps.routes = append(ps.routes, route)
+}
+
+// Clear oublie les courbes enregistrées
+func (ps *PatrolSystem) Clear() {
+	ps.paths = ps.paths[:0]
+	ps.routes = ps.routes[:0]
+}
+
+// Move rapproche l'ennemi de son point de passage courant
+func (ps *PatrolSystem) Move(enemy *EnemyEntity, deltaTime time.Duration) {
+	movement := enemy.Movement
+	position := enemy.Position
+	dt := deltaTime.Seconds()
+
+	target, ok := enemy.Patrol.Target()
+	if !ok {
+		movement.Decelerate(dt)
+		return
+	}
+
+	diff := target.Sub(position.Position)
+	distance := math.Hypot(diff.X, diff.Y)
+	if distance <= enemy.Patrol.ReachRadius {
+		enemy.Patrol.Advance()
+		if target, ok = enemy.Patrol.Target(); ok {
+			diff = target.Sub(position.Position)
+			distance = math.Hypot(diff.X, diff.Y)
+		}
+	}
+
+	if distance > 0 {
+		direction := diff.Mul(1.0 / distance)
+		movement.Accelerate(direction.Mul(movement.Speed*ps.SpeedFactor), dt)
+		movement.IsMoving = true
+	}
+
+	position.Position = position.Position.Add(movement.Velocity.Mul(dt))
+}
+
+// RenderDebug trace les courbes de patrouille
+func (ps *PatrolSystem) RenderDebug(renderer LineRenderer) {
+	if !ps.ShowDebug {
+		return
+	}
+
+	curveColor := components.Color{R: 80, G: 200, B: 255, A: 200}
+	for _, path := range ps.paths {
+		points := path.Sample(debugCurveSamples)
+		for i := 1; i < len(points); i++ {
+			renderer.DrawLine(points[i-1], points[i], curveColor, 1)
+		}
+	}
+	for _, route := range ps.routes {
+		for i := 1; i < len(route); i++ {
+			renderer.DrawLine(route[i-1], route[i], curveColor, 1)
+		}
+	}
+}
@@ -34,6 +34,16 @@ type PlayerSpriteSet struct {
 	RightIdle   *SpriteAnimation
 	RightAttack *SpriteAnimation
 
+	// Sprites diagonaux optionnels (nil : repli sur la direction cardinale)
+	UpLeftIdle    *SpriteAnimation
+	UpLeftWalk    *SpriteAnimation
+	UpRightIdle   *SpriteAnimation
+	UpRightWalk   *SpriteAnimation
+	DownLeftIdle  *SpriteAnimation
+	DownLeftWalk  *SpriteAnimation
+	DownRightIdle *SpriteAnimation
+	DownRightWalk *SpriteAnimation
+
 	// Sprite principal
 	MainSprite *ebiten.Image
 
@@ -43,6 +53,70 @@ type PlayerSpriteSet struct {
 	Loaded       bool
 }
 
+// HasDirection retourne si un sprite dédié existe pour la direction
+// ("up", "down-left"...). Les directions cardinales sont toujours disponibles.
+func (pss *PlayerSpriteSet) HasDirection(direction string) bool {
+	switch direction {
+	case "up-left":
+		return pss.UpLeftIdle != nil || pss.UpLeftWalk != nil
+	case "up-right":
+		return pss.UpRightIdle != nil || pss.UpRightWalk != nil
+	case "down-left":
+		return pss.DownLeftIdle != nil || pss.DownLeftWalk != nil
+	case "down-right":
+		return pss.DownRightIdle != nil || pss.DownRightWalk != nil
+	default:
+		return true
+	}
+}
+
+// AnimationFor retourne l'animation correspondant à la direction et à l'état
+// du joueur. Les diagonales sans sprite dédié retombent sur leur direction
+// verticale, et la marche sur l'animation au repos.
+func (pss *PlayerSpriteSet) AnimationFor(direction string, isMoving bool, isAttacking bool) *SpriteAnimation {
+	if isAttacking {
+		switch direction {
+		case "up", "up-left", "up-right":
+			return pss.UpAttack
+		case "left":
+			return pss.LeftAttack
+		case "right":
+			return pss.RightAttack
+		default:
+			return pss.DownAttack
+		}
+	}
+
+	var idle, walk *SpriteAnimation
+	switch direction {
+	case "up-left":
+		idle, walk = pss.UpLeftIdle, pss.UpLeftWalk
+	case "up-right":
+		idle, walk = pss.UpRightIdle, pss.UpRightWalk
+	case "down-left":
+		idle, walk = pss.DownLeftIdle, pss.DownLeftWalk
+	case "down-right":
+		idle, walk = pss.DownRightIdle, pss.DownRightWalk
+	}
+	if isMoving && walk != nil {
+		return walk
+	}
+	if idle != nil {
+		return idle
+	}
+
+	switch direction {
+	case "up", "up-left", "up-right":
+		return pss.UpIdle
+	case "left":
+		return pss.LeftIdle
+	case "right":
+		return pss.RightIdle
+	default:
+		return pss.DownIdle
+	}
+}
+
 // GetSpriteForAnimation retourne le sprite approprié
 func (pss *PlayerSpriteSet) GetSpriteForAnimation(direction string, isMoving bool, isAttacking bool, frameIndex int) *ebiten.Image {
 	if !pss.Loaded || pss.MainSprite == nil {
@@ -64,6 +138,19 @@ type InputManager interface {
 	IsKeyJustPressedSystems(key int) bool
 }
 
+// MouseButtons boutons de la souris, pour les gestionnaires d'entrées qui les
+// suivent (numérotation d'ebiten.MouseButton)
+type MouseButtons interface {
+	IsMouseButtonPressed(button int) bool
+	IsMouseButtonJustPressed(button int) bool
+}
+
+// Boutons de la souris utilisés en combat (ebiten.MouseButtonLeft et Right)
+const (
+	mouseButtonLeft  = 0
+	mouseButtonRight = 2
+)
+
 // Renderer interface minimale pour le rendu
 type Renderer interface {
 	DrawRectangle(rect components.Rectangle, color components.Color, filled bool)
@@ -77,6 +164,32 @@ type Camera interface {
 	FollowTarget(target interface{}, speed float64, offset components.Vector2)
 }
 
+// Cooldowns temps de recharge des capacités, par nom (core.CooldownManager)
+type Cooldowns interface {
+	IsReady(name string) bool
+	Start(name string, duration time.Duration)
+}
+
+// Capacités du joueur soumises à un temps de recharge
+const (
+	AbilityAttack = "attack" // Attaques légère et lourde
+	AbilityRoll   = "roll"
+	AbilitySpell  = "spell"
+	AbilityFocus  = "focus" // Ralenti du temps
+)
+
+// Temps de recharge des capacités (celui de l'attaque suit l'arme)
+const (
+	rollCooldown  = 400 * time.Millisecond
+	spellCooldown = 800 * time.Millisecond
+	focusCooldown = 12 * time.Second
+)
+
+// LockOnTarget cible que le joueur peut verrouiller (ennemi, boss...)
+type LockOnTarget interface {
+	GetPosition() components.Vector2
+}
+
 // SpriteLoader interface compatible avec assets/sprite_loader.go
 type SpriteLoader interface {
 	LoadPlayerSprites(assetsDir string) (*PlayerSpriteSet, error)
@@ -97,6 +210,8 @@ type PlayerEntity struct {
 	Collider       *components.ColliderComponent
 	Player         *components.PlayerComponent
 	Input          *components.InputComponent
+	Block          *components.BlockComponent
+	WallRun        *components.WallRunComponent
 
 	// État interne
 	EntityID uint32
@@ -117,6 +232,8 @@ func NewPlayerEntity(x, y float64) *PlayerEntity {
 		Collider:       components.NewColliderComponent(24, 24, components.LayerPlayer),
 		Player:         components.NewPlayerComponent(),
 		Input:          components.NewInputComponent(),
+		Block:          components.NewBlockComponent(),
+		WallRun:        components.NewWallRunComponent(),
 		EntityID:       1,
 		Active:         true,
 	}
@@ -124,11 +241,11 @@ func NewPlayerEntity(x, y float64) *PlayerEntity {
 	// Configuration du sprite renderer
 	entity.SpriteRenderer.Position = components.Vector2{X: x, Y: y}
 	entity.SpriteRenderer.Scale = components.Vector2{X: 2.0, Y: 2.0} // Agrandir 2x
-	entity.SpriteRenderer.Layer = 10
+	entity.SpriteRenderer.Layer = EntityLayer
 	entity.SpriteRenderer.Visible = true
 
 	// Configuration du sprite de fallback
-	entity.Sprite.Layer = 10
+	entity.Sprite.Layer = EntityLayer
 	entity.Sprite.Color = components.Color{100, 150, 255, 255}
 	entity.Sprite.Visible = true
 	entity.Collider.Offset = components.Vector2{X: 0, Y: 4}
@@ -142,7 +259,8 @@ func NewPlayerEntity(x, y float64) *PlayerEntity {
 
 // setupAnimations configure les animations du joueur (fallback)
 func (pe *PlayerEntity) setupAnimations() {
-	// Animation idle (statique)
+	// Animation idle (statique), puis une par direction cardinale (une ligne
+	// de la planche par direction) pour garder l'orientation à l'arrêt
 	idleFrames := []components.AnimationFrame{
 		{SourceRect: components.Rectangle{X: 0, Y: 0, Width: 32, Height: 32}, Duration: time.Second},
 	}
@@ -154,6 +272,21 @@ func (pe *PlayerEntity) setupAnimations() {
 	}
 	pe.Animation.AddAnimation("idle", idleAnim)
 
+	idleRows := []components.Direction{
+		components.DirectionDown, components.DirectionLeft, components.DirectionRight, components.DirectionUp,
+	}
+	for row, direction := range idleRows {
+		name := idleAnimationName(direction)
+		pe.Animation.AddAnimation(name, &components.Animation{
+			Name: name,
+			Frames: []components.AnimationFrame{
+				{SourceRect: components.Rectangle{X: 0, Y: float64(row) * 32, Width: 32, Height: 32}, Duration: time.Second},
+			},
+			Loop:     true,
+			PlayRate: 1.0,
+		})
+	}
+
 	// Animation de marche
 	walkFrames := []components.AnimationFrame{
 		{SourceRect: components.Rectangle{X: 0, Y: 0, Width: 32, Height: 32}, Duration: time.Millisecond * 200},
@@ -169,7 +302,17 @@ func (pe *PlayerEntity) setupAnimations() {
 	}
 	pe.Animation.AddAnimation("walk", walkAnim)
 
-	pe.Animation.Play("idle")
+	pe.Animation.Play(idleAnimationName(components.DirectionDown))
+}
+
+// idleAnimationName nom de l'animation idle d'une orientation (diagonales
+// rabattues sur la direction cardinale la plus proche)
+func idleAnimationName(facing components.Direction) string {
+	cardinal := facing.Cardinal()
+	if cardinal == components.DirectionNone {
+		cardinal = components.DirectionDown
+	}
+	return "idle_" + cardinal.String()
 }
 
 // SetPlayerSprites définit les sprites du joueur
@@ -200,8 +343,79 @@ type PlayerSystem struct {
 	spriteLoader  SpriteLoader
 	spritesLoaded bool
 	frameCount    int
+	godMode       bool
+
+	// Fenêtre de blocage parfait appliquée aux nouveaux joueurs
+	perfectBlockWindow time.Duration
+
+	// Fioles de soin appliquées aux nouveaux joueurs (0 : valeurs par défaut)
+	maxHealCharges int
+	healAmount     int
+
+	// Appelé à chaque fiole bue, ou tentée sans charge restante
+	OnHeal func(success bool)
+
+	// Appelé avant la fiole quand le joueur utilise un objet : s'il retourne
+	// true, l'objet utilisé (un antidote) remplace la fiole
+	OnUseItem func() bool
+
+	// Appelé quand le joueur entre en concentration (ralenti du temps)
+	OnFocus func()
+
+	// Appelé au départ d'une roulade, avec sa direction (vecteur unitaire)
+	OnRoll func(position, direction components.Vector2)
+
+	// Réglages de déplacement appliqués aux nouveaux joueurs
+	movementProfile components.MovementProfile
+
+	// Attaque lancée cette frame, à résoudre par le système de combat
+	attackPending bool
+	heavyAttack   bool // L'attaque en attente est une attaque lourde
+
+	// Interaction demandée cette frame, à résoudre par le gestionnaire d'états
+	interactPending bool
+
+	// Sort lancé cette frame, à résoudre par le système de sorts
+	castPending bool
+
+	// Cible verrouillée : le joueur lui fait face en se déplaçant (strafe)
+	lockOnTarget LockOnTarget
+
+	// Visée à la souris : le joueur fait face au curseur (position monde)
+	aimTarget components.Vector2
+	aimActive bool
+
+	// Combat à la souris : clic gauche pour attaquer, clic droit pour bloquer.
+	// Après un clic d'interface, les boutons sont ignorés jusqu'au relâchement.
+	mouseCombat     bool
+	mouseSuppressed bool
+
+	// Recharge des capacités (nil : aucune recharge)
+	cooldowns Cooldowns
+
+	// Arme équipée (caractéristiques, rythme des attaques) et armes disponibles
+	weapon  components.Weapon
+	weapons map[string]components.Weapon
+
+	// Roulade en cours (temps restant) : contre un mur, elle devient une course murale
+	rollTimer time.Duration
+
+	// Valeurs affichées des barres, animées vers les valeurs réelles
+	healthBar  *components.SmoothValue
+	staminaBar *components.SmoothValue
+
+	// Avancement (0 à 1) entre le tick précédent et le tick courant au moment
+	// du rendu : le joueur est dessiné entre ses deux positions
+	interpolationAlpha float64
+
+	// Animations du jeu de sprites converties pour le SpriteRendererComponent,
+	// gardées pour que BlendTo compare toujours les mêmes pointeurs
+	spriteAnimations map[*SpriteAnimation]*components.SpriteAnimationData
 }
 
+// animationBlendTime durée du fondu entre deux animations du joueur, en secondes
+const animationBlendTime = 0.15
+
 // NewPlayerSystem crée un nouveau système joueur
 func NewPlayerSystem() *PlayerSystem {
 	fmt.Println("✓ PlayerSystem créé")
@@ -209,6 +423,38 @@ func NewPlayerSystem() *PlayerSystem {
 		player:        nil,
 		spritesLoaded: false,
 		frameCount:    0,
+		healthBar:     components.NewSmoothValue(8.0),
+		staminaBar:    components.NewSmoothValue(8.0),
+		weapon:        components.DefaultWeapon(),
+
+		interpolationAlpha: 1,
+	}
+}
+
+// SetInterpolationAlpha règle l'avancement entre deux ticks utilisé au rendu
+func (ps *PlayerSystem) SetInterpolationAlpha(alpha float64) {
+	ps.interpolationAlpha = alpha
+}
+
+// renderPosition retourne la position interpolée à laquelle dessiner le joueur
+func (ps *PlayerSystem) renderPosition() components.Vector2 {
+	return ps.player.Position.RenderPosition(ps.interpolationAlpha)
+}
+
+// SetCooldowns injecte le gestionnaire des temps de recharge
+func (ps *PlayerSystem) SetCooldowns(cooldowns Cooldowns) {
+	ps.cooldowns = cooldowns
+}
+
+// abilityReady retourne si une capacité est rechargée
+func (ps *PlayerSystem) abilityReady(name string) bool {
+	return ps.cooldowns == nil || ps.cooldowns.IsReady(name)
+}
+
+// startCooldown lance la recharge d'une capacité qui vient d'être utilisée
+func (ps *PlayerSystem) startCooldown(name string, duration time.Duration) {
+	if ps.cooldowns != nil {
+		ps.cooldowns.Start(name, duration)
 	}
 }
 
@@ -256,7 +502,7 @@ func (ps *PlayerSystem) SetSpriteLoader(loader interface{}) {
 		fmt.Printf("⚠ ERREUR: Type incompatible pour SpriteLoader. Attendu: SpriteLoader, reçu: %T\n", loader)
 	}
 
-	fmt.Println("=== Fin PlayerSystem.SetSpriteLoader ===\n")
+	fmt.Print("=== Fin PlayerSystem.SetSpriteLoader ===\n\n")
 }
 
 // CreatePlayer crée l'entité joueur avec sprites
@@ -264,6 +510,17 @@ func (ps *PlayerSystem) CreatePlayer(x, y float64) {
 	fmt.Printf("\n=== CreatePlayer appelé à (%.1f, %.1f) ===\n", x, y)
 
 	ps.player = NewPlayerEntity(x, y)
+	ps.player.Movement.ApplyProfile(ps.movementProfile)
+	ps.lockOnTarget = nil
+	ps.rollTimer = 0
+	ps.player.Player.GodMode = ps.godMode
+	if ps.perfectBlockWindow > 0 {
+		ps.player.Block.PerfectWindow = ps.perfectBlockWindow
+	}
+	ps.applyHealCharges()
+	ps.applyWeapon()
+	ps.healthBar.Snap(float64(ps.player.Player.Health))
+	ps.staminaBar.Snap(ps.player.Player.Stamina)
 
 	// Vérifier l'état du spriteLoader
 	fmt.Printf("SpriteLoader disponible: %t\n", ps.spriteLoader != nil)
@@ -327,6 +584,144 @@ func (ps *PlayerSystem) loadPlayerSprites() {
 	fmt.Println("=== Fin loadPlayerSprites ===")
 }
 
+// SetGodMode active/désactive l'invulnérabilité de debug
+func (ps *PlayerSystem) SetGodMode(enabled bool) {
+	ps.godMode = enabled
+	if ps.player != nil {
+		ps.player.Player.GodMode = enabled
+	}
+	fmt.Printf("God mode: %t\n", enabled)
+}
+
+// SetMovementProfile définit les réglages de déplacement du joueur
+func (ps *PlayerSystem) SetMovementProfile(profile components.MovementProfile) {
+	ps.movementProfile = profile
+	if ps.player != nil {
+		ps.player.Movement.ApplyProfile(profile)
+	}
+}
+
+// SetPerfectBlockWindow définit la fenêtre de blocage parfait (ignorée si nulle)
+func (ps *PlayerSystem) SetPerfectBlockWindow(window time.Duration) {
+	if window <= 0 {
+		return
+	}
+	ps.perfectBlockWindow = window
+	if ps.player != nil {
+		ps.player.Block.PerfectWindow = window
+	}
+}
+
+// SetHealCharges définit le nombre de fioles et leur soin (ignorés si nuls)
+func (ps *PlayerSystem) SetHealCharges(maxCharges, healAmount int) {
+	if maxCharges > 0 {
+		ps.maxHealCharges = maxCharges
+	}
+	if healAmount > 0 {
+		ps.healAmount = healAmount
+	}
+	ps.applyHealCharges()
+}
+
+// applyHealCharges applique les réglages des fioles au joueur courant
+func (ps *PlayerSystem) applyHealCharges() {
+	if ps.player == nil {
+		return
+	}
+	if ps.maxHealCharges > 0 {
+		ps.player.Player.SetMaxHealCharges(ps.maxHealCharges)
+	}
+	if ps.healAmount > 0 {
+		ps.player.Player.HealAmount = ps.healAmount
+	}
+}
+
+// SetWeapon équipe une arme : ses caractéristiques s'appliquent aux attaques suivantes
+func (ps *PlayerSystem) SetWeapon(weapon components.Weapon) {
+	ps.weapon = weapon.Normalized()
+	ps.applyWeapon()
+	fmt.Printf("Arme équipée: %s (dégâts +%d, stamina %.0f, portée %.0f, attaque %v)\n",
+		ps.weapon.Name, ps.weapon.BaseDamage, ps.weapon.StaminaCost, ps.weapon.Range, ps.weapon.AttackDuration())
+}
+
+// GetWeapon retourne l'arme équipée
+func (ps *PlayerSystem) GetWeapon() components.Weapon {
+	return ps.weapon
+}
+
+// SetWeapons déclare les armes disponibles, par identifiant
+func (ps *PlayerSystem) SetWeapons(weapons map[string]components.Weapon) {
+	ps.weapons = weapons
+}
+
+// EquipWeapon équipe une arme disponible par son identifiant
+func (ps *PlayerSystem) EquipWeapon(id string) bool {
+	weapon, ok := ps.weapons[id]
+	if !ok {
+		fmt.Printf("⚠ Arme inconnue: %s\n", id)
+		return false
+	}
+	ps.SetWeapon(weapon)
+	return true
+}
+
+// applyWeapon équipe le joueur courant et règle la durée de ses attaques
+func (ps *PlayerSystem) applyWeapon() {
+	if ps.player == nil {
+		return
+	}
+	ps.player.Player.Weapon = ps.weapon
+	if ps.player.SpriteRenderer != nil {
+		ps.player.SpriteRenderer.AttackDuration = ps.weapon.AttackDuration().Seconds()
+	}
+}
+
+// IsAttacking retourne si le joueur est engagé dans une attaque
+func (ps *PlayerSystem) IsAttacking() bool {
+	return ps.player != nil && ps.player.SpriteRenderer != nil && ps.player.SpriteRenderer.IsAttacking
+}
+
+// IsAttackActive retourne si l'attaque en cours est dans ses frames actives
+func (ps *PlayerSystem) IsAttackActive() bool {
+	return ps.IsAttacking() && ps.weapon.IsActive(ps.player.SpriteRenderer.AttackElapsed())
+}
+
+// ConsumeAttack retourne true une seule fois par attaque lancée
+func (ps *PlayerSystem) ConsumeAttack() bool {
+	pending := ps.attackPending
+	ps.attackPending = false
+	return pending
+}
+
+// ConsumeHeavyAttack retourne true une seule fois par attaque lourde lancée
+func (ps *PlayerSystem) ConsumeHeavyAttack() bool {
+	if !ps.attackPending || !ps.heavyAttack {
+		return false
+	}
+	ps.attackPending = false
+	ps.heavyAttack = false
+	return true
+}
+
+// ConsumeInteract retourne true une seule fois par appui sur la touche d'interaction
+func (ps *PlayerSystem) ConsumeInteract() bool {
+	pending := ps.interactPending
+	ps.interactPending = false
+	return pending
+}
+
+// ConsumeCast retourne true une seule fois par sort lancé
+func (ps *PlayerSystem) ConsumeCast() bool {
+	pending := ps.castPending
+	ps.castPending = false
+	return pending
+}
+
+// IsGodMode retourne si le god mode est actif
+func (ps *PlayerSystem) IsGodMode() bool {
+	return ps.godMode
+}
+
 // GetPlayer retourne l'entité joueur
 func (ps *PlayerSystem) GetPlayer() *PlayerEntity {
 	return ps.player
@@ -347,6 +742,7 @@ func (ps *PlayerSystem) Update(deltaTime time.Duration) {
 	}
 
 	ps.frameCount++
+	ps.player.Position.BeginStep()
 
 	// Forcer le chargement des sprites si pas encore fait
 	if !ps.spritesLoaded && ps.spriteLoader != nil {
@@ -374,9 +770,16 @@ func (ps *PlayerSystem) Update(deltaTime time.Duration) {
 	ps.updateSprites(deltaTime)
 	ps.updateAnimation(deltaTime)
 	ps.updatePlayer(deltaTime)
+	ps.updateBars(deltaTime)
 	ps.updateCamera()
 }
 
+// updateBars anime les barres de vie et de stamina
+func (ps *PlayerSystem) updateBars(deltaTime time.Duration) {
+	ps.healthBar.Update(float64(ps.player.Player.Health), deltaTime)
+	ps.staminaBar.Update(ps.player.Player.Stamina, deltaTime)
+}
+
 // updateInput met à jour les entrées du joueur
 func (ps *PlayerSystem) updateInput(deltaTime time.Duration) {
 	if ps.inputManager == nil {
@@ -395,12 +798,54 @@ func (ps *PlayerSystem) updateInput(deltaTime time.Duration) {
 	input.MoveRight = ps.inputManager.IsActionPressedSystems(3) // ActionMoveRight
 
 	// Actions "just pressed"
-	input.AttackJustPressed = ps.inputManager.IsKeyJustPressedSystems(32)    // Espace
-	input.RollJustPressed = ps.inputManager.IsKeyJustPressedSystems(99)      // C
-	input.InteractJustPressed = ps.inputManager.IsKeyJustPressedSystems(101) // E
+	input.AttackJustPressed = ps.inputManager.IsKeyJustPressedSystems(int(ebiten.KeySpace))
+	input.HeavyAttackJustPressed = ps.inputManager.IsKeyJustPressedSystems(int(ebiten.KeyV))
+	input.RollJustPressed = ps.inputManager.IsKeyJustPressedSystems(int(ebiten.KeyC))
+	input.InteractJustPressed = ps.inputManager.IsKeyJustPressedSystems(int(ebiten.KeyE))
+	input.CastJustPressed = ps.inputManager.IsKeyJustPressedSystems(int(ebiten.KeyF))
+	input.UseItemJustPressed = ps.inputManager.IsKeyJustPressedSystems(int(ebiten.KeyR))
+	input.FocusJustPressed = ps.inputManager.IsKeyJustPressedSystems(6) // G (ebiten.KeyG)
 
 	// Actions maintenues
 	input.Block = ps.inputManager.IsActionPressedSystems(5) // ActionBlock
+	input.Roll = ps.inputManager.IsActionPressedSystems(6)  // ActionRoll (saut mural)
+
+	ps.updateMouseButtons(input)
+}
+
+// updateMouseButtons ajoute les clics aux actions du clavier : attaque au
+// clic gauche (dans la direction visée), blocage tant que le clic droit est
+// maintenu
+func (ps *PlayerSystem) updateMouseButtons(input *components.InputComponent) {
+	mouse, ok := ps.inputManager.(MouseButtons)
+	if !ps.mouseCombat || !ok {
+		return
+	}
+
+	if ps.mouseSuppressed {
+		if mouse.IsMouseButtonPressed(mouseButtonLeft) || mouse.IsMouseButtonPressed(mouseButtonRight) {
+			return
+		}
+		ps.mouseSuppressed = false
+	}
+
+	if mouse.IsMouseButtonJustPressed(mouseButtonLeft) {
+		input.AttackJustPressed = true
+	}
+	if mouse.IsMouseButtonPressed(mouseButtonRight) {
+		input.Block = true
+	}
+}
+
+// SetMouseCombat active l'attaque et le blocage à la souris
+func (ps *PlayerSystem) SetMouseCombat(enabled bool) {
+	ps.mouseCombat = enabled
+}
+
+// SuppressMouseUntilRelease ignore les boutons de la souris jusqu'à ce
+// qu'ils soient relâchés, pour qu'un clic de menu ne devienne pas une attaque
+func (ps *PlayerSystem) SuppressMouseUntilRelease() {
+	ps.mouseSuppressed = true
 }
 
 // updateSprites met à jour le système de sprites
@@ -415,24 +860,27 @@ func (ps *PlayerSystem) updateSprites(deltaTime time.Duration) {
 	// Mettre à jour la position du sprite
 	spriteRenderer.Position = ps.player.Position.Position
 
-	// Déterminer la direction
-	var direction string
-	switch movement.FacingDir {
-	case components.DirectionUp, components.DirectionUpLeft, components.DirectionUpRight:
-		direction = "up"
-	case components.DirectionDown, components.DirectionDownLeft, components.DirectionDownRight:
-		direction = "down"
-	case components.DirectionLeft:
-		direction = "left"
-	case components.DirectionRight:
-		direction = "right"
-	default:
-		direction = "down"
+	// Déterminer la direction : diagonale si un sprite dédié est chargé,
+	// sinon la direction cardinale la plus proche
+	direction := "down"
+	facing := movement.FacingDir
+	if facing.IsDiagonal() && ps.hasDirectionalSprite(facing.String()) {
+		direction = facing.String()
+	} else if cardinal := facing.Cardinal(); cardinal != components.DirectionNone {
+		direction = cardinal.String()
 	}
 
 	// Mettre à jour la direction et l'état
 	spriteRenderer.SetDirection(direction, movement.IsMoving)
 
+	// Fondu enchaîné vers l'animation de l'état courant (repos, marche, attaque)
+	if sprites, ok := ps.player.PlayerSprites.(*PlayerSpriteSet); ok {
+		animation := sprites.AnimationFor(direction, movement.IsMoving, spriteRenderer.IsAttacking)
+		if data := ps.spriteAnimation(animation); data != nil {
+			spriteRenderer.BlendTo(data, animationBlendTime)
+		}
+	}
+
 	// Mettre à jour l'animation du sprite
 	spriteRenderer.Update(deltaTime)
 
@@ -445,6 +893,47 @@ func (ps *PlayerSystem) updateSprites(deltaTime time.Duration) {
 	}
 }
 
+// spriteAnimation convertit une animation du jeu de sprites en données
+// d'animation du composant de rendu (une seule fois par animation)
+func (ps *PlayerSystem) spriteAnimation(animation *SpriteAnimation) *components.SpriteAnimationData {
+	if animation == nil {
+		return nil
+	}
+	if data, ok := ps.spriteAnimations[animation]; ok {
+		return data
+	}
+
+	frames := make([]components.Rectangle, len(animation.Frames))
+	for i, frame := range animation.Frames {
+		frames[i] = components.Rectangle{
+			X:      float64(frame.Min.X),
+			Y:      float64(frame.Min.Y),
+			Width:  float64(frame.Dx()),
+			Height: float64(frame.Dy()),
+		}
+	}
+	data := &components.SpriteAnimationData{
+		Frames:        frames,
+		FrameDuration: animation.FrameTime,
+		Loop:          animation.Loop,
+	}
+
+	if ps.spriteAnimations == nil {
+		ps.spriteAnimations = make(map[*SpriteAnimation]*components.SpriteAnimationData)
+	}
+	ps.spriteAnimations[animation] = data
+	return data
+}
+
+// hasDirectionalSprite vérifie si les sprites chargés couvrent une direction
+// (PlayerSpriteSet des systems ou des assets)
+func (ps *PlayerSystem) hasDirectionalSprite(direction string) bool {
+	sprites, ok := ps.player.PlayerSprites.(interface {
+		HasDirection(direction string) bool
+	})
+	return ok && sprites.HasDirection(direction)
+}
+
 // updateMovement met à jour le mouvement du joueur
 func (ps *PlayerSystem) updateMovement(deltaTime time.Duration) {
 	movement := ps.player.Movement
@@ -453,50 +942,194 @@ func (ps *PlayerSystem) updateMovement(deltaTime time.Duration) {
 
 	dt := deltaTime.Seconds()
 
-	// Calculer le vecteur de mouvement depuis les inputs
+	if ps.rollTimer > 0 {
+		ps.rollTimer -= deltaTime
+	}
+
+	// Course murale : le joueur garde son élan le long du mur
+	if ps.player.WallRun.Active {
+		ps.updateWallRun(deltaTime)
+		position.Position = position.Position.Add(movement.Velocity.Mul(dt))
+		if response := ps.applyScreenBounds(); response.Hit {
+			ps.HandleWallContact(response)
+		}
+		return
+	}
+
+	// Calculer le vecteur de mouvement depuis les inputs ; une attaque
+	// engage le joueur et réduit (ou annule) son déplacement
 	inputVector := input.GetMovementVector()
+	if ps.IsAttacking() {
+		inputVector = inputVector.Mul(ps.weapon.MoveFactor)
+	}
 
-	// Appliquer l'accélération ou la friction
+	// Accélération vers la vitesse cible ou friction (pixels/s²)
 	if inputVector.X != 0 || inputVector.Y != 0 {
 		movement.IsMoving = true
+		movement.Accelerate(inputVector.Mul(movement.Speed), dt)
+		movement.Direction = ps.vectorToDirection(inputVector)
+	} else {
+		movement.IsMoving = false
+		movement.Decelerate(dt)
+	}
+
+	position.Position = position.Position.Add(movement.Velocity.Mul(dt))
+
+	if response := ps.applyScreenBounds(); response.Hit {
+		ps.HandleWallContact(response)
+	}
+	ps.updateFacing()
+}
 
-		targetVelocity := inputVector.Mul(movement.Speed)
-		velocityDiff := targetVelocity.Sub(movement.Velocity)
-		acceleration := velocityDiff.Mul(movement.Acceleration * dt)
+// updateWallRun décompte la course murale ; à son terme, la touche de
+// roulade maintenue déclenche un saut mural qui éloigne le joueur du mur
+func (ps *PlayerSystem) updateWallRun(deltaTime time.Duration) {
+	wallRun := ps.player.WallRun
+	if !wallRun.Update(deltaTime) {
+		return
+	}
 
-		movement.Velocity = movement.Velocity.Add(acceleration)
+	if ps.player.Input.Roll {
+		ps.player.Movement.Velocity = wallRun.WallNormal.Mul(components.WallJumpSpeed)
+		fmt.Println("Saut mural!")
+	}
+}
 
-		// Limiter à la vitesse maximale
-		velocityLengthSq := movement.Velocity.X*movement.Velocity.X + movement.Velocity.Y*movement.Velocity.Y
-		if velocityLengthSq > movement.MaxSpeed*movement.MaxSpeed {
-			invLength := movement.MaxSpeed / math.Sqrt(velocityLengthSq)
-			movement.Velocity.X *= invLength
-			movement.Velocity.Y *= invLength
+// HandleWallContact réagit au contact d'un mur : une roulade dirigée vers le
+// mur se change en course le long de celui-ci ; sinon le joueur s'arrête
+// contre la paroi (la composante de sa vitesse vers le mur est annulée)
+func (ps *PlayerSystem) HandleWallContact(response CollisionResponse) {
+	if ps.player == nil || !response.Hit {
+		return
+	}
+
+	movement := ps.player.Movement
+	wallRun := ps.player.WallRun
+	normal := response.Normal
+	into := movement.Velocity.X*normal.X + movement.Velocity.Y*normal.Y
+
+	// Déjà en course : seul un autre mur (un coin) l'interrompt
+	if wallRun.Active {
+		if normal.X*wallRun.WallNormal.X+normal.Y*wallRun.WallNormal.Y < 0.9 {
+			wallRun.Stop()
+			movement.Velocity = components.Vector2{}
 		}
+		return
+	}
 
-		movement.Direction = ps.vectorToDirection(inputVector)
-		movement.FacingDir = movement.Direction
+	if into >= 0 {
+		return
+	}
 
-	} else {
-		movement.IsMoving = false
+	if ps.rollTimer > 0 {
+		ps.startWallRun(normal)
+		return
+	}
+
+	movement.Velocity = movement.Velocity.Sub(normal.Mul(into))
+}
+
+// startWallRun lance la course le long du mur, dans le sens de la roulade
+func (ps *PlayerSystem) startWallRun(normal components.Vector2) {
+	movement := ps.player.Movement
+	speed := math.Hypot(movement.Velocity.X, movement.Velocity.Y)
+
+	// Direction le long du mur : la vitesse privée de sa composante normale ;
+	// face au mur, le joueur part sur sa droite
+	into := movement.Velocity.X*normal.X + movement.Velocity.Y*normal.Y
+	along := movement.Velocity.Sub(normal.Mul(into))
+	length := math.Hypot(along.X, along.Y)
+	if length < 1e-6 {
+		along = components.Vector2{X: -normal.Y, Y: normal.X}
+		length = 1
+	}
+
+	ps.rollTimer = 0
+	movement.Velocity = along.Mul(speed / length)
+	ps.player.WallRun.Start(normal, components.WallRunDuration)
+	fmt.Println("Course murale!")
+}
+
+// updateFacing oriente le joueur : vers la cible verrouillée, sinon dans le
+// sens du déplacement, et garde la dernière orientation à l'arrêt
+func (ps *PlayerSystem) updateFacing() {
+	movement := ps.player.Movement
+
+	// L'attaque garde la direction dans laquelle elle a été lancée
+	if ps.IsAttacking() {
+		return
+	}
 
-		velocityLength := math.Sqrt(movement.Velocity.X*movement.Velocity.X + movement.Velocity.Y*movement.Velocity.Y)
-		if velocityLength > 0 {
-			frictionMagnitude := movement.Friction * dt
-			if frictionMagnitude >= velocityLength {
-				movement.Velocity = components.Vector2{X: 0, Y: 0}
-			} else {
-				invLength := frictionMagnitude / velocityLength
-				movement.Velocity.X -= movement.Velocity.X * invLength
-				movement.Velocity.Y -= movement.Velocity.Y * invLength
+	if ps.lockOnTarget != nil {
+		// Une cible vaincue libère le verrouillage
+		if targetable, ok := ps.lockOnTarget.(interface{ IsTargetable() bool }); ok && !targetable.IsTargetable() {
+			ps.ClearLockOn()
+		} else {
+			toTarget := ps.lockOnTarget.GetPosition().Sub(ps.player.Position.Position)
+			if facing := components.DirectionFromVector(toTarget); facing != components.DirectionNone {
+				movement.FacingDir = facing
 			}
+			return
 		}
 	}
 
-	position.LastPosition = position.Position
-	position.Position = position.Position.Add(movement.Velocity.Mul(dt))
+	if ps.aimActive {
+		if facing := ps.aimDirection(ps.aimTarget.Sub(ps.player.Position.Position)); facing != components.DirectionNone {
+			movement.FacingDir = facing
+		}
+		return
+	}
 
-	ps.applyScreenBounds()
+	if movement.IsMoving && movement.Direction != components.DirectionNone {
+		movement.FacingDir = movement.Direction
+	}
+}
+
+// SetAimTarget fait viser au joueur un point du monde (curseur de la souris) :
+// son orientation ne suit plus le déplacement
+func (ps *PlayerSystem) SetAimTarget(target components.Vector2) {
+	ps.aimTarget = target
+	ps.aimActive = true
+}
+
+// ClearAim rend l'orientation au déplacement
+func (ps *PlayerSystem) ClearAim() {
+	ps.aimActive = false
+}
+
+// aimDirection retourne la direction de visée parmi celles que les sprites
+// savent afficher : 8 directions si le sprite diagonal est chargé, sinon la
+// direction cardinale la plus proche
+func (ps *PlayerSystem) aimDirection(toTarget components.Vector2) components.Direction {
+	facing := components.DirectionFromVector(toTarget)
+	if !facing.IsDiagonal() || ps.hasDirectionalSprite(facing.String()) {
+		return facing
+	}
+	if math.Abs(toTarget.X) >= math.Abs(toTarget.Y) {
+		if toTarget.X < 0 {
+			return components.DirectionLeft
+		}
+		return components.DirectionRight
+	}
+	if toTarget.Y < 0 {
+		return components.DirectionUp
+	}
+	return components.DirectionDown
+}
+
+// SetLockOnTarget verrouille une cible : l'orientation ne suit plus le déplacement
+func (ps *PlayerSystem) SetLockOnTarget(target LockOnTarget) {
+	ps.lockOnTarget = target
+}
+
+// ClearLockOn libère la cible verrouillée
+func (ps *PlayerSystem) ClearLockOn() {
+	ps.lockOnTarget = nil
+}
+
+// GetLockOnTarget retourne la cible verrouillée, ou nil
+func (ps *PlayerSystem) GetLockOnTarget() LockOnTarget {
+	return ps.lockOnTarget
 }
 
 // vectorToDirection convertit un vecteur en direction
@@ -531,8 +1164,9 @@ func (ps *PlayerSystem) vectorToDirection(vector components.Vector2) components.
 	return components.DirectionDownRight
 }
 
-// applyScreenBounds limite le joueur aux bords de l'écran
-func (ps *PlayerSystem) applyScreenBounds() {
+// applyScreenBounds limite le joueur aux bords de l'écran, qui font office
+// de murs : retourne la normale du bord touché
+func (ps *PlayerSystem) applyScreenBounds() CollisionResponse {
 	position := ps.player.Position
 	size := ps.player.Sprite.Size
 
@@ -542,21 +1176,31 @@ func (ps *PlayerSystem) applyScreenBounds() {
 	minY := margin + size.Y/2
 	maxY := 720 - margin - size.Y/2
 
+	var response CollisionResponse
+	before := position.Position
+
 	if position.Position.X < minX {
 		position.Position.X = minX
-		ps.player.Movement.Velocity.X = 0
+		response.Normal.X = 1
 	} else if position.Position.X > maxX {
 		position.Position.X = maxX
-		ps.player.Movement.Velocity.X = 0
+		response.Normal.X = -1
 	}
 
 	if position.Position.Y < minY {
 		position.Position.Y = minY
-		ps.player.Movement.Velocity.Y = 0
+		response.Normal.Y = 1
 	} else if position.Position.Y > maxY {
 		position.Position.Y = maxY
-		ps.player.Movement.Velocity.Y = 0
+		response.Normal.Y = -1
+	}
+
+	if response.Normal.X != 0 || response.Normal.Y != 0 {
+		response.Hit = true
+		response.Penetration = position.Position.Sub(before)
+		response.Normal = response.Normal.Mul(1 / math.Hypot(response.Normal.X, response.Normal.Y))
 	}
+	return response
 }
 
 // updateAnimation met à jour les animations
@@ -570,7 +1214,11 @@ func (ps *PlayerSystem) updateAnimation(deltaTime time.Duration) {
 	if movement.IsMoving {
 		targetAnim = "walk"
 	} else {
-		targetAnim = "idle"
+		// À l'arrêt, le joueur garde sa dernière orientation
+		targetAnim = idleAnimationName(movement.FacingDir)
+		if _, ok := animation.Animations[targetAnim]; !ok {
+			targetAnim = "idle"
+		}
 	}
 
 	// Changer d'animation si nécessaire
@@ -625,10 +1273,33 @@ func (ps *PlayerSystem) updateAnimation(deltaTime time.Duration) {
 
 // updatePlayer met à jour les stats du joueur et traite les actions
 func (ps *PlayerSystem) updatePlayer(deltaTime time.Duration) {
+	ps.updateBlock(deltaTime)
 	ps.player.Player.Update(deltaTime)
 	ps.handlePlayerActions()
 }
 
+// updateBlock lève la garde tant que ActionBlock est maintenue et draine la stamina
+func (ps *PlayerSystem) updateBlock(deltaTime time.Duration) {
+	block := ps.player.Block
+	player := ps.player.Player
+
+	active := ps.player.Input.Block && player.IsAlive() && (player.Stamina > 0 || player.GodMode)
+	block.SetActive(active)
+	player.StaminaRegenPaused = active
+	if !active {
+		return
+	}
+
+	// La garde suit l'orientation du joueur
+	facing := ps.player.Movement.FacingDir.ToVector2()
+	if facing.X != 0 || facing.Y != 0 {
+		block.BlockAngle = math.Atan2(facing.Y, facing.X)
+	}
+
+	block.Update(deltaTime)
+	player.DrainStamina(block.StaminaDrainRate * deltaTime.Seconds())
+}
+
 // handlePlayerActions traite les actions spéciales du joueur
 func (ps *PlayerSystem) handlePlayerActions() {
 	if !ps.player.Player.IsAlive() {
@@ -637,9 +1308,28 @@ func (ps *PlayerSystem) handlePlayerActions() {
 
 	input := ps.player.Input
 
+	// Engagé dans une attaque : ni nouvelle attaque ni roulade avant la fin
+	if ps.IsAttacking() {
+		input.AttackJustPressed = false
+		input.HeavyAttackJustPressed = false
+		input.RollJustPressed = false
+	}
+
 	if input.AttackJustPressed {
-		if ps.TryAttack() && ps.player.SpriteRenderer != nil {
-			ps.player.SpriteRenderer.StartAttack()
+		if ps.TryAttack() {
+			ps.attackPending = true
+			ps.heavyAttack = false
+			if ps.player.SpriteRenderer != nil {
+				ps.player.SpriteRenderer.StartAttack()
+			}
+		}
+	} else if input.HeavyAttackJustPressed {
+		if ps.TryHeavyAttack() {
+			ps.attackPending = true
+			ps.heavyAttack = true
+			if ps.player.SpriteRenderer != nil {
+				ps.player.SpriteRenderer.StartAttack()
+			}
 		}
 	}
 
@@ -650,6 +1340,20 @@ func (ps *PlayerSystem) handlePlayerActions() {
 	if input.InteractJustPressed {
 		ps.TryInteract()
 	}
+
+	if input.CastJustPressed {
+		ps.TryCastSpell()
+	}
+
+	if input.UseItemJustPressed {
+		if ps.OnUseItem == nil || !ps.OnUseItem() {
+			ps.TryHeal()
+		}
+	}
+
+	if input.FocusJustPressed {
+		ps.TryFocus()
+	}
 }
 
 // updateCamera met à jour la caméra pour suivre le joueur
@@ -668,13 +1372,45 @@ func (ps *PlayerSystem) Render(renderer Renderer) {
 		return
 	}
 
-	// Essayer d'abord le rendu avec sprites
-	if ps.renderWithSprites(renderer) {
+	// Essayer d'abord le rendu avec sprites, sinon fallback rectangulaire
+	if !ps.renderWithSprites(renderer) {
+		ps.renderFallback(renderer)
+	}
+
+	ps.renderShield(renderer)
+}
+
+// Submit soumet le joueur à la file de rendu, sur la couche de son sprite et
+// trié selon l'ancre du sprite
+func (ps *PlayerSystem) Submit(queue *RenderQueue) {
+	if ps.player == nil || !ps.player.Active {
 		return
 	}
 
-	// Fallback vers le rendu rectangulaire
-	ps.renderFallback(renderer)
+	layer := ps.player.Sprite.Layer
+	if ps.player.SpriteRenderer != nil {
+		layer = ps.player.SpriteRenderer.Layer
+	}
+	queue.Submit(layer, ps.player.Sprite.SortY(ps.player.Position.Position), ps.Render)
+}
+
+// renderShield dessine le bouclier devant le joueur quand la garde est levée
+func (ps *PlayerSystem) renderShield(renderer Renderer) {
+	block := ps.player.Block
+	if block == nil || !block.Active {
+		return
+	}
+
+	const distance = 20.0
+	const size = 10.0
+	center := ps.renderPosition().Add(components.Vector2{
+		X: math.Cos(block.BlockAngle) * distance,
+		Y: math.Sin(block.BlockAngle) * distance,
+	})
+
+	shield := components.Rectangle{X: center.X - size/2, Y: center.Y - size/2, Width: size, Height: size}
+	renderer.DrawRectangle(shield, components.Color{R: 120, G: 180, B: 255, A: 220}, true)
+	renderer.DrawRectangle(shield, components.ColorWhite, false)
 }
 
 // renderWithSprites tente le rendu avec les vrais sprites chargés
@@ -729,7 +1465,7 @@ func (ps *PlayerSystem) renderWithSprites(renderer Renderer) bool {
 	currentSprite := playerSprites.MainSprite
 
 	// Préparer les paramètres de rendu
-	position := spriteRenderer.Position
+	position := ps.renderPosition()
 	spriteBounds := currentSprite.Bounds()
 	sourceRect := components.Rectangle{
 		X:      0,
@@ -742,6 +1478,18 @@ func (ps *PlayerSystem) renderWithSprites(renderer Renderer) bool {
 	rotation := spriteRenderer.Rotation
 	tint := spriteRenderer.Tint
 
+	// Fondu enchaîné : la frame de l'animation sortante s'efface pendant que
+	// celle de l'animation suivante apparaît
+	blending := spriteRenderer.IsBlending()
+	currentAlpha, nextAlpha := spriteRenderer.BlendAlphas()
+	if spriteRenderer.CurrentAnimation != nil {
+		sourceRect = frameSourceRect(spriteRenderer.SourceRect, spriteBounds)
+	}
+	nextRect := sourceRect
+	if frame, ok := spriteRenderer.PendingSourceRect(); ok {
+		nextRect = frameSourceRect(frame, spriteBounds)
+	}
+
 	// Vérifier si le renderer supporte DrawSprite
 	if spriteRenderer, ok := renderer.(interface {
 		DrawSprite(sprite interface{}, position components.Vector2, sourceRect components.Rectangle, scale components.Vector2, rotation float64, tint components.Color)
@@ -753,7 +1501,12 @@ func (ps *PlayerSystem) renderWithSprites(renderer Renderer) bool {
 		}
 
 		// Dessiner le sprite réel
-		spriteRenderer.DrawSprite(currentSprite, position, sourceRect, scale, rotation, tint)
+		if blending {
+			spriteRenderer.DrawSprite(currentSprite, position, sourceRect, scale, rotation, scaleAlpha(tint, currentAlpha))
+			spriteRenderer.DrawSprite(currentSprite, position, nextRect, scale, rotation, scaleAlpha(tint, nextAlpha))
+		} else {
+			spriteRenderer.DrawSprite(currentSprite, position, sourceRect, scale, rotation, tint)
+		}
 
 		return true
 	}
@@ -770,7 +1523,7 @@ func (ps *PlayerSystem) renderFallback(renderer Renderer) {
 		return
 	}
 
-	position := ps.player.Position.Position
+	position := ps.renderPosition()
 	sprite := ps.player.Sprite
 
 	playerRect := components.Rectangle{
@@ -790,8 +1543,8 @@ func (ps *PlayerSystem) renderFallback(renderer Renderer) {
 		}
 	}
 
-	if ps.player.Player.InvulnTime > 0 {
-		if (ps.player.Player.InvulnTime.Milliseconds()/100)%2 == 0 {
+	if ps.player.Player.IsInvulnerable() {
+		if (ps.player.Player.InvulnTimer.Remaining().Milliseconds()/100)%2 == 0 {
 			color.A = 128
 		}
 	}
@@ -799,22 +1552,20 @@ func (ps *PlayerSystem) renderFallback(renderer Renderer) {
 	renderer.DrawRectangle(playerRect, color, true)
 
 	borderColor := components.ColorWhite
-	if ps.player.Player.InvulnTime > 0 {
+	if ps.player.Player.IsInvulnerable() {
 		borderColor = components.ColorYellow
 	}
 	renderer.DrawRectangle(playerRect, borderColor, false)
 
-	if ps.player.Movement.IsMoving {
-		ps.renderDirectionIndicator(renderer, position)
-	}
+	ps.renderDirectionIndicator(renderer, position)
 
 	ps.renderHealthBar(renderer, position)
 	ps.renderStaminaBar(renderer, position)
 }
 
-// renderDirectionIndicator dessine un indicateur de direction
+// renderDirectionIndicator dessine un indicateur d'orientation, conservé à l'arrêt
 func (ps *PlayerSystem) renderDirectionIndicator(renderer Renderer, position components.Vector2) {
-	direction := ps.player.Movement.Direction
+	direction := ps.player.Movement.FacingDir
 	if direction == components.DirectionNone {
 		return
 	}
@@ -855,7 +1606,7 @@ func (ps *PlayerSystem) renderHealthBar(renderer Renderer, position components.V
 	bgRect := components.Rectangle{X: barX, Y: barY, Width: barWidth, Height: barHeight}
 	renderer.DrawRectangle(bgRect, components.ColorBlack, true)
 
-	healthPercent := float64(player.Health) / float64(player.MaxHealth)
+	healthPercent := ps.healthBar.Displayed / float64(player.MaxHealth)
 	healthWidth := barWidth * healthPercent
 
 	var healthColor components.Color
@@ -873,6 +1624,12 @@ func (ps *PlayerSystem) renderHealthBar(renderer Renderer, position components.V
 	}
 
 	renderer.DrawRectangle(bgRect, components.ColorWhite, false)
+
+	// Indicateur clignotant du god mode
+	if player.GodMode && (ps.frameCount/20)%2 == 0 {
+		gold := components.Color{R: 255, G: 215, B: 0, A: 255}
+		renderer.DrawText("GOD", components.Vector2{X: barX + barWidth + 4, Y: barY + barHeight}, gold)
+	}
 }
 
 // renderStaminaBar dessine une barre de stamina
@@ -887,7 +1644,7 @@ func (ps *PlayerSystem) renderStaminaBar(renderer Renderer, position components.
 	bgRect := components.Rectangle{X: barX, Y: barY, Width: barWidth, Height: barHeight}
 	renderer.DrawRectangle(bgRect, components.ColorBlack, true)
 
-	staminaPercent := player.Stamina / player.MaxStamina
+	staminaPercent := ps.staminaBar.Displayed / player.MaxStamina
 	staminaWidth := barWidth * staminaPercent
 
 	if staminaWidth > 0 {
@@ -904,23 +1661,41 @@ func (ps *PlayerSystem) renderStaminaBar(renderer Renderer, position components.
 
 // TryAttack tente une attaque
 func (ps *PlayerSystem) TryAttack() bool {
-	if ps.player == nil || !ps.player.Player.IsAlive() {
+	if ps.player == nil || !ps.player.Player.IsAlive() || !ps.abilityReady(AbilityAttack) {
 		return false
 	}
 
-	staminaCost := 15.0
+	staminaCost := ps.player.Player.Weapon.StaminaCost
 	if !ps.player.Player.UseStamina(staminaCost) {
 		fmt.Println("Pas assez de stamina pour attaquer!")
 		return false
 	}
 
+	ps.startCooldown(AbilityAttack, ps.weapon.AttackDuration())
 	fmt.Println("Attaque réussie!")
 	return true
 }
 
+// TryHeavyAttack tente une attaque lourde (double coût en stamina)
+func (ps *PlayerSystem) TryHeavyAttack() bool {
+	if ps.player == nil || !ps.player.Player.IsAlive() || !ps.abilityReady(AbilityAttack) {
+		return false
+	}
+
+	staminaCost := ps.player.Player.Weapon.StaminaCost * 2
+	if !ps.player.Player.UseStamina(staminaCost) {
+		fmt.Println("Pas assez de stamina pour une attaque lourde!")
+		return false
+	}
+
+	ps.startCooldown(AbilityAttack, ps.weapon.AttackDuration())
+	fmt.Println("Attaque lourde!")
+	return true
+}
+
 // TryRoll tente une roulade
 func (ps *PlayerSystem) TryRoll() bool {
-	if ps.player == nil || !ps.player.Player.IsAlive() {
+	if ps.player == nil || !ps.player.Player.IsAlive() || !ps.abilityReady(AbilityRoll) {
 		return false
 	}
 
@@ -929,6 +1704,7 @@ func (ps *PlayerSystem) TryRoll() bool {
 		fmt.Println("Pas assez de stamina pour rouler!")
 		return false
 	}
+	ps.startCooldown(AbilityRoll, rollCooldown)
 
 	rollDirection := ps.player.Movement.Direction
 	if rollDirection == components.DirectionNone {
@@ -939,8 +1715,12 @@ func (ps *PlayerSystem) TryRoll() bool {
 	rollVector := rollDirection.ToVector2().Mul(rollSpeed)
 	ps.player.Movement.Velocity = rollVector
 
-	ps.player.Player.InvulnTime = time.Millisecond * 300
+	ps.player.Player.StartInvulnerability(time.Millisecond * 300)
+	ps.rollTimer = time.Millisecond * 300
 
+	if ps.OnRoll != nil {
+		ps.OnRoll(ps.player.Position.Position, rollDirection.ToVector2())
+	}
 	fmt.Println("Roulade effectuée!")
 	return true
 }
@@ -951,8 +1731,62 @@ func (ps *PlayerSystem) TryInteract() bool {
 		return false
 	}
 
-	fmt.Println("Interaction (rien à proximité)")
-	return false
+	// Les objets à proximité sont résolus hors du système joueur
+	ps.interactPending = true
+	return true
+}
+
+// TryHeal boit une fiole de soin (emplacement rapide) ; sans charge, rien ne se passe
+func (ps *PlayerSystem) TryHeal() bool {
+	if ps.player == nil || !ps.player.Player.IsAlive() {
+		return false
+	}
+
+	success := ps.player.Player.UseHealCharge()
+	if success {
+		fmt.Printf("Fiole bue ! (%d restante(s))\n", ps.player.Player.HealCharges)
+	} else {
+		fmt.Println("Plus de fiole de soin!")
+	}
+	if ps.OnHeal != nil {
+		ps.OnHeal(success)
+	}
+	return success
+}
+
+// TryCastSpell tente de lancer un sort (chaîne d'éclairs) face au joueur
+func (ps *PlayerSystem) TryCastSpell() bool {
+	if ps.player == nil || !ps.player.Player.IsAlive() || !ps.abilityReady(AbilitySpell) {
+		return false
+	}
+
+	staminaCost := 30.0
+	if !ps.player.Player.UseStamina(staminaCost) {
+		fmt.Println("Pas assez de stamina pour lancer un sort!")
+		return false
+	}
+	ps.startCooldown(AbilitySpell, spellCooldown)
+
+	fmt.Println("Sort lancé!")
+
+	// Le projectile est créé par le système de sorts
+	ps.castPending = true
+	return true
+}
+
+// TryFocus tente d'entrer en concentration : le temps du gameplay ralentit
+// (appliqué par OnFocus), puis la capacité se recharge
+func (ps *PlayerSystem) TryFocus() bool {
+	if ps.player == nil || !ps.player.Player.IsAlive() || !ps.abilityReady(AbilityFocus) {
+		return false
+	}
+	ps.startCooldown(AbilityFocus, focusCooldown)
+
+	fmt.Println("Concentration!")
+	if ps.OnFocus != nil {
+		ps.OnFocus()
+	}
+	return true
 }
 
 // ===============================
@@ -983,3 +1817,24 @@ func minByte(a, b uint8) uint8 {
 	}
 	return b
 }
+
+// frameSourceRect limite le rectangle d'une frame à l'image du sprite
+// (image entière si la frame est vide ou hors de l'image)
+func frameSourceRect(frame components.Rectangle, bounds image.Rectangle) components.Rectangle {
+	rect := image.Rect(int(frame.X), int(frame.Y), int(frame.X+frame.Width), int(frame.Y+frame.Height)).Intersect(bounds)
+	if rect.Empty() {
+		rect = bounds
+	}
+	return components.Rectangle{
+		X:      float64(rect.Min.X),
+		Y:      float64(rect.Min.Y),
+		Width:  float64(rect.Dx()),
+		Height: float64(rect.Dy()),
+	}
+}
+
+// scaleAlpha applique un facteur d'opacité (0-1) à une teinte
+func scaleAlpha(c components.Color, factor float64) components.Color {
+	c.A = uint8(float64(c.A) * components.Clamp(factor, 0, 1))
+	return c
+}
@@ -0,0 +1,185 @@
+package systems
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+const testFrame = time.Second / 60
+
+// keyboardInput gestionnaire d'entrées factice : une seule touche vient d'être
+// pressée (numérotation d'ebiten.Key, comme FinalInputWrapper)
+type keyboardInput struct {
+	justPressed ebiten.Key
+}
+
+func (ki *keyboardInput) IsActionPressedSystems(action int) bool { return false }
+
+func (ki *keyboardInput) IsKeyJustPressedSystems(key int) bool {
+	return key == int(ki.justPressed)
+}
+
+// pressKey joue une frame du système joueur avec la touche pressée
+func pressKey(ps *PlayerSystem, key ebiten.Key) {
+	ps.SetInputManager(&keyboardInput{justPressed: key})
+	ps.Update(testFrame)
+}
+
+// playerAgainstRightEdge crée un joueur tourné vers le bord droit de l'écran, qui fait office de mur
+func playerAgainstRightEdge() *PlayerSystem {
+	ps := NewPlayerSystem()
+	ps.CreatePlayer(1260, 360)
+	ps.GetPlayer().Movement.FacingDir = components.DirectionRight
+	return ps
+}
+
+func TestWallContactDuringRollStartsWallRun(t *testing.T) {
+	ps := playerAgainstRightEdge()
+	if !ps.TryRoll() {
+		t.Fatal("la roulade a échoué")
+	}
+	ps.updateMovement(testFrame)
+
+	player := ps.GetPlayer()
+	wallRun := player.WallRun
+	if !wallRun.Active {
+		t.Fatal("la roulade contre le mur doit lancer une course murale")
+	}
+	if wallRun.WallNormal != (components.Vector2{X: -1}) {
+		t.Errorf("normale du mur = %+v, attendu {-1 0}", wallRun.WallNormal)
+	}
+
+	velocity := player.Movement.Velocity
+	if velocity.X != 0 {
+		t.Errorf("vitesse vers le mur = %.1f, attendu 0", velocity.X)
+	}
+	if speed := math.Abs(velocity.Y); speed < 300 {
+		t.Errorf("vitesse le long du mur = %.1f, attendu l'élan de la roulade", speed)
+	}
+
+	// La course murale ignore la friction
+	before := velocity
+	ps.updateMovement(testFrame)
+	if player.Movement.Velocity != before {
+		t.Errorf("vitesse pendant la course = %+v, attendu %+v", player.Movement.Velocity, before)
+	}
+}
+
+func TestWallContactWithoutRollStops(t *testing.T) {
+	ps := playerAgainstRightEdge()
+	player := ps.GetPlayer()
+	player.Movement.Velocity = components.Vector2{X: 200, Y: 50}
+
+	ps.updateMovement(testFrame)
+
+	if player.WallRun.Active {
+		t.Error("sans roulade, le contact d'un mur ne doit pas lancer de course murale")
+	}
+	if player.Movement.Velocity.X != 0 {
+		t.Errorf("vitesse vers le mur = %.1f, attendu 0", player.Movement.Velocity.X)
+	}
+	if player.Movement.Velocity.Y <= 0 {
+		t.Errorf("vitesse le long du mur = %.1f, attendu conservée", player.Movement.Velocity.Y)
+	}
+}
+
+func TestWallRunExpiry(t *testing.T) {
+	tests := []struct {
+		name         string
+		jumpHeld     bool
+		wantVelocity components.Vector2
+	}{
+		{"saut mural", true, components.Vector2{X: -components.WallJumpSpeed}},
+		{"sans saut", false, components.Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := playerAgainstRightEdge()
+			ps.TryRoll()
+			ps.updateMovement(testFrame)
+
+			player := ps.GetPlayer()
+			if !player.WallRun.Active {
+				t.Fatal("pas de course murale")
+			}
+			player.Input.Roll = tt.jumpHeld
+
+			elapsed := time.Duration(0)
+			for player.WallRun.Active && elapsed < time.Second {
+				ps.updateMovement(testFrame)
+				elapsed += testFrame
+			}
+			if elapsed < components.WallRunDuration || elapsed > components.WallRunDuration+testFrame {
+				t.Errorf("course murale de %v, attendu %v", elapsed, components.WallRunDuration)
+			}
+
+			if tt.jumpHeld && player.Movement.Velocity != tt.wantVelocity {
+				t.Errorf("vitesse après la course = %+v, attendu %+v", player.Movement.Velocity, tt.wantVelocity)
+			}
+			if !tt.jumpHeld && player.Movement.Velocity.X < 0 {
+				t.Errorf("vitesse après la course = %+v, aucun saut attendu", player.Movement.Velocity)
+			}
+		})
+	}
+}
+
+func TestInteractKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          ebiten.Key
+		wantInteract bool
+	}{
+		{"E", ebiten.KeyE, true},
+		{"entrée du pavé numérique", ebiten.KeyNumpadEnter, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := NewPlayerSystem()
+			ps.CreatePlayer(400, 300)
+
+			pressKey(ps, tt.key)
+			if got := ps.ConsumeInteract(); got != tt.wantInteract {
+				t.Errorf("interaction = %t, attendu %t", got, tt.wantInteract)
+			}
+			if ps.ConsumeInteract() {
+				t.Error("l'interaction ne doit être consommée qu'une fois")
+			}
+		})
+	}
+}
+
+func TestCombatKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        ebiten.Key
+		wantAttack bool
+		wantRoll   bool
+	}{
+		{"espace attaque", ebiten.KeySpace, true, false},
+		{"C roule", ebiten.KeyC, false, true},
+		{"accent grave", ebiten.KeyBackquote, false, false},
+		{"point du pavé numérique", ebiten.KeyNumpadDecimal, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := NewPlayerSystem()
+			ps.CreatePlayer(400, 300)
+
+			pressKey(ps, tt.key)
+			if got := ps.IsAttacking(); got != tt.wantAttack {
+				t.Errorf("attaque = %t, attendu %t", got, tt.wantAttack)
+			}
+			if got := ps.rollTimer > 0; got != tt.wantRoll {
+				t.Errorf("roulade = %t, attendu %t", got, tt.wantRoll)
+			}
+		})
+	}
+}
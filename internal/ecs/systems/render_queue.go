@@ -0,0 +1,78 @@
+// internal/ecs/systems/render_queue.go - File de rendu triée par couche et profondeur
+package systems
+
+import "sort"
+
+// Couches de rendu
+const (
+	EntityLayer       = 10          // Joueur et ennemis : triés entre eux par profondeur
+	NPCLayer          = EntityLayer // PNJ, sans composant de sprite
+	ParticleLayer     = 50          // Particules devant les entités et les décors
+	SpeechBubbleLayer = 100         // Bulles par-dessus toutes les entités
+)
+
+// RenderItem élément soumis à la file : Y est son ordonnée de tri dans le monde
+// (ses pieds en général, voir SpriteComponent.SortY) ; à couche égale, un
+// élément plus bas à l'écran passe devant
+type RenderItem struct {
+	Layer int
+	Y     float64
+	Draw  func(renderer Renderer)
+
+	order int // Ordre de soumission, départage les égalités
+}
+
+// RenderQueue collecte les éléments soumis par les systèmes pendant la frame
+// puis les dessine triés par couche, puis par Y (profondeur vue de dessus)
+type RenderQueue struct {
+	items []RenderItem
+}
+
+// NewRenderQueue crée une file de rendu vide
+func NewRenderQueue() *RenderQueue {
+	return &RenderQueue{items: make([]RenderItem, 0, 64)}
+}
+
+// Submit ajoute un élément à dessiner
+func (rq *RenderQueue) Submit(layer int, y float64, draw func(renderer Renderer)) {
+	if draw == nil {
+		return
+	}
+	rq.items = append(rq.items, RenderItem{Layer: layer, Y: y, Draw: draw, order: len(rq.items)})
+}
+
+// Len retourne le nombre d'éléments en attente
+func (rq *RenderQueue) Len() int {
+	return len(rq.items)
+}
+
+// Sort trie les éléments : couche croissante, puis Y croissant, puis ordre de soumission
+func (rq *RenderQueue) Sort() {
+	sort.Slice(rq.items, func(i, j int) bool {
+		a, b := rq.items[i], rq.items[j]
+		if a.Layer != b.Layer {
+			return a.Layer < b.Layer
+		}
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+		return a.order < b.order
+	})
+}
+
+// Flush dessine les éléments dans l'ordre trié puis vide la file
+func (rq *RenderQueue) Flush(renderer Renderer) {
+	rq.Sort()
+	for _, item := range rq.items {
+		item.Draw(renderer)
+	}
+	rq.Clear()
+}
+
+// Clear vide la file sans dessiner
+func (rq *RenderQueue) Clear() {
+	for i := range rq.items {
+		rq.items[i].Draw = nil // Libère les closures
+	}
+	rq.items = rq.items[:0]
+}
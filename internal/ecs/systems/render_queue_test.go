@@ -0,0 +1,129 @@
+package systems
+
+import (
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// drawLog retient l'ordre dans lequel les éléments soumis sont dessinés
+type drawLog []string
+
+func (dl *drawLog) draw(name string) func(renderer Renderer) {
+	return func(renderer Renderer) { *dl = append(*dl, name) }
+}
+
+func TestRenderQueueOrder(t *testing.T) {
+	type submission struct {
+		name  string
+		layer int
+		y     float64
+	}
+	tests := []struct {
+		name   string
+		submit []submission
+		want   []string
+	}{
+		{
+			"couche avant profondeur",
+			[]submission{{"bulle", SpeechBubbleLayer, 0}, {"particules", ParticleLayer, 0}, {"joueur", EntityLayer, 500}},
+			[]string{"joueur", "particules", "bulle"},
+		},
+		{
+			"plus bas à l'écran devant",
+			[]submission{{"ennemi bas", EntityLayer, 400}, {"joueur", EntityLayer, 300}, {"ennemi haut", EntityLayer, 200}},
+			[]string{"ennemi haut", "joueur", "ennemi bas"},
+		},
+		{
+			"égalité : ordre de soumission",
+			[]submission{{"premier", EntityLayer, 300}, {"second", EntityLayer, 300}, {"troisième", EntityLayer, 300}},
+			[]string{"premier", "second", "troisième"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var log drawLog
+			queue := NewRenderQueue()
+			for _, s := range tt.submit {
+				queue.Submit(s.layer, s.y, log.draw(s.name))
+			}
+			queue.Flush(nil)
+
+			if len(log) != len(tt.want) {
+				t.Fatalf("dessins = %v, attendu %v", log, tt.want)
+			}
+			for i := range log {
+				if log[i] != tt.want[i] {
+					t.Errorf("dessins = %v, attendu %v", log, tt.want)
+					break
+				}
+			}
+			if queue.Len() != 0 {
+				t.Errorf("Len = %d après Flush, attendu 0", queue.Len())
+			}
+		})
+	}
+}
+
+func TestRenderQueueSortAnchor(t *testing.T) {
+	// Un grand sprite (64 px) un peu plus haut qu'un petit (16 px) : ses pieds
+	// sont plus bas, son centre plus haut
+	tests := []struct {
+		name   string
+		anchor components.SortAnchor
+		want   []string
+	}{
+		{"pieds", components.SortAnchorFeet, []string{"petit", "grand"}},
+		{"centre", components.SortAnchorCenter, []string{"grand", "petit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tall := components.NewSpriteComponent("", 64, 64)
+			small := components.NewSpriteComponent("", 16, 16)
+			tall.SortAnchor, small.SortAnchor = tt.anchor, tt.anchor
+
+			var log drawLog
+			queue := NewRenderQueue()
+			queue.Submit(EntityLayer, tall.SortY(components.Vector2{X: 100, Y: 100}), log.draw("grand"))
+			queue.Submit(EntityLayer, small.SortY(components.Vector2{X: 100, Y: 120}), log.draw("petit"))
+			queue.Flush(nil)
+
+			if len(log) != 2 || log[0] != tt.want[0] || log[1] != tt.want[1] {
+				t.Errorf("dessins = %v, attendu %v", log, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderQueueSortsPlayerBetweenEnemies(t *testing.T) {
+	ps := NewPlayerSystem()
+	ps.CreatePlayer(400, 300)
+	es := NewEnemySystem()
+	behind := es.SpawnEnemy(420, 250)
+	inFront := es.SpawnEnemy(380, 350)
+
+	queue := NewRenderQueue()
+	es.Submit(queue)
+	ps.Submit(queue)
+	queue.Sort()
+
+	player := ps.GetPlayer()
+	want := []float64{
+		behind.Sprite.SortY(behind.Position.Position),
+		player.Sprite.SortY(player.Position.Position),
+		inFront.Sprite.SortY(inFront.Position.Position),
+	}
+	if queue.Len() != len(want) {
+		t.Fatalf("%d éléments soumis, attendu %d", queue.Len(), len(want))
+	}
+	for i, item := range queue.items {
+		if item.Layer != EntityLayer {
+			t.Errorf("élément %d : couche %d, attendu %d", i, item.Layer, EntityLayer)
+		}
+		if item.Y != want[i] {
+			t.Errorf("élément %d : Y = %.1f, attendu %.1f", i, item.Y, want[i])
+		}
+	}
+}
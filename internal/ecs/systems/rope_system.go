@@ -0,0 +1,116 @@
+// internal/ecs/systems/rope_system.go - Chaînes, lianes et ponts de corde
+package systems
+
+import (
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Réglages des ponts
+const (
+	bridgeGravity        = 120.0 // Un pont tendu s'affaisse peu
+	bridgePlankThickness = 6
+)
+
+// Rope corde affichée comme une suite de segments
+type Rope struct {
+	Rope      *components.RopeComponent
+	Color     components.Color
+	Thickness float32
+}
+
+// Bridge pont : deux cordes côte à côte, une planche entre chaque paire de
+// segments de même rang
+type Bridge struct {
+	Rails [2]*components.RopeComponent
+}
+
+// RopeSystem simule et dessine les cordes et les ponts
+type RopeSystem struct {
+	ropes   []*Rope
+	bridges []*Bridge
+}
+
+// NewRopeSystem crée un système sans corde
+func NewRopeSystem() *RopeSystem {
+	return &RopeSystem{
+		ropes:   make([]*Rope, 0),
+		bridges: make([]*Bridge, 0),
+	}
+}
+
+// Clear retire toutes les cordes et tous les ponts
+func (rs *RopeSystem) Clear() {
+	rs.ropes = rs.ropes[:0]
+	rs.bridges = rs.bridges[:0]
+}
+
+// AddRope suspend une corde à anchor ; avec attached, son extrémité reste fixée sur end
+func (rs *RopeSystem) AddRope(anchor, end components.Vector2, segments int, attached bool, color components.Color, thickness float32) *Rope {
+	rope := components.NewRopeComponent(anchor, end, segments)
+	if attached {
+		rope.Attach(end)
+	}
+	r := &Rope{Rope: rope, Color: color, Thickness: thickness}
+	rs.ropes = append(rs.ropes, r)
+	return r
+}
+
+// AddBridge tend un pont de start à end : deux cordes fixées aux deux bouts,
+// écartées de width perpendiculairement au pont
+func (rs *RopeSystem) AddBridge(start, end components.Vector2, segments int, width float64) *Bridge {
+	along := end.Sub(start)
+	length := math.Hypot(along.X, along.Y)
+	if length == 0 {
+		return nil
+	}
+	side := components.Vector2{X: -along.Y / length, Y: along.X / length}.Mul(width / 2)
+
+	bridge := &Bridge{}
+	for i, offset := range []components.Vector2{side.Mul(-1), side} {
+		rail := components.NewRopeComponent(start.Add(offset), end.Add(offset), segments)
+		rail.Attach(end.Add(offset))
+		rail.Gravity = components.Vector2{X: 0, Y: bridgeGravity}
+		bridge.Rails[i] = rail
+	}
+	rs.bridges = append(rs.bridges, bridge)
+	return bridge
+}
+
+// Update simule toutes les cordes
+func (rs *RopeSystem) Update(deltaTime time.Duration) {
+	dt := deltaTime.Seconds()
+	for _, rope := range rs.ropes {
+		rope.Rope.Update(dt)
+	}
+	for _, bridge := range rs.bridges {
+		bridge.Rails[0].Update(dt)
+		bridge.Rails[1].Update(dt)
+	}
+}
+
+// Render dessine les planches des ponts, leurs cordes puis les autres cordes
+func (rs *RopeSystem) Render(renderer LineRenderer) {
+	plankColor := components.Color{R: 120, G: 85, B: 50, A: 255}
+	railColor := components.Color{R: 170, G: 150, B: 110, A: 255}
+	for _, bridge := range rs.bridges {
+		left, right := bridge.Rails[0].Segments, bridge.Rails[1].Segments
+		for i := 0; i < len(left) && i < len(right); i++ {
+			renderer.DrawLine(left[i].Position, right[i].Position, plankColor, bridgePlankThickness)
+		}
+		renderSegments(renderer, left, railColor, 2)
+		renderSegments(renderer, right, railColor, 2)
+	}
+
+	for _, rope := range rs.ropes {
+		renderSegments(renderer, rope.Rope.Segments, rope.Color, rope.Thickness)
+	}
+}
+
+// renderSegments trace une ligne entre chaque paire de segments voisins
+func renderSegments(renderer LineRenderer, segments []components.RopeSegment, color components.Color, thickness float32) {
+	for i := 1; i < len(segments); i++ {
+		renderer.DrawLine(segments[i-1].Position, segments[i].Position, color, thickness)
+	}
+}
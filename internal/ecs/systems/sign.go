@@ -0,0 +1,82 @@
+// internal/ecs/systems/sign.go - Panneaux lus avec la touche d'interaction
+package systems
+
+import "zelda-souls-game/internal/ecs/components"
+
+// Sign panneau du décor : l'interaction affiche son message. Un panneau
+// jamais lu porte un « ! » au-dessus de lui.
+type Sign struct {
+	EntityID uint32
+	Position components.Vector2
+	Radius   float64 // Portée d'interaction (pixels)
+	Sign     *components.SignComponent
+	Read     bool
+
+	// Appelé à chaque lecture, avant que le panneau soit marqué comme lu
+	OnRead func(sign *Sign)
+}
+
+// Taille d'un panneau à l'écran
+const (
+	signWidth  = 18.0
+	signHeight = 12.0
+	signPost   = 10.0 // Hauteur du poteau sous la planche
+)
+
+// NewSign crée un panneau non lu
+func NewSign(entityID uint32, x, y float64, sign *components.SignComponent) *Sign {
+	return &Sign{
+		EntityID: entityID,
+		Position: components.Vector2{X: x, Y: y},
+		Radius:   36,
+		Sign:     sign,
+	}
+}
+
+// InteractionPosition implémente Interactable
+func (s *Sign) InteractionPosition() components.Vector2 {
+	return s.Position
+}
+
+// InteractionRadius implémente Interactable
+func (s *Sign) InteractionRadius() float64 {
+	return s.Radius
+}
+
+// Interact implémente Interactable : le joueur lit le panneau
+func (s *Sign) Interact(player *PlayerEntity) {
+	if player == nil || !player.Player.IsAlive() {
+		return
+	}
+	if s.OnRead != nil {
+		s.OnRead(s)
+	}
+	s.Read = true
+}
+
+// IsNew retourne si le panneau n'a jamais été lu
+func (s *Sign) IsNew() bool {
+	return !s.Read
+}
+
+// Render dessine le poteau, la planche et, s'il n'a pas été lu, le « ! »
+func (s *Sign) Render(renderer Renderer) {
+	wood := components.Color{R: 120, G: 85, B: 50, A: 255}
+	renderer.DrawRectangle(components.Rectangle{
+		X: s.Position.X - 1.5, Y: s.Position.Y - signPost, Width: 3, Height: signPost,
+	}, wood, true)
+
+	board := components.Rectangle{
+		X:      s.Position.X - signWidth/2,
+		Y:      s.Position.Y - signPost - signHeight,
+		Width:  signWidth,
+		Height: signHeight,
+	}
+	renderer.DrawRectangle(board, components.Color{R: 160, G: 120, B: 75, A: 255}, true)
+	renderer.DrawRectangle(board, components.Color{R: 70, G: 50, B: 30, A: 255}, false)
+
+	if s.IsNew() {
+		renderer.DrawText("!", components.Vector2{X: s.Position.X - 3, Y: board.Y - 4},
+			components.Color{R: 255, G: 215, B: 0, A: 255})
+	}
+}
@@ -0,0 +1,179 @@
+// internal/ecs/systems/spawn_system.go - Placement aléatoire selon une carte de densité
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// SpawnDensityMap couche de densité d'une grille : 0 (jamais) à 255 (toujours accepté)
+type SpawnDensityMap interface {
+	GridSize() (int, int)
+	GetTileSize() float64
+	Density(tx, ty int) uint8
+	IsSolid(tx, ty int) bool
+}
+
+// SpawnTableEntry entrée pondérée d'une table d'apparition
+type SpawnTableEntry struct {
+	Kind   string // "enemy" ou "item"
+	ID     string // Archétype d'ennemi ou nom d'objet
+	Weight int
+}
+
+// SpawnTable liste pondérée des entités d'une salle
+type SpawnTable []SpawnTableEntry
+
+// Pick tire une entrée proportionnellement à son poids (false si la table est vide)
+func (st SpawnTable) Pick(rng *rand.Rand) (SpawnTableEntry, bool) {
+	total := 0
+	for _, entry := range st {
+		if entry.Weight > 0 {
+			total += entry.Weight
+		}
+	}
+	if total == 0 {
+		return SpawnTableEntry{}, false
+	}
+
+	roll := rng.Intn(total)
+	for _, entry := range st {
+		if entry.Weight <= 0 {
+			continue
+		}
+		if roll < entry.Weight {
+			return entry, true
+		}
+		roll -= entry.Weight
+	}
+	return SpawnTableEntry{}, false
+}
+
+// SpawnedEntity entité placée par le système, retenue pour la réapparition
+type SpawnedEntity struct {
+	Entry    SpawnTableEntry
+	Position components.Vector2
+}
+
+// SpawnSystem place les entités d'une salle par échantillonnage par rejet :
+// une tuile tirée au hasard est acceptée avec la probabilité densité/255.
+type SpawnSystem struct {
+	density SpawnDensityMap
+	rng     *rand.Rand
+	spawned []SpawnedEntity
+
+	MinSeparation    float64 // Distance minimale (pixels) entre deux entités placées
+	AttemptsPerSpawn int     // Tirages au plus par entité demandée
+
+	// Crée réellement l'entité (ennemi ou objet) à la position choisie
+	Spawn func(entry SpawnTableEntry, position components.Vector2)
+}
+
+// NewSpawnSystem crée un nouveau système d'apparition
+func NewSpawnSystem() *SpawnSystem {
+	return &SpawnSystem{
+		rng:              rand.New(rand.NewSource(time.Now().UnixNano())),
+		spawned:          make([]SpawnedEntity, 0, 16),
+		MinSeparation:    64,
+		AttemptsPerSpawn: 50,
+	}
+}
+
+// SetDensityMap définit la couche de densité utilisée pour les tirages
+func (ss *SpawnSystem) SetDensityMap(density SpawnDensityMap) {
+	ss.density = density
+}
+
+// SetRandomSource remplace le générateur (tirages reproductibles)
+func (ss *SpawnSystem) SetRandomSource(rng *rand.Rand) {
+	if rng != nil {
+		ss.rng = rng
+	}
+}
+
+// GetSpawned retourne les entités placées depuis le dernier Clear
+func (ss *SpawnSystem) GetSpawned() []SpawnedEntity {
+	return ss.spawned
+}
+
+// Clear oublie les entités placées
+func (ss *SpawnSystem) Clear() {
+	ss.spawned = ss.spawned[:0]
+}
+
+// PopulateRoom place jusqu'à count entités tirées dans la table et retourne
+// celles placées (moins si la densité ou l'espacement l'empêchent)
+func (ss *SpawnSystem) PopulateRoom(table SpawnTable, count int) []SpawnedEntity {
+	if ss.density == nil || count <= 0 {
+		return nil
+	}
+
+	width, height := ss.density.GridSize()
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	placed := make([]SpawnedEntity, 0, count)
+	for attempts := count * ss.AttemptsPerSpawn; attempts > 0 && len(placed) < count; attempts-- {
+		position, ok := ss.sampleCell(width, height)
+		if !ok || !ss.farEnough(position) {
+			continue
+		}
+
+		entry, ok := table.Pick(ss.rng)
+		if !ok {
+			break
+		}
+
+		spawned := SpawnedEntity{Entry: entry, Position: position}
+		ss.spawned = append(ss.spawned, spawned)
+		placed = append(placed, spawned)
+		if ss.Spawn != nil {
+			ss.Spawn(entry, position)
+		}
+	}
+	return placed
+}
+
+// sampleCell tire une tuile au hasard et l'accepte avec la probabilité densité/255
+func (ss *SpawnSystem) sampleCell(width, height int) (components.Vector2, bool) {
+	tx, ty := ss.rng.Intn(width), ss.rng.Intn(height)
+	if ss.density.IsSolid(tx, ty) {
+		return components.Vector2{}, false
+	}
+
+	density := ss.density.Density(tx, ty)
+	if density == 0 || ss.rng.Intn(255) >= int(density) {
+		return components.Vector2{}, false
+	}
+
+	tileSize := ss.density.GetTileSize()
+	return components.Vector2{
+		X: (float64(tx) + 0.5) * tileSize,
+		Y: (float64(ty) + 0.5) * tileSize,
+	}, true
+}
+
+// farEnough vérifie l'espacement minimal avec les entités déjà placées
+func (ss *SpawnSystem) farEnough(position components.Vector2) bool {
+	for _, other := range ss.spawned {
+		if math.Hypot(position.X-other.Position.X, position.Y-other.Position.Y) < ss.MinSeparation {
+			return false
+		}
+	}
+	return true
+}
+
+// Respawn recrée aux mêmes positions les entités placées d'un type ("" : toutes)
+func (ss *SpawnSystem) Respawn(kind string) {
+	if ss.Spawn == nil {
+		return
+	}
+	for _, spawned := range ss.spawned {
+		if kind == "" || spawned.Entry.Kind == kind {
+			ss.Spawn(spawned.Entry, spawned.Position)
+		}
+	}
+}
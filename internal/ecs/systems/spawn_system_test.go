@@ -0,0 +1,143 @@
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// uniformDensity grille de densité constante ; les tuiles de la colonne solidColumn sont des murs
+type uniformDensity struct {
+	width, height int
+	density       uint8
+	solidColumn   int
+}
+
+func (d uniformDensity) GridSize() (int, int)     { return d.width, d.height }
+func (d uniformDensity) GetTileSize() float64     { return 32 }
+func (d uniformDensity) Density(tx, ty int) uint8 { return d.density }
+func (d uniformDensity) IsSolid(tx, ty int) bool  { return tx == d.solidColumn }
+
+var slimeTable = SpawnTable{{Kind: "enemy", ID: "slime", Weight: 1}}
+
+// populate tente trials tirages sur une grille de densité uniforme
+func populate(density uint8, trials int, seed int64) int {
+	ss := NewSpawnSystem()
+	ss.SetDensityMap(uniformDensity{width: 40, height: 40, density: density, solidColumn: -1})
+	ss.SetRandomSource(rand.New(rand.NewSource(seed)))
+	ss.MinSeparation = 0
+	ss.AttemptsPerSpawn = 1
+	return len(ss.PopulateRoom(slimeTable, trials))
+}
+
+func TestSpawnDensityAffectsCount(t *testing.T) {
+	const trials = 2000
+
+	tests := []struct {
+		name    string
+		density uint8
+		minRate float64
+		maxRate float64
+	}{
+		{"densité nulle", 0, 0, 0},
+		{"densité 64", 64, 0.22, 0.28}, // ≈ 64/255
+		{"densité 128", 128, 0.47, 0.53},
+		{"densité 255", 255, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate := float64(populate(tt.density, trials, 1)) / trials
+			if rate < tt.minRate || rate > tt.maxRate {
+				t.Errorf("taux d'acceptation = %.3f, attendu entre %.2f et %.2f", rate, tt.minRate, tt.maxRate)
+			}
+		})
+	}
+
+	// Même graine, même nombre de tirages : la densité pleine place bien plus d'entités
+	dense, sparse := populate(255, trials, 7), populate(64, trials, 7)
+	if dense < sparse*3 {
+		t.Errorf("densité 255 : %d entités, densité 64 : %d, attendu au moins trois fois plus", dense, sparse)
+	}
+}
+
+func TestSpawnMinSeparation(t *testing.T) {
+	ss := NewSpawnSystem()
+	ss.SetDensityMap(uniformDensity{width: 20, height: 20, density: 255, solidColumn: 3})
+	ss.SetRandomSource(rand.New(rand.NewSource(3)))
+	ss.MinSeparation = 96
+
+	placed := ss.PopulateRoom(slimeTable, 30)
+	if len(placed) == 0 {
+		t.Fatal("aucune entité placée")
+	}
+	for i, a := range placed {
+		if column := int(a.Position.X / 32); column == 3 {
+			t.Errorf("entité %d placée sur un mur en %+v", i, a.Position)
+		}
+		for _, b := range placed[i+1:] {
+			if d := math.Hypot(a.Position.X-b.Position.X, a.Position.Y-b.Position.Y); d < ss.MinSeparation {
+				t.Errorf("entités à %.1f px, attendu au moins %.0f", d, ss.MinSeparation)
+			}
+		}
+	}
+}
+
+func TestSpawnTableWeights(t *testing.T) {
+	table := SpawnTable{
+		{Kind: "enemy", ID: "slime", Weight: 3},
+		{Kind: "item", ID: "potion", Weight: 1},
+		{Kind: "enemy", ID: "ignoré", Weight: 0},
+	}
+	rng := rand.New(rand.NewSource(5))
+
+	counts := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		entry, ok := table.Pick(rng)
+		if !ok {
+			t.Fatal("Pick a échoué sur une table non vide")
+		}
+		counts[entry.ID]++
+	}
+
+	if counts["ignoré"] != 0 {
+		t.Errorf("une entrée de poids nul a été tirée %d fois", counts["ignoré"])
+	}
+	if ratio := float64(counts["slime"]) / float64(counts["potion"]); ratio < 2.6 || ratio > 3.4 {
+		t.Errorf("rapport slime/potion = %.2f, attendu environ 3", ratio)
+	}
+	if _, ok := (SpawnTable{}).Pick(rng); ok {
+		t.Error("Pick doit échouer sur une table vide")
+	}
+}
+
+func TestSpawnRespawnByKind(t *testing.T) {
+	ss := NewSpawnSystem()
+	ss.SetDensityMap(uniformDensity{width: 20, height: 20, density: 255, solidColumn: -1})
+	ss.SetRandomSource(rand.New(rand.NewSource(9)))
+
+	spawned := make(map[string][]components.Vector2)
+	ss.Spawn = func(entry SpawnTableEntry, position components.Vector2) {
+		spawned[entry.Kind] = append(spawned[entry.Kind], position)
+	}
+	placed := ss.PopulateRoom(SpawnTable{{Kind: "enemy", ID: "slime", Weight: 1}, {Kind: "item", ID: "potion", Weight: 1}}, 6)
+
+	enemies := len(spawned["enemy"])
+	items := len(spawned["item"])
+	if enemies+items != len(placed) {
+		t.Fatalf("%d entités créées, attendu %d", enemies+items, len(placed))
+	}
+
+	ss.Respawn("enemy")
+	if len(spawned["enemy"]) != enemies*2 || len(spawned["item"]) != items {
+		t.Errorf("après Respawn(enemy) : %d ennemis, %d objets, attendu %d et %d",
+			len(spawned["enemy"]), len(spawned["item"]), enemies*2, items)
+	}
+	for i := 0; i < enemies; i++ {
+		if spawned["enemy"][enemies+i] != spawned["enemy"][i] {
+			t.Errorf("ennemi %d réapparu en %+v, attendu %+v", i, spawned["enemy"][enemies+i], spawned["enemy"][i])
+		}
+	}
+}
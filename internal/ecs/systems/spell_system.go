@@ -0,0 +1,244 @@
+// internal/ecs/systems/spell_system.go - Sorts du joueur : chaîne d'éclairs
+package systems
+
+import (
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// ChainLightningProjectile éclair en vol. Le premier part en ligne droite ;
+// chaque rebond vise l'ennemi suivant de la chaîne.
+type ChainLightningProjectile struct {
+	Position  components.Vector2
+	Direction components.Vector2 // Direction unitaire (tir initial)
+	Target    *EnemyEntity       // Ennemi visé par un rebond (nil : tir en ligne droite)
+
+	// Ennemis déjà touchés par cette chaîne : jamais visés à nouveau
+	HitList []uint32
+	Bounces int // Rebonds déjà effectués
+
+	origin    components.Vector2 // Point de départ du segment (traînée)
+	travelled float64
+	Active    bool
+}
+
+// lightningTrail segment lumineux entre deux rebonds, qui s'estompe
+type lightningTrail struct {
+	Start components.Vector2
+	End   components.Vector2
+	Age   time.Duration
+}
+
+// SpellSystem gère les éclairs en vol, leurs rebonds et leurs traînées
+type SpellSystem struct {
+	projectiles []*ChainLightningProjectile
+	trails      []lightningTrail
+
+	// Réglages de la chaîne d'éclairs
+	MaxBounces    int           // Rebonds au plus après le premier impact
+	BounceRange   float64       // Distance (pixels) de recherche du prochain ennemi
+	Damage        int           // Dégâts par impact
+	Speed         float64       // Vitesse des éclairs (pixels/s)
+	MaxRange      float64       // Portée du tir initial (pixels)
+	TrailDuration time.Duration // Durée d'affichage d'un segment
+
+	// Appelé à chaque ennemi touché
+	OnEnemyHit func(enemy *EnemyEntity, position components.Vector2)
+}
+
+// Taille de la zone d'impact d'un éclair
+const lightningHitRadius = 6.0
+
+// NewSpellSystem crée un nouveau système de sorts
+func NewSpellSystem() *SpellSystem {
+	return &SpellSystem{
+		projectiles:   make([]*ChainLightningProjectile, 0, 8),
+		trails:        make([]lightningTrail, 0, 8),
+		MaxBounces:    3,
+		BounceRange:   150,
+		Damage:        15,
+		Speed:         600,
+		MaxRange:      400,
+		TrailDuration: 200 * time.Millisecond,
+	}
+}
+
+// CastChainLightning lance un éclair en ligne droite depuis origin
+func (ss *SpellSystem) CastChainLightning(origin, direction components.Vector2) *ChainLightningProjectile {
+	length := math.Hypot(direction.X, direction.Y)
+	if length == 0 {
+		direction = components.Vector2{X: 1, Y: 0}
+		length = 1
+	}
+
+	projectile := &ChainLightningProjectile{
+		Position:  origin,
+		Direction: components.Vector2{X: direction.X / length, Y: direction.Y / length},
+		HitList:   make([]uint32, 0, ss.MaxBounces+1),
+		origin:    origin,
+		Active:    true,
+	}
+	ss.projectiles = append(ss.projectiles, projectile)
+	return projectile
+}
+
+// GetProjectiles retourne les éclairs en vol
+func (ss *SpellSystem) GetProjectiles() []*ChainLightningProjectile {
+	return ss.projectiles
+}
+
+// Clear supprime éclairs et traînées
+func (ss *SpellSystem) Clear() {
+	ss.projectiles = ss.projectiles[:0]
+	ss.trails = ss.trails[:0]
+}
+
+// Update déplace les éclairs, applique les impacts et fait vieillir les traînées
+func (ss *SpellSystem) Update(deltaTime time.Duration, enemies []*EnemyEntity) {
+	step := ss.Speed * deltaTime.Seconds()
+
+	// Les rebonds créés pendant la boucle partent à la frame suivante
+	current := ss.projectiles
+	ss.projectiles = make([]*ChainLightningProjectile, 0, len(current))
+	for _, projectile := range current {
+		if projectile.Target != nil {
+			ss.updateBounce(projectile, step, enemies)
+		} else {
+			ss.updateStraight(projectile, step, enemies)
+		}
+		if projectile.Active {
+			ss.projectiles = append(ss.projectiles, projectile)
+		}
+	}
+
+	trails := ss.trails[:0]
+	for _, trail := range ss.trails {
+		trail.Age += deltaTime
+		if trail.Age < ss.TrailDuration {
+			trails = append(trails, trail)
+		}
+	}
+	ss.trails = trails
+}
+
+// updateStraight avance un tir initial jusqu'au premier ennemi touché ou sa portée
+func (ss *SpellSystem) updateStraight(projectile *ChainLightningProjectile, step float64, enemies []*EnemyEntity) {
+	projectile.Position = projectile.Position.Add(projectile.Direction.Mul(step))
+	projectile.travelled += step
+
+	hitbox := components.Rectangle{
+		X:      projectile.Position.X - lightningHitRadius,
+		Y:      projectile.Position.Y - lightningHitRadius,
+		Width:  lightningHitRadius * 2,
+		Height: lightningHitRadius * 2,
+	}
+	for _, enemy := range enemies {
+		if enemy.IsTargetable() && boundsIntersect(hitbox, enemy.BodyBounds()) {
+			ss.hit(projectile, enemy, enemies)
+			return
+		}
+	}
+
+	if projectile.travelled >= ss.MaxRange {
+		ss.addTrail(projectile.origin, projectile.Position)
+		projectile.Active = false
+	}
+}
+
+// updateBounce rapproche un rebond de sa cible ; l'éclair s'éteint si elle meurt
+func (ss *SpellSystem) updateBounce(projectile *ChainLightningProjectile, step float64, enemies []*EnemyEntity) {
+	target := projectile.Target
+	if !target.IsTargetable() {
+		projectile.Active = false
+		return
+	}
+
+	diff := target.Position.Position.Sub(projectile.Position)
+	remaining := math.Hypot(diff.X, diff.Y)
+	if remaining <= step {
+		projectile.Position = target.Position.Position
+		ss.hit(projectile, target, enemies)
+		return
+	}
+	projectile.Position = projectile.Position.Add(diff.Mul(step / remaining))
+}
+
+// hit blesse l'ennemi puis lance un rebond vers le prochain ennemi de la chaîne
+func (ss *SpellSystem) hit(projectile *ChainLightningProjectile, enemy *EnemyEntity, enemies []*EnemyEntity) {
+	projectile.Active = false
+	projectile.HitList = append(projectile.HitList, enemy.EntityID)
+
+	position := enemy.Position.Position
+	ss.addTrail(projectile.origin, position)
+	enemy.Enemy.TakeDamage(ss.Damage)
+	if ss.OnEnemyHit != nil {
+		ss.OnEnemyHit(enemy, position)
+	}
+
+	if projectile.Bounces >= ss.MaxBounces {
+		return
+	}
+	next := NextChainTarget(position, enemies, projectile.HitList, ss.BounceRange)
+	if next == nil {
+		return
+	}
+
+	hitList := make([]uint32, len(projectile.HitList), len(projectile.HitList)+1)
+	copy(hitList, projectile.HitList)
+	ss.projectiles = append(ss.projectiles, &ChainLightningProjectile{
+		Position: position,
+		Target:   next,
+		HitList:  hitList,
+		Bounces:  projectile.Bounces + 1,
+		origin:   position,
+		Active:   true,
+	})
+}
+
+// NextChainTarget retourne l'ennemi vivant le plus proche à portée qui n'est
+// pas dans hitList (nil si aucun : la chaîne s'arrête)
+func NextChainTarget(from components.Vector2, enemies []*EnemyEntity, hitList []uint32, radius float64) *EnemyEntity {
+	var nearest *EnemyEntity
+	best := radius
+	for _, enemy := range enemies {
+		if !enemy.IsTargetable() || containsID(hitList, enemy.EntityID) {
+			continue
+		}
+		pos := enemy.Position.Position
+		if dist := math.Hypot(pos.X-from.X, pos.Y-from.Y); dist <= best {
+			nearest = enemy
+			best = dist
+		}
+	}
+	return nearest
+}
+
+// containsID indique si id figure dans la liste
+func containsID(ids []uint32, id uint32) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// addTrail ajoute un segment lumineux
+func (ss *SpellSystem) addTrail(start, end components.Vector2) {
+	ss.trails = append(ss.trails, lightningTrail{Start: start, End: end})
+}
+
+// Render trace les traînées (bleu vif qui s'estompe) et les éclairs en vol
+func (ss *SpellSystem) Render(renderer LineRenderer) {
+	for _, trail := range ss.trails {
+		fade := 1 - float64(trail.Age)/float64(ss.TrailDuration)
+		color := components.Color{R: 120, G: 200, B: 255, A: uint8(255 * fade)}
+		renderer.DrawLine(trail.Start, trail.End, color, 2)
+	}
+
+	bolt := components.Color{R: 200, G: 235, B: 255, A: 255}
+	for _, projectile := range ss.projectiles {
+		renderer.DrawLine(projectile.origin, projectile.Position, bolt, 2)
+	}
+}
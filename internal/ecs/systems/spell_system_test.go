@@ -0,0 +1,107 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// enemiesAt crée des ennemis aux abscisses données, sur la ligne y = 100
+func enemiesAt(xs ...float64) []*EnemyEntity {
+	enemies := make([]*EnemyEntity, len(xs))
+	for i, x := range xs {
+		enemies[i] = NewEnemyEntity(uint32(i+1), x, 100)
+	}
+	return enemies
+}
+
+// runChain lance un éclair vers la droite et retourne les IDs touchés, dans l'ordre
+func runChain(ss *SpellSystem, enemies []*EnemyEntity) []uint32 {
+	var hits []uint32
+	ss.OnEnemyHit = func(enemy *EnemyEntity, position components.Vector2) {
+		hits = append(hits, enemy.EntityID)
+	}
+
+	ss.CastChainLightning(components.Vector2{X: 0, Y: 100}, components.Vector2{X: 1, Y: 0})
+	for frame := 0; frame < 600 && len(ss.GetProjectiles()) > 0; frame++ {
+		ss.Update(time.Second/60, enemies)
+	}
+	return hits
+}
+
+func TestChainLightningBounces(t *testing.T) {
+	tests := []struct {
+		name       string
+		xs         []float64
+		maxBounces int
+		want       []uint32
+	}{
+		{"chaîne complète", []float64{100, 200, 300}, 3, []uint32{1, 2, 3}},
+		{"plus proche d'abord", []float64{100, 300, 220}, 3, []uint32{1, 3, 2}},
+		{"ennemi hors de portée", []float64{100, 200, 400}, 3, []uint32{1, 2}},
+		{"limite de rebonds", []float64{100, 200, 300, 400, 500, 600}, 3, []uint32{1, 2, 3, 4}},
+		{"sans rebond", []float64{100, 200}, 0, []uint32{1}},
+		{"aucun ennemi touché", []float64{1000}, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := NewSpellSystem()
+			ss.MaxBounces = tt.maxBounces
+			hits := runChain(ss, enemiesAt(tt.xs...))
+
+			if len(hits) != len(tt.want) {
+				t.Fatalf("ennemis touchés %v, attendu %v", hits, tt.want)
+			}
+			for i := range hits {
+				if hits[i] != tt.want[i] {
+					t.Fatalf("ennemis touchés %v, attendu %v", hits, tt.want)
+				}
+			}
+			if len(ss.GetProjectiles()) != 0 {
+				t.Error("la chaîne doit se terminer")
+			}
+		})
+	}
+}
+
+func TestChainLightningNeverRevisits(t *testing.T) {
+	// Deux ennemis proches : après A → B, A est le seul à portée mais déjà touché
+	enemies := enemiesAt(100, 150)
+	ss := NewSpellSystem()
+	ss.MaxBounces = 10
+	hits := runChain(ss, enemies)
+
+	if len(hits) != 2 {
+		t.Fatalf("ennemis touchés %v, attendu [1 2]", hits)
+	}
+	for _, enemy := range enemies {
+		if want := enemy.Enemy.MaxHealth - ss.Damage; enemy.Enemy.Health != want {
+			t.Errorf("ennemi %d : %d PV, attendu %d (touché une seule fois)", enemy.EntityID, enemy.Enemy.Health, want)
+		}
+	}
+}
+
+func TestNextChainTargetSkipsHitAndDead(t *testing.T) {
+	enemies := enemiesAt(110, 120, 130)
+	enemies[1].Enemy.TakeDamage(enemies[1].Enemy.MaxHealth)
+
+	from := components.Vector2{X: 100, Y: 100}
+	if next := NextChainTarget(from, enemies, []uint32{1}, 150); next != enemies[2] {
+		t.Errorf("cible suivante = %v, attendu l'ennemi 3", next)
+	}
+	if next := NextChainTarget(from, enemies, []uint32{1, 3}, 150); next != nil {
+		t.Errorf("cible suivante = ennemi %d, attendu aucune", next.EntityID)
+	}
+}
+
+func TestChainLightningTrailFades(t *testing.T) {
+	ss := NewSpellSystem()
+	runChain(ss, enemiesAt(100))
+
+	ss.Update(ss.TrailDuration, nil)
+	if len(ss.trails) != 0 {
+		t.Errorf("%d traînée(s) après %v, attendu 0", len(ss.trails), ss.TrailDuration)
+	}
+}
@@ -0,0 +1,52 @@
+// internal/ecs/systems/stat_tracker.go - Statistiques persistantes du joueur
+package systems
+
+import (
+	"time"
+)
+
+// StatTracker conserve les records du joueur d'une partie à l'autre
+type StatTracker struct {
+	challengeBestTimes map[string]time.Duration
+}
+
+// NewStatTracker crée un nouveau tracker de statistiques
+func NewStatTracker() *StatTracker {
+	return &StatTracker{
+		challengeBestTimes: make(map[string]time.Duration),
+	}
+}
+
+// RecordChallengeTime enregistre un temps de défi et retourne true si c'est un record
+func (st *StatTracker) RecordChallengeTime(roomID string, elapsed time.Duration) bool {
+	best, exists := st.challengeBestTimes[roomID]
+	if exists && best <= elapsed {
+		return false
+	}
+	st.challengeBestTimes[roomID] = elapsed
+	return true
+}
+
+// GetChallengeBestTime retourne le meilleur temps d'une salle (0 si aucun)
+func (st *StatTracker) GetChallengeBestTime(roomID string) time.Duration {
+	return st.challengeBestTimes[roomID]
+}
+
+// ChallengeBestTimes retourne une copie des records (pour la sauvegarde)
+func (st *StatTracker) ChallengeBestTimes() map[string]time.Duration {
+	times := make(map[string]time.Duration, len(st.challengeBestTimes))
+	for id, best := range st.challengeBestTimes {
+		times[id] = best
+	}
+	return times
+}
+
+// LoadChallengeBestTimes restaure les records depuis une sauvegarde
+func (st *StatTracker) LoadChallengeBestTimes(times map[string]time.Duration) {
+	st.challengeBestTimes = make(map[string]time.Duration, len(times))
+	for id, best := range times {
+		if best > 0 {
+			st.challengeBestTimes[id] = best
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// internal/ecs/systems/status_effect_system.go - Altérations d'état du joueur : sols empoisonnés
+package systems
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Intervalle entre deux bulles de poison au-dessus du joueur empoisonné
+const poisonBubbleInterval = 250 * time.Millisecond
+
+// Teinte verte du joueur empoisonné, ajoutée à sa couleur
+const (
+	poisonTintGreen = 50
+	poisonTintRed   = -30
+)
+
+// Bulles vertes qui montent du joueur empoisonné
+var PoisonBubbleBurst = BurstConfig{
+	Count: 2, Direction: -math.Pi / 2, Spread: math.Pi / 3, MinSpeed: 15, MaxSpeed: 35,
+	MinLifetime: 600 * time.Millisecond, MaxLifetime: 1000 * time.Millisecond,
+	Color: components.Color{R: 90, G: 200, B: 70, A: 200}, Size: 3, Gravity: -30,
+}
+
+// StatusEffectSystem applique les altérations d'état du joueur : à chaque
+// tick, la tuile sous ses pieds est interrogée ; un sol empoisonné lui pose
+// un poison (PoisonDoT) qui s'aggrave avec l'exposition. Tant qu'il agit, le
+// joueur est teinté de vert et des bulles s'en échappent.
+type StatusEffectSystem struct {
+	Poison components.PoisonDoT
+
+	// Sols empoisonnés dessinés sous les entités (en pixels)
+	PoisonFloors []components.Rectangle
+
+	grid      TileCollisionGrid
+	particles *ParticleSystem
+
+	bubbleTimer time.Duration
+
+	// Couleurs du joueur avant la teinte du poison
+	tinted       bool
+	spriteColor  components.Color
+	rendererTint components.Color
+
+	// Appelé quand le poison prend (premier cumul) et quand il est guéri ou dissipé
+	OnPoisoned func()
+	OnCured    func()
+}
+
+// NewStatusEffectSystem crée le système ; particles peut être nil
+func NewStatusEffectSystem(particles *ParticleSystem) *StatusEffectSystem {
+	return &StatusEffectSystem{
+		Poison:    components.NewPoisonDoT(),
+		particles: particles,
+	}
+}
+
+// SetGrid définit la grille de tuiles interrogée sous le joueur
+func (ses *StatusEffectSystem) SetGrid(grid TileCollisionGrid) {
+	ses.grid = grid
+}
+
+// IsPoisoned retourne si le poison agit sur le joueur
+func (ses *StatusEffectSystem) IsPoisoned() bool {
+	return ses.Poison.IsActive()
+}
+
+// onPoisonTile retourne si la position est sur un sol empoisonné
+func (ses *StatusEffectSystem) onPoisonTile(position components.Vector2) bool {
+	if ses.grid == nil {
+		return false
+	}
+	tile, ok := ses.grid.GetTileAt(position)
+	return ok && tile.Material.IsPoison()
+}
+
+// Update fait agir le poison sur le joueur pendant deltaTime
+func (ses *StatusEffectSystem) Update(deltaTime time.Duration, player *PlayerEntity) {
+	if player == nil || player.Player == nil {
+		return
+	}
+	if !player.Player.IsAlive() {
+		ses.Clear(player)
+		return
+	}
+
+	wasPoisoned := ses.Poison.IsActive()
+	exposed := ses.onPoisonTile(player.Position.Position)
+	player.Player.DrainHealth(ses.Poison.Tick(deltaTime, exposed))
+
+	switch {
+	case ses.Poison.IsActive() && !wasPoisoned:
+		fmt.Println("Empoisonné !")
+		if ses.OnPoisoned != nil {
+			ses.OnPoisoned()
+		}
+	case !ses.Poison.IsActive() && wasPoisoned:
+		fmt.Println("Le poison s'est dissipé")
+		if ses.OnCured != nil {
+			ses.OnCured()
+		}
+	}
+
+	ses.updateTint(player)
+	ses.updateBubbles(deltaTime, player)
+}
+
+// Cure guérit tous les cumuls de poison (antidote) ; retourne false si le
+// joueur n'était pas empoisonné
+func (ses *StatusEffectSystem) Cure(player *PlayerEntity) bool {
+	stacks := ses.Poison.Cure()
+	ses.updateTint(player)
+	if stacks == 0 {
+		return false
+	}
+	fmt.Printf("Poison guéri (%d cumul(s))\n", stacks)
+	if ses.OnCured != nil {
+		ses.OnCured()
+	}
+	return true
+}
+
+// Clear retire le poison sans prévenir (mort, nouvelle partie)
+func (ses *StatusEffectSystem) Clear(player *PlayerEntity) {
+	ses.Poison.Cure()
+	ses.bubbleTimer = 0
+	ses.updateTint(player)
+}
+
+// updateTint teinte le joueur de vert tant que le poison agit, et lui rend
+// ses couleurs ensuite
+func (ses *StatusEffectSystem) updateTint(player *PlayerEntity) {
+	if player == nil {
+		ses.tinted = false // Plus de joueur dont rétablir les couleurs
+		return
+	}
+	poisoned := ses.Poison.IsActive()
+	if poisoned == ses.tinted {
+		return
+	}
+
+	if poisoned {
+		if player.Sprite != nil {
+			ses.spriteColor = player.Sprite.Color
+			player.Sprite.Color = poisonTint(player.Sprite.Color)
+		}
+		if player.SpriteRenderer != nil {
+			ses.rendererTint = player.SpriteRenderer.Tint
+			player.SpriteRenderer.Tint = poisonTint(player.SpriteRenderer.Tint)
+		}
+	} else {
+		if player.Sprite != nil {
+			player.Sprite.Color = ses.spriteColor
+		}
+		if player.SpriteRenderer != nil {
+			player.SpriteRenderer.Tint = ses.rendererTint
+		}
+	}
+	ses.tinted = poisoned
+}
+
+// poisonTint verdit une couleur (G +50, R -30, bornés à 0-255)
+func poisonTint(color components.Color) components.Color {
+	shift := func(value uint8, delta int) uint8 {
+		return uint8(math.Max(0, math.Min(255, float64(int(value)+delta))))
+	}
+	color.G = shift(color.G, poisonTintGreen)
+	color.R = shift(color.R, poisonTintRed)
+	return color
+}
+
+// updateBubbles fait monter des bulles vertes du joueur empoisonné
+func (ses *StatusEffectSystem) updateBubbles(deltaTime time.Duration, player *PlayerEntity) {
+	if ses.particles == nil || !ses.Poison.IsActive() {
+		ses.bubbleTimer = 0
+		return
+	}
+	ses.bubbleTimer -= deltaTime
+	if ses.bubbleTimer > 0 {
+		return
+	}
+	ses.bubbleTimer = poisonBubbleInterval
+	origin := player.Position.Position
+	if player.Sprite != nil {
+		origin.Y -= player.Sprite.Size.Y / 2
+	}
+	ses.particles.EmitBurst(origin, PoisonBubbleBurst)
+}
+
+// Render dessine les sols empoisonnés
+func (ses *StatusEffectSystem) Render(renderer Renderer) {
+	for _, floor := range ses.PoisonFloors {
+		renderer.DrawRectangle(floor, components.Color{R: 70, G: 140, B: 50, A: 110}, true)
+	}
+}
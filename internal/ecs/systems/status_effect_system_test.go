@@ -0,0 +1,85 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// poisonFloor sol empoisonné sur x < 200, pierre ailleurs
+type poisonFloor struct{}
+
+func (poisonFloor) GetTileAt(position components.Vector2) (components.TileComponent, bool) {
+	if position.X < 200 {
+		return components.TileComponent{Material: components.MaterialPoison}, true
+	}
+	return components.TileComponent{Material: components.MaterialStone}, true
+}
+
+// standOnPoison met à jour le système pendant duration, par frames de 60 Hz
+func standOnPoison(ses *StatusEffectSystem, player *PlayerEntity, duration time.Duration) {
+	for elapsed := time.Duration(0); elapsed < duration; elapsed += testFrame {
+		ses.Update(testFrame, player)
+	}
+}
+
+func TestStatusEffectPoisonEscalates(t *testing.T) {
+	ses := NewStatusEffectSystem(nil)
+	ses.SetGrid(poisonFloor{})
+	player := NewPlayerEntity(100, 100)
+	player.Player.Health = 1000
+	player.Player.MaxHealth = 1000
+
+	standOnPoison(ses, player, 3500*time.Millisecond)
+	if ses.Poison.Stacks != 2 {
+		t.Errorf("cumuls après 3,5 s = %d, attendu 2", ses.Poison.Stacks)
+	}
+	standOnPoison(ses, player, 2*time.Second)
+	if ses.Poison.Stacks != 4 {
+		t.Errorf("cumuls après 5,5 s = %d, attendu 4", ses.Poison.Stacks)
+	}
+	if player.Player.Health >= 1000 {
+		t.Error("le poison doit retirer des points de vie")
+	}
+}
+
+func TestStatusEffectCure(t *testing.T) {
+	ses := NewStatusEffectSystem(nil)
+	ses.SetGrid(poisonFloor{})
+	player := NewPlayerEntity(100, 100)
+	player.Player.Health = 1000
+	color := player.Sprite.Color
+	cured := 0
+	ses.OnCured = func() { cured++ }
+
+	standOnPoison(ses, player, 6*time.Second)
+	if player.Sprite.Color == color {
+		t.Fatal("le joueur empoisonné doit être teinté de vert")
+	}
+
+	// L'antidote retire tous les cumuls, même sur le sol empoisonné
+	if !ses.Cure(player) {
+		t.Fatal("Cure doit réussir sur un joueur empoisonné")
+	}
+	if ses.IsPoisoned() || ses.Poison.Stacks != 0 {
+		t.Errorf("cumuls après l'antidote = %d, attendu 0", ses.Poison.Stacks)
+	}
+	if player.Sprite.Color != color {
+		t.Errorf("couleur = %+v, attendu %+v", player.Sprite.Color, color)
+	}
+	if cured != 1 {
+		t.Errorf("OnCured appelé %d fois, attendu 1", cured)
+	}
+	if ses.Cure(player) {
+		t.Error("Cure sur un joueur sain doit échouer")
+	}
+
+	// Hors du sol, plus rien ne l'empoisonne
+	player.Position.Position.X = 300
+	health := player.Player.Health
+	standOnPoison(ses, player, time.Second)
+	if player.Player.Health != health || ses.IsPoisoned() {
+		t.Errorf("points de vie = %d, attendu %d", player.Player.Health, health)
+	}
+}
@@ -0,0 +1,115 @@
+// internal/ecs/systems/transparency_system.go - Décors de premier plan estompés devant le joueur
+package systems
+
+import (
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// PropEntity décor du monde (pilier, arbre...), dessiné au premier plan
+type PropEntity struct {
+	Position   *components.PositionComponent
+	Sprite     *components.SpriteComponent
+	Occludable *components.OccludableComponent // nil si le décor ne s'estompe pas
+
+	EntityID uint32
+}
+
+// GetBounds retourne le rectangle du décor dans le monde
+func (pe *PropEntity) GetBounds() components.Rectangle {
+	position := pe.Position.Position
+	size := pe.Sprite.Size
+	return components.Rectangle{
+		X:      position.X - size.X/2 + pe.Sprite.Offset.X,
+		Y:      position.Y - size.Y/2 + pe.Sprite.Offset.Y,
+		Width:  size.X,
+		Height: size.Y,
+	}
+}
+
+// TransparencySystem estompe les décors qui masquent le joueur
+type TransparencySystem struct {
+	props  []*PropEntity
+	nextID uint32
+}
+
+// NewTransparencySystem crée un nouveau système de transparence
+func NewTransparencySystem() *TransparencySystem {
+	return &TransparencySystem{
+		props:  make([]*PropEntity, 0),
+		nextID: 10000, // Plage d'IDs réservée aux décors
+	}
+}
+
+// AddProp ajoute un décor ; occludable indique s'il s'estompe devant le joueur
+func (ts *TransparencySystem) AddProp(x, y, width, height float64, color components.Color, occludable bool) *PropEntity {
+	prop := &PropEntity{
+		Position: components.NewPositionComponent(x, y),
+		Sprite:   components.NewSpriteComponent("prop", width, height),
+		EntityID: ts.nextID,
+	}
+	prop.Sprite.Color = color
+	prop.Sprite.Layer = 20 // Au-dessus du joueur
+
+	if occludable {
+		prop.Occludable = components.NewOccludableComponent(8.0, 90)
+	}
+
+	ts.nextID++
+	ts.props = append(ts.props, prop)
+	return prop
+}
+
+// GetProps retourne les décors
+func (ts *TransparencySystem) GetProps() []*PropEntity {
+	return ts.props
+}
+
+// Clear supprime tous les décors
+func (ts *TransparencySystem) Clear() {
+	ts.props = ts.props[:0]
+}
+
+// Update met à jour l'opacité des décors (en temps réel, pas en temps de jeu)
+func (ts *TransparencySystem) Update(deltaTime time.Duration, playerPos components.Vector2) {
+	for _, prop := range ts.props {
+		if prop.Occludable == nil {
+			continue
+		}
+
+		occluding := prop.Occludable.Occludes(prop.GetBounds(), playerPos)
+		prop.Sprite.Color.A = prop.Occludable.Update(occluding, deltaTime)
+	}
+}
+
+// Render dessine les décors (à appeler après le joueur)
+func (ts *TransparencySystem) Render(renderer Renderer) {
+	for _, prop := range ts.props {
+		if !prop.Sprite.Visible {
+			continue
+		}
+		ts.renderProp(renderer, prop)
+	}
+}
+
+// Submit soumet les décors visibles à la file de rendu, sur leur couche
+func (ts *TransparencySystem) Submit(queue *RenderQueue) {
+	for _, prop := range ts.props {
+		if !prop.Sprite.Visible {
+			continue
+		}
+		prop := prop
+		queue.Submit(prop.Sprite.Layer, prop.Sprite.SortY(prop.Position.Position), func(renderer Renderer) {
+			ts.renderProp(renderer, prop)
+		})
+	}
+}
+
+// renderProp dessine un décor et sa bordure
+func (ts *TransparencySystem) renderProp(renderer Renderer, prop *PropEntity) {
+	bounds := prop.GetBounds()
+	renderer.DrawRectangle(bounds, prop.Sprite.Color, true)
+
+	border := components.Color{R: 40, G: 40, B: 40, A: prop.Sprite.Color.A}
+	renderer.DrawRectangle(bounds, border, false)
+}
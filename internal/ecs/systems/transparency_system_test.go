@@ -0,0 +1,75 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// advanceTransparency fait avancer le système par pas de 10 ms
+func advanceTransparency(ts *TransparencySystem, duration time.Duration, playerPos components.Vector2) {
+	const step = 10 * time.Millisecond
+	for elapsed := time.Duration(0); elapsed < duration; elapsed += step {
+		ts.Update(step, playerPos)
+	}
+}
+
+func TestTransparencyFadeThreshold(t *testing.T) {
+	// Pilier de 40x40 centré en (100, 100) : bord droit à 120, seuil à 128
+	tests := []struct {
+		name     string
+		playerX  float64
+		wantFade bool
+	}{
+		{"derrière le pilier", 100, true},
+		{"1 px dans le seuil", 127, true},
+		{"sur le seuil", 128, true},
+		{"1 px hors du seuil", 129, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := NewTransparencySystem()
+			prop := ts.AddProp(100, 100, 40, 40, components.ColorWhite, true)
+
+			ts.Update(time.Second/60, components.Vector2{X: tt.playerX, Y: 100})
+
+			if fading := prop.Sprite.Color.A < 255; fading != tt.wantFade {
+				t.Errorf("alpha = %d, estompé = %t, attendu %t", prop.Sprite.Color.A, fading, tt.wantFade)
+			}
+		})
+	}
+}
+
+func TestTransparencyRestoresAfterFadeDuration(t *testing.T) {
+	ts := NewTransparencySystem()
+	prop := ts.AddProp(100, 100, 40, 40, components.ColorWhite, true)
+	behind := components.Vector2{X: 100, Y: 100}
+	away := components.Vector2{X: 300, Y: 100}
+
+	advanceTransparency(ts, 200*time.Millisecond, behind)
+	if prop.Sprite.Color.A != prop.Occludable.FadedAlpha {
+		t.Fatalf("alpha = %d après 200 ms derrière le pilier, attendu %d", prop.Sprite.Color.A, prop.Occludable.FadedAlpha)
+	}
+
+	advanceTransparency(ts, 100*time.Millisecond, away)
+	if a := prop.Sprite.Color.A; a == 255 || a == prop.Occludable.FadedAlpha {
+		t.Errorf("alpha = %d à mi-fondu, attendu une valeur intermédiaire", a)
+	}
+
+	advanceTransparency(ts, 100*time.Millisecond, away)
+	if prop.Sprite.Color.A != 255 {
+		t.Errorf("alpha = %d 200 ms après l'éloignement, attendu 255", prop.Sprite.Color.A)
+	}
+}
+
+func TestTransparencyIgnoresSolidProps(t *testing.T) {
+	ts := NewTransparencySystem()
+	prop := ts.AddProp(100, 100, 40, 40, components.ColorWhite, false)
+
+	advanceTransparency(ts, 200*time.Millisecond, components.Vector2{X: 100, Y: 100})
+	if prop.Sprite.Color.A != 255 {
+		t.Errorf("alpha = %d, attendu 255 pour un décor non occultant", prop.Sprite.Color.A)
+	}
+}
@@ -0,0 +1,254 @@
+// internal/ecs/systems/treasure_room_system.go - Salle au trésor : plaques, rochers et coffre
+package systems
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// Réglages de la salle au trésor
+const (
+	boulderSize     = 28.0
+	boulderMass     = 3.0
+	boulderFriction = 1200.0 // pixels/s²
+	plateRadius     = 10.0   // Écart toléré entre le centre d'un rocher et celui de la plaque
+	plateMinMass    = 2.0    // Masse minimale pour enfoncer une plaque
+	chestSize       = 24.0
+)
+
+// PressurePlate plaque enfoncée tant qu'un objet assez lourd repose dessus
+type PressurePlate struct {
+	Position components.Vector2
+	Radius   float64
+	MinMass  float64
+	Active   bool
+}
+
+// Boulder rocher poussé par le joueur
+type Boulder struct {
+	Position components.Vector2
+	Size     float64
+	Physics  *components.PhysicsObjectComponent
+}
+
+// Bounds retourne la zone occupée par le rocher
+func (b *Boulder) Bounds() components.Rectangle {
+	return components.Rectangle{X: b.Position.X - b.Size/2, Y: b.Position.Y - b.Size/2, Width: b.Size, Height: b.Size}
+}
+
+// TreasureChest coffre scellé par les plaques de pression
+type TreasureChest struct {
+	Position components.Vector2
+	Loot     []string
+	Opened   bool
+}
+
+// ReinforcedDoor porte de l'entrée, fermée jusqu'à ce que le joueur l'ouvre
+type ReinforcedDoor struct {
+	Bounds components.Rectangle
+	Open   bool
+}
+
+// InteractionPosition implémente Interactable
+func (d *ReinforcedDoor) InteractionPosition() components.Vector2 {
+	return components.Vector2{X: d.Bounds.X + d.Bounds.Width/2, Y: d.Bounds.Y + d.Bounds.Height/2}
+}
+
+// InteractionRadius implémente Interactable
+func (d *ReinforcedDoor) InteractionRadius() float64 {
+	return d.Bounds.Width
+}
+
+// Interact implémente Interactable : la porte s'ouvre
+func (d *ReinforcedDoor) Interact(player *PlayerEntity) {
+	if d.Open {
+		return
+	}
+	d.Open = true
+	fmt.Println("✓ Porte renforcée ouverte")
+}
+
+// TreasureRoom salle au trésor : le coffre s'ouvre quand toutes les plaques
+// sont enfoncées en même temps par les rochers
+type TreasureRoom struct {
+	Walls    []components.Rectangle
+	Door     *ReinforcedDoor
+	Plates   []*PressurePlate
+	Boulders []*Boulder
+	Chest    *TreasureChest
+
+	// Appelé à l'ouverture du coffre (apparition du butin)
+	OnChestOpened func(chest *TreasureChest)
+}
+
+// NewTreasureRoom crée une salle : un rocher par position, une plaque par position
+func NewTreasureRoom(walls []components.Rectangle, door components.Rectangle, chest components.Vector2, plates, boulders []components.Vector2, loot []string) *TreasureRoom {
+	room := &TreasureRoom{
+		Walls: walls,
+		Door:  &ReinforcedDoor{Bounds: door},
+		Chest: &TreasureChest{Position: chest, Loot: loot},
+	}
+	for _, position := range plates {
+		room.Plates = append(room.Plates, &PressurePlate{Position: position, Radius: plateRadius, MinMass: plateMinMass})
+	}
+	for _, position := range boulders {
+		room.Boulders = append(room.Boulders, &Boulder{
+			Position: position,
+			Size:     boulderSize,
+			Physics:  components.NewPhysicsObjectComponent(boulderMass, boulderFriction),
+		})
+	}
+	return room
+}
+
+// Update pousse les rochers au contact du joueur, met à jour les plaques et
+// ouvre le coffre quand elles sont toutes enfoncées
+func (tr *TreasureRoom) Update(deltaTime time.Duration, player *PlayerEntity) {
+	dt := deltaTime.Seconds()
+
+	for _, boulder := range tr.Boulders {
+		boulder.Position = boulder.Position.Add(boulder.Physics.Update(dt))
+		tr.blockBoulder(boulder)
+	}
+
+	if player != nil && player.Active {
+		tr.resolvePlayer(player, dt)
+	}
+
+	for _, plate := range tr.Plates {
+		plate.Active = tr.plateCovered(plate)
+	}
+
+	if !tr.Chest.Opened && tr.AllPlatesActive() {
+		tr.Chest.Opened = true
+		fmt.Printf("✓ Coffre ouvert: %v\n", tr.Chest.Loot)
+		if tr.OnChestOpened != nil {
+			tr.OnChestOpened(tr.Chest)
+		}
+	}
+}
+
+// AllPlatesActive retourne si toutes les plaques sont enfoncées
+func (tr *TreasureRoom) AllPlatesActive() bool {
+	if len(tr.Plates) == 0 {
+		return false
+	}
+	for _, plate := range tr.Plates {
+		if !plate.Active {
+			return false
+		}
+	}
+	return true
+}
+
+// plateCovered vérifie qu'un objet assez lourd repose sur la plaque
+func (tr *TreasureRoom) plateCovered(plate *PressurePlate) bool {
+	for _, boulder := range tr.Boulders {
+		if boulder.Physics.Mass < plate.MinMass {
+			continue
+		}
+		if math.Hypot(boulder.Position.X-plate.Position.X, boulder.Position.Y-plate.Position.Y) <= plate.Radius {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePlayer sépare le joueur des rochers (qu'il pousse selon leur masse)
+// et de la porte fermée
+func (tr *TreasureRoom) resolvePlayer(player *PlayerEntity, dt float64) {
+	for _, boulder := range tr.Boulders {
+		bounds := player.Collider.GetWorldBounds(player.Position.Position)
+		if !boundsIntersect(bounds, boulder.Bounds()) {
+			continue
+		}
+
+		// Le rocher sort du joueur ; le joueur recule de la part non absorbée
+		push, _ := separateAxis(boulder.Bounds(), bounds)
+		share := boulder.Physics.PushShare(components.PlayerPushMass)
+		boulder.Position = boulder.Position.Add(push.Mul(share))
+		boulder.Physics.Push(push.Mul(share), dt)
+		player.Position.Position = player.Position.Position.Sub(push.Mul(1 - share))
+
+		// Un rocher bloqué par un mur ou un autre rocher arrête le joueur
+		if blocked := tr.blockBoulder(boulder); blocked.X != 0 || blocked.Y != 0 {
+			player.Position.Position = player.Position.Position.Add(blocked)
+		}
+	}
+
+	if !tr.Door.Open {
+		bounds := player.Collider.GetWorldBounds(player.Position.Position)
+		if boundsIntersect(bounds, tr.Door.Bounds) {
+			push, _ := separateAxis(bounds, tr.Door.Bounds)
+			player.Position.Position = player.Position.Position.Add(push)
+		}
+	}
+}
+
+// blockBoulder sépare un rocher des murs, de la porte et des autres rochers ;
+// retourne la correction appliquée (nulle s'il était libre)
+func (tr *TreasureRoom) blockBoulder(boulder *Boulder) components.Vector2 {
+	var correction components.Vector2
+
+	obstacles := make([]components.Rectangle, 0, len(tr.Walls)+len(tr.Boulders))
+	obstacles = append(obstacles, tr.Walls...)
+	obstacles = append(obstacles, tr.Door.Bounds)
+	for _, other := range tr.Boulders {
+		if other != boulder {
+			obstacles = append(obstacles, other.Bounds())
+		}
+	}
+
+	for _, obstacle := range obstacles {
+		if !boundsIntersect(boulder.Bounds(), obstacle) {
+			continue
+		}
+		push, _ := separateAxis(boulder.Bounds(), obstacle)
+		boulder.Position = boulder.Position.Add(push)
+		correction = correction.Add(push)
+	}
+
+	if correction.X != 0 || correction.Y != 0 {
+		boulder.Physics.Stop()
+	}
+	return correction
+}
+
+// Render dessine les murs, la porte, les plaques, le coffre et les rochers
+func (tr *TreasureRoom) Render(renderer Renderer) {
+	wallColor := components.Color{R: 70, G: 70, B: 80, A: 255}
+	for _, wall := range tr.Walls {
+		renderer.DrawRectangle(wall, wallColor, true)
+	}
+
+	if !tr.Door.Open {
+		renderer.DrawRectangle(tr.Door.Bounds, components.Color{R: 110, G: 80, B: 50, A: 255}, true)
+		renderer.DrawRectangle(tr.Door.Bounds, components.Color{R: 180, G: 180, B: 190, A: 255}, false)
+	}
+
+	for _, plate := range tr.Plates {
+		color := components.Color{R: 90, G: 90, B: 60, A: 255}
+		if plate.Active {
+			color = components.Color{R: 230, G: 200, B: 60, A: 255}
+		}
+		size := plate.Radius * 2.4
+		renderer.DrawRectangle(components.Rectangle{
+			X: plate.Position.X - size/2, Y: plate.Position.Y - size/2, Width: size, Height: size,
+		}, color, true)
+	}
+
+	chestColor := components.Color{R: 150, G: 100, B: 30, A: 255}
+	if tr.Chest.Opened {
+		chestColor = components.Color{R: 80, G: 60, B: 30, A: 255}
+	}
+	renderer.DrawRectangle(components.Rectangle{
+		X: tr.Chest.Position.X - chestSize/2, Y: tr.Chest.Position.Y - chestSize/2, Width: chestSize, Height: chestSize,
+	}, chestColor, true)
+
+	for _, boulder := range tr.Boulders {
+		renderer.DrawRectangle(boulder.Bounds(), components.Color{R: 130, G: 125, B: 115, A: 255}, true)
+		renderer.DrawRectangle(boulder.Bounds(), components.Color{R: 60, G: 55, B: 50, A: 255}, false)
+	}
+}
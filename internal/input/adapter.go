@@ -28,6 +28,26 @@ func (w *InputManagerWrapperFixed) IsActionPressed(action int) bool {
 	return w.inputManager.IsActionCorePressed(action)
 }
 
+// IsMouseButtonPressed vérifie si un bouton de souris est enfoncé
+func (w *InputManagerWrapperFixed) IsMouseButtonPressed(button int) bool {
+	return w.inputManager.IsMouseButtonPressed(button)
+}
+
+// IsMouseButtonJustPressed vérifie si un bouton de souris vient d'être enfoncé
+func (w *InputManagerWrapperFixed) IsMouseButtonJustPressed(button int) bool {
+	return w.inputManager.IsMouseButtonJustPressed(button)
+}
+
+// WheelDelta retourne le défilement de la molette cette frame
+func (w *InputManagerWrapperFixed) WheelDelta() (float64, float64) {
+	return w.inputManager.WheelDelta()
+}
+
+// CameraZoomInput retourne les crans de zoom demandés (molette et clavier)
+func (w *InputManagerWrapperFixed) CameraZoomInput() float64 {
+	return w.inputManager.CameraZoomInput()
+}
+
 // IsWindowCloseRequested (interface core)
 func (w *InputManagerWrapperFixed) IsWindowCloseRequested() bool {
 	return w.inputManager.IsWindowCloseRequested()
@@ -3,27 +3,73 @@ package input
 
 import (
 	"fmt"
+	"zelda-souls-game/internal/core"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// keyEvent état d'une touche enregistré à la fin d'une frame
+type keyEvent struct {
+	Key     ebiten.Key
+	Pressed bool
+}
+
+// trackedKeys touches dont l'état est historisé
+var trackedKeys = []ebiten.Key{
+	ebiten.KeyEscape,
+	ebiten.KeyI,
+	ebiten.KeySpace,
+	ebiten.KeyC,
+	ebiten.KeyE,
+	ebiten.KeyF,
+	ebiten.KeyW, ebiten.KeyZ,
+	ebiten.KeyS,
+	ebiten.KeyA, ebiten.KeyQ,
+	ebiten.KeyD,
+	ebiten.KeyG,
+	ebiten.KeyR,
+	ebiten.KeyV,
+	ebiten.KeyShiftLeft, ebiten.KeyShiftRight,
+}
+
+// keyHistoryFrames nombre de frames conservées dans l'historique des touches
+const keyHistoryFrames = 8
+
 // FinalInputWrapper wrapper final sans imports cycliques ni conflits
 type FinalInputWrapper struct {
 	inputManager  *InputManagerImpl
 	coreGame      interface{}
-	lastFrameKeys map[ebiten.Key]bool
+	keyHistory    *core.RingBuffer[keyEvent]
+
+	// Macros d'entrées (tests de gameplay)
+	macroRecorder *MacroRecorder
+	macroPlayer   *MacroPlayer
+	lastMacro     Macro
+	macroPaused   func() bool // Suspend macros (ex: console ouverte)
+
+	// Manettes branchées ou débranchées en cours de partie
+	gamepadHotplug *GamepadHotplugMonitor
 	
 	// État des actions pour éviter les répétitions
 	lastPauseState     bool
 	lastInstructState  bool
+	lastHUDState       bool
+	lastCraftState     bool
+	lastSkillState     bool
 }
 
 // NewFinalInputWrapper crée un wrapper final
 func NewFinalInputWrapper(im *InputManagerImpl) *FinalInputWrapper {
-	return &FinalInputWrapper{
-		inputManager:  im,
-		lastFrameKeys: make(map[ebiten.Key]bool),
+	w := &FinalInputWrapper{
+		inputManager:   im,
+		keyHistory:     core.NewRingBuffer[keyEvent](len(trackedKeys) * keyHistoryFrames),
+		macroRecorder:  NewMacroRecorder(),
+		macroPlayer:    NewMacroPlayer(),
+		gamepadHotplug: NewGamepadHotplugMonitor(),
 	}
+	w.gamepadHotplug.OnConnected = w.onGamepadConnected
+	w.gamepadHotplug.OnDisconnected = w.onGamepadDisconnected
+	return w
 }
 
 // SetCoreGame injecte le jeu core
@@ -35,11 +81,50 @@ func (w *FinalInputWrapper) SetCoreGame(cg interface{}) {
 // Update met à jour et traite les actions
 func (w *FinalInputWrapper) Update() {
 	w.inputManager.Update()
+	if w.macroPaused == nil || !w.macroPaused() {
+		w.macroRecorder.RecordFrame(w.inputManager)
+		w.macroPlayer.Advance()
+	}
 	w.updateMouseInput()
+	w.gamepadHotplug.Update()
 	w.handleGlobalActions()
 	w.updateLastFrameKeys()
 }
 
+// GetGamepadHotplug retourne le moniteur des manettes
+func (w *FinalInputWrapper) GetGamepadHotplug() *GamepadHotplugMonitor {
+	return w.gamepadHotplug
+}
+
+// onGamepadConnected signale au gestionnaire d'états une manette branchée
+func (w *FinalInputWrapper) onGamepadConnected(event GamepadConnectedEvent) {
+	if sm, ok := w.stateManager().(interface {
+		GamepadConnected(id int)
+	}); ok {
+		sm.GamepadConnected(int(event.ID))
+	}
+}
+
+// onGamepadDisconnected signale au gestionnaire d'états une manette débranchée
+// (qui met le jeu en pause)
+func (w *FinalInputWrapper) onGamepadDisconnected(event GamepadDisconnectedEvent) {
+	if sm, ok := w.stateManager().(interface {
+		GamepadDisconnected(id int)
+	}); ok {
+		sm.GamepadDisconnected(int(event.ID))
+	}
+}
+
+// stateManager retourne le gestionnaire d'états du jeu core (nil sans jeu)
+func (w *FinalInputWrapper) stateManager() interface{} {
+	if provider, ok := w.coreGame.(interface {
+		GetBuiltinStateManager() interface{}
+	}); ok {
+		return provider.GetBuiltinStateManager()
+	}
+	return nil
+}
+
 // updateMouseInput met à jour les entrées souris - SOLUTION SIMPLE
 func (w *FinalInputWrapper) updateMouseInput() {
 	if w.coreGame == nil {
@@ -102,6 +187,10 @@ func (w *FinalInputWrapper) handleGlobalActions() {
 		fmt.Println("ESC pressé - traitement global...")
 		
 		if sm, ok := stateManager.(interface {
+			TogglePause()
+		}); ok {
+			sm.TogglePause()
+		} else if sm, ok := stateManager.(interface {
 			GetCurrentStateType() interface{}
 			ChangeState(interface{})
 		}); ok {
@@ -137,6 +226,52 @@ func (w *FinalInputWrapper) handleGlobalActions() {
 		}
 	}
 	w.lastInstructState = iPressed
+
+	// H - Toggle HUD (seulement en gameplay)
+	hPressed := ebiten.IsKeyPressed(ebiten.KeyH)
+	if hPressed && !w.lastHUDState {
+		if sm, ok := stateManager.(interface {
+			IsInGame() bool
+			ToggleHUD()
+		}); ok && sm.IsInGame() {
+			sm.ToggleHUD()
+		}
+	}
+	w.lastHUDState = hPressed
+
+	// K - Panneau de fabrication (seulement en gameplay)
+	kPressed := ebiten.IsKeyPressed(ebiten.KeyK)
+	if kPressed && !w.lastCraftState {
+		if sm, ok := stateManager.(interface {
+			IsInGame() bool
+			ToggleCrafting()
+		}); ok && sm.IsInGame() {
+			sm.ToggleCrafting()
+		}
+	}
+	w.lastCraftState = kPressed
+
+	// T - Arbre de compétences (seulement en gameplay)
+	tPressed := ebiten.IsKeyPressed(ebiten.KeyT)
+	if tPressed && !w.lastSkillState {
+		if sm, ok := stateManager.(interface {
+			IsInGame() bool
+			ToggleSkillTree()
+		}); ok && sm.IsInGame() {
+			sm.ToggleSkillTree()
+		}
+	}
+	w.lastSkillState = tPressed
+
+	// Origine - Recentrer la caméra sur le joueur (seulement en gameplay)
+	if w.inputManager.IsActionPressed(ActionCameraReset) {
+		if sm, ok := stateManager.(interface {
+			IsInGame() bool
+			RecenterCamera()
+		}); ok && sm.IsInGame() {
+			sm.RecenterCamera()
+		}
+	}
 }
 
 // ===============================
@@ -148,7 +283,7 @@ func (w *FinalInputWrapper) IsKeyJustPressed(key int) bool {
 }
 
 func (w *FinalInputWrapper) IsActionPressed(action int) bool {
-	return w.inputManager.IsActionPressedSystems(action)
+	return w.IsActionPressedSystems(action)
 }
 
 func (w *FinalInputWrapper) IsWindowCloseRequested() bool {
@@ -160,13 +295,77 @@ func (w *FinalInputWrapper) IsWindowCloseRequested() bool {
 // ===============================
 
 func (w *FinalInputWrapper) IsActionPressedSystems(action int) bool {
+	// Le rejeu d'une macro remplace les entrées réelles
+	if w.macroPlayer.IsPlaying() {
+		return w.macroPlayer.IsActionPressedSystems(action)
+	}
 	return w.inputManager.IsActionPressedSystems(action)
 }
 
+// ===============================
+// MACROS
+// ===============================
+
+// SetMacroPauseCondition suspend l'enregistrement et le rejeu tant que
+// la condition est vraie (le jeu est figé, la saisie ne doit pas être enregistrée)
+func (w *FinalInputWrapper) SetMacroPauseCondition(paused func() bool) {
+	w.macroPaused = paused
+}
+
+// StartMacroRecording démarre l'enregistrement des actions
+func (w *FinalInputWrapper) StartMacroRecording() {
+	w.macroRecorder.StartRecording()
+}
+
+// StopMacroRecording termine l'enregistrement et conserve la macro
+func (w *FinalInputWrapper) StopMacroRecording() Macro {
+	w.lastMacro = w.macroRecorder.StopRecording()
+	return w.lastMacro
+}
+
+// PlayMacro rejoue une macro
+func (w *FinalInputWrapper) PlayMacro(m Macro) {
+	w.macroPlayer.Play(m)
+}
+
+// PlayLastMacro rejoue la dernière macro enregistrée
+func (w *FinalInputWrapper) PlayLastMacro() bool {
+	if w.lastMacro.Frames == 0 {
+		return false
+	}
+	w.macroPlayer.Play(w.lastMacro)
+	return true
+}
+
+// IsRecordingMacro retourne si un enregistrement est en cours
+func (w *FinalInputWrapper) IsRecordingMacro() bool {
+	return w.macroRecorder.IsRecording()
+}
+
 func (w *FinalInputWrapper) IsKeyJustPressedSystems(key int) bool {
 	return w.wasKeyJustPressed(ebiten.Key(key))
 }
 
+// IsMouseButtonPressed vérifie si un bouton de souris (ebiten.MouseButton) est enfoncé
+func (w *FinalInputWrapper) IsMouseButtonPressed(button int) bool {
+	return w.inputManager.IsMouseButtonPressed(button)
+}
+
+// IsMouseButtonJustPressed vérifie si un bouton de souris vient d'être enfoncé
+func (w *FinalInputWrapper) IsMouseButtonJustPressed(button int) bool {
+	return w.inputManager.IsMouseButtonJustPressed(button)
+}
+
+// WheelDelta retourne le défilement de la molette cette frame
+func (w *FinalInputWrapper) WheelDelta() (float64, float64) {
+	return w.inputManager.WheelDelta()
+}
+
+// CameraZoomInput retourne les crans de zoom demandés (molette et clavier)
+func (w *FinalInputWrapper) CameraZoomInput() float64 {
+	return w.inputManager.CameraZoomInput()
+}
+
 // ===============================
 // MÉTHODES UTILITAIRES
 // ===============================
@@ -174,28 +373,24 @@ func (w *FinalInputWrapper) IsKeyJustPressedSystems(key int) bool {
 // wasKeyJustPressed vérifie si une touche vient d'être pressée cette frame
 func (w *FinalInputWrapper) wasKeyJustPressed(key ebiten.Key) bool {
 	currentlyPressed := ebiten.IsKeyPressed(key)
-	wasPressed := w.lastFrameKeys[key]
-	return currentlyPressed && !wasPressed
+	return currentlyPressed && !w.wasKeyPressedLastFrame(key)
+}
+
+// wasKeyPressedLastFrame cherche l'état le plus récent d'une touche dans l'historique
+func (w *FinalInputWrapper) wasKeyPressedLastFrame(key ebiten.Key) bool {
+	for i := w.keyHistory.Len() - 1; i >= 0; i-- {
+		event, _ := w.keyHistory.At(i)
+		if event.Key == key {
+			return event.Pressed
+		}
+	}
+	return false
 }
 
-// updateLastFrameKeys met à jour l'état des touches de la frame précédente
+// updateLastFrameKeys enregistre l'état des touches suivies pour cette frame
 func (w *FinalInputWrapper) updateLastFrameKeys() {
-	// Sauvegarder l'état de toutes les touches importantes
-	keysToTrack := []ebiten.Key{
-		ebiten.KeyEscape,
-		ebiten.KeyI,
-		ebiten.KeySpace,
-		ebiten.KeyC,
-		ebiten.KeyE,
-		ebiten.KeyW, ebiten.KeyZ,
-		ebiten.KeyS,
-		ebiten.KeyA, ebiten.KeyQ,
-		ebiten.KeyD,
-		ebiten.KeyShiftLeft, ebiten.KeyShiftRight,
-	}
-
-	for _, key := range keysToTrack {
-		w.lastFrameKeys[key] = ebiten.IsKeyPressed(key)
+	for _, key := range trackedKeys {
+		w.keyHistory.Push(keyEvent{Key: key, Pressed: ebiten.IsKeyPressed(key)})
 	}
 }
 
@@ -0,0 +1,101 @@
+// internal/input/gamepad_hotplug.go - Branchement et débranchement des manettes en cours de partie
+package input
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// GamepadConnectedEvent manette branchée
+type GamepadConnectedEvent struct {
+	ID ebiten.GamepadID
+}
+
+// GamepadDisconnectedEvent manette débranchée
+type GamepadDisconnectedEvent struct {
+	ID ebiten.GamepadID
+}
+
+// GamepadHotplugMonitor compare à chaque tick les manettes présentes à celles
+// du tick précédent et signale les apparitions et disparitions. Le premier
+// tick sert de référence : les manettes déjà branchées au lancement ne sont
+// pas signalées.
+type GamepadHotplugMonitor struct {
+	previous    []ebiten.GamepadID
+	current     []ebiten.GamepadID
+	initialized bool
+
+	// Source des manettes présentes (ebiten.AppendGamepadIDs par défaut)
+	AppendIDs func(ids []ebiten.GamepadID) []ebiten.GamepadID
+
+	OnConnected    func(event GamepadConnectedEvent)
+	OnDisconnected func(event GamepadDisconnectedEvent)
+}
+
+// NewGamepadHotplugMonitor crée un moniteur branché sur Ebiten
+func NewGamepadHotplugMonitor() *GamepadHotplugMonitor {
+	return &GamepadHotplugMonitor{
+		previous:  make([]ebiten.GamepadID, 0, 4),
+		current:   make([]ebiten.GamepadID, 0, 4),
+		AppendIDs: ebiten.AppendGamepadIDs,
+	}
+}
+
+// Update relève les manettes présentes et signale les changements
+func (ghm *GamepadHotplugMonitor) Update() {
+	ghm.current = ghm.AppendIDs(ghm.current[:0])
+	if !ghm.initialized {
+		ghm.initialized = true
+		ghm.previous, ghm.current = ghm.current, ghm.previous
+		return
+	}
+
+	connected, disconnected := DiffGamepadIDs(ghm.previous, ghm.current)
+	for _, id := range connected {
+		fmt.Printf("✓ Manette %d connectée\n", id)
+		if ghm.OnConnected != nil {
+			ghm.OnConnected(GamepadConnectedEvent{ID: id})
+		}
+	}
+	for _, id := range disconnected {
+		fmt.Printf("⚠ Manette %d déconnectée\n", id)
+		if ghm.OnDisconnected != nil {
+			ghm.OnDisconnected(GamepadDisconnectedEvent{ID: id})
+		}
+	}
+
+	// Le relevé courant devient la référence ; l'ancien tampon est réutilisé
+	ghm.previous, ghm.current = ghm.current, ghm.previous
+}
+
+// Connected retourne les manettes présentes au dernier tick
+func (ghm *GamepadHotplugMonitor) Connected() []ebiten.GamepadID {
+	return ghm.previous
+}
+
+// DiffGamepadIDs compare deux relevés de manettes : connected contient les
+// IDs apparus dans current, disconnected ceux disparus de previous
+func DiffGamepadIDs(previous, current []ebiten.GamepadID) (connected, disconnected []ebiten.GamepadID) {
+	for _, id := range current {
+		if !containsGamepadID(previous, id) {
+			connected = append(connected, id)
+		}
+	}
+	for _, id := range previous {
+		if !containsGamepadID(current, id) {
+			disconnected = append(disconnected, id)
+		}
+	}
+	return connected, disconnected
+}
+
+// containsGamepadID retourne si id figure dans ids (quelques manettes au plus)
+func containsGamepadID(ids []ebiten.GamepadID, id ebiten.GamepadID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
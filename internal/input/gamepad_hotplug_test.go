@@ -0,0 +1,71 @@
+package input
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestDiffGamepadIDs(t *testing.T) {
+	tests := []struct {
+		name             string
+		previous         []ebiten.GamepadID
+		current          []ebiten.GamepadID
+		wantConnected    []ebiten.GamepadID
+		wantDisconnected []ebiten.GamepadID
+	}{
+		{"aucune manette", nil, nil, nil, nil},
+		{"inchangé", []ebiten.GamepadID{0, 1}, []ebiten.GamepadID{1, 0}, nil, nil},
+		{"branchement", []ebiten.GamepadID{0}, []ebiten.GamepadID{0, 1}, []ebiten.GamepadID{1}, nil},
+		{"première manette", nil, []ebiten.GamepadID{0}, []ebiten.GamepadID{0}, nil},
+		{"débranchement", []ebiten.GamepadID{0, 1}, []ebiten.GamepadID{1}, nil, []ebiten.GamepadID{0}},
+		{"échange", []ebiten.GamepadID{0}, []ebiten.GamepadID{2}, []ebiten.GamepadID{2}, []ebiten.GamepadID{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connected, disconnected := DiffGamepadIDs(tt.previous, tt.current)
+			if !slices.Equal(connected, tt.wantConnected) {
+				t.Errorf("connectées = %v, attendu %v", connected, tt.wantConnected)
+			}
+			if !slices.Equal(disconnected, tt.wantDisconnected) {
+				t.Errorf("déconnectées = %v, attendu %v", disconnected, tt.wantDisconnected)
+			}
+		})
+	}
+}
+
+func TestGamepadHotplugMonitorEvents(t *testing.T) {
+	// Relevés successifs : une manette au lancement, une deuxième branchée,
+	// puis la première débranchée
+	ticks := [][]ebiten.GamepadID{{0}, {0}, {0, 1}, {1}, {1}}
+	tick := 0
+
+	monitor := NewGamepadHotplugMonitor()
+	monitor.AppendIDs = func(ids []ebiten.GamepadID) []ebiten.GamepadID {
+		return append(ids, ticks[tick]...)
+	}
+
+	var events []string
+	monitor.OnConnected = func(event GamepadConnectedEvent) {
+		events = append(events, fmt.Sprintf("+%d", event.ID))
+	}
+	monitor.OnDisconnected = func(event GamepadDisconnectedEvent) {
+		events = append(events, fmt.Sprintf("-%d", event.ID))
+	}
+
+	for tick = range ticks {
+		monitor.Update()
+	}
+
+	// La manette présente au lancement n'est pas signalée
+	want := []string{"+1", "-0"}
+	if !slices.Equal(events, want) {
+		t.Errorf("événements = %v, attendu %v", events, want)
+	}
+	if connected := monitor.Connected(); !slices.Equal(connected, []ebiten.GamepadID{1}) {
+		t.Errorf("Connected = %v, attendu [1]", connected)
+	}
+}
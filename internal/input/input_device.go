@@ -0,0 +1,132 @@
+// internal/input/input_device.go - Périphérique utilisé en dernier et navigation des menus sans souris
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// InputDevice périphérique d'entrée
+type InputDevice int
+
+const (
+	DeviceKeyboardMouse InputDevice = iota
+	DeviceGamepad
+)
+
+// String retourne le nom du périphérique
+func (d InputDevice) String() string {
+	if d == DeviceGamepad {
+		return "gamepad"
+	}
+	return "keyboard_mouse"
+}
+
+// gamepadStickThreshold inclinaison du stick gauche qui compte comme une entrée
+const gamepadStickThreshold = 0.5
+
+// gamepadState suivi des manettes pour le périphérique actif et les menus
+type gamepadState struct {
+	ids         []ebiten.GamepadID
+	buttons     []ebiten.GamepadButton
+	keys        []ebiten.Key
+	lastDevice  InputDevice
+	stickHeld   bool // Stick incliné à la frame précédente (un cran par inclinaison)
+	menuStep    int  // -1 : haut, 1 : bas, 0 : rien
+	menuConfirm bool
+}
+
+// updateGamepadState relève le périphérique utilisé et la navigation des menus
+// de la frame (appelé par Update, avant la mise à jour de la souris)
+func (im *InputManagerImpl) updateGamepadState() {
+	gs := &im.gamepad
+
+	// Clavier et souris : touche, clic ou déplacement du curseur (im.mouseX
+	// et im.mouseY sont encore ceux de la frame précédente)
+	cursorX, cursorY := ebiten.CursorPosition()
+	cursorMoved := cursorX != im.mouseX || cursorY != im.mouseY
+
+	gs.keys = inpututil.AppendJustPressedKeys(gs.keys[:0])
+	if len(gs.keys) > 0 || cursorMoved || ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		gs.lastDevice = DeviceKeyboardMouse
+	}
+
+	gs.menuStep = 0
+	gs.menuConfirm = false
+	if im.keyJustPressed[ebiten.KeyArrowUp] || im.keyJustPressed[ebiten.KeyW] || im.keyJustPressed[ebiten.KeyZ] {
+		gs.menuStep = -1
+	}
+	if im.keyJustPressed[ebiten.KeyArrowDown] || im.keyJustPressed[ebiten.KeyS] {
+		gs.menuStep = 1
+	}
+	if im.keyJustPressed[ebiten.KeyEnter] || im.keyJustPressed[ebiten.KeySpace] {
+		gs.menuConfirm = true
+	}
+
+	// Manettes : bouton pressé ou stick gauche incliné
+	stickY := 0.0
+	gs.ids = ebiten.AppendGamepadIDs(gs.ids[:0])
+	for _, id := range gs.ids {
+		gs.buttons = inpututil.AppendJustPressedGamepadButtons(id, gs.buttons[:0])
+		if len(gs.buttons) > 0 {
+			gs.lastDevice = DeviceGamepad
+		}
+		if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+			continue
+		}
+
+		if inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonLeftTop) {
+			gs.menuStep = -1
+		}
+		if inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonLeftBottom) {
+			gs.menuStep = 1
+		}
+		if inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonRightBottom) {
+			gs.menuConfirm = true
+		}
+
+		value := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+		if value > gamepadStickThreshold || value < -gamepadStickThreshold {
+			stickY = value
+			gs.lastDevice = DeviceGamepad
+		}
+	}
+
+	// Le stick avance d'un cran par inclinaison
+	if stickY != 0 && !gs.stickHeld {
+		if stickY < 0 {
+			gs.menuStep = -1
+		} else {
+			gs.menuStep = 1
+		}
+	}
+	gs.stickHeld = stickY != 0
+}
+
+// LastInputDevice retourne le dernier périphérique utilisé
+func (im *InputManagerImpl) LastInputDevice() InputDevice {
+	return im.gamepad.lastDevice
+}
+
+// MenuNavigation retourne la navigation des menus de la frame : step -1 (haut)
+// ou 1 (bas) au clavier (flèches) ou à la manette (croix, stick gauche),
+// confirm pour Entrée, Espace ou le bouton A
+func (im *InputManagerImpl) MenuNavigation() (step int, confirm bool) {
+	return im.gamepad.menuStep, im.gamepad.menuConfirm
+}
+
+// LastInputDevice retourne le dernier périphérique utilisé
+func (w *FinalInputWrapper) LastInputDevice() InputDevice {
+	return w.inputManager.LastInputDevice()
+}
+
+// UsingGamepad indique si le dernier périphérique utilisé est une manette
+// (les menus affichent alors les boutons de la manette)
+func (w *FinalInputWrapper) UsingGamepad() bool {
+	return w.inputManager.LastInputDevice() == DeviceGamepad
+}
+
+// MenuNavigation retourne la navigation des menus de la frame
+func (w *FinalInputWrapper) MenuNavigation() (step int, confirm bool) {
+	return w.inputManager.MenuNavigation()
+}
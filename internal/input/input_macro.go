@@ -0,0 +1,167 @@
+// internal/input/input_macro.go - Enregistrement et rejeu de séquences d'actions
+package input
+
+import (
+	"fmt"
+)
+
+// macroActionCount actions suivies par les macros (ActionMoveUp..ActionInteract)
+const macroActionCount = int(ActionInteract) + 1
+
+// MacroEvent changement d'état d'une action à une frame donnée
+type MacroEvent struct {
+	Frame   int
+	Action  int
+	Pressed bool
+}
+
+// Macro séquence enregistrée d'événements d'actions
+type Macro struct {
+	Events []MacroEvent
+	Frames int // Durée totale en frames
+}
+
+// ActionSource source d'état des actions (InputManagerImpl, TouchInputHandler...)
+type ActionSource interface {
+	IsActionPressedSystems(action int) bool
+}
+
+// ===============================
+// ENREGISTREMENT
+// ===============================
+
+// MacroRecorder enregistre les changements d'état des actions frame par frame
+type MacroRecorder struct {
+	recording bool
+	frame     int
+	last      [macroActionCount]bool
+	events    []MacroEvent
+}
+
+// NewMacroRecorder crée un enregistreur de macro
+func NewMacroRecorder() *MacroRecorder {
+	return &MacroRecorder{
+		events: make([]MacroEvent, 0),
+	}
+}
+
+// StartRecording démarre un nouvel enregistrement
+func (mr *MacroRecorder) StartRecording() {
+	mr.recording = true
+	mr.frame = 0
+	mr.last = [macroActionCount]bool{}
+	mr.events = mr.events[:0]
+	fmt.Println("Enregistrement de macro démarré")
+}
+
+// StopRecording termine l'enregistrement et retourne la macro
+func (mr *MacroRecorder) StopRecording() Macro {
+	mr.recording = false
+
+	// Relâcher les actions encore pressées pour que le rejeu se termine proprement
+	for action, pressed := range mr.last {
+		if pressed {
+			mr.events = append(mr.events, MacroEvent{Frame: mr.frame, Action: action, Pressed: false})
+		}
+	}
+
+	macro := Macro{
+		Events: make([]MacroEvent, len(mr.events)),
+		Frames: mr.frame,
+	}
+	copy(macro.Events, mr.events)
+
+	fmt.Printf("Macro enregistrée: %d événements sur %d frames\n", len(macro.Events), macro.Frames)
+	return macro
+}
+
+// IsRecording retourne si un enregistrement est en cours
+func (mr *MacroRecorder) IsRecording() bool {
+	return mr.recording
+}
+
+// RecordFrame enregistre l'état des actions pour la frame courante
+func (mr *MacroRecorder) RecordFrame(source ActionSource) {
+	if !mr.recording {
+		return
+	}
+
+	for action := 0; action < macroActionCount; action++ {
+		pressed := source.IsActionPressedSystems(action)
+		if pressed != mr.last[action] {
+			mr.events = append(mr.events, MacroEvent{Frame: mr.frame, Action: action, Pressed: pressed})
+			mr.last[action] = pressed
+		}
+	}
+	mr.frame++
+}
+
+// ===============================
+// REJEU
+// ===============================
+
+// MacroPlayer rejoue une macro frame par frame en remplaçant les entrées réelles
+type MacroPlayer struct {
+	macro   Macro
+	playing bool
+	frame   int
+	index   int
+	state   [macroActionCount]bool
+}
+
+// NewMacroPlayer crée un lecteur de macro
+func NewMacroPlayer() *MacroPlayer {
+	return &MacroPlayer{}
+}
+
+// Play démarre le rejeu d'une macro
+func (mp *MacroPlayer) Play(m Macro) {
+	mp.macro = m
+	mp.playing = true
+	mp.frame = 0
+	mp.index = 0
+	mp.state = [macroActionCount]bool{}
+	fmt.Printf("Rejeu de macro: %d frames\n", m.Frames)
+}
+
+// Stop interrompt le rejeu
+func (mp *MacroPlayer) Stop() {
+	mp.playing = false
+	mp.state = [macroActionCount]bool{}
+}
+
+// IsPlaying retourne si une macro est en cours de rejeu
+func (mp *MacroPlayer) IsPlaying() bool {
+	return mp.playing
+}
+
+// Advance applique les événements de la frame courante puis passe à la suivante
+func (mp *MacroPlayer) Advance() {
+	if !mp.playing {
+		return
+	}
+
+	if mp.frame >= mp.macro.Frames {
+		mp.Stop()
+		fmt.Println("Rejeu de macro terminé")
+		return
+	}
+
+	events := mp.macro.Events
+	for mp.index < len(events) && events[mp.index].Frame <= mp.frame {
+		event := events[mp.index]
+		if event.Action >= 0 && event.Action < macroActionCount {
+			mp.state[event.Action] = event.Pressed
+		}
+		mp.index++
+	}
+	mp.frame++
+}
+
+// IsActionPressedSystems retourne l'état rejoué d'une action
+func (mp *MacroPlayer) IsActionPressedSystems(action int) bool {
+	if action < 0 || action >= macroActionCount {
+		return false
+	}
+	return mp.state[action]
+}
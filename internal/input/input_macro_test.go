@@ -0,0 +1,113 @@
+package input
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/ecs/systems"
+)
+
+// scriptedSource source d'actions pilotée par le test
+type scriptedSource struct {
+	pressed map[int]bool
+}
+
+func (s *scriptedSource) IsActionPressedSystems(action int) bool {
+	return s.pressed[action]
+}
+
+// macroInput expose le lecteur de macro au PlayerSystem
+type macroInput struct {
+	player *MacroPlayer
+}
+
+func (m macroInput) IsActionPressedSystems(action int) bool {
+	return m.player.IsActionPressedSystems(action)
+}
+
+func (m macroInput) IsKeyJustPressedSystems(key int) bool {
+	return false
+}
+
+// recordMoveRight enregistre "droite maintenue pendant frames frames"
+func recordMoveRight(frames int) Macro {
+	source := &scriptedSource{pressed: map[int]bool{int(ActionMoveRight): true}}
+	recorder := NewMacroRecorder()
+	recorder.StartRecording()
+	for i := 0; i < frames; i++ {
+		recorder.RecordFrame(source)
+	}
+	return recorder.StopRecording()
+}
+
+func TestMacroRecordsStateChanges(t *testing.T) {
+	macro := recordMoveRight(60)
+
+	if macro.Frames != 60 {
+		t.Errorf("Frames = %d, attendu 60", macro.Frames)
+	}
+	want := []MacroEvent{
+		{Frame: 0, Action: int(ActionMoveRight), Pressed: true},
+		{Frame: 60, Action: int(ActionMoveRight), Pressed: false},
+	}
+	if len(macro.Events) != len(want) {
+		t.Fatalf("événements = %+v, attendu %+v", macro.Events, want)
+	}
+	for i := range want {
+		if macro.Events[i] != want[i] {
+			t.Errorf("événement %d = %+v, attendu %+v", i, macro.Events[i], want[i])
+		}
+	}
+}
+
+func TestMacroPlaybackStops(t *testing.T) {
+	player := NewMacroPlayer()
+	player.Play(recordMoveRight(3))
+
+	for frame := 0; frame < 3; frame++ {
+		player.Advance()
+		if !player.IsActionPressedSystems(int(ActionMoveRight)) {
+			t.Fatalf("frame %d: droite relâchée, attendu pressée", frame)
+		}
+	}
+
+	player.Advance()
+	if player.IsPlaying() {
+		t.Error("le rejeu doit s'arrêter après la dernière frame")
+	}
+	if player.IsActionPressedSystems(int(ActionMoveRight)) {
+		t.Error("les actions doivent être relâchées à la fin du rejeu")
+	}
+}
+
+func TestMacroReplayMovesPlayer(t *testing.T) {
+	const frame = time.Second / 60
+
+	macroPlayer := NewMacroPlayer()
+	ps := systems.NewPlayerSystem()
+	ps.SetInputManager(macroInput{player: macroPlayer})
+	ps.CreatePlayer(200, 360)
+
+	start := ps.GetPlayerPosition()
+	// Le joueur se déplace à sa vitesse plafonnée une fois lancé
+	speed := ps.GetPlayer().Movement.MaxSpeed
+
+	macroPlayer.Play(recordMoveRight(60))
+	for macroPlayer.IsPlaying() {
+		macroPlayer.Advance()
+		ps.Update(frame)
+	}
+	// Laisser le joueur s'arrêter après le relâchement
+	for i := 0; i < 30; i++ {
+		ps.Update(frame)
+	}
+
+	moved := ps.GetPlayerPosition().X - start.X
+	if math.Abs(moved-speed) > speed*0.1 {
+		t.Errorf("déplacement = %.1f px, attendu environ %.1f px (vitesse × 1 s)", moved, speed)
+	}
+	if dy := ps.GetPlayerPosition().Y - start.Y; dy != 0 {
+		t.Errorf("déplacement vertical = %.1f px, attendu 0", dy)
+	}
+}
@@ -50,17 +50,21 @@ type InputManagerImpl struct {
 	keyJustReleased      map[ebiten.Key]bool
 	mouseX, mouseY       int
 	mousePressed         map[int]bool
+	mouseJustPressed     map[int]bool
+	wheelX, wheelY       float64 // Défilement de la molette cette frame
 	windowCloseRequested bool
+	gamepad              gamepadState // Périphérique actif, navigation des menus
 }
 
 // NewInputManager crée un nouveau gestionnaire d'entrées
 func NewInputManager(config GameConfig) *InputManagerImpl {
 	return &InputManagerImpl{
-		config:          config,
-		keyPressed:      make(map[ebiten.Key]bool),
-		keyJustPressed:  make(map[ebiten.Key]bool),
-		keyJustReleased: make(map[ebiten.Key]bool),
-		mousePressed:    make(map[int]bool),
+		config:           config,
+		keyPressed:       make(map[ebiten.Key]bool),
+		keyJustPressed:   make(map[ebiten.Key]bool),
+		keyJustReleased:  make(map[ebiten.Key]bool),
+		mousePressed:     make(map[int]bool),
+		mouseJustPressed: make(map[int]bool),
 	}
 }
 
@@ -76,8 +80,17 @@ func (im *InputManagerImpl) Update() {
 		im.keyPressed[key] = pressed
 	}
 
+	// Périphérique actif et navigation des menus
+	im.updateGamepadState()
+
 	// Mise à jour de la souris
 	im.mouseX, im.mouseY = ebiten.CursorPosition()
+	for button := ebiten.MouseButton0; button <= ebiten.MouseButtonMax; button++ {
+		pressed := ebiten.IsMouseButtonPressed(button)
+		im.mouseJustPressed[int(button)] = pressed && !im.mousePressed[int(button)]
+		im.mousePressed[int(button)] = pressed
+	}
+	im.wheelX, im.wheelY = ebiten.Wheel()
 
 	// Vérifier si la fenêtre doit se fermer (stub)
 	im.windowCloseRequested = false
@@ -93,6 +106,36 @@ func (im *InputManagerImpl) IsKeyJustPressed(key ebiten.Key) bool {
 	return im.keyJustPressed[key]
 }
 
+// IsMouseButtonPressed vérifie si un bouton de souris (ebiten.MouseButton) est enfoncé
+func (im *InputManagerImpl) IsMouseButtonPressed(button int) bool {
+	return im.mousePressed[button]
+}
+
+// IsMouseButtonJustPressed vérifie si un bouton de souris vient d'être enfoncé
+func (im *InputManagerImpl) IsMouseButtonJustPressed(button int) bool {
+	return im.mouseJustPressed[button]
+}
+
+// WheelDelta retourne le défilement de la molette cette frame (crans ; Y
+// positif vers le haut)
+func (im *InputManagerImpl) WheelDelta() (float64, float64) {
+	return im.wheelX, im.wheelY
+}
+
+// CameraZoomInput retourne les crans de zoom demandés cette frame : la
+// molette, plus ActionCameraZoomIn et ActionCameraZoomOut au clavier
+// (positif : rapprocher)
+func (im *InputManagerImpl) CameraZoomInput() float64 {
+	zoom := im.wheelY
+	if im.IsActionPressed(ActionCameraZoomIn) {
+		zoom++
+	}
+	if im.IsActionPressed(ActionCameraZoomOut) {
+		zoom--
+	}
+	return zoom
+}
+
 // Méthodes pour l'interface core (avec int au lieu d'ebiten.Key)
 func (im *InputManagerImpl) IsKeyCorePressed(key int) bool {
 	return im.keyJustPressed[ebiten.Key(key)]
@@ -122,6 +165,12 @@ func (im *InputManagerImpl) IsActionPressed(action InputAction) bool {
 		return im.IsKeyPressed(ebiten.KeyShiftLeft) // Shift pour bloquer
 	case ActionRoll:
 		return im.IsKeyPressed(ebiten.KeyControlLeft) // Ctrl pour rouler
+	case ActionCameraReset:
+		return im.IsKeyJustPressed(ebiten.KeyHome) // Origine pour recentrer la caméra (R sert aux soins)
+	case ActionCameraZoomIn:
+		return im.IsKeyJustPressed(ebiten.KeyEqual) || im.IsKeyJustPressed(ebiten.KeyNumpadAdd) // = ou + du pavé
+	case ActionCameraZoomOut:
+		return im.IsKeyJustPressed(ebiten.KeyMinus) || im.IsKeyJustPressed(ebiten.KeyNumpadSubtract) // - ou - du pavé
 	default:
 		return false
 	}
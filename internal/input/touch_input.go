@@ -0,0 +1,218 @@
+// internal/input/touch_input.go - Entrées tactiles (mobile/tablette)
+package input
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// MaxTouches nombre maximum de touchers suivis simultanément
+const MaxTouches = 5
+
+// TouchRegion zone de l'écran associée à un contrôle virtuel
+type TouchRegion int
+
+const (
+	TouchRegionNone TouchRegion = iota
+	TouchRegionJoystick
+	TouchRegionAttack
+	TouchRegionRoll
+)
+
+// ===============================
+// JOYSTICK VIRTUEL
+// ===============================
+
+// VirtualJoystick calcule une direction à partir d'un toucher relatif à son ancre
+type VirtualJoystick struct {
+	AnchorX, AnchorY float64
+	Radius           float64 // Rayon de déplacement maximum
+	DeadZone         float64 // Fraction du rayon ignorée (0-1)
+
+	// Toucher qui contrôle le joystick
+	touchID ebiten.TouchID
+	active  bool
+
+	// Direction normalisée courante
+	dirX, dirY float64
+}
+
+// NewVirtualJoystick crée un joystick virtuel
+func NewVirtualJoystick(radius, deadZone float64) *VirtualJoystick {
+	return &VirtualJoystick{
+		Radius:   radius,
+		DeadZone: deadZone,
+	}
+}
+
+// Press ancre le joystick sous un nouveau toucher
+func (vj *VirtualJoystick) Press(id ebiten.TouchID, x, y float64) {
+	vj.touchID = id
+	vj.active = true
+	vj.AnchorX = x
+	vj.AnchorY = y
+	vj.dirX, vj.dirY = 0, 0
+}
+
+// Move met à jour la direction à partir de la position du toucher
+func (vj *VirtualJoystick) Move(x, y float64) {
+	vj.dirX, vj.dirY = vj.ComputeDirection(x, y)
+}
+
+// Release relâche le joystick
+func (vj *VirtualJoystick) Release() {
+	vj.active = false
+	vj.dirX, vj.dirY = 0, 0
+}
+
+// ComputeDirection retourne le vecteur normalisé de l'ancre vers (x, y),
+// ou (0, 0) si le toucher est dans la zone morte
+func (vj *VirtualJoystick) ComputeDirection(x, y float64) (float64, float64) {
+	dx := x - vj.AnchorX
+	dy := y - vj.AnchorY
+	length := math.Hypot(dx, dy)
+
+	if length == 0 || length < vj.DeadZone*vj.Radius {
+		return 0, 0
+	}
+	return dx / length, dy / length
+}
+
+// Direction retourne la direction normalisée courante
+func (vj *VirtualJoystick) Direction() (float64, float64) {
+	return vj.dirX, vj.dirY
+}
+
+// IsActive retourne si un toucher contrôle le joystick
+func (vj *VirtualJoystick) IsActive() bool {
+	return vj.active
+}
+
+// ===============================
+// GESTIONNAIRE TACTILE
+// ===============================
+
+// TouchInputHandler traduit les touchers en actions de jeu :
+// moitié gauche = joystick, moitié droite haute = attaque, basse = roulade
+type TouchInputHandler struct {
+	config   GameConfig
+	joystick *VirtualJoystick
+
+	// Seuil de composante pour déclencher une direction (~22.5°, 8 directions)
+	DirectionThreshold float64
+
+	// État des boutons virtuels
+	attackPressed bool
+	rollPressed   bool
+
+	// Buffers réutilisés à chaque frame
+	touchIDs    []ebiten.TouchID
+	justPressed []ebiten.TouchID
+}
+
+// NewTouchInputHandler crée un gestionnaire d'entrées tactiles
+func NewTouchInputHandler(config GameConfig) *TouchInputHandler {
+	return &TouchInputHandler{
+		config:             config,
+		joystick:           NewVirtualJoystick(60.0, 0.2),
+		DirectionThreshold: 0.38,
+		touchIDs:           make([]ebiten.TouchID, 0, MaxTouches),
+		justPressed:        make([]ebiten.TouchID, 0, MaxTouches),
+	}
+}
+
+// Update lit les touchers de la frame
+func (th *TouchInputHandler) Update() {
+	th.touchIDs = ebiten.AppendTouchIDs(th.touchIDs[:0])
+	if len(th.touchIDs) > MaxTouches {
+		th.touchIDs = th.touchIDs[:MaxTouches]
+	}
+	th.justPressed = inpututil.AppendJustPressedTouchIDs(th.justPressed[:0])
+
+	th.attackPressed = false
+	th.rollPressed = false
+	joystickHeld := false
+
+	for _, id := range th.touchIDs {
+		ix, iy := ebiten.TouchPosition(id)
+		x, y := float64(ix), float64(iy)
+
+		// Le toucher du joystick le reste même s'il sort de la moitié gauche
+		if th.joystick.IsActive() && th.joystick.touchID == id {
+			th.joystick.Move(x, y)
+			joystickHeld = true
+			continue
+		}
+
+		switch th.RegionAt(x, y) {
+		case TouchRegionJoystick:
+			if !th.joystick.IsActive() && th.isJustPressed(id) {
+				th.joystick.Press(id, x, y)
+				joystickHeld = true
+			}
+		case TouchRegionAttack:
+			th.attackPressed = true
+		case TouchRegionRoll:
+			th.rollPressed = true
+		}
+	}
+
+	if !joystickHeld && th.joystick.IsActive() {
+		th.joystick.Release()
+	}
+}
+
+// RegionAt retourne le contrôle virtuel situé sous une position
+func (th *TouchInputHandler) RegionAt(x, y float64) TouchRegion {
+	width := float64(th.config.WindowWidth())
+	height := float64(th.config.WindowHeight())
+
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return TouchRegionNone
+	}
+	if x < width/2 {
+		return TouchRegionJoystick
+	}
+	if y < height/2 {
+		return TouchRegionAttack
+	}
+	return TouchRegionRoll
+}
+
+func (th *TouchInputHandler) isJustPressed(id ebiten.TouchID) bool {
+	for _, pressed := range th.justPressed {
+		if pressed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// GetJoystick retourne le joystick virtuel
+func (th *TouchInputHandler) GetJoystick() *VirtualJoystick {
+	return th.joystick
+}
+
+// IsActionPressedSystems même interface que InputManagerImpl
+func (th *TouchInputHandler) IsActionPressedSystems(action int) bool {
+	dx, dy := th.joystick.Direction()
+
+	switch action {
+	case 0: // ActionMoveUp
+		return dy < -th.DirectionThreshold
+	case 1: // ActionMoveDown
+		return dy > th.DirectionThreshold
+	case 2: // ActionMoveLeft
+		return dx < -th.DirectionThreshold
+	case 3: // ActionMoveRight
+		return dx > th.DirectionThreshold
+	case 4: // ActionAttack
+		return th.attackPressed
+	case 6: // ActionRoll
+		return th.rollPressed
+	default:
+		return false
+	}
+}
@@ -0,0 +1,111 @@
+package input
+
+import (
+	"math"
+	"testing"
+)
+
+// screenConfig taille de fenêtre fixe pour les tests
+type screenConfig struct{ width, height int }
+
+func (c screenConfig) WindowWidth() int  { return c.width }
+func (c screenConfig) WindowHeight() int { return c.height }
+
+func TestVirtualJoystickDeadZone(t *testing.T) {
+	// Rayon 60, zone morte 20 % : les touchers à moins de 12 px sont ignorés
+	joystick := NewVirtualJoystick(60, 0.2)
+	joystick.Press(1, 100, 100)
+
+	tests := []struct {
+		name         string
+		x, y         float64
+		wantX, wantY float64
+	}{
+		{"sur l'ancre", 100, 100, 0, 0},
+		{"dans la zone morte", 110, 100, 0, 0},
+		{"juste sous le seuil", 100, 111.9, 0, 0},
+		{"au seuil", 112, 100, 1, 0},
+		{"vers le haut", 100, 70, 0, -1},
+		{"en diagonale", 130, 130, math.Sqrt2 / 2, math.Sqrt2 / 2},
+		{"au-delà du rayon", 400, 100, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			joystick.Move(tt.x, tt.y)
+			dx, dy := joystick.Direction()
+			if math.Abs(dx-tt.wantX) > 1e-9 || math.Abs(dy-tt.wantY) > 1e-9 {
+				t.Errorf("direction = (%.3f, %.3f), attendu (%.3f, %.3f)", dx, dy, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+func TestVirtualJoystickRelease(t *testing.T) {
+	joystick := NewVirtualJoystick(60, 0.2)
+	joystick.Press(1, 100, 100)
+	joystick.Move(160, 100)
+	joystick.Release()
+
+	if joystick.IsActive() {
+		t.Error("le joystick doit être inactif après Release")
+	}
+	if dx, dy := joystick.Direction(); dx != 0 || dy != 0 {
+		t.Errorf("direction = (%.2f, %.2f) après Release, attendu (0, 0)", dx, dy)
+	}
+}
+
+func TestTouchRegionMapping(t *testing.T) {
+	handler := NewTouchInputHandler(screenConfig{800, 600})
+
+	tests := []struct {
+		name string
+		x, y float64
+		want TouchRegion
+	}{
+		{"moitié gauche en haut", 10, 10, TouchRegionJoystick},
+		{"moitié gauche en bas", 399, 599, TouchRegionJoystick},
+		{"moitié droite en haut", 400, 0, TouchRegionAttack},
+		{"moitié droite juste au-dessus du milieu", 799, 299, TouchRegionAttack},
+		{"moitié droite au milieu", 600, 300, TouchRegionRoll},
+		{"moitié droite en bas", 799, 599, TouchRegionRoll},
+		{"hors de l'écran à gauche", -1, 100, TouchRegionNone},
+		{"hors de l'écran en bas", 600, 600, TouchRegionNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := handler.RegionAt(tt.x, tt.y); got != tt.want {
+				t.Errorf("RegionAt(%.0f, %.0f) = %d, attendu %d", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTouchJoystickMovementActions(t *testing.T) {
+	handler := NewTouchInputHandler(screenConfig{800, 600})
+	joystick := handler.GetJoystick()
+	joystick.Press(1, 200, 300)
+
+	tests := []struct {
+		name string
+		x, y float64
+		want [4]bool // Haut, bas, gauche, droite
+	}{
+		{"droite", 260, 300, [4]bool{false, false, false, true}},
+		{"haut", 200, 240, [4]bool{true, false, false, false}},
+		{"bas-gauche", 150, 350, [4]bool{false, true, true, false}},
+		{"zone morte", 205, 300, [4]bool{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			joystick.Move(tt.x, tt.y)
+			for action, want := range tt.want {
+				if got := handler.IsActionPressedSystems(action); got != want {
+					t.Errorf("action %d = %t, attendu %t", action, got, want)
+				}
+			}
+		})
+	}
+}
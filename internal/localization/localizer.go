@@ -0,0 +1,135 @@
+// internal/localization/localizer.go - Tables de chaînes traduites
+package localization
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DefaultLanguage langue utilisée par défaut et comme repli pour les clés manquantes
+const DefaultLanguage = "fr"
+
+// DefaultStringsDir dossier des tables de chaînes (<lang>.json)
+const DefaultStringsDir = "assets/data/strings"
+
+// Localizer charge une table de chaînes JSON par langue
+type Localizer struct {
+	mutex    sync.RWMutex
+	dir      string
+	language string
+	strings  map[string]string
+	fallback map[string]string // Table de la langue par défaut
+}
+
+// NewLocalizer crée un localizer sur un dossier de tables, sans table chargée
+func NewLocalizer(dir string) *Localizer {
+	return &Localizer{
+		dir:      dir,
+		language: DefaultLanguage,
+		strings:  make(map[string]string),
+		fallback: make(map[string]string),
+	}
+}
+
+// SetDirectory change le dossier des tables (effectif au prochain SetLanguage)
+func (l *Localizer) SetDirectory(dir string) {
+	l.mutex.Lock()
+	l.dir = dir
+	l.mutex.Unlock()
+}
+
+// SetLanguage charge la table <lang>.json (et la table par défaut en repli)
+func (l *Localizer) SetLanguage(lang string) error {
+	if lang == "" {
+		lang = DefaultLanguage
+	}
+
+	table, err := loadTable(filepath.Join(l.dir, lang+".json"))
+	if err != nil {
+		return err
+	}
+
+	fallback := table
+	if lang != DefaultLanguage {
+		fallback, err = loadTable(filepath.Join(l.dir, DefaultLanguage+".json"))
+		if err != nil {
+			fmt.Printf("⚠ Table de repli %s indisponible: %v\n", DefaultLanguage, err)
+			fallback = make(map[string]string)
+		}
+	}
+
+	l.mutex.Lock()
+	l.language = lang
+	l.strings = table
+	l.fallback = fallback
+	l.mutex.Unlock()
+
+	fmt.Printf("✓ Langue chargée: %s (%d chaînes)\n", lang, len(table))
+	return nil
+}
+
+// Language retourne la langue courante
+func (l *Localizer) Language() string {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.language
+}
+
+// Has vérifie si une clé existe dans la langue courante ou la langue par défaut
+func (l *Localizer) Has(key string) bool {
+	_, ok := l.lookup(key)
+	return ok
+}
+
+// Get retourne la chaîne traduite, formatée avec fmt.Sprintf si des arguments
+// sont fournis. Une clé inconnue est retournée telle quelle.
+func (l *Localizer) Get(key string, args ...interface{}) string {
+	value, ok := l.lookup(key)
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return value
+	}
+	return fmt.Sprintf(value, args...)
+}
+
+// lookup cherche une clé dans la langue courante puis dans la langue par défaut
+func (l *Localizer) lookup(key string) (string, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	if value, ok := l.strings[key]; ok {
+		return value, true
+	}
+	value, ok := l.fallback[key]
+	return value, ok
+}
+
+// loadTable lit une table de chaînes JSON
+func loadTable(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossible de lire %s: %v", path, err)
+	}
+
+	table := make(map[string]string)
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("table de chaînes invalide %s: %v", path, err)
+	}
+	return table, nil
+}
+
+// ===============================
+// INSTANCE PAR DÉFAUT
+// ===============================
+
+var defaultLocalizer = NewLocalizer(DefaultStringsDir)
+
+// Default retourne le localizer partagé par l'interface
+func Default() *Localizer {
+	return defaultLocalizer
+}
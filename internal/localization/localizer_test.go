@@ -0,0 +1,99 @@
+package localization
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTables écrit des tables de chaînes dans un dossier temporaire
+func writeTables(t *testing.T, tables map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for lang, content := range tables {
+		if err := os.WriteFile(filepath.Join(dir, lang+".json"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLocalizerGet(t *testing.T) {
+	dir := writeTables(t, map[string]string{
+		"fr": `{"ui.menu.newgame": "Nouvelle partie", "ui.hud.souls": "Âmes: %d", "ui.menu.quit": "Quitter"}`,
+		"en": `{"ui.menu.newgame": "New game", "ui.hud.souls": "Souls: %d"}`,
+	})
+
+	tests := []struct {
+		name string
+		lang string
+		key  string
+		args []interface{}
+		want string
+	}{
+		{"clé française", "fr", "ui.menu.newgame", nil, "Nouvelle partie"},
+		{"clé anglaise", "en", "ui.menu.newgame", nil, "New game"},
+		{"substitution", "en", "ui.hud.souls", []interface{}{42}, "Souls: 42"},
+		{"repli sur le français", "en", "ui.menu.quit", nil, "Quitter"},
+		{"clé manquante", "fr", "ui.menu.inconnu", nil, "ui.menu.inconnu"},
+		{"clé manquante partout", "en", "ui.menu.inconnu", nil, "ui.menu.inconnu"},
+		{"clé manquante avec arguments", "fr", "ui.inconnu", []interface{}{1}, "ui.inconnu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localizer := NewLocalizer(dir)
+			if err := localizer.SetLanguage(tt.lang); err != nil {
+				t.Fatal(err)
+			}
+			if got := localizer.Get(tt.key, tt.args...); got != tt.want {
+				t.Errorf("Get(%q) = %q, attendu %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalizerWithoutTable(t *testing.T) {
+	localizer := NewLocalizer(t.TempDir())
+
+	if err := localizer.SetLanguage("de"); err == nil {
+		t.Error("une langue sans table doit retourner une erreur")
+	}
+	if localizer.Language() != DefaultLanguage {
+		t.Errorf("Language = %q, attendu %q", localizer.Language(), DefaultLanguage)
+	}
+	if got := localizer.Get("ui.menu.newgame"); got != "ui.menu.newgame" {
+		t.Errorf("Get = %q, attendu la clé elle-même", got)
+	}
+}
+
+func TestLocalizerInvalidTable(t *testing.T) {
+	dir := writeTables(t, map[string]string{"fr": `{"ui.menu.newgame": `})
+
+	if err := NewLocalizer(dir).SetLanguage("fr"); err == nil {
+		t.Error("une table JSON invalide doit retourner une erreur")
+	}
+}
+
+func TestShippedTablesHaveSameKeys(t *testing.T) {
+	dir := filepath.Join("..", "..", DefaultStringsDir)
+	fr, err := loadTable(filepath.Join(dir, "fr.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	en, err := loadTable(filepath.Join(dir, "en.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for key := range fr {
+		if _, ok := en[key]; !ok {
+			t.Errorf("clé %q absente de en.json", key)
+		}
+	}
+	for key := range en {
+		if _, ok := fr[key]; !ok {
+			t.Errorf("clé %q absente de fr.json", key)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// internal/network/leaderboard_client.go - Client HTTP du classement en ligne
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Réglages des nouvelles tentatives
+const (
+	DefaultLeaderboardTimeout = 5 * time.Second
+	leaderboardMaxRetries     = 3
+	leaderboardBaseBackoff    = 250 * time.Millisecond
+)
+
+// ScoreEntry résultat d'une partie, tel qu'échangé avec le classement
+type ScoreEntry struct {
+	PlayerName    string  `json:"player_name"`
+	Score         int     `json:"score"`
+	Difficulty    string  `json:"difficulty"`
+	PlayTime      float64 `json:"play_time"` // Secondes
+	EnemiesKilled int     `json:"enemies_killed"`
+}
+
+// PlayDuration retourne la durée de la partie
+func (e ScoreEntry) PlayDuration() time.Duration {
+	return time.Duration(e.PlayTime * float64(time.Second))
+}
+
+// StatusError réponse HTTP en erreur du serveur de classement
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("classement: réponse HTTP %d", e.StatusCode)
+	}
+	return fmt.Sprintf("classement: réponse HTTP %d: %s", e.StatusCode, e.Body)
+}
+
+// retryable indique si la requête peut être retentée (erreur serveur, trop
+// de requêtes) ; une erreur 4xx ne changera pas en insistant
+func (e *StatusError) retryable() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
+
+// LeaderboardClient envoie les scores à un classement HTTP et lit les
+// meilleurs. Chaque requête est retentée jusqu'à trois fois, avec une attente
+// qui double à chaque essai, sur erreur réseau ou réponse 5xx.
+type LeaderboardClient struct {
+	BaseURL string        // Ex: https://scores.example.com/api ; les requêtes visent <BaseURL>/scores
+	APIKey  string        // Envoyée en « Authorization: Bearer » si non vide
+	Timeout time.Duration // Par tentative (DefaultLeaderboardTimeout si nul)
+
+	httpClient *http.Client
+	backoff    time.Duration // Première attente avant une nouvelle tentative
+}
+
+// NewLeaderboardClient crée un client pour le classement à baseURL
+func NewLeaderboardClient(baseURL, apiKey string, timeout time.Duration) *LeaderboardClient {
+	if timeout <= 0 {
+		timeout = DefaultLeaderboardTimeout
+	}
+	return &LeaderboardClient{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		APIKey:     apiKey,
+		Timeout:    timeout,
+		httpClient: &http.Client{},
+		backoff:    leaderboardBaseBackoff,
+	}
+}
+
+// PostScore envoie le résultat d'une partie (POST <BaseURL>/scores)
+func (c *LeaderboardClient) PostScore(ctx context.Context, entry ScoreEntry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("classement: encodage du score: %w", err)
+	}
+	return c.do(ctx, http.MethodPost, c.scoresURL(), body, nil)
+}
+
+// FetchTopScores lit les count meilleurs scores (GET <BaseURL>/scores?limit=count)
+func (c *LeaderboardClient) FetchTopScores(ctx context.Context, count int) ([]ScoreEntry, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("classement: nombre de scores invalide: %d", count)
+	}
+	var entries []ScoreEntry
+	url := c.scoresURL() + "?limit=" + strconv.Itoa(count)
+	if err := c.do(ctx, http.MethodGet, url, nil, &entries); err != nil {
+		return nil, err
+	}
+	if len(entries) > count {
+		entries = entries[:count]
+	}
+	return entries, nil
+}
+
+// scoresURL retourne l'adresse de la ressource des scores
+func (c *LeaderboardClient) scoresURL() string {
+	return strings.TrimRight(c.BaseURL, "/") + "/scores"
+}
+
+// do exécute une requête avec nouvelles tentatives ; la réponse JSON est
+// décodée dans out s'il n'est pas nil
+func (c *LeaderboardClient) do(ctx context.Context, method, url string, body []byte, out interface{}) error {
+	wait := c.backoff
+	var lastErr error
+	for attempt := 0; attempt <= leaderboardMaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("classement: abandon après %d tentative(s): %w", attempt, lastErr)
+			case <-time.After(wait):
+			}
+			wait *= 2
+		}
+
+		lastErr = c.attempt(ctx, method, url, body, out)
+		if lastErr == nil {
+			return nil
+		}
+		var statusErr *StatusError
+		if errors.As(lastErr, &statusErr) && !statusErr.retryable() {
+			return lastErr
+		}
+		if ctx.Err() != nil {
+			return lastErr
+		}
+	}
+	return fmt.Errorf("classement: échec après %d tentatives: %w", leaderboardMaxRetries+1, lastErr)
+}
+
+// attempt exécute une seule requête, limitée à Timeout
+func (c *LeaderboardClient) attempt(ctx context.Context, method, url string, body []byte, out interface{}) error {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultLeaderboardTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return fmt.Errorf("classement: requête invalide: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if c.APIKey != "" {
+		request.Header.Set("Authorization", "Bearer "+c.APIKey)
+	}
+
+	client := c.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("classement: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return &StatusError{StatusCode: response.StatusCode, Body: strings.TrimSpace(string(message))}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(response.Body).Decode(out); err != nil {
+		return fmt.Errorf("classement: réponse illisible: %w", err)
+	}
+	return nil
+}
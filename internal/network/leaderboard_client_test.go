@@ -0,0 +1,211 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testBackoff première attente des tests : assez courte pour rester rapide,
+// assez longue pour être mesurable
+const testBackoff = 20 * time.Millisecond
+
+// scoreServer serveur de classement factice : les failures premières
+// requêtes répondent status, les suivantes passent à handler
+type scoreServer struct {
+	mu       sync.Mutex
+	failures int
+	status   int
+	requests []time.Time
+	handler  http.HandlerFunc
+}
+
+func (s *scoreServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, time.Now())
+	fail := len(s.requests) <= s.failures
+	s.mu.Unlock()
+
+	if fail {
+		http.Error(w, "serveur indisponible", s.status)
+		return
+	}
+	if s.handler != nil {
+		s.handler(w, r)
+	}
+}
+
+// attempts retourne le nombre de requêtes reçues
+func (s *scoreServer) attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+// requestTimes retourne les instants des requêtes reçues
+func (s *scoreServer) requestTimes() []time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]time.Time(nil), s.requests...)
+}
+
+// newTestClient crée un client vers server, avec une attente courte
+func newTestClient(server *httptest.Server) *LeaderboardClient {
+	client := NewLeaderboardClient(server.URL+"/", "cle-secrete", time.Second)
+	client.httpClient = server.Client()
+	client.backoff = testBackoff
+	return client
+}
+
+func TestPostScore(t *testing.T) {
+	entry := ScoreEntry{PlayerName: "Solaire", Score: 4200, Difficulty: "normal", PlayTime: 1830.5, EnemiesKilled: 57}
+
+	var received ScoreEntry
+	scores := &scoreServer{handler: func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/scores" {
+			t.Errorf("requête = %s %s, attendu POST /scores", r.Method, r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer cle-secrete" {
+			t.Errorf("Authorization = %q", auth)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("Content-Type = %q", contentType)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("corps illisible: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}}
+	server := httptest.NewServer(scores)
+	defer server.Close()
+
+	if err := newTestClient(server).PostScore(context.Background(), entry); err != nil {
+		t.Fatalf("PostScore: %v", err)
+	}
+	if received != entry {
+		t.Errorf("score reçu = %+v, attendu %+v", received, entry)
+	}
+	if scores.attempts() != 1 {
+		t.Errorf("%d requêtes, attendu 1", scores.attempts())
+	}
+}
+
+func TestFetchTopScores(t *testing.T) {
+	top := []ScoreEntry{
+		{PlayerName: "Siegmeyer", Score: 9000},
+		{PlayerName: "Solaire", Score: 4200},
+		{PlayerName: "Laurentius", Score: 1200},
+	}
+	scores := &scoreServer{handler: func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Query().Get("limit") != "2" {
+			t.Errorf("requête = %s %s, attendu GET avec limit=2", r.Method, r.URL)
+		}
+		// Le serveur en renvoie trop : le client tronque
+		json.NewEncoder(w).Encode(top)
+	}}
+	server := httptest.NewServer(scores)
+	defer server.Close()
+
+	entries, err := newTestClient(server).FetchTopScores(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("FetchTopScores: %v", err)
+	}
+	if len(entries) != 2 || entries[0] != top[0] || entries[1] != top[1] {
+		t.Errorf("scores = %+v, attendu les deux premiers de %+v", entries, top)
+	}
+
+	if _, err := newTestClient(server).FetchTopScores(context.Background(), 0); err == nil {
+		t.Error("un nombre de scores nul doit être une erreur")
+	}
+}
+
+func TestLeaderboardRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		status       int
+		wantErr      bool
+		wantAttempts int
+	}{
+		{"une erreur 500 puis succès", 1, http.StatusInternalServerError, false, 2},
+		{"trois erreurs 500 puis succès", 3, http.StatusInternalServerError, false, 4},
+		{"erreurs 500 persistantes", 10, http.StatusInternalServerError, true, 4},
+		{"trop de requêtes", 1, http.StatusTooManyRequests, false, 2},
+		{"requête refusée, pas de nouvel essai", 10, http.StatusBadRequest, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores := &scoreServer{failures: tt.failures, status: tt.status, handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}}
+			server := httptest.NewServer(scores)
+			defer server.Close()
+
+			err := newTestClient(server).PostScore(context.Background(), ScoreEntry{PlayerName: "Solaire"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("erreur = %v, attendu une erreur : %t", err, tt.wantErr)
+			}
+			if scores.attempts() != tt.wantAttempts {
+				t.Errorf("%d requêtes, attendu %d", scores.attempts(), tt.wantAttempts)
+			}
+
+			var statusErr *StatusError
+			if tt.wantErr && (!errors.As(err, &statusErr) || statusErr.StatusCode != tt.status) {
+				t.Errorf("erreur = %v, attendu une réponse HTTP %d", err, tt.status)
+			}
+		})
+	}
+}
+
+func TestLeaderboardBackoffDoubles(t *testing.T) {
+	scores := &scoreServer{failures: 10, status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(scores)
+	defer server.Close()
+
+	if err := newTestClient(server).PostScore(context.Background(), ScoreEntry{}); err == nil {
+		t.Fatal("PostScore doit échouer")
+	}
+	requests := scores.requestTimes()
+	if len(requests) != 4 {
+		t.Fatalf("%d requêtes, attendu 4", len(requests))
+	}
+
+	// Attentes de 20, 40 puis 80 ms entre les tentatives
+	wait := testBackoff
+	for i := 1; i < len(requests); i++ {
+		gap := requests[i].Sub(requests[i-1])
+		if gap < wait || gap > wait+testBackoff*4 {
+			t.Errorf("attente avant la tentative %d = %v, attendu %v", i+1, gap, wait)
+		}
+		wait *= 2
+	}
+}
+
+func TestLeaderboardCancelDuringBackoff(t *testing.T) {
+	scores := &scoreServer{failures: 10, status: http.StatusInternalServerError}
+	server := httptest.NewServer(scores)
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.backoff = time.Hour // Seule l'annulation peut interrompre l'attente
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := client.PostScore(ctx, ScoreEntry{}); err == nil {
+		t.Fatal("PostScore doit échouer")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("abandon après %v, attendu dès l'annulation", elapsed)
+	}
+	if scores.attempts() != 1 {
+		t.Errorf("%d requêtes, attendu 1", scores.attempts())
+	}
+}
@@ -0,0 +1,173 @@
+// internal/pathfinding/astar.go - Recherche de chemin A* sur une grille de tuiles
+package pathfinding
+
+import (
+	"container/heap"
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// DefaultMaxSearchNodes limite par défaut de tuiles explorées par recherche
+const DefaultMaxSearchNodes = 2000
+
+// Grid grille de tuiles sur laquelle chercher un chemin (world.TileMap)
+type Grid interface {
+	InBounds(tx, ty int) bool
+	IsSolid(tx, ty int) bool
+	GetTileSize() float64
+}
+
+// Tile coordonnées d'une tuile
+type Tile struct {
+	X, Y int
+}
+
+// neighbourOffsets déplacements autorisés : 4 directions puis diagonales
+var neighbourOffsets = []Tile{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{1, 1}, {1, -1}, {-1, 1}, {-1, -1},
+}
+
+// TileAt retourne la tuile contenant une position du monde
+func TileAt(grid Grid, position components.Vector2) Tile {
+	size := grid.GetTileSize()
+	return Tile{X: int(math.Floor(position.X / size)), Y: int(math.Floor(position.Y / size))}
+}
+
+// TileCenter retourne le centre d'une tuile dans le monde
+func TileCenter(grid Grid, tile Tile) components.Vector2 {
+	size := grid.GetTileSize()
+	return components.Vector2{X: (float64(tile.X) + 0.5) * size, Y: (float64(tile.Y) + 0.5) * size}
+}
+
+// walkable vérifie qu'une tuile est dans la grille et non solide
+func walkable(grid Grid, tile Tile) bool {
+	return grid.InBounds(tile.X, tile.Y) && !grid.IsSolid(tile.X, tile.Y)
+}
+
+// FindPath cherche un chemin entre deux positions du monde et retourne les
+// points de passage (centres de tuiles, le dernier étant la destination exacte).
+// Retourne nil si aucun chemin n'est trouvé en explorant au plus maxNodes tuiles.
+func FindPath(grid Grid, from, to components.Vector2, maxNodes int) []components.Vector2 {
+	start := TileAt(grid, from)
+	goal := TileAt(grid, to)
+	if !walkable(grid, goal) {
+		return nil
+	}
+	if start == goal {
+		return []components.Vector2{to}
+	}
+
+	tiles := FindTilePath(grid, start, goal, maxNodes)
+	if tiles == nil {
+		return nil
+	}
+
+	// La tuile de départ est déjà occupée, la dernière est remplacée par la cible exacte
+	waypoints := make([]components.Vector2, 0, len(tiles)-1)
+	for _, tile := range tiles[1 : len(tiles)-1] {
+		waypoints = append(waypoints, TileCenter(grid, tile))
+	}
+	return append(waypoints, to)
+}
+
+// FindTilePath A* entre deux tuiles, déplacements diagonaux compris (sans couper
+// les coins de murs). Retourne les tuiles du départ à l'arrivée, ou nil.
+func FindTilePath(grid Grid, start, goal Tile, maxNodes int) []Tile {
+	if maxNodes <= 0 {
+		maxNodes = DefaultMaxSearchNodes
+	}
+
+	open := &nodeQueue{}
+	heap.Push(open, &node{tile: start, cost: 0, estimate: octile(start, goal)})
+
+	cameFrom := map[Tile]Tile{}
+	bestCost := map[Tile]float64{start: 0}
+	closed := map[Tile]bool{}
+
+	for open.Len() > 0 && len(closed) < maxNodes {
+		current := heap.Pop(open).(*node)
+		if closed[current.tile] {
+			continue
+		}
+		if current.tile == goal {
+			return rebuildPath(cameFrom, start, goal)
+		}
+		closed[current.tile] = true
+
+		for _, offset := range neighbourOffsets {
+			next := Tile{X: current.tile.X + offset.X, Y: current.tile.Y + offset.Y}
+			if closed[next] || !walkable(grid, next) {
+				continue
+			}
+
+			// Une diagonale exige que les deux tuiles adjacentes soient libres
+			diagonal := offset.X != 0 && offset.Y != 0
+			if diagonal && (!walkable(grid, Tile{X: next.X, Y: current.tile.Y}) ||
+				!walkable(grid, Tile{X: current.tile.X, Y: next.Y})) {
+				continue
+			}
+
+			stepCost := 1.0
+			if diagonal {
+				stepCost = math.Sqrt2
+			}
+			cost := current.cost + stepCost
+			if known, exists := bestCost[next]; exists && cost >= known {
+				continue
+			}
+
+			bestCost[next] = cost
+			cameFrom[next] = current.tile
+			heap.Push(open, &node{tile: next, cost: cost, estimate: cost + octile(next, goal)})
+		}
+	}
+
+	return nil
+}
+
+// rebuildPath remonte les prédécesseurs depuis l'arrivée
+func rebuildPath(cameFrom map[Tile]Tile, start, goal Tile) []Tile {
+	path := []Tile{goal}
+	for current := goal; current != start; {
+		current = cameFrom[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// octile heuristique de distance avec diagonales
+func octile(a, b Tile) float64 {
+	dx := math.Abs(float64(a.X - b.X))
+	dy := math.Abs(float64(a.Y - b.Y))
+	return math.Max(dx, dy) + (math.Sqrt2-1)*math.Min(dx, dy)
+}
+
+// ===============================
+// FILE DE PRIORITÉ
+// ===============================
+
+// node tuile en attente d'exploration
+type node struct {
+	tile     Tile
+	cost     float64 // Coût depuis le départ
+	estimate float64 // Coût + heuristique
+}
+
+// nodeQueue tas binaire trié sur l'estimation (container/heap)
+type nodeQueue []*node
+
+func (q nodeQueue) Len() int           { return len(q) }
+func (q nodeQueue) Less(i, j int) bool { return q[i].estimate < q[j].estimate }
+func (q nodeQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *nodeQueue) Push(value any)    { *q = append(*q, value.(*node)) }
+func (q *nodeQueue) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,154 @@
+// internal/pathfinding/bezier_path.go - Courbes de Bézier pour les patrouilles
+package pathfinding
+
+import (
+	"math"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// lengthSamples nombre d'échantillons pour estimer la longueur de la courbe
+const lengthSamples = 64
+
+// BezierPath suite de courbes de Bézier cubiques raccordées.
+// Les points de contrôle vont par 3n+1 : chaque segment partage son dernier
+// point avec le suivant. Pour un raccord C1, le point de jonction doit être
+// le milieu des deux points de contrôle qui l'entourent.
+type BezierPath struct {
+	ControlPoints []components.Vector2
+}
+
+// NewBezierPath crée un chemin ; les points en trop (hors 3n+1) sont ignorés
+func NewBezierPath(controlPoints []components.Vector2) *BezierPath {
+	return &BezierPath{ControlPoints: controlPoints}
+}
+
+// SmoothBezierPath construit un chemin C1 passant par tous les points de passage
+// (tangentes de Catmull-Rom converties en points de contrôle)
+func SmoothBezierPath(waypoints []components.Vector2) *BezierPath {
+	if len(waypoints) < 2 {
+		return NewBezierPath(waypoints)
+	}
+
+	controlPoints := []components.Vector2{waypoints[0]}
+	for i := 0; i < len(waypoints)-1; i++ {
+		previous := waypoints[max(i-1, 0)]
+		current := waypoints[i]
+		next := waypoints[i+1]
+		after := waypoints[min(i+2, len(waypoints)-1)]
+
+		controlPoints = append(controlPoints,
+			current.Add(next.Sub(previous).Mul(1.0/6.0)),
+			next.Sub(after.Sub(current).Mul(1.0/6.0)),
+			next,
+		)
+	}
+	return NewBezierPath(controlPoints)
+}
+
+// Segments retourne le nombre de segments cubiques complets
+func (bp *BezierPath) Segments() int {
+	if len(bp.ControlPoints) < 4 {
+		return 0
+	}
+	return (len(bp.ControlPoints) - 1) / 3
+}
+
+// segmentAt retourne l'index du segment et le paramètre local pour t global dans [0, 1]
+func (bp *BezierPath) segmentAt(t float64) (int, float64) {
+	segments := bp.Segments()
+	t = math.Max(0, math.Min(1, t))
+
+	scaled := t * float64(segments)
+	index := int(scaled)
+	if index >= segments {
+		index = segments - 1
+	}
+	return index, scaled - float64(index)
+}
+
+// Evaluate retourne le point de la courbe pour t dans [0, 1]
+func (bp *BezierPath) Evaluate(t float64) components.Vector2 {
+	switch {
+	case len(bp.ControlPoints) == 0:
+		return components.Vector2{}
+	case bp.Segments() == 0:
+		// Pas assez de points pour une cubique : interpolation linéaire
+		first := bp.ControlPoints[0]
+		last := bp.ControlPoints[len(bp.ControlPoints)-1]
+		t = math.Max(0, math.Min(1, t))
+		return first.Add(last.Sub(first).Mul(t))
+	}
+
+	index, local := bp.segmentAt(t)
+	p := bp.ControlPoints[index*3 : index*3+4]
+
+	u := 1 - local
+	b0 := u * u * u
+	b1 := 3 * u * u * local
+	b2 := 3 * u * local * local
+	b3 := local * local * local
+
+	return components.Vector2{
+		X: b0*p[0].X + b1*p[1].X + b2*p[2].X + b3*p[3].X,
+		Y: b0*p[0].Y + b1*p[1].Y + b2*p[2].Y + b3*p[3].Y,
+	}
+}
+
+// Derivative retourne la tangente de la courbe pour t dans [0, 1] (par rapport à t global)
+func (bp *BezierPath) Derivative(t float64) components.Vector2 {
+	segments := bp.Segments()
+	if segments == 0 {
+		if len(bp.ControlPoints) < 2 {
+			return components.Vector2{}
+		}
+		return bp.ControlPoints[len(bp.ControlPoints)-1].Sub(bp.ControlPoints[0])
+	}
+
+	index, local := bp.segmentAt(t)
+	p := bp.ControlPoints[index*3 : index*3+4]
+
+	u := 1 - local
+	d0 := p[1].Sub(p[0]).Mul(3 * u * u)
+	d1 := p[2].Sub(p[1]).Mul(6 * u * local)
+	d2 := p[3].Sub(p[2]).Mul(3 * local * local)
+
+	// Chaque segment couvre 1/segments du paramètre global
+	return d0.Add(d1).Add(d2).Mul(float64(segments))
+}
+
+// Sample retourne count points régulièrement espacés en t
+func (bp *BezierPath) Sample(count int) []components.Vector2 {
+	if count < 2 {
+		count = 2
+	}
+	points := make([]components.Vector2, count)
+	for i := range points {
+		points[i] = bp.Evaluate(float64(i) / float64(count-1))
+	}
+	return points
+}
+
+// Length estime la longueur de la courbe (pixels)
+func (bp *BezierPath) Length() float64 {
+	points := bp.Sample(lengthSamples + 1)
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		diff := points[i].Sub(points[i-1])
+		length += math.Hypot(diff.X, diff.Y)
+	}
+	return length
+}
+
+// Waypoints discrétise la courbe en points espacés d'environ step pixels.
+// Le premier et le dernier point de contrôle sont toujours inclus.
+func (bp *BezierPath) Waypoints(step float64) []components.Vector2 {
+	if len(bp.ControlPoints) == 0 {
+		return nil
+	}
+	if step <= 0 {
+		step = 16.0
+	}
+
+	count := int(math.Ceil(bp.Length()/step)) + 1
+	return bp.Sample(count)
+}
@@ -0,0 +1,111 @@
+package pathfinding
+
+import (
+	"math"
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+func nearlyEqual(a, b components.Vector2, tolerance float64) bool {
+	return math.Abs(a.X-b.X) <= tolerance && math.Abs(a.Y-b.Y) <= tolerance
+}
+
+// twoSegmentPath deux cubiques raccordées en (100, 0), milieu de (80, -20) et (120, 20)
+func twoSegmentPath() *BezierPath {
+	return NewBezierPath([]components.Vector2{
+		{X: 0, Y: 0}, {X: 30, Y: 60}, {X: 80, Y: -20},
+		{X: 100, Y: 0},
+		{X: 120, Y: 20}, {X: 170, Y: 60}, {X: 200, Y: 0},
+	})
+}
+
+func TestBezierEvaluateEndpoints(t *testing.T) {
+	tests := []struct {
+		name string
+		path *BezierPath
+	}{
+		{"un segment", NewBezierPath([]components.Vector2{{X: 0, Y: 0}, {X: 10, Y: 50}, {X: 90, Y: 50}, {X: 100, Y: 0}})},
+		{"deux segments", twoSegmentPath()},
+		{"lissé", SmoothBezierPath([]components.Vector2{{X: 0, Y: 0}, {X: 50, Y: 80}, {X: 120, Y: 40}, {X: 200, Y: 100}})},
+		{"linéaire", NewBezierPath([]components.Vector2{{X: 5, Y: 5}, {X: 25, Y: -5}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := tt.path.ControlPoints
+			first, last := points[0], points[len(points)-1]
+
+			if got := tt.path.Evaluate(0); !nearlyEqual(got, first, 1e-9) {
+				t.Errorf("Evaluate(0) = %+v, attendu %+v", got, first)
+			}
+			if got := tt.path.Evaluate(1); !nearlyEqual(got, last, 1e-9) {
+				t.Errorf("Evaluate(1) = %+v, attendu %+v", got, last)
+			}
+			// t hors de [0, 1] est borné
+			if got := tt.path.Evaluate(1.5); !nearlyEqual(got, last, 1e-9) {
+				t.Errorf("Evaluate(1.5) = %+v, attendu %+v", got, last)
+			}
+		})
+	}
+}
+
+func TestBezierC1ContinuityAtJoints(t *testing.T) {
+	tests := []struct {
+		name string
+		path *BezierPath
+	}{
+		{"jonction au milieu", twoSegmentPath()},
+		{"lissé", SmoothBezierPath([]components.Vector2{{X: 0, Y: 0}, {X: 50, Y: 80}, {X: 120, Y: 40}, {X: 200, Y: 100}, {X: 260, Y: 0}})},
+	}
+
+	const h = 1e-7
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments := tt.path.Segments()
+			for joint := 1; joint < segments; joint++ {
+				at := float64(joint) / float64(segments)
+
+				// Continuité de position
+				before, after := tt.path.Evaluate(at-h), tt.path.Evaluate(at+h)
+				if !nearlyEqual(before, after, 1e-3) {
+					t.Errorf("jonction %d : position %+v / %+v", joint, before, after)
+				}
+
+				// Continuité de la tangente
+				left, right := tt.path.Derivative(at-h), tt.path.Derivative(at)
+				if !nearlyEqual(left, right, 1e-3) {
+					t.Errorf("jonction %d : tangente %+v à gauche, %+v à droite", joint, left, right)
+				}
+			}
+		})
+	}
+}
+
+func TestBezierDetectsC1Break(t *testing.T) {
+	// Jonction en (100, 0) qui n'est pas le milieu de ses voisins : coude
+	path := NewBezierPath([]components.Vector2{
+		{X: 0, Y: 0}, {X: 30, Y: 60}, {X: 80, Y: -20},
+		{X: 100, Y: 0},
+		{X: 100, Y: 50}, {X: 170, Y: 60}, {X: 200, Y: 0},
+	})
+
+	left, right := path.Derivative(0.5-1e-7), path.Derivative(0.5)
+	if nearlyEqual(left, right, 1e-3) {
+		t.Error("un coude à la jonction doit donner des tangentes différentes")
+	}
+}
+
+func TestBezierWaypointsSpacing(t *testing.T) {
+	path := NewBezierPath([]components.Vector2{{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 200, Y: 0}, {X: 300, Y: 0}})
+
+	waypoints := path.Waypoints(20)
+	if len(waypoints) != 16 {
+		t.Fatalf("%d points de passage pour 300 px, attendu 16", len(waypoints))
+	}
+	for i := 1; i < len(waypoints); i++ {
+		if gap := waypoints[i].X - waypoints[i-1].X; math.Abs(gap-20) > 1e-6 {
+			t.Errorf("écart %d = %.3f px, attendu 20", i, gap)
+		}
+	}
+}
@@ -25,17 +25,25 @@ type Camera struct {
 
 	// Suivi d'entité
 	Target      interface{}  // Entité à suivre (Player, etc.)
-	FollowSpeed float64      // Vitesse de suivi (0-1, 1=instantané)
+	FollowSpeed float64      // Mémorisée par FollowTarget ; le retard du suivi vient de SetSmoothing
 	Offset      core.Vector2 // Décalage par rapport à la cible
 
+	// Cadrage de plusieurs entités (SetMultiTarget), prioritaire sur Target
+	multiTargets       []Positionable
+	MultiTargetPadding float64 // Marge autour des entités cadrées, en pixels
+
 	// Effets de caméra
-	Shake *CameraShake
+	Shake        *CameraShake
+	ReduceMotion bool // Accessibilité : désactive les tremblements
 
-	// Interpolation
+	// Interpolation : la position rejoint la cible avec une constante de
+	// temps de smoothing secondes
 	targetPosition core.Vector2
-	velocity       core.Vector2
 	smoothing      float64
 
+	// Animations MoveTo et ZoomTo
+	animator CameraAnimator
+
 	// Limites de zoom
 	MinZoom float64
 	MaxZoom float64
@@ -58,6 +66,88 @@ type CameraShake struct {
 	active      bool
 }
 
+// CameraAnimator anime la position et le zoom de la caméra d'une valeur à
+// une autre sur une durée, en accélérant puis ralentissant (SmoothStep)
+type CameraAnimator struct {
+	fromPosition core.Vector2
+	toPosition   core.Vector2
+	moveDuration time.Duration
+	moveElapsed  time.Duration
+	moving       bool
+
+	fromZoom     float64
+	toZoom       float64
+	zoomDuration time.Duration
+	zoomElapsed  time.Duration
+	zooming      bool
+}
+
+// StartMove lance une animation de position
+func (ca *CameraAnimator) StartMove(from, to core.Vector2, duration time.Duration) {
+	ca.fromPosition, ca.toPosition = from, to
+	ca.moveDuration, ca.moveElapsed = duration, 0
+	ca.moving = true
+}
+
+// StartZoom lance une animation de zoom
+func (ca *CameraAnimator) StartZoom(from, to float64, duration time.Duration) {
+	ca.fromZoom, ca.toZoom = from, to
+	ca.zoomDuration, ca.zoomElapsed = duration, 0
+	ca.zooming = true
+}
+
+// Update fait avancer les animations en cours
+func (ca *CameraAnimator) Update(deltaTime time.Duration) {
+	if ca.moving {
+		ca.moveElapsed += deltaTime
+	}
+	if ca.zooming {
+		ca.zoomElapsed += deltaTime
+	}
+}
+
+// Moving retourne si une animation de position est en cours
+func (ca *CameraAnimator) Moving() bool {
+	return ca.moving
+}
+
+// Zooming retourne si une animation de zoom est en cours
+func (ca *CameraAnimator) Zooming() bool {
+	return ca.zooming
+}
+
+// Position retourne la position animée ; l'animation se termine en l'atteignant
+func (ca *CameraAnimator) Position() core.Vector2 {
+	t := animationProgress(ca.moveElapsed, ca.moveDuration)
+	if t >= 1 {
+		ca.moving = false
+	}
+	return ca.fromPosition.SmoothStep(ca.toPosition, t)
+}
+
+// Zoom retourne le zoom animé ; l'animation se termine en l'atteignant
+func (ca *CameraAnimator) Zoom() float64 {
+	t := animationProgress(ca.zoomElapsed, ca.zoomDuration)
+	if t >= 1 {
+		ca.zooming = false
+	}
+	return core.SmoothStep(ca.fromZoom, ca.toZoom, t)
+}
+
+// Stop interrompt les animations
+func (ca *CameraAnimator) Stop() {
+	ca.moving = false
+	ca.zooming = false
+}
+
+// animationProgress avancement (0 à 1) d'une animation ; une durée nulle est terminée
+func animationProgress(elapsed, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 1
+	}
+	return core.Clamp(float64(elapsed)/float64(duration), 0, 1)
+}
+
 // ===============================
 // CAMERA INITIALIZATION
 // ===============================
@@ -74,6 +164,8 @@ func NewCamera(position core.Vector2, width, height float64) *Camera {
 		MaxZoom:     5.0,
 		smoothing:   0.1,
 		needUpdate:  true,
+
+		MultiTargetPadding: DefaultMultiTargetPadding,
 	}
 
 	camera.targetPosition = position
@@ -143,11 +235,15 @@ func (c *Camera) StopFollowing() {
 func (c *Camera) Update(deltaTime time.Duration) {
 	dt := deltaTime.Seconds()
 
-	// Mise à jour du suivi de cible
-	c.updateTargetFollowing(dt)
-
-	// Interpolation vers la position cible
-	c.updateMovementSmoothing(dt)
+	// Une animation MoveTo prend la main sur le suivi de cible
+	if !c.updateAnimation(deltaTime) {
+		if c.HasMultiTarget() {
+			c.updateMultiTarget()
+		} else {
+			c.updateTargetFollowing()
+		}
+		c.updateMovementSmoothing(dt)
+	}
 
 	// Mise à jour des effets de tremblement
 	c.updateShake(dt)
@@ -162,8 +258,29 @@ func (c *Camera) Update(deltaTime time.Duration) {
 	}
 }
 
-// updateTargetFollowing met à jour le suivi de la cible
-func (c *Camera) updateTargetFollowing(deltaTime float64) {
+// updateAnimation applique les animations MoveTo et ZoomTo ; retourne si la
+// position est animée
+func (c *Camera) updateAnimation(deltaTime time.Duration) bool {
+	if !c.animator.Moving() && !c.animator.Zooming() {
+		return false
+	}
+	c.animator.Update(deltaTime)
+
+	if c.animator.Zooming() {
+		c.SetZoom(c.animator.Zoom())
+	}
+	if !c.animator.Moving() {
+		return false
+	}
+	c.Position = c.animator.Position()
+	c.targetPosition = c.Position
+	c.needUpdate = true
+	return true
+}
+
+// updateTargetFollowing place la position cible sur l'entité suivie ; le
+// lissage est appliqué ensuite par updateMovementSmoothing
+func (c *Camera) updateTargetFollowing() {
 	if c.Target == nil {
 		return
 	}
@@ -171,11 +288,6 @@ func (c *Camera) updateTargetFollowing(deltaTime float64) {
 	// Obtenir la position de la cible
 	var targetPos core.Vector2
 
-	// Interface pour les objets avec position
-	type Positionable interface {
-		GetPosition() core.Vector2
-	}
-
 	if positionable, ok := c.Target.(Positionable); ok {
 		targetPos = positionable.GetPosition()
 	} else {
@@ -183,43 +295,18 @@ func (c *Camera) updateTargetFollowing(deltaTime float64) {
 	}
 
 	// Ajouter le décalage
-	targetPos = targetPos.Add(c.Offset)
-
-	// Interpolation vers la cible
-	if c.FollowSpeed >= 1.0 {
-		// Suivi instantané
-		c.targetPosition = targetPos
-	} else {
-		// Suivi avec lissage
-		diff := targetPos.Sub(c.targetPosition)
-		c.targetPosition = c.targetPosition.Add(diff.Mul(c.FollowSpeed * deltaTime))
-	}
+	c.targetPosition = targetPos.Add(c.Offset)
 }
 
-// updateMovementSmoothing applique le lissage de mouvement
+// updateMovementSmoothing rapproche la position de la cible : la fraction
+// parcourue par tick dépend de sa durée, pas de la fréquence des ticks
 func (c *Camera) updateMovementSmoothing(deltaTime float64) {
+	oldPos := c.Position
 	if c.smoothing <= 0 {
 		c.Position = c.targetPosition
-		return
-	}
-
-	// Calculer la force vers la cible
-	diff := c.targetPosition.Sub(c.Position)
-	force := diff.Mul(1.0 / c.smoothing)
-
-	// Appliquer la force à la vélocité
-	c.velocity = c.velocity.Add(force.Mul(deltaTime))
-
-	// Appliquer un amortissement
-	damping := 1.0 - (5.0 * deltaTime)
-	if damping < 0 {
-		damping = 0
+	} else {
+		c.Position = c.Position.Lerp(c.targetPosition, 1-math.Exp(-deltaTime/c.smoothing))
 	}
-	c.velocity = c.velocity.Mul(damping)
-
-	// Mettre à jour la position
-	oldPos := c.Position
-	c.Position = c.Position.Add(c.velocity.Mul(deltaTime))
 
 	// Marquer pour mise à jour si la position a changé
 	if oldPos.X != c.Position.X || oldPos.Y != c.Position.Y {
@@ -268,11 +355,20 @@ func (c *Camera) applyBounds() {
 	halfWidth := (c.Width / c.Zoom) / 2
 	halfHeight := (c.Height / c.Zoom) / 2
 
-	// Limites min/max
+	// Limites min/max ; sur un axe où la carte est plus petite que la vue,
+	// la caméra reste centrée sur la carte plutôt que d'inverser min et max
 	minX := c.Bounds.X + halfWidth
 	maxX := c.Bounds.X + c.Bounds.Width - halfWidth
+	if minX > maxX {
+		minX = c.Bounds.X + c.Bounds.Width/2
+		maxX = minX
+	}
 	minY := c.Bounds.Y + halfHeight
 	maxY := c.Bounds.Y + c.Bounds.Height - halfHeight
+	if minY > maxY {
+		minY = c.Bounds.Y + c.Bounds.Height/2
+		maxY = minY
+	}
 
 	// Appliquer les contraintes
 	oldPos := c.Position
@@ -389,6 +485,10 @@ func (c *Camera) StartShake(intensity float64, duration time.Duration) {
 
 // StartShakeWithFrequency démarre un tremblement avec fréquence personnalisée
 func (c *Camera) StartShakeWithFrequency(intensity float64, duration time.Duration, frequency float64) {
+	if c.ReduceMotion {
+		return
+	}
+
 	if c.Shake == nil {
 		c.Shake = &CameraShake{}
 	}
@@ -420,18 +520,29 @@ func (c *Camera) IsShaking() bool {
 // CAMERA ANIMATION
 // ===============================
 
-// MoveTo anime la caméra vers une position
+// MoveTo anime la caméra vers une position ; le suivi de cible reprend ensuite
 func (c *Camera) MoveTo(targetPos core.Vector2, duration time.Duration) {
-	// TODO: Implémenter une animation fluide vers la position
-	// Pour l'instant, utilisation du système de target
-	c.SetTarget(targetPos)
+	c.animator.StartMove(c.Position, targetPos, duration)
 }
 
 // ZoomTo anime le zoom vers une valeur
 func (c *Camera) ZoomTo(targetZoom float64, duration time.Duration) {
-	// TODO: Implémenter une animation de zoom fluide
-	// Pour l'instant, changement direct
-	c.SetZoom(targetZoom)
+	c.animator.StartZoom(c.Zoom, targetZoom, duration)
+}
+
+// UpdateZoom fait avancer la seule animation ZoomTo, pour les scènes qui
+// pilotent le zoom sans appeler Update
+func (c *Camera) UpdateZoom(deltaTime time.Duration) {
+	if !c.animator.Zooming() {
+		return
+	}
+	c.animator.zoomElapsed += deltaTime
+	c.SetZoom(c.animator.Zoom())
+}
+
+// ZoomLimits retourne les bornes du zoom
+func (c *Camera) ZoomLimits() (float64, float64) {
+	return c.MinZoom, c.MaxZoom
 }
 
 // ===============================
@@ -442,7 +553,7 @@ func (c *Camera) ZoomTo(targetZoom float64, duration time.Duration) {
 func (c *Camera) Reset() {
 	c.Position = core.Vector2{X: 0, Y: 0}
 	c.targetPosition = core.Vector2{X: 0, Y: 0}
-	c.velocity = core.Vector2{X: 0, Y: 0}
+	c.animator.Stop()
 	c.Zoom = 1.0
 	c.StopShake()
 	c.StopFollowing()
@@ -461,6 +572,11 @@ func (c *Camera) GetCenter() core.Vector2 {
 	return c.Position
 }
 
+// GetZoom retourne le niveau de zoom
+func (c *Camera) GetZoom() float64 {
+	return c.Zoom
+}
+
 // GetSize retourne la taille de la vue
 func (c *Camera) GetSize() (float64, float64) {
 	return c.Width, c.Height
@@ -488,10 +604,13 @@ func (c *Camera) SetSmoothing(smoothing float64) {
 // ADVANCED CAMERA FEATURES
 // ===============================
 
-// PanTo fait un panoramique vers une position avec vitesse contrôlée
+// PanTo fait un panoramique vers une position à speed pixels par seconde en moyenne
 func (c *Camera) PanTo(targetPos core.Vector2, speed float64) {
-	direction := targetPos.Sub(c.Position).Normalize()
-	c.velocity = direction.Mul(speed)
+	if speed <= 0 {
+		return
+	}
+	seconds := c.Position.Distance(targetPos) / speed
+	c.MoveTo(targetPos, time.Duration(seconds*float64(time.Second)))
 }
 
 // LookAt fait regarder la caméra vers un point avec un décalage temporel
@@ -520,10 +639,6 @@ func (c *Camera) ConstrainToTarget(maxDistance float64) {
 		return
 	}
 
-	type Positionable interface {
-		GetPosition() core.Vector2
-	}
-
 	if positionable, ok := c.Target.(Positionable); ok {
 		targetPos := positionable.GetPosition()
 		distance := c.Position.Distance(targetPos)
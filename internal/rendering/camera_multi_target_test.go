@@ -0,0 +1,87 @@
+package rendering
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"zelda-souls-game/internal/core"
+)
+
+// entityAt entité immobile
+type entityAt core.Vector2
+
+func (e *entityAt) GetPosition() core.Vector2 { return core.Vector2(*e) }
+
+// settleCamera laisse la caméra rejoindre sa cible (2 s à 60 Hz)
+func settleCamera(camera *Camera) {
+	for i := 0; i < 120; i++ {
+		camera.Update(time.Second / 60)
+	}
+}
+
+func TestMultiTargetFraming(t *testing.T) {
+	tests := []struct {
+		name       string
+		entities   []core.Vector2
+		wantCenter core.Vector2
+		wantZoom   float64
+	}{
+		// Boîte de 300 + 2×64 px de large : 1280 / 428 ≈ 2,991
+		{"deux entités à 300 px", []core.Vector2{{X: 100, Y: 200}, {X: 400, Y: 200}}, core.Vector2{X: 250, Y: 200}, 1280.0 / 428},
+		// 138 × 128 px : le zoom calculé (5,2) est plafonné
+		{"deux entités à 10 px", []core.Vector2{{X: 100, Y: 200}, {X: 110, Y: 200}}, core.Vector2{X: 105, Y: 200}, 5},
+		{"entités confondues", []core.Vector2{{X: 50, Y: 50}, {X: 50, Y: 50}}, core.Vector2{X: 50, Y: 50}, 5},
+		// Boîte de 2128 × 848 px : la hauteur n'impose rien, la largeur dézoome
+		{"entités très éloignées", []core.Vector2{{X: -1000, Y: 0}, {X: 1000, Y: 720}}, core.Vector2{X: 0, Y: 360}, 1280.0 / 2128},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			camera := NewCamera(core.Vector2{}, 1280, 720)
+			entities := make([]Positionable, len(tt.entities))
+			for i, position := range tt.entities {
+				entity := entityAt(position)
+				entities[i] = &entity
+			}
+
+			camera.SetMultiTarget(entities)
+			if camera.targetPosition != tt.wantCenter {
+				t.Errorf("cible = %+v, attendu %+v", camera.targetPosition, tt.wantCenter)
+			}
+			if math.Abs(camera.Zoom-tt.wantZoom) > 1e-9 {
+				t.Errorf("zoom = %.4f, attendu %.4f", camera.Zoom, tt.wantZoom)
+			}
+			if camera.Zoom > camera.MaxZoom || camera.Zoom < camera.MinZoom {
+				t.Errorf("zoom = %.4f hors de [%.1f, %.1f]", camera.Zoom, camera.MinZoom, camera.MaxZoom)
+			}
+		})
+	}
+}
+
+func TestMultiTargetFollowsEntities(t *testing.T) {
+	camera := NewCamera(core.Vector2{}, 1280, 720)
+	player, boss := entityAt{X: 100, Y: 200}, entityAt{X: 400, Y: 200}
+	camera.SetMultiTarget([]Positionable{&player, &boss})
+
+	// L'invocation s'éloigne : la caméra recentre et dézoome à chaque tick
+	boss.X = 700
+	camera.Update(time.Second / 60)
+	if want := (core.Vector2{X: 400, Y: 200}); camera.targetPosition != want {
+		t.Errorf("cible = %+v, attendu %+v", camera.targetPosition, want)
+	}
+	if want := 1280.0 / 728; math.Abs(camera.Zoom-want) > 1e-9 {
+		t.Errorf("zoom = %.4f, attendu %.4f", camera.Zoom, want)
+	}
+
+	settleCamera(camera)
+	if math.Abs(camera.Position.X-400) > 1 || math.Abs(camera.Position.Y-200) > 1 {
+		t.Errorf("position = %+v, attendu près de (400, 200)", camera.Position)
+	}
+
+	// Une liste vide rend la main au suivi d'une cible unique
+	camera.SetMultiTarget(nil)
+	if camera.HasMultiTarget() {
+		t.Error("SetMultiTarget(nil) doit quitter le cadrage multiple")
+	}
+}
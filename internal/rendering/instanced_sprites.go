@@ -0,0 +1,132 @@
+// internal/rendering/instanced_sprites.go - Sprites instanciés (foules d'ennemis)
+package rendering
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"zelda-souls-game/internal/core"
+)
+
+// InstancedShaderPath shader Kage des sprites instanciés
+const InstancedShaderPath = "assets/shaders/instanced_sprite.kage"
+
+// Au-delà, les indices 16 bits ne suffisent plus : le lot est coupé en
+// plusieurs appels
+const maxInstancesPerDraw = (1 << 16) / 4
+
+// InstancedSpriteRenderer accumule les instances d'une même texture (position,
+// décalage UV dans l'atlas, teinte) et les dessine en un seul appel
+// DrawTrianglesShader, au lieu d'un DrawImage par sprite. Sans shader, le même
+// lot passe par DrawTriangles.
+type InstancedSpriteRenderer struct {
+	texture *ebiten.Image
+	shader  *ebiten.Shader // nil : DrawTriangles
+
+	// Taille d'une frame de l'atlas, en pixels
+	frameWidth  float32
+	frameHeight float32
+
+	vertices  []ebiten.Vertex
+	indices   []uint16
+	instances int
+}
+
+// LoadInstancedShader compile le shader des sprites instanciés ; nil (avec un
+// avertissement) s'il est introuvable ou non supporté
+func LoadInstancedShader() *ebiten.Shader {
+	source, err := os.ReadFile(InstancedShaderPath)
+	if err != nil {
+		fmt.Printf("⚠ Shader des sprites instanciés introuvable, DrawTriangles: %v\n", err)
+		return nil
+	}
+	shader, err := ebiten.NewShader(source)
+	if err != nil {
+		fmt.Printf("⚠ Shader des sprites instanciés non supporté, DrawTriangles: %v\n", err)
+		return nil
+	}
+	fmt.Println("✓ Shader des sprites instanciés compilé")
+	return shader
+}
+
+// NewInstancedSpriteRenderer crée le lot d'une texture dont les frames font
+// frameWidth x frameHeight (0 : toute la texture)
+func NewInstancedSpriteRenderer(texture *ebiten.Image, frameWidth, frameHeight int, shader *ebiten.Shader) *InstancedSpriteRenderer {
+	bounds := texture.Bounds()
+	if frameWidth <= 0 {
+		frameWidth = bounds.Dx()
+	}
+	if frameHeight <= 0 {
+		frameHeight = bounds.Dy()
+	}
+	return &InstancedSpriteRenderer{
+		texture:     texture,
+		shader:      shader,
+		frameWidth:  float32(frameWidth),
+		frameHeight: float32(frameHeight),
+		vertices:    make([]ebiten.Vertex, 0, 64*4),
+		indices:     make([]uint16, 0, 64*6),
+	}
+}
+
+// Add ajoute une instance : pos est son coin haut-gauche à l'écran, uv le coin
+// haut-gauche de sa frame dans la texture (pixels), tint sa teinte
+func (isr *InstancedSpriteRenderer) Add(pos, uv core.Vector2, tint core.Color) {
+	x, y := float32(pos.X), float32(pos.Y)
+	u, v := float32(uv.X), float32(uv.Y)
+	r := float32(tint.R) / 255
+	g := float32(tint.G) / 255
+	b := float32(tint.B) / 255
+	a := float32(tint.A) / 255
+
+	// Indices relatifs au lot de l'appel courant
+	base := uint16((isr.instances % maxInstancesPerDraw) * 4)
+	corners := [4][2]float32{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	for _, corner := range corners {
+		isr.vertices = append(isr.vertices, ebiten.Vertex{
+			DstX:   x + corner[0]*isr.frameWidth,
+			DstY:   y + corner[1]*isr.frameHeight,
+			SrcX:   u + corner[0]*isr.frameWidth,
+			SrcY:   v + corner[1]*isr.frameHeight,
+			ColorR: r,
+			ColorG: g,
+			ColorB: b,
+			ColorA: a,
+		})
+	}
+	isr.indices = append(isr.indices, base, base+1, base+2, base, base+2, base+3)
+	isr.instances++
+}
+
+// Count retourne le nombre d'instances en attente
+func (isr *InstancedSpriteRenderer) Count() int {
+	return isr.instances
+}
+
+// Flush dessine les instances en attente sur target puis vide le lot ;
+// retourne le nombre d'appels de dessin (un seul sous maxInstancesPerDraw)
+func (isr *InstancedSpriteRenderer) Flush(target *ebiten.Image) int {
+	drawCalls := 0
+	for start := 0; start < isr.instances; start += maxInstancesPerDraw {
+		end := min(start+maxInstancesPerDraw, isr.instances)
+		vertices := isr.vertices[start*4 : end*4]
+		indices := isr.indices[start*6 : end*6]
+
+		if isr.shader != nil {
+			op := &ebiten.DrawTrianglesShaderOptions{}
+			op.Images[0] = isr.texture
+			target.DrawTrianglesShader(vertices, indices, isr.shader, op)
+		} else {
+			op := &ebiten.DrawTrianglesOptions{ColorScaleMode: ebiten.ColorScaleModeStraightAlpha}
+			target.DrawTriangles(vertices, indices, isr.texture, op)
+		}
+		drawCalls++
+	}
+
+	isr.vertices = isr.vertices[:0]
+	isr.indices = isr.indices[:0]
+	isr.instances = 0
+	return drawCalls
+}
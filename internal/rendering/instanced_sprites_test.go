@@ -0,0 +1,148 @@
+package rendering
+
+import (
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"zelda-souls-game/internal/core"
+)
+
+// Foule du benchmark : 200 squelettes de 32x32 sur un atlas de 4 frames
+const (
+	crowdSize   = 200
+	skeletonDim = 32
+)
+
+// skeletonAtlas crée un atlas de 4 frames côte à côte
+func skeletonAtlas() *ebiten.Image {
+	return ebiten.NewImage(skeletonDim*4, skeletonDim)
+}
+
+// crowdPosition répartit la foule sur une grille de l'écran
+func crowdPosition(i int) core.Vector2 {
+	return core.Vector2{X: float64(i%20) * 60, Y: float64(i/20) * 60}
+}
+
+// crowdFrame alterne les frames d'animation de l'atlas
+func crowdFrame(i int) core.Vector2 {
+	return core.Vector2{X: float64(i%4) * skeletonDim}
+}
+
+// instancedShader compile le shader livré, depuis la racine du module
+func instancedShader(tb testing.TB) *ebiten.Shader {
+	tb.Helper()
+	source, err := os.ReadFile(filepath.Join("..", "..", InstancedShaderPath))
+	if err != nil {
+		tb.Fatalf("lecture du shader: %v", err)
+	}
+	shader, err := ebiten.NewShader(source)
+	if err != nil {
+		tb.Fatalf("compilation du shader des sprites instanciés: %v", err)
+	}
+	return shader
+}
+
+func TestInstancedFlushDrawCalls(t *testing.T) {
+	shader := instancedShader(t)
+	defer shader.Deallocate()
+
+	tests := []struct {
+		name      string
+		shader    *ebiten.Shader
+		instances int
+		wantCalls int
+	}{
+		{"lot vide", shader, 0, 0},
+		{"foule en un appel", shader, crowdSize, 1},
+		{"limite des indices 16 bits", shader, maxInstancesPerDraw, 1},
+		{"lot coupé au-delà", shader, maxInstancesPerDraw + 1, 2},
+		{"sans shader", nil, crowdSize, 1},
+	}
+
+	target := ebiten.NewImage(1280, 720)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isr := NewInstancedSpriteRenderer(skeletonAtlas(), skeletonDim, skeletonDim, tt.shader)
+			for i := 0; i < tt.instances; i++ {
+				isr.Add(crowdPosition(i), crowdFrame(i), core.Color{R: 255, G: 255, B: 255, A: 255})
+			}
+			if isr.Count() != tt.instances {
+				t.Errorf("Count = %d, attendu %d", isr.Count(), tt.instances)
+			}
+
+			if calls := isr.Flush(target); calls != tt.wantCalls {
+				t.Errorf("appels de dessin = %d, attendu %d", calls, tt.wantCalls)
+			}
+			if isr.Count() != 0 {
+				t.Errorf("Count après Flush = %d, attendu 0", isr.Count())
+			}
+		})
+	}
+}
+
+func TestInstancedAddQuad(t *testing.T) {
+	isr := NewInstancedSpriteRenderer(skeletonAtlas(), skeletonDim, skeletonDim, nil)
+	isr.Add(core.Vector2{X: 100, Y: 50}, core.Vector2{X: 64}, core.Color{R: 255, G: 0, B: 0, A: 255})
+	isr.Add(core.Vector2{X: 200, Y: 50}, core.Vector2{}, core.Color{R: 255, G: 255, B: 255, A: 255})
+
+	// Coin bas-droit de la première instance
+	corner := isr.vertices[2]
+	if corner.DstX != 132 || corner.DstY != 82 || corner.SrcX != 96 || corner.SrcY != 32 {
+		t.Errorf("coin = dst (%v, %v) src (%v, %v), attendu dst (132, 82) src (96, 32)",
+			corner.DstX, corner.DstY, corner.SrcX, corner.SrcY)
+	}
+	if corner.ColorR != 1 || corner.ColorG != 0 {
+		t.Errorf("teinte = (%v, %v), attendu (1, 0)", corner.ColorR, corner.ColorG)
+	}
+
+	// La seconde instance référence ses propres sommets
+	want := []uint16{4, 5, 6, 4, 6, 7}
+	for i, index := range isr.indices[6:] {
+		if index != want[i] {
+			t.Fatalf("indices = %v, attendu %v", isr.indices[6:], want)
+		}
+	}
+}
+
+// BenchmarkCrowdNaive dessine la foule avec un DrawImage par squelette
+func BenchmarkCrowdNaive(b *testing.B) {
+	atlas := skeletonAtlas()
+	frames := make([]*ebiten.Image, 4)
+	for i := range frames {
+		frames[i] = atlas.SubImage(image.Rect(i*skeletonDim, 0, (i+1)*skeletonDim, skeletonDim)).(*ebiten.Image)
+	}
+	target := ebiten.NewImage(1280, 720)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < crowdSize; i++ {
+			pos := crowdPosition(i)
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(pos.X, pos.Y)
+			op.ColorScale.Scale(1, 0.9, 0.9, 1)
+			target.DrawImage(frames[i%4], op)
+		}
+		target.Clear()
+	}
+}
+
+// BenchmarkCrowdInstanced dessine la même foule en un seul appel
+func BenchmarkCrowdInstanced(b *testing.B) {
+	shader := instancedShader(b)
+	defer shader.Deallocate()
+	isr := NewInstancedSpriteRenderer(skeletonAtlas(), skeletonDim, skeletonDim, shader)
+	target := ebiten.NewImage(1280, 720)
+	tint := core.Color{R: 255, G: 230, B: 230, A: 255}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < crowdSize; i++ {
+			isr.Add(crowdPosition(i), crowdFrame(i), tint)
+		}
+		isr.Flush(target)
+		target.Clear()
+	}
+}
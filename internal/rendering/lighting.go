@@ -0,0 +1,127 @@
+// internal/rendering/lighting.go - Éclairage 2D : obscurité percée par les lumières
+package rendering
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"zelda-souls-game/internal/core"
+)
+
+// Réglages de l'éclairage
+const (
+	lightTextureSize = 128  // Côté de la texture du dégradé radial
+	lightTintOpacity = 0.25 // Part de la couleur des lumières ajoutée à la scène
+)
+
+// LightLayer couche d'éclairage : une obscurité uniforme dont chaque lumière
+// retire un disque dégradé, composée par-dessus la scène, puis la teinte
+// additive des lumières. Le dégradé est précalculé une fois.
+type LightLayer struct {
+	image    *ebiten.Image
+	gradient *ebiten.Image
+	darkness float64
+
+	lights []core.Light
+}
+
+// NewLightLayer crée la couche d'éclairage d'un écran width x height ;
+// darkness est l'opacité de l'obscurité hors des lumières (0 à 1)
+func NewLightLayer(width, height int, darkness float64) *LightLayer {
+	if darkness <= 0 || darkness > 1 {
+		darkness = core.DefaultAmbientDarkness
+	}
+	return &LightLayer{
+		image:    ebiten.NewImage(width, height),
+		gradient: newRadialGradient(lightTextureSize),
+		darkness: darkness,
+		lights:   make([]core.Light, 0, 16),
+	}
+}
+
+// newRadialGradient crée un disque blanc opaque au centre et transparent au
+// bord (décroissance quadratique), en alpha prémultiplié
+func newRadialGradient(size int) *ebiten.Image {
+	pixels := image.NewRGBA(image.Rect(0, 0, size, size))
+	center := float64(size) / 2
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			distance := math.Hypot(float64(x)+0.5-center, float64(y)+0.5-center) / center
+			alpha := uint8(0)
+			if distance < 1 {
+				falloff := 1 - distance
+				alpha = uint8(math.Round(255 * falloff * falloff))
+			}
+			pixels.SetRGBA(x, y, color.RGBA{R: alpha, G: alpha, B: alpha, A: alpha})
+		}
+	}
+	return ebiten.NewImageFromImage(pixels)
+}
+
+// Begin oublie les lumières de la frame précédente
+func (ll *LightLayer) Begin() {
+	ll.lights = ll.lights[:0]
+}
+
+// Add ajoute une lumière à la frame
+func (ll *LightLayer) Add(light core.Light) {
+	if light.Radius <= 0 || light.Intensity <= 0 {
+		return
+	}
+	ll.lights = append(ll.lights, light)
+}
+
+// Count retourne le nombre de lumières de la frame
+func (ll *LightLayer) Count() int {
+	return len(ll.lights)
+}
+
+// Compose assombrit target hors des lumières puis y ajoute leur teinte
+func (ll *LightLayer) Compose(target *ebiten.Image, camera *Camera) {
+	ll.image.Fill(color.RGBA{A: uint8(math.Round(255 * ll.darkness))})
+
+	// Chaque lumière retire l'obscurité sous son dégradé
+	for _, light := range ll.lights {
+		op := ll.lightOptions(light, camera)
+		op.ColorScale.ScaleAlpha(float32(core.Clamp(light.Intensity, 0, 1)))
+		op.Blend = ebiten.BlendDestinationOut
+		ll.image.DrawImage(ll.gradient, op)
+	}
+	target.DrawImage(ll.image, &ebiten.DrawImageOptions{})
+
+	// Teinte colorée, additive
+	for _, light := range ll.lights {
+		op := ll.lightOptions(light, camera)
+		strength := float32(core.Clamp(light.Intensity, 0, 1) * lightTintOpacity)
+		op.ColorScale.Scale(
+			float32(light.Color.R)/255*strength,
+			float32(light.Color.G)/255*strength,
+			float32(light.Color.B)/255*strength,
+			strength,
+		)
+		op.Blend = ebiten.BlendLighter
+		target.DrawImage(ll.gradient, op)
+	}
+}
+
+// lightOptions place le dégradé sur le disque de la lumière, à l'écran
+func (ll *LightLayer) lightOptions(light core.Light, camera *Camera) *ebiten.DrawImageOptions {
+	center := camera.WorldToScreen(light.Position)
+	radius := light.Radius * camera.Zoom
+	scale := 2 * radius / lightTextureSize
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(center.X-radius, center.Y-radius)
+	op.Filter = ebiten.FilterLinear
+	return op
+}
+
+// Dispose libère les images
+func (ll *LightLayer) Dispose() {
+	ll.image.Deallocate()
+	ll.gradient.Deallocate()
+}
@@ -0,0 +1,301 @@
+// internal/rendering/post_process.go - Post-traitement de l'image finale
+package rendering
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
+
+	"zelda-souls-game/internal/core"
+)
+
+// Effect effet plein écran : Apply retourne l'image traitée, src elle-même
+// si l'effet est inactif, sinon une image appartenant à l'effet
+type Effect interface {
+	Apply(src *ebiten.Image) *ebiten.Image
+}
+
+// Effets activables par leur nom (post_effects de la configuration)
+const (
+	EffectVignette   = "vignette"
+	EffectColorGrade = "color_grade"
+	EffectBloom      = "bloom"
+)
+
+// NewEffect crée un effet par son nom, avec ses réglages par défaut
+func NewEffect(name string) (Effect, error) {
+	switch name {
+	case EffectVignette:
+		return NewVignetteEffect(core.ColorBlack, 0.45), nil
+	case EffectColorGrade:
+		return NewColorGradeEffect(), nil
+	case EffectBloom:
+		return NewBloomEffect(), nil
+	}
+	return nil, fmt.Errorf("effet de post-traitement inconnu: %q", name)
+}
+
+// PostProcessor chaîne d'effets appliqués dans l'ordre à l'image composée
+type PostProcessor struct {
+	effects []Effect
+}
+
+// NewPostProcessor crée une chaîne vide
+func NewPostProcessor() *PostProcessor {
+	return &PostProcessor{effects: make([]Effect, 0, 4)}
+}
+
+// Add ajoute un effet en fin de chaîne
+func (pp *PostProcessor) Add(effect Effect) {
+	if effect != nil {
+		pp.effects = append(pp.effects, effect)
+	}
+}
+
+// Count retourne le nombre d'effets
+func (pp *PostProcessor) Count() int {
+	return len(pp.effects)
+}
+
+// Apply fait passer src par tous les effets et retourne le résultat
+func (pp *PostProcessor) Apply(src *ebiten.Image) *ebiten.Image {
+	current := src
+	for _, effect := range pp.effects {
+		current = effect.Apply(current)
+	}
+	return current
+}
+
+// Dispose libère les images des effets qui en possèdent
+func (pp *PostProcessor) Dispose() {
+	for _, effect := range pp.effects {
+		if disposable, ok := effect.(interface{ Dispose() }); ok {
+			disposable.Dispose()
+		}
+	}
+	pp.effects = nil
+}
+
+// effectTarget retourne *target, (re)créée à la taille de src
+func effectTarget(target **ebiten.Image, src *ebiten.Image) *ebiten.Image {
+	size := src.Bounds().Size()
+	if *target == nil || (*target).Bounds().Size() != size {
+		if *target != nil {
+			(*target).Deallocate()
+		}
+		*target = ebiten.NewImage(size.X, size.Y)
+	}
+	(*target).Clear()
+	return *target
+}
+
+// disposeImage libère une image d'effet
+func disposeImage(img **ebiten.Image) {
+	if *img != nil {
+		(*img).Deallocate()
+		*img = nil
+	}
+}
+
+// ===============================
+// VIGNETTE
+// ===============================
+
+// VignetteEffect assombrit (ou teinte) les bords de l'écran ; Strength 0 le
+// rend inactif, ce qui permet de l'animer (voile de mort)
+type VignetteEffect struct {
+	Color    core.Color
+	Strength float64 // 0 à 1
+
+	mask   *ebiten.Image // Masque blanc, transparent au centre
+	output *ebiten.Image
+}
+
+// Distance au centre (fraction de la demi-diagonale) où la vignette commence
+const vignetteInnerRadius = 0.45
+
+// NewVignetteEffect crée une vignette de couleur et d'intensité données
+func NewVignetteEffect(tint core.Color, strength float64) *VignetteEffect {
+	return &VignetteEffect{Color: tint, Strength: strength}
+}
+
+// Apply implémente Effect
+func (ve *VignetteEffect) Apply(src *ebiten.Image) *ebiten.Image {
+	strength := core.Clamp(ve.Strength, 0, 1)
+	if strength == 0 {
+		return src
+	}
+	size := src.Bounds().Size()
+	if ve.mask == nil || ve.mask.Bounds().Size() != size {
+		disposeImage(&ve.mask)
+		ve.mask = newVignetteMask(size.X, size.Y)
+	}
+
+	output := effectTarget(&ve.output, src)
+	output.DrawImage(src, &ebiten.DrawImageOptions{Blend: ebiten.BlendCopy})
+
+	op := &ebiten.DrawImageOptions{}
+	alpha := float32(strength) * float32(ve.Color.A) / 255
+	op.ColorScale.Scale(
+		float32(ve.Color.R)/255*alpha,
+		float32(ve.Color.G)/255*alpha,
+		float32(ve.Color.B)/255*alpha,
+		alpha,
+	)
+	output.DrawImage(ve.mask, op)
+	return output
+}
+
+// newVignetteMask crée le masque : opacité nulle jusqu'à vignetteInnerRadius
+// puis croissante (lissée) jusqu'aux coins, en alpha prémultiplié
+func newVignetteMask(width, height int) *ebiten.Image {
+	pixels := image.NewRGBA(image.Rect(0, 0, width, height))
+	centerX, centerY := float64(width)/2, float64(height)/2
+	halfDiagonal := math.Hypot(centerX, centerY)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			distance := math.Hypot(float64(x)+0.5-centerX, float64(y)+0.5-centerY) / halfDiagonal
+			t := core.Clamp((distance-vignetteInnerRadius)/(1-vignetteInnerRadius), 0, 1)
+			alpha := uint8(math.Round(255 * core.EaseSmoothStep(t)))
+			pixels.SetRGBA(x, y, color.RGBA{R: alpha, G: alpha, B: alpha, A: alpha})
+		}
+	}
+	return ebiten.NewImageFromImage(pixels)
+}
+
+// Dispose libère les images
+func (ve *VignetteEffect) Dispose() {
+	disposeImage(&ve.mask)
+	disposeImage(&ve.output)
+}
+
+// ===============================
+// ÉTALONNAGE
+// ===============================
+
+// ColorGradeEffect étalonnage : luminosité, contraste, saturation et teinte
+type ColorGradeEffect struct {
+	Brightness float64 // Décalage ajouté (-1 à 1)
+	Contrast   float64 // 1 : inchangé
+	Saturation float64 // 1 : inchangée, 0 : noir et blanc
+	Tint       core.Color
+
+	output *ebiten.Image
+}
+
+// NewColorGradeEffect crée l'étalonnage par défaut : couleurs un peu ternes et contrastées
+func NewColorGradeEffect() *ColorGradeEffect {
+	return &ColorGradeEffect{
+		Contrast:   1.1,
+		Saturation: 0.85,
+		Tint:       core.Color{R: 255, G: 245, B: 235, A: 255},
+	}
+}
+
+// Apply implémente Effect
+func (cge *ColorGradeEffect) Apply(src *ebiten.Image) *ebiten.Image {
+	var cm colorm.ColorM
+	cm.ChangeHSV(0, cge.Saturation, 1)
+
+	// Contraste autour du gris moyen, puis luminosité
+	offset := 0.5*(1-cge.Contrast) + cge.Brightness
+	cm.Scale(cge.Contrast, cge.Contrast, cge.Contrast, 1)
+	cm.Translate(offset, offset, offset, 0)
+	cm.Scale(float64(cge.Tint.R)/255, float64(cge.Tint.G)/255, float64(cge.Tint.B)/255, 1)
+
+	output := effectTarget(&cge.output, src)
+	colorm.DrawImage(output, src, cm, &colorm.DrawImageOptions{Blend: ebiten.BlendCopy})
+	return output
+}
+
+// Dispose libère l'image
+func (cge *ColorGradeEffect) Dispose() {
+	disposeImage(&cge.output)
+}
+
+// ===============================
+// BLOOM
+// ===============================
+
+// BloomEffect halo des zones claires : elles sont extraites, floutées par
+// réductions successives (filtrage linéaire) puis ajoutées à l'image
+type BloomEffect struct {
+	Threshold float64 // Luminosité à partir de laquelle une zone brille (0 à 1)
+	Intensity float64 // Force du halo ajouté
+
+	levels []*ebiten.Image // Réductions successives (1/2, 1/4, 1/8)
+	output *ebiten.Image
+}
+
+// Nombre de réductions de moitié : plus il y en a, plus le halo est large
+const bloomLevels = 3
+
+// NewBloomEffect crée un bloom léger
+func NewBloomEffect() *BloomEffect {
+	return &BloomEffect{Threshold: 0.7, Intensity: 0.6}
+}
+
+// Apply implémente Effect
+func (be *BloomEffect) Apply(src *ebiten.Image) *ebiten.Image {
+	if be.Intensity <= 0 {
+		return src
+	}
+	be.allocateLevels(src.Bounds().Size())
+
+	// Extraction des zones claires dans la première réduction
+	var extract colorm.ColorM
+	gain := 1 / math.Max(1-be.Threshold, 0.01)
+	extract.Translate(-be.Threshold, -be.Threshold, -be.Threshold, 0)
+	extract.Scale(gain, gain, gain, 1)
+	op := &colorm.DrawImageOptions{Blend: ebiten.BlendCopy, Filter: ebiten.FilterLinear}
+	op.GeoM.Scale(0.5, 0.5)
+	be.levels[0].Clear()
+	colorm.DrawImage(be.levels[0], src, extract, op)
+
+	// Flou par réductions
+	for i := 1; i < len(be.levels); i++ {
+		down := &ebiten.DrawImageOptions{Blend: ebiten.BlendCopy, Filter: ebiten.FilterLinear}
+		down.GeoM.Scale(0.5, 0.5)
+		be.levels[i].Clear()
+		be.levels[i].DrawImage(be.levels[i-1], down)
+	}
+
+	output := effectTarget(&be.output, src)
+	output.DrawImage(src, &ebiten.DrawImageOptions{Blend: ebiten.BlendCopy})
+	for i, level := range be.levels {
+		up := &ebiten.DrawImageOptions{Blend: ebiten.BlendLighter, Filter: ebiten.FilterLinear}
+		scale := math.Pow(2, float64(i+1))
+		up.GeoM.Scale(scale, scale)
+		up.ColorScale.ScaleAlpha(float32(be.Intensity / bloomLevels))
+		output.DrawImage(level, up)
+	}
+	return output
+}
+
+// allocateLevels (re)crée les réductions pour une image de taille size
+func (be *BloomEffect) allocateLevels(size image.Point) {
+	if len(be.levels) == bloomLevels && be.levels[0].Bounds().Size() == size.Div(2) {
+		return
+	}
+	for i := range be.levels {
+		disposeImage(&be.levels[i])
+	}
+	be.levels = make([]*ebiten.Image, bloomLevels)
+	for i := range be.levels {
+		divisor := 1 << (i + 1)
+		be.levels[i] = ebiten.NewImage(max(1, size.X/divisor), max(1, size.Y/divisor))
+	}
+}
+
+// Dispose libère les images
+func (be *BloomEffect) Dispose() {
+	for i := range be.levels {
+		disposeImage(&be.levels[i])
+	}
+	be.levels = nil
+	disposeImage(&be.output)
+}
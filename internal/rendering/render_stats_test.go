@@ -0,0 +1,97 @@
+package rendering
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"zelda-souls-game/internal/core"
+)
+
+// newStatsRenderer crée un renderer sans culling, avec une seconde texture
+func newStatsRenderer(t *testing.T, batching bool) *Renderer {
+	t.Helper()
+	config := core.GetDefaultConfig()
+	config.Rendering.EnableBatching = batching
+	config.Rendering.EnableCulling = false
+
+	r, err := NewRenderer(config)
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+	r.textures["autre"] = ebiten.NewImage(8, 8)
+	return r
+}
+
+// repeatTexture répète un identifiant de texture count fois
+func repeatTexture(textureID string, count int) []string {
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = textureID
+	}
+	return ids
+}
+
+func TestRenderStatsCountBatchedSprites(t *testing.T) {
+	tests := []struct {
+		name          string
+		batching      bool
+		batchSize     int      // 0 : taille par défaut
+		textures      []string // Textures dessinées, dans l'ordre
+		wantDrawCalls int
+		wantBatches   int
+	}{
+		{"un lot", true, 0, repeatTexture(BenchmarkTextureID, 10), 1, 1},
+		{"lot plein", true, 4, repeatTexture(BenchmarkTextureID, 10), 3, 3},
+		{"changement de texture", true, 0, []string{BenchmarkTextureID, "autre", BenchmarkTextureID, "autre"}, 4, 4},
+		{"sans batch", false, 0, repeatTexture(BenchmarkTextureID, 5), 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newStatsRenderer(t, tt.batching)
+			if tt.batchSize > 0 {
+				r.spriteBatch = NewSpriteBatch(tt.batchSize)
+				r.spriteBatch.target = r.mainImage
+			}
+
+			r.BeginFrame()
+			for i, textureID := range tt.textures {
+				r.DrawSprite(textureID, core.Vector2{X: float64(i * 20), Y: 100}, NewDrawSpriteOptions())
+			}
+			r.EndFrame()
+
+			stats := r.GetStats()
+			if stats.SpritesDrawn != len(tt.textures) {
+				t.Errorf("SpritesDrawn = %d, attendu %d", stats.SpritesDrawn, len(tt.textures))
+			}
+			if stats.TrianglesDrawn != 2*len(tt.textures) {
+				t.Errorf("TrianglesDrawn = %d, attendu %d", stats.TrianglesDrawn, 2*len(tt.textures))
+			}
+			if stats.DrawCalls != tt.wantDrawCalls {
+				t.Errorf("DrawCalls = %d, attendu %d", stats.DrawCalls, tt.wantDrawCalls)
+			}
+			if stats.BatchesFlushed != tt.wantBatches {
+				t.Errorf("BatchesFlushed = %d, attendu %d", stats.BatchesFlushed, tt.wantBatches)
+			}
+
+			drawCalls, sprites, batches := r.FrameStats()
+			if drawCalls != stats.DrawCalls || sprites != stats.SpritesDrawn || batches != stats.BatchesFlushed {
+				t.Errorf("FrameStats = (%d, %d, %d), attendu les statistiques de la frame", drawCalls, sprites, batches)
+			}
+		})
+	}
+}
+
+func TestRenderStatsResetEachFrame(t *testing.T) {
+	r := newStatsRenderer(t, true)
+
+	r.BeginFrame()
+	r.DrawSprite(BenchmarkTextureID, core.Vector2{}, NewDrawSpriteOptions())
+	r.EndFrame()
+
+	r.BeginFrame()
+	r.EndFrame()
+	if stats := *r.GetStats(); stats != (RenderStats{}) {
+		t.Errorf("statistiques d'une frame vide = %+v, attendu à zéro", stats)
+	}
+}
@@ -23,6 +23,9 @@ import (
 // RENDERER STRUCTURE
 // ===============================
 
+// BenchmarkTextureID texture générée pour le mode benchmark
+const BenchmarkTextureID = core.BenchmarkTextureID
+
 // Renderer implémente le système de rendu avec Ebiten
 type Renderer struct {
 	// Configuration
@@ -32,18 +35,41 @@ type Renderer struct {
 
 	// Buffers de rendu
 	mainImage  *ebiten.Image
+	decalImage *ebiten.Image // Décalques au sol, vidée et redessinée à chaque frame
 	uiImage    *ebiten.Image
 	debugImage *ebiten.Image
 
+	// Image blanche servant de source pour les triangles en couleur unie
+	whiteImage *ebiten.Image
+
 	// Gestion des textures
 	textures     map[string]*ebiten.Image // Changé de core.TextureID à string
 	textureCache map[string]*ebiten.Image
 
+	// Tuiles animées (shader de l'eau)
+	tileAnimator *TileAnimator
+
+	// Éclairage 2D (nil si désactivé)
+	lighting *LightLayer
+
+	// Post-traitement de l'image finale (nil si désactivé) ; la vignette de
+	// l'écran, pilotée par le jeu (voile de mort), ouvre la chaîne
+	postProcessor  *PostProcessor
+	screenVignette *VignetteEffect
+
+	// Teinte globale de la scène (cycle jour/nuit), blanche par défaut
+	ambientTint core.Color
+
 	// Batch rendering
 	spriteBatch  *SpriteBatch
 	drawCalls    int
 	maxDrawCalls int
 
+	// Sprites instanciés : un lot par texture, dessiné en fin de frame
+	instancedShader  *ebiten.Shader // nil : lots dessinés par DrawTriangles
+	instancedBatches map[string]*InstancedSpriteRenderer
+	instancedOrder   []string // Textures des lots dans l'ordre de leur premier sprite de la frame
+
 	// Caméra et viewport
 	camera         *Camera
 	viewportBounds core.Rectangle
@@ -52,13 +78,22 @@ type Renderer struct {
 	defaultFont font.Face
 	fonts       map[string]font.Face
 
-	// Debug info
-	debugEnabled  bool
-	showColliders bool
-	showChunks    bool
+	// Accessibilité
+	colorMatrix  *core.ColorMatrix // nil sans mode daltonien
+	uiScale      float64
+	highContrast bool
 
-	// Statistiques
-	stats *RenderStats
+	// Debug info
+	debugEnabled    bool
+	showColliders   bool
+	showChunks      bool
+	showRenderStats bool
+
+	// Statistiques (lastStats : frame précédente complète) et textures
+	// distinctes utilisées pendant la frame
+	stats         *RenderStats
+	lastStats     RenderStats
+	frameTextures map[*ebiten.Image]struct{}
 }
 
 // SpriteBatch optimise le rendu des sprites
@@ -70,6 +105,8 @@ type SpriteBatch struct {
 	currentTexture *ebiten.Image
 	batchSize      int
 	maxBatchSize   int
+	target         *ebiten.Image // Image où les lots sont dessinés
+	stats          *RenderStats  // Statistiques de la frame (lots, triangles, draw calls)
 }
 
 // RenderStats contient les statistiques de rendu
@@ -88,33 +125,67 @@ type RenderStats struct {
 // NewRenderer crée un nouveau renderer
 func NewRenderer(config *core.GameConfig) (*Renderer, error) {
 	renderer := &Renderer{
-		config:        config,
-		width:         config.WindowWidth(),
-		height:        config.WindowHeight(),
-		textures:      make(map[string]*ebiten.Image), // Changé
-		textureCache:  make(map[string]*ebiten.Image),
-		fonts:         make(map[string]font.Face),
-		maxDrawCalls:  config.Rendering.MaxDrawCalls,
-		debugEnabled:  config.Debug.EnableDebug,
-		showColliders: config.Debug.ShowColliders,
-		showChunks:    config.Debug.ShowChunkBorders,
-		stats:         &RenderStats{},
+		config:          config,
+		width:           config.WindowWidth(),
+		height:          config.WindowHeight(),
+		textures:        make(map[string]*ebiten.Image), // Changé
+		textureCache:    make(map[string]*ebiten.Image),
+		fonts:           make(map[string]font.Face),
+		maxDrawCalls:    config.Rendering.MaxDrawCalls,
+		debugEnabled:    config.Debug.EnableDebug,
+		showColliders:   config.Debug.ShowColliders,
+		showChunks:      config.Debug.ShowChunkBorders,
+		showRenderStats: config.Debug.ShowRenderStats,
+		stats:           &RenderStats{},
+		frameTextures:   make(map[*ebiten.Image]struct{}),
+
+		instancedBatches: make(map[string]*InstancedSpriteRenderer),
+		ambientTint:      core.ColorWhite,
 	}
 
 	// Initialiser les images de rendu
 	renderer.mainImage = ebiten.NewImage(renderer.width, renderer.height)
+	renderer.decalImage = ebiten.NewImage(renderer.width, renderer.height)
 	renderer.uiImage = ebiten.NewImage(renderer.width, renderer.height)
 	renderer.debugImage = ebiten.NewImage(renderer.width, renderer.height)
 
+	// Sous-image 1x1 au centre d'une image 3x3 pour éviter le filtrage des bords
+	whiteImage := ebiten.NewImage(3, 3)
+	whiteImage.Fill(color.White)
+	renderer.whiteImage = whiteImage.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+
 	// Initialiser la caméra
 	renderer.camera = NewCamera(
 		core.Vector2{X: 0, Y: 0},
 		float64(renderer.width),
 		float64(renderer.height),
 	)
+	if config.Rendering.CameraMultiTargetPadding > 0 {
+		renderer.camera.MultiTargetPadding = config.Rendering.CameraMultiTargetPadding
+	}
+
+	// Tuiles animées : l'eau passe par son shader quand il est supporté
+	renderer.tileAnimator = NewTileAnimator(config.Rendering)
+
+	// Éclairage optionnel
+	if config.Rendering.EnableLighting {
+		renderer.lighting = NewLightLayer(renderer.width, renderer.height, config.Rendering.AmbientDarkness)
+	}
+
+	// Post-traitement optionnel : sans lui, aucun coût
+	if config.Rendering.EnablePostProcessing {
+		renderer.setupPostProcessing(config.Rendering.PostEffects)
+	}
+
+	// Texture unie du mode benchmark
+	benchmarkImage := ebiten.NewImage(16, 16)
+	benchmarkImage.Fill(color.RGBA{R: 230, G: 80, B: 200, A: 255})
+	renderer.textures[BenchmarkTextureID] = benchmarkImage
 
 	// Initialiser le batch de sprites
 	renderer.spriteBatch = NewSpriteBatch(1000) // 1000 sprites max par batch
+	renderer.spriteBatch.target = renderer.mainImage
+	renderer.instancedShader = LoadInstancedShader()
 
 	// Charger la police par défaut
 	renderer.defaultFont = basicfont.Face7x13
@@ -123,6 +194,8 @@ func NewRenderer(config *core.GameConfig) (*Renderer, error) {
 	// Calculer le viewport
 	renderer.updateViewport()
 
+	renderer.ApplyAccessibility(config.Accessibility)
+
 	return renderer, nil
 }
 
@@ -135,30 +208,51 @@ func (r *Renderer) BeginFrame() {
 	// Réinitialiser les statistiques
 	r.stats = &RenderStats{}
 	r.drawCalls = 0
+	clear(r.frameTextures)
 
 	// Vider les buffers
 	r.mainImage.Clear()
+	r.decalImage.Clear()
 	r.uiImage.Clear()
 	if r.debugEnabled {
 		r.debugImage.Clear()
 	}
 
+	if r.lighting != nil {
+		r.lighting.Begin()
+	}
+	if r.screenVignette != nil {
+		r.screenVignette.Strength = 0 // Redemandée à chaque frame
+	}
+	r.ambientTint = core.ColorWhite // Idem
+
 	// Commencer le batch
+	r.spriteBatch.stats = r.stats
 	r.spriteBatch.Begin()
 }
 
 // EndFrame termine le frame et affiche le résultat
 func (r *Renderer) EndFrame() {
-	// Terminer le batch
+	// Terminer le batch, puis les sprites instanciés
 	r.spriteBatch.End()
+	r.flushInstanced()
+	r.stats.DrawCalls += r.drawCalls
+	r.stats.TexturesUsed = len(r.frameTextures)
+	r.lastStats = *r.stats
+
+	if r.debugEnabled && r.showRenderStats {
+		r.drawRenderStats()
+	}
 
 	// Composer les couches finales
 	r.composeFinalImage()
+	r.applyPostProcessing()
 }
 
 // Clear vide l'écran (méthode ajoutée pour compatibilité)
 func (r *Renderer) Clear() {
 	r.mainImage.Clear()
+	r.decalImage.Clear()
 	r.uiImage.Clear()
 	if r.debugEnabled {
 		r.debugImage.Clear()
@@ -173,7 +267,12 @@ func (r *Renderer) Present() {
 
 // composeFinalImage compose toutes les couches en une image finale
 func (r *Renderer) composeFinalImage() {
-	// L'image principale est déjà dans mainImage
+	// L'image principale est déjà dans mainImage ; la teinte et l'éclairage ne
+	// touchent que la scène
+	r.applyAmbientTint()
+	if r.lighting != nil {
+		r.lighting.Compose(r.mainImage, r.camera)
+	}
 
 	// Ajouter l'UI par dessus
 	op := &ebiten.DrawImageOptions{}
@@ -185,6 +284,84 @@ func (r *Renderer) composeFinalImage() {
 	}
 }
 
+// ambientTintBlend multiplie la scène par la couleur dessinée
+var ambientTintBlend = ebiten.Blend{
+	BlendFactorSourceRGB:        ebiten.BlendFactorZero,
+	BlendFactorSourceAlpha:      ebiten.BlendFactorZero,
+	BlendFactorDestinationRGB:   ebiten.BlendFactorSourceColor,
+	BlendFactorDestinationAlpha: ebiten.BlendFactorOne,
+	BlendOperationRGB:           ebiten.BlendOperationAdd,
+	BlendOperationAlpha:         ebiten.BlendOperationAdd,
+}
+
+// SetAmbientTint teinte toute la scène pour cette frame (cycle jour/nuit) ;
+// le blanc la laisse intacte
+func (r *Renderer) SetAmbientTint(tint core.Color) {
+	r.ambientTint = tint
+}
+
+// applyAmbientTint multiplie les couleurs de la scène par la teinte ambiante
+func (r *Renderer) applyAmbientTint() {
+	if r.ambientTint == core.ColorWhite {
+		return
+	}
+	op := &ebiten.DrawImageOptions{Blend: ambientTintBlend}
+	op.GeoM.Scale(float64(r.width), float64(r.height))
+	op.ColorScale.Scale(
+		float32(r.ambientTint.R)/255,
+		float32(r.ambientTint.G)/255,
+		float32(r.ambientTint.B)/255,
+		1,
+	)
+	r.mainImage.DrawImage(r.whiteImage, op)
+}
+
+// setupPostProcessing crée la chaîne : vignette de l'écran puis effets nommés
+func (r *Renderer) setupPostProcessing(effects []string) {
+	r.postProcessor = NewPostProcessor()
+	r.screenVignette = NewVignetteEffect(core.ColorRed, 0)
+	r.postProcessor.Add(r.screenVignette)
+	for _, name := range effects {
+		effect, err := NewEffect(name)
+		if err != nil {
+			fmt.Printf("⚠ %v\n", err)
+			continue
+		}
+		r.postProcessor.Add(effect)
+	}
+	fmt.Printf("✓ Post-traitement activé (%d effet(s))\n", r.postProcessor.Count()-1)
+}
+
+// AddPostEffect ajoute un effet en fin de chaîne ; false si le post-traitement est désactivé
+func (r *Renderer) AddPostEffect(effect Effect) bool {
+	if r.postProcessor == nil {
+		return false
+	}
+	r.postProcessor.Add(effect)
+	return true
+}
+
+// SetVignette teinte les bords de l'écran pour cette frame (voile de mort) ;
+// false si le post-traitement est désactivé
+func (r *Renderer) SetVignette(tint core.Color, strength float64) bool {
+	if r.screenVignette == nil {
+		return false
+	}
+	r.screenVignette.Color = tint
+	r.screenVignette.Strength = strength
+	return true
+}
+
+// applyPostProcessing fait passer l'image composée par la chaîne d'effets
+func (r *Renderer) applyPostProcessing() {
+	if r.postProcessor == nil {
+		return
+	}
+	if result := r.postProcessor.Apply(r.mainImage); result != r.mainImage {
+		r.mainImage.DrawImage(result, &ebiten.DrawImageOptions{Blend: ebiten.BlendCopy})
+	}
+}
+
 // ===============================
 // DRAWING METHODS
 // ===============================
@@ -215,7 +392,14 @@ func (r *Renderer) DrawSprite(textureID string, position core.Vector2, options *
 
 	// Utiliser le batch si possible
 	if r.config.Rendering.EnableBatching {
-		r.spriteBatch.DrawSprite(texture, position, options)
+		// Le batch centre le quad : passer le centre du sprite à l'écran
+		screenPos := r.camera.WorldToScreen(position)
+		center := core.Vector2{
+			X: screenPos.X + float64(texture.Bounds().Dx())*options.ScaleX/2,
+			Y: screenPos.Y + float64(texture.Bounds().Dy())*options.ScaleY/2,
+		}
+		r.spriteBatch.DrawSprite(texture, center, options)
+		r.useTexture(texture)
 	} else {
 		r.drawSpriteDirect(texture, position, options)
 	}
@@ -223,8 +407,8 @@ func (r *Renderer) DrawSprite(textureID string, position core.Vector2, options *
 	r.stats.SpritesDrawn++
 }
 
-// DrawTile dessine une tile de la carte
-func (r *Renderer) DrawTile(textureID string, srcRect core.Rectangle, destRect core.Rectangle) {
+// DrawTile dessine une tile de la carte ; les tuiles d'eau sont animées par le TileAnimator
+func (r *Renderer) DrawTile(textureID string, srcRect core.Rectangle, destRect core.Rectangle, kind TileKind) {
 	texture := r.getTexture(textureID)
 	if texture == nil {
 		return
@@ -238,24 +422,78 @@ func (r *Renderer) DrawTile(textureID string, srcRect core.Rectangle, destRect c
 	// Convertir en coordonnées écran
 	screenPos := r.camera.WorldToScreen(core.Vector2{X: destRect.X, Y: destRect.Y})
 
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(screenPos.X, screenPos.Y)
-
 	// Créer une sous-image pour la partie source
 	srcImage := texture.SubImage(image.Rect(
 		int(srcRect.X), int(srcRect.Y),
 		int(srcRect.X+srcRect.Width), int(srcRect.Y+srcRect.Height),
 	)).(*ebiten.Image)
 
-	r.mainImage.DrawImage(srcImage, op)
+	r.spriteBatch.Flush() // Garder l'ordre de dessin avec les sprites en attente
+	r.tileAnimator.DrawTile(r.mainImage, srcImage, screenPos, kind)
 	r.drawCalls++
+	r.stats.TrianglesDrawn += 2
 	r.stats.SpritesDrawn++
+	r.useTexture(texture)
 }
 
 // DrawText dessine du texte à l'écran
 func (r *Renderer) DrawText(textStr string, position core.Vector2, color core.Color) {
-	clr := r.coreColorToEbiten(color)
-	text.Draw(r.uiImage, textStr, r.defaultFont, int(position.X), int(position.Y), clr)
+	r.drawUIText(textStr, r.defaultFont, position, color)
+}
+
+// highContrastOffsets décalages du contour du texte en contraste élevé
+var highContrastOffsets = []core.Vector2{{X: -1, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: 1}}
+
+// DrawTextScaled dessine du texte agrandi (scale) depuis sa ligne de base
+func (r *Renderer) DrawTextScaled(textStr string, position core.Vector2, scale float64, color core.Color) {
+	r.drawUITextScaled(textStr, r.defaultFont, position, color, scale)
+}
+
+// drawUIText dessine du texte d'UI avec l'échelle et le contraste d'accessibilité
+func (r *Renderer) drawUIText(textStr string, face font.Face, position core.Vector2, color core.Color) {
+	r.drawUITextScaled(textStr, face, position, color, 1)
+}
+
+// drawUITextScaled dessine du texte d'UI agrandi de scale, en plus de
+// l'échelle d'accessibilité
+func (r *Renderer) drawUITextScaled(textStr string, face font.Face, position core.Vector2, color core.Color, scale float64) {
+	scale *= r.uiScale
+	if scale == 1 && !r.highContrast {
+		text.Draw(r.uiImage, textStr, face, int(position.X), int(position.Y), r.coreColorToEbiten(color))
+		return
+	}
+
+	draw := func(offset core.Vector2, clr core.Color) {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(offset.X, offset.Y)
+		op.GeoM.Scale(scale, scale)
+		op.GeoM.Translate(position.X, position.Y)
+		op.ColorScale.ScaleWithColor(r.coreColorToEbiten(clr))
+		text.DrawWithOptions(r.uiImage, textStr, face, op)
+	}
+
+	// Contour sombre épaississant le trait
+	if r.highContrast {
+		outline := core.Color{R: 0, G: 0, B: 0, A: color.A}
+		for _, offset := range highContrastOffsets {
+			draw(offset, outline)
+		}
+	}
+	draw(core.Vector2{}, color)
+}
+
+// DrawTextWithShadow dessine l'ombre à position+shadowOffset (aucune si le
+// décalage est nul), le contour si la config le demande, puis le texte par-dessus
+func (r *Renderer) DrawTextWithShadow(textStr string, position core.Vector2, color, shadowColor core.Color, shadowOffset core.Vector2) {
+	if shadowOffset != (core.Vector2{}) {
+		r.drawUIText(textStr, r.defaultFont, position.Add(shadowOffset), shadowColor)
+	}
+	if r.config.Rendering.HUDText.Stroke {
+		for _, offset := range highContrastOffsets {
+			r.drawUIText(textStr, r.defaultFont, position.Add(offset), shadowColor)
+		}
+	}
+	r.drawUIText(textStr, r.defaultFont, position, color)
 }
 
 // DrawTextWithFont dessine du texte avec une police spécifique
@@ -265,8 +503,7 @@ func (r *Renderer) DrawTextWithFont(textStr string, position core.Vector2, fontN
 		font = r.defaultFont
 	}
 
-	clr := r.coreColorToEbiten(color)
-	text.Draw(r.uiImage, textStr, font, int(position.X), int(position.Y), clr)
+	r.drawUIText(textStr, font, position, color)
 }
 
 // DrawRectangle dessine un rectangle (pour debug principalement)
@@ -334,6 +571,87 @@ func (r *Renderer) DrawCircle(center core.Vector2, radius float32, color core.Co
 	}
 }
 
+// DrawPolygon dessine un polygone convexe (hitboxes non rectangulaires)
+func (r *Renderer) DrawPolygon(vertices []core.Vector2, color core.Color, filled bool) {
+	if len(vertices) < 3 {
+		return
+	}
+
+	clr := r.coreColorToEbiten(color)
+
+	if !filled {
+		for _, edge := range polygonOutline(vertices) {
+			start, end := edge[0], edge[1]
+			vector.StrokeLine(
+				r.debugImage,
+				float32(start.X), float32(start.Y),
+				float32(end.X), float32(end.Y),
+				1.0, clr, false,
+			)
+		}
+		return
+	}
+
+	// Couleur prémultipliée (ColorScaleModePremultipliedAlpha ci-dessous)
+	cr := float32(color.R) / 255
+	cg := float32(color.G) / 255
+	cb := float32(color.B) / 255
+	ca := float32(color.A) / 255
+
+	points, indices := triangulatePolygonFan(vertices)
+	ebitenVertices := make([]ebiten.Vertex, len(points))
+	for i, p := range points {
+		ebitenVertices[i] = ebiten.Vertex{
+			DstX:   float32(p.X),
+			DstY:   float32(p.Y),
+			SrcX:   1,
+			SrcY:   1,
+			ColorR: cr * ca,
+			ColorG: cg * ca,
+			ColorB: cb * ca,
+			ColorA: ca,
+		}
+	}
+
+	r.debugImage.DrawTriangles(ebitenVertices, indices, r.whiteImage, &ebiten.DrawTrianglesOptions{
+		ColorScaleMode: ebiten.ColorScaleModePremultipliedAlpha,
+	})
+	r.stats.TrianglesDrawn += len(indices) / 3
+}
+
+// polygonOutline retourne les arêtes d'un polygone fermé, du sommet i au
+// sommet i+1 (la dernière revient au premier)
+func polygonOutline(vertices []core.Vector2) [][2]core.Vector2 {
+	edges := make([][2]core.Vector2, len(vertices))
+	for i := range vertices {
+		edges[i] = [2]core.Vector2{vertices[i], vertices[(i+1)%len(vertices)]}
+	}
+	return edges
+}
+
+// triangulatePolygonFan découpe un polygone en triangles disposés en éventail
+// autour de son centroïde. Retourne les sommets (centroïde en premier) et les indices.
+func triangulatePolygonFan(vertices []core.Vector2) ([]core.Vector2, []uint16) {
+	centroid := core.Vector2{}
+	for _, v := range vertices {
+		centroid = centroid.Add(v)
+	}
+	centroid = centroid.Mul(1.0 / float64(len(vertices)))
+
+	points := make([]core.Vector2, 0, len(vertices)+1)
+	points = append(points, centroid)
+	points = append(points, vertices...)
+
+	n := len(vertices)
+	indices := make([]uint16, 0, n*3)
+	for i := 0; i < n; i++ {
+		next := (i+1)%n + 1
+		indices = append(indices, 0, uint16(i+1), uint16(next))
+	}
+
+	return points, indices
+}
+
 // ===============================
 // TEXTURE MANAGEMENT
 // ===============================
@@ -364,6 +682,27 @@ func (r *Renderer) LoadTexture(id string, filepath string) error {
 	return nil
 }
 
+// StoreTexture met en cache une image déjà décodée (préchargement) : un
+// LoadTexture ultérieur sur ce chemin n'accède plus au disque
+func (r *Renderer) StoreTexture(filepath string, img image.Image) {
+	r.textureCache[filepath] = ebiten.NewImageFromImage(img)
+}
+
+// ReloadTexture recharge une texture depuis le disque, même si déjà chargée
+func (r *Renderer) ReloadTexture(id string, filepath string) error {
+	delete(r.textures, id)
+	delete(r.textureCache, filepath)
+	return r.LoadTexture(id, filepath)
+}
+
+// RefreshDebugFlags relit les options de debug depuis la configuration
+func (r *Renderer) RefreshDebugFlags() {
+	r.debugEnabled = r.config.Debug.EnableDebug
+	r.showColliders = r.config.Debug.ShowColliders
+	r.showChunks = r.config.Debug.ShowChunkBorders
+	r.showRenderStats = r.config.Debug.ShowRenderStats
+}
+
 // UnloadTexture décharge une texture
 func (r *Renderer) UnloadTexture(id string) {
 	delete(r.textures, id)
@@ -379,6 +718,59 @@ func (r *Renderer) getTexture(id string) *ebiten.Image {
 	return r.textures[id]
 }
 
+// ===============================
+// DECALS
+// ===============================
+
+// decalFallbackSize taille (pixels) d'un décalque sans texture
+const decalFallbackSize = 6
+
+// DrawDecal dessine un décalque sur la couche des décalques
+func (r *Renderer) DrawDecal(textureID string, position core.Vector2, rotation float64, alpha uint8) {
+	texture := r.getTexture(textureID)
+	if texture == nil {
+		// Pas de texture : petite tache colorée
+		clr := decalFallbackColor(textureID)
+		clr.A = alpha
+		vector.DrawFilledRect(r.decalImage,
+			float32(position.X-decalFallbackSize/2), float32(position.Y-decalFallbackSize/2),
+			decalFallbackSize, decalFallbackSize, r.coreColorToEbiten(clr), false)
+		return
+	}
+
+	// Rotation autour du centre de la texture
+	bounds := texture.Bounds()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-float64(bounds.Dx())/2, -float64(bounds.Dy())/2)
+	op.GeoM.Rotate(rotation)
+	op.GeoM.Translate(position.X, position.Y)
+	op.ColorScale.ScaleAlpha(float32(alpha) / 255)
+	r.decalImage.DrawImage(texture, op)
+	r.drawCalls++
+	r.stats.TrianglesDrawn += 2
+	r.useTexture(texture)
+}
+
+// ComposeDecals dépose la couche des décalques sur l'image principale.
+// À appeler après le sol et avant les entités.
+func (r *Renderer) ComposeDecals() {
+	r.spriteBatch.Flush()
+	r.mainImage.DrawImage(r.decalImage, &ebiten.DrawImageOptions{})
+	r.decalImage.Clear()
+}
+
+// decalFallbackColor couleur d'un décalque selon son type
+func decalFallbackColor(textureID string) core.Color {
+	switch textureID {
+	case "decal_blood":
+		return core.Color{R: 120, G: 10, B: 10, A: 255}
+	case "decal_scorch":
+		return core.Color{R: 25, G: 20, B: 20, A: 255}
+	default:
+		return core.Color{R: 70, G: 50, B: 35, A: 255} // Empreintes
+	}
+}
+
 // ===============================
 // CAMERA METHODS
 // ===============================
@@ -530,8 +922,15 @@ func (sb *SpriteBatch) Flush() {
 		return
 	}
 
-	// Dessiner les triangles
-	// Note: Cette partie nécessite une image cible, elle sera appelée par le renderer
+	// Dessiner les triangles en un seul appel
+	if sb.target != nil {
+		sb.target.DrawTriangles(sb.vertices, sb.indices, sb.currentTexture, sb.drawOptions)
+		if sb.stats != nil {
+			sb.stats.DrawCalls++
+			sb.stats.BatchesFlushed++
+			sb.stats.TrianglesDrawn += len(sb.indices) / 3
+		}
+	}
 
 	// Réinitialiser le batch
 	sb.vertices = sb.vertices[:0]
@@ -605,12 +1004,61 @@ func (r *Renderer) drawSpriteDirect(texture *ebiten.Image, position core.Vector2
 		float64(options.ColorA)/255.0,
 	)
 
+	r.spriteBatch.Flush()
 	r.mainImage.DrawImage(texture, op)
 	r.drawCalls++
+	r.stats.TrianglesDrawn += 2
+	r.useTexture(texture)
 }
 
-// coreColorToEbiten convertit une couleur core en couleur Ebiten
+// useTexture compte une texture parmi celles utilisées pendant la frame
+func (r *Renderer) useTexture(texture *ebiten.Image) {
+	r.frameTextures[texture] = struct{}{}
+}
+
+// drawRenderStats affiche les statistiques de la frame dans le coin bas-gauche
+// de la couche de debug
+func (r *Renderer) drawRenderStats() {
+	lines := []string{
+		fmt.Sprintf("Draw calls: %d", r.stats.DrawCalls),
+		fmt.Sprintf("Triangles:  %d", r.stats.TrianglesDrawn),
+		fmt.Sprintf("Sprites:    %d", r.stats.SpritesDrawn),
+		fmt.Sprintf("Textures:   %d", r.stats.TexturesUsed),
+		fmt.Sprintf("Lots:       %d", r.stats.BatchesFlushed),
+	}
+
+	const lineHeight = 14
+	top := r.height - len(lines)*lineHeight - 12
+	vector.DrawFilledRect(r.debugImage, 4, float32(top), 150, float32(len(lines)*lineHeight+8),
+		color.RGBA{A: 170}, false)
+	for i, line := range lines {
+		text.Draw(r.debugImage, line, r.defaultFont, 10, top+(i+1)*lineHeight, color.RGBA{R: 120, G: 255, B: 120, A: 255})
+	}
+}
+
+// ApplyAccessibility applique les options d'accessibilité au rendu et à la caméra
+func (r *Renderer) ApplyAccessibility(accessibility core.AccessibilityConfig) {
+	r.colorMatrix = nil
+	if matrix, ok := core.ColorblindMatrix(accessibility.ColorblindMode); ok {
+		r.colorMatrix = &matrix
+	}
+
+	r.uiScale = accessibility.UIScale
+	if r.uiScale <= 0 {
+		r.uiScale = 1.0
+	}
+	r.highContrast = accessibility.HighContrast
+
+	if r.camera != nil {
+		r.camera.ReduceMotion = accessibility.ReduceMotion
+	}
+}
+
+// coreColorToEbiten convertit une couleur core en couleur Ebiten (palette daltonienne comprise)
 func (r *Renderer) coreColorToEbiten(c core.Color) color.RGBA {
+	if r.colorMatrix != nil {
+		c = r.colorMatrix.Apply(c)
+	}
 	return color.RGBA{R: c.R, G: c.G, B: c.B, A: c.A}
 }
 
@@ -638,6 +1086,84 @@ func (r *Renderer) GetStats() *RenderStats {
 	return r.stats
 }
 
+// FrameStats retourne les statistiques de la dernière frame terminée
+func (r *Renderer) FrameStats() (drawCalls, spritesDrawn, batchesFlushed int) {
+	return r.lastStats.DrawCalls, r.lastStats.SpritesDrawn, r.lastStats.BatchesFlushed
+}
+
+// DrawTexture dessine une texture chargée avec les options par défaut
+// (chemin du batch et du culling)
+func (r *Renderer) DrawTexture(textureID string, position core.Vector2) {
+	r.DrawSprite(textureID, position, NewDrawSpriteOptions())
+}
+
+// DrawTextureInstanced ajoute une texture chargée au lot instancié de sa
+// texture (foules d'ennemis) ; tous les lots sont dessinés en fin de frame,
+// par-dessus le reste de la scène
+func (r *Renderer) DrawTextureInstanced(textureID string, position core.Vector2) {
+	texture := r.getTexture(textureID)
+	if texture == nil {
+		return
+	}
+
+	if r.config.Rendering.EnableCulling {
+		bounds := core.Rectangle{
+			X: position.X, Y: position.Y,
+			Width: float64(texture.Bounds().Dx()), Height: float64(texture.Bounds().Dy()),
+		}
+		if !r.isInViewport(bounds) {
+			return
+		}
+	}
+
+	batch, ok := r.instancedBatches[textureID]
+	if !ok {
+		batch = NewInstancedSpriteRenderer(texture, 0, 0, r.instancedShader)
+		r.instancedBatches[textureID] = batch
+	}
+	if batch.Count() == 0 {
+		r.instancedOrder = append(r.instancedOrder, textureID)
+	}
+	batch.Add(r.camera.WorldToScreen(position), core.Vector2{}, core.ColorWhite)
+	r.useTexture(texture)
+	r.stats.SpritesDrawn++
+}
+
+// DrawTextureDirect dessine une texture chargée par un DrawImage isolé, sans
+// batch (référence du benchmark)
+func (r *Renderer) DrawTextureDirect(textureID string, position core.Vector2) {
+	texture := r.getTexture(textureID)
+	if texture == nil {
+		return
+	}
+	r.drawSpriteDirect(texture, position, NewDrawSpriteOptions())
+	r.stats.SpritesDrawn++
+}
+
+// flushInstanced dessine les lots instanciés de la frame dans l'ordre où leurs
+// textures ont été utilisées : les chevauchements restent stables d'une frame
+// à l'autre
+func (r *Renderer) flushInstanced() {
+	for _, textureID := range r.instancedOrder {
+		batch := r.instancedBatches[textureID]
+		instances := batch.Count()
+		if instances == 0 {
+			continue
+		}
+		r.drawCalls += batch.Flush(r.mainImage)
+		r.stats.TrianglesDrawn += instances * 2
+		r.stats.BatchesFlushed++
+	}
+	r.instancedOrder = r.instancedOrder[:0]
+}
+
+// AddLight ajoute une source de lumière à la frame (ignorée sans éclairage)
+func (r *Renderer) AddLight(light core.Light) {
+	if r.lighting != nil {
+		r.lighting.Add(light)
+	}
+}
+
 // GetMainImage retourne l'image principale pour Ebiten
 func (r *Renderer) GetMainImage() *ebiten.Image {
 	return r.mainImage
@@ -645,9 +1171,26 @@ func (r *Renderer) GetMainImage() *ebiten.Image {
 
 // Cleanup nettoie les ressources
 func (r *Renderer) Cleanup() {
+	r.tileAnimator.Dispose()
+	if r.instancedShader != nil {
+		r.instancedShader.Deallocate()
+		r.instancedShader = nil
+	}
+	r.instancedBatches = nil
+	r.instancedOrder = nil
+	if r.postProcessor != nil {
+		r.postProcessor.Dispose()
+		r.postProcessor = nil
+		r.screenVignette = nil
+	}
+	if r.lighting != nil {
+		r.lighting.Dispose()
+		r.lighting = nil
+	}
 	r.textures = nil
 	r.textureCache = nil
 	r.mainImage = nil
+	r.decalImage = nil
 	r.uiImage = nil
 	r.debugImage = nil
 }
@@ -0,0 +1,95 @@
+package rendering
+
+import (
+	"math"
+	"testing"
+
+	"zelda-souls-game/internal/core"
+)
+
+// equilateralTriangle triangle équilatéral de côté side, base sur l'axe X
+func equilateralTriangle(side float64) []core.Vector2 {
+	return []core.Vector2{
+		{X: 100, Y: 200},
+		{X: 100 + side, Y: 200},
+		{X: 100 + side/2, Y: 200 - side*math.Sqrt(3)/2},
+	}
+}
+
+// signedArea aire signée d'un triangle (positive ou négative selon le sens)
+func signedArea(a, b, c core.Vector2) float64 {
+	return ((b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)) / 2
+}
+
+func TestTriangulatePolygonFanCoversTriangle(t *testing.T) {
+	const side = 100.0
+	vertices := equilateralTriangle(side)
+	points, indices := triangulatePolygonFan(vertices)
+
+	if len(points) != len(vertices)+1 {
+		t.Fatalf("%d sommets, attendu %d (centroïde compris)", len(points), len(vertices)+1)
+	}
+	if len(indices) != 3*len(vertices) {
+		t.Fatalf("%d indices, attendu %d", len(indices), 3*len(vertices))
+	}
+
+	centroid := core.Vector2{X: 100 + side/2, Y: 200 - side*math.Sqrt(3)/6}
+	if points[0].Distance(centroid) > 1e-9 {
+		t.Errorf("centroïde = %+v, attendu %+v", points[0], centroid)
+	}
+
+	// Les triangles de l'éventail, tous dans le même sens, couvrent
+	// exactement l'aire du triangle équilatéral
+	total := 0.0
+	sign := 0.0
+	for i := 0; i < len(indices); i += 3 {
+		area := signedArea(points[indices[i]], points[indices[i+1]], points[indices[i+2]])
+		if sign == 0 {
+			sign = math.Copysign(1, area)
+		} else if math.Copysign(1, area) != sign {
+			t.Errorf("triangle %d dans le mauvais sens (aire %.2f)", i/3, area)
+		}
+		total += math.Abs(area)
+	}
+
+	want := math.Sqrt(3) / 4 * side * side
+	if math.Abs(total-want) > 1e-6 {
+		t.Errorf("aire couverte = %.4f, attendu %.4f", total, want)
+	}
+}
+
+func TestPolygonOutlineEdgeLengths(t *testing.T) {
+	const side = 100.0
+	edges := polygonOutline(equilateralTriangle(side))
+
+	if len(edges) != 3 {
+		t.Fatalf("%d arêtes, attendu 3", len(edges))
+	}
+	for i, edge := range edges {
+		if length := edge[0].Distance(edge[1]); math.Abs(length-side) > 1e-9 {
+			t.Errorf("arête %d de longueur %.4f, attendu %.4f", i, length, side)
+		}
+	}
+	if edges[2][1] != edges[0][0] {
+		t.Error("le contour doit se refermer sur le premier sommet")
+	}
+}
+
+func TestCoreColorToEbitenAppliesColorblindMode(t *testing.T) {
+	r := &Renderer{}
+	red := core.Color{R: 255, G: 0, B: 0, A: 255}
+
+	if got := r.coreColorToEbiten(red); got.R != 255 || got.G != 0 || got.B != 0 {
+		t.Errorf("sans mode daltonien: %+v, attendu le rouge inchangé", got)
+	}
+
+	r.ApplyAccessibility(core.AccessibilityConfig{ColorblindMode: core.ColorblindDeuteranopia})
+	if got := r.coreColorToEbiten(red); got.R != 159 || got.G != 179 || got.B != 0 || got.A != 255 {
+		t.Errorf("deutéranopie: %+v, attendu {159 179 0 255}", got)
+	}
+
+	r.ApplyAccessibility(core.AccessibilityConfig{ColorblindMode: core.ColorblindNone})
+	if got := r.coreColorToEbiten(red); got.R != 255 || got.G != 0 {
+		t.Errorf("retour à none: %+v, attendu le rouge inchangé", got)
+	}
+}
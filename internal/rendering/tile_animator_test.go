@@ -0,0 +1,46 @@
+package rendering
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"zelda-souls-game/internal/core"
+)
+
+// waterShaderSource lit le shader livré, depuis la racine du module
+func waterShaderSource(t *testing.T) []byte {
+	t.Helper()
+	source, err := os.ReadFile(filepath.Join("..", "..", WaterShaderPath))
+	if err != nil {
+		t.Fatalf("lecture du shader: %v", err)
+	}
+	return source
+}
+
+func TestWaterShaderCompiles(t *testing.T) {
+	shader, err := ebiten.NewShader(waterShaderSource(t))
+	if err != nil {
+		t.Fatalf("compilation du shader de l'eau: %v", err)
+	}
+	shader.Deallocate()
+}
+
+func TestWaterShaderRejectsInvalidSource(t *testing.T) {
+	// Garde-fou : la compilation signale bien les erreurs de Kage
+	broken := append(waterShaderSource(t), []byte("\nfunc Broken() vec4 { return undefined }\n")...)
+	if _, err := ebiten.NewShader(broken); err == nil {
+		t.Error("un shader invalide doit être refusé")
+	}
+}
+
+func TestTileAnimatorFallsBackWithoutShader(t *testing.T) {
+	// Le chemin du shader est relatif à la racine : introuvable depuis ce dossier
+	ta := NewTileAnimator(core.GetDefaultConfig().Rendering)
+	defer ta.Dispose()
+
+	if ta.HasWaterShader() {
+		t.Error("sans shader chargé, l'eau doit être dessinée simplement")
+	}
+}
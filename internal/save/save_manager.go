@@ -1,15 +1,44 @@
-// internal/save/save_manager.go - Gestionnaire de sauvegarde (stub)
+// internal/save/save_manager.go - Gestionnaire de sauvegarde
 package save
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// ErrNoMigrationPath aucune chaîne de migration ne mène à la version du jeu
+var ErrNoMigrationPath = errors.New("aucune migration de sauvegarde disponible")
+
 // SaveManager gère les sauvegardes du jeu
 type SaveManager struct {
 	savesDirectory string
 	maxSlots       int
+
+	// Version du jeu inscrite dans les nouvelles sauvegardes
+	gameVersion string
+
+	// Migrations indexées par "ancienne->nouvelle" version (ex: "0.2.0->0.3.0"),
+	// enchaînées au chargement jusqu'à la version du jeu
+	SaveMigrationFn map[string]func(*FullSaveData) error
+}
+
+// SaveMetadata en-tête d'une sauvegarde
+type SaveMetadata struct {
+	GameVersion string
+	SlotID      int
+	SaveTime    time.Time
+}
+
+// FullSaveData sauvegarde telle qu'écrite sur disque : en-tête et données
+type FullSaveData struct {
+	Metadata SaveMetadata
+	Data     *SaveData
 }
 
 // SaveData structure temporaire pour les données de sauvegarde
@@ -17,6 +46,70 @@ type SaveData struct {
 	PlayerData *PlayerData
 	WorldData  interface{}
 	SaveTime   time.Time
+
+	// Meilleurs temps des salles de défi, par identifiant de salle
+	ChallengeBestTimes map[string]time.Duration
+
+	// Dernier feu de camp où le joueur s'est reposé (nil : aucun)
+	LastBonfire *BonfireData
+
+	// Âmes portées et tache de sang en attente (nil : aucune)
+	Souls      int
+	Bloodstain *BloodstainData
+
+	// Identifiant de l'arme équipée ("" : arme par défaut)
+	EquippedWeapon string
+
+	// Niveau, expérience et compétences débloquées (nil : progression de départ)
+	Progression *ProgressionData
+
+	// Panneaux déjà lus, par identifiant d'entité
+	ReadSigns map[uint32]bool
+
+	// Plan du donjon et salles visitées (nil : plan de départ)
+	RoomGraph *RoomGraphData
+}
+
+// RoomGraphData plan du donjon de la mini-carte
+type RoomGraphData struct {
+	Nodes   []RoomNodeData
+	Edges   []RoomEdgeData
+	Current string
+}
+
+// RoomNodeData salle du plan
+type RoomNodeData struct {
+	ID      string
+	X, Y    int
+	Type    string
+	Visited bool
+}
+
+// RoomEdgeData passage entre deux salles
+type RoomEdgeData struct {
+	From, To  string
+	Direction string
+}
+
+// ProgressionData progression du joueur
+type ProgressionData struct {
+	Level            int
+	Experience       int
+	ExperienceToNext int
+	SkillPoints      int
+	UnlockedSkills   []string
+}
+
+// BloodstainData âmes laissées à la mort
+type BloodstainData struct {
+	X, Y  float64
+	Souls int
+}
+
+// BonfireData feu de camp de réapparition
+type BonfireData struct {
+	Name string
+	X, Y float64
 }
 
 // PlayerData données temporaires du joueur
@@ -30,31 +123,184 @@ type PlayerData struct {
 // NewSaveManager crée un nouveau gestionnaire de sauvegarde
 func NewSaveManager(savesDir string) *SaveManager {
 	return &SaveManager{
-		savesDirectory: savesDir,
-		maxSlots:       10,
+		savesDirectory:  savesDir,
+		maxSlots:        10,
+		SaveMigrationFn: make(map[string]func(*FullSaveData) error),
 	}
 }
 
-// SaveGame sauvegarde une partie (stub)
+// SetGameVersion définit la version du jeu (GameConfig.GameVersion)
+func (sm *SaveManager) SetGameVersion(version string) {
+	sm.gameVersion = version
+}
+
+// GetGameVersion retourne la version du jeu
+func (sm *SaveManager) GetGameVersion() string {
+	return sm.gameVersion
+}
+
+// slotPath retourne le chemin du fichier d'un slot
+func (sm *SaveManager) slotPath(slotID int) string {
+	return filepath.Join(sm.savesDirectory, fmt.Sprintf("slot_%d.json", slotID))
+}
+
+// SaveGame sauvegarde une partie dans un slot
 func (sm *SaveManager) SaveGame(slotID int, gameData interface{}) error {
-	fmt.Printf("Sauvegarde dans le slot %d (stub)\n", slotID)
+	if slotID < 1 || slotID > sm.maxSlots {
+		return fmt.Errorf("slot de sauvegarde invalide: %d", slotID)
+	}
+
+	data, ok := gameData.(*SaveData)
+	if !ok {
+		return fmt.Errorf("données de sauvegarde non supportées: %T", gameData)
+	}
+
+	full := &FullSaveData{
+		Metadata: SaveMetadata{GameVersion: sm.gameVersion, SlotID: slotID, SaveTime: time.Now()},
+		Data:     data,
+	}
+	content, err := json.MarshalIndent(full, "", "  ")
+	if err != nil {
+		return fmt.Errorf("sérialisation de la sauvegarde impossible: %w", err)
+	}
+
+	if err := os.MkdirAll(sm.savesDirectory, 0755); err != nil {
+		return fmt.Errorf("impossible de créer %s: %w", sm.savesDirectory, err)
+	}
+	if err := os.WriteFile(sm.slotPath(slotID), content, 0644); err != nil {
+		return fmt.Errorf("écriture de la sauvegarde impossible: %w", err)
+	}
+
+	fmt.Printf("✓ Partie sauvegardée dans le slot %d\n", slotID)
 	return nil
 }
 
-// LoadGame charge une partie (stub)
+// ReadSave lit une sauvegarde sans la migrer
+func (sm *SaveManager) ReadSave(slotID int) (*FullSaveData, error) {
+	content, err := os.ReadFile(sm.slotPath(slotID))
+	if err != nil {
+		return nil, fmt.Errorf("lecture du slot %d impossible: %w", slotID, err)
+	}
+
+	var full FullSaveData
+	if err := json.Unmarshal(content, &full); err != nil {
+		return nil, fmt.Errorf("sauvegarde du slot %d corrompue: %w", slotID, err)
+	}
+	if full.Data == nil {
+		full.Data = &SaveData{}
+	}
+	return &full, nil
+}
+
+// LoadGame charge une partie : la sauvegarde est migrée jusqu'à la version du jeu
 func (sm *SaveManager) LoadGame(slotID int) (interface{}, error) {
-	fmt.Printf("Chargement du slot %d (stub)\n", slotID)
-	return &SaveData{
-		PlayerData: &PlayerData{
-			Name:      "TestPlayer",
-			Level:     1,
-			CreatedAt: time.Now(),
-		},
-		SaveTime: time.Now(),
-	}, nil
-}
-
-// SlotExists vérifie si un slot existe (stub)
+	full, err := sm.ReadSave(slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch CompareVersions(full.Metadata.GameVersion, sm.gameVersion) {
+	case VersionIncompatible:
+		fmt.Printf("⚠ Sauvegarde du slot %d créée en v%s (jeu en v%s) : peut être incompatible\n",
+			slotID, full.Metadata.GameVersion, sm.gameVersion)
+	case VersionMigrated:
+		fmt.Printf("Sauvegarde du slot %d : migration v%s → v%s\n", slotID, full.Metadata.GameVersion, sm.gameVersion)
+	}
+
+	if err := sm.Migrate(full); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("✓ Slot %d chargé\n", slotID)
+	return full.Data, nil
+}
+
+// Migrate applique la chaîne de migrations depuis la version de la sauvegarde
+// jusqu'à la version du jeu ; seuls les numéros majeur et mineur comptent, un
+// simple correctif ne demande pas de migration. Retourne ErrNoMigrationPath si
+// un maillon manque.
+func (sm *SaveManager) Migrate(full *FullSaveData) error {
+	version := full.Metadata.GameVersion
+	for steps := 0; version != sm.gameVersion && CompareVersions(version, sm.gameVersion) != VersionCurrent; steps++ {
+		// Chaque migration ne sert qu'une fois : au-delà, la chaîne boucle
+		if steps > len(sm.SaveMigrationFn) {
+			return fmt.Errorf("%w: v%s → v%s (chaîne cyclique)", ErrNoMigrationPath, full.Metadata.GameVersion, sm.gameVersion)
+		}
+
+		next, migrate, found := sm.nextMigration(version)
+		if !found {
+			return fmt.Errorf("%w: v%s → v%s", ErrNoMigrationPath, version, sm.gameVersion)
+		}
+		if err := migrate(full); err != nil {
+			return fmt.Errorf("migration v%s → v%s échouée: %w", version, next, err)
+		}
+
+		version = next
+		full.Metadata.GameVersion = version
+	}
+
+	full.Metadata.GameVersion = sm.gameVersion
+	return nil
+}
+
+// nextMigration retourne la migration partant d'une version
+func (sm *SaveManager) nextMigration(from string) (string, func(*FullSaveData) error, bool) {
+	for key, migrate := range sm.SaveMigrationFn {
+		source, target, ok := strings.Cut(key, "->")
+		if ok && strings.TrimSpace(source) == from {
+			return strings.TrimSpace(target), migrate, true
+		}
+	}
+	return "", nil, false
+}
+
+// SlotExists vérifie si un slot contient une sauvegarde
 func (sm *SaveManager) SlotExists(slotID int) bool {
-	return false // Stub
+	_, err := os.Stat(sm.slotPath(slotID))
+	return err == nil
+}
+
+// ===============================
+// VERSIONS
+// ===============================
+
+// VersionCheck résultat de la comparaison de version d'une sauvegarde
+type VersionCheck int
+
+const (
+	VersionCurrent      VersionCheck = iota // Même version majeure et mineure
+	VersionMigrated                         // Version mineure différente : migrée sans risque
+	VersionIncompatible                     // Version majeure différente (ou illisible)
+)
+
+// CompareVersions compare deux versions "majeure.mineure.correctif"
+func CompareVersions(saveVersion, gameVersion string) VersionCheck {
+	saveMajor, saveMinor, saveErr := parseVersion(saveVersion)
+	gameMajor, gameMinor, gameErr := parseVersion(gameVersion)
+	switch {
+	case saveErr != nil || gameErr != nil || saveMajor != gameMajor:
+		return VersionIncompatible
+	case saveMinor != gameMinor:
+		return VersionMigrated
+	default:
+		return VersionCurrent
+	}
+}
+
+// parseVersion extrait les numéros majeur et mineur d'une version
+func parseVersion(version string) (int, int, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("version invalide: %q", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("version invalide: %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("version invalide: %q", version)
+	}
+	return major, minor, nil
 }
@@ -0,0 +1,181 @@
+package save
+
+import (
+	"errors"
+	"testing"
+)
+
+// saveAtVersion écrit une sauvegarde comme si elle venait d'une version donnée
+func saveAtVersion(t *testing.T, dir, version string, data *SaveData) {
+	t.Helper()
+	old := NewSaveManager(dir)
+	old.SetGameVersion(version)
+	if err := old.SaveGame(1, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadGameMigratesOlderSave(t *testing.T) {
+	dir := t.TempDir()
+	saveAtVersion(t, dir, "0.2.0", &SaveData{Souls: 40})
+
+	sm := NewSaveManager(dir)
+	sm.SetGameVersion("0.3.0")
+	migrations := 0
+	sm.SaveMigrationFn["0.2.0->0.3.0"] = func(full *FullSaveData) error {
+		migrations++
+		full.Data.EquippedWeapon = "epee_courte" // Champ apparu en 0.3.0
+		return nil
+	}
+
+	loaded, err := sm.LoadGame(1)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+	data := loaded.(*SaveData)
+
+	if migrations != 1 {
+		t.Errorf("%d migration(s), attendu 1", migrations)
+	}
+	if data.Souls != 40 || data.EquippedWeapon != "epee_courte" {
+		t.Errorf("données migrées = %+v", data)
+	}
+}
+
+func TestLoadGameWithoutMigrationPath(t *testing.T) {
+	dir := t.TempDir()
+	saveAtVersion(t, dir, "0.1.0", &SaveData{})
+
+	sm := NewSaveManager(dir)
+	sm.SetGameVersion("0.3.0")
+	sm.SaveMigrationFn["0.2.0->0.3.0"] = func(*FullSaveData) error { return nil }
+
+	if _, err := sm.LoadGame(1); !errors.Is(err, ErrNoMigrationPath) {
+		t.Errorf("erreur = %v, attendu ErrNoMigrationPath", err)
+	}
+}
+
+func TestMigrateChainsSteps(t *testing.T) {
+	sm := NewSaveManager(t.TempDir())
+	sm.SetGameVersion("0.4.0")
+
+	var applied []string
+	step := func(name string) func(*FullSaveData) error {
+		return func(*FullSaveData) error {
+			applied = append(applied, name)
+			return nil
+		}
+	}
+	sm.SaveMigrationFn["0.3.0->0.4.0"] = step("0.3→0.4")
+	sm.SaveMigrationFn["0.2.0->0.3.0"] = step("0.2→0.3")
+
+	full := &FullSaveData{Metadata: SaveMetadata{GameVersion: "0.2.0"}, Data: &SaveData{}}
+	if err := sm.Migrate(full); err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 2 || applied[0] != "0.2→0.3" || applied[1] != "0.3→0.4" {
+		t.Errorf("migrations appliquées %v, attendu [0.2→0.3 0.3→0.4]", applied)
+	}
+	if full.Metadata.GameVersion != "0.4.0" {
+		t.Errorf("version = %s, attendu 0.4.0", full.Metadata.GameVersion)
+	}
+}
+
+func TestMigrateIgnoresPatchVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		saveVersion string
+		wantApplied int
+	}{
+		{"même version", "0.3.1", 0},
+		{"correctif précédent", "0.3.0", 0},
+		{"correctif suivant", "0.3.4", 0},
+		{"mineure précédente", "0.2.0", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := NewSaveManager(t.TempDir())
+			sm.SetGameVersion("0.3.1")
+			applied := 0
+			sm.SaveMigrationFn["0.2.0->0.3.0"] = func(*FullSaveData) error {
+				applied++
+				return nil
+			}
+
+			full := &FullSaveData{Metadata: SaveMetadata{GameVersion: tt.saveVersion}, Data: &SaveData{}}
+			if err := sm.Migrate(full); err != nil {
+				t.Fatalf("migration: %v", err)
+			}
+			if applied != tt.wantApplied {
+				t.Errorf("%d migration(s), attendu %d", applied, tt.wantApplied)
+			}
+			if full.Metadata.GameVersion != "0.3.1" {
+				t.Errorf("version = %s, attendu 0.3.1", full.Metadata.GameVersion)
+			}
+		})
+	}
+}
+
+func TestLoadGameAfterPatchRelease(t *testing.T) {
+	dir := t.TempDir()
+	saveAtVersion(t, dir, "0.3.0", &SaveData{Souls: 12})
+
+	sm := NewSaveManager(dir)
+	sm.SetGameVersion("0.3.1")
+
+	loaded, err := sm.LoadGame(1)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+	if data := loaded.(*SaveData); data.Souls != 12 {
+		t.Errorf("âmes = %d, attendu 12", data.Souls)
+	}
+}
+
+func TestMigrateDetectsCycle(t *testing.T) {
+	sm := NewSaveManager(t.TempDir())
+	sm.SetGameVersion("1.0.0")
+	sm.SaveMigrationFn["0.2.0->0.3.0"] = func(*FullSaveData) error { return nil }
+	sm.SaveMigrationFn["0.3.0->0.2.0"] = func(*FullSaveData) error { return nil }
+
+	full := &FullSaveData{Metadata: SaveMetadata{GameVersion: "0.2.0"}, Data: &SaveData{}}
+	if err := sm.Migrate(full); !errors.Is(err, ErrNoMigrationPath) {
+		t.Errorf("erreur = %v, attendu ErrNoMigrationPath", err)
+	}
+}
+
+func TestMigrateStopsOnFailure(t *testing.T) {
+	sm := NewSaveManager(t.TempDir())
+	sm.SetGameVersion("0.3.0")
+	failure := errors.New("champ illisible")
+	sm.SaveMigrationFn["0.2.0->0.3.0"] = func(*FullSaveData) error { return failure }
+
+	full := &FullSaveData{Metadata: SaveMetadata{GameVersion: "0.2.0"}, Data: &SaveData{}}
+	if err := sm.Migrate(full); !errors.Is(err, failure) {
+		t.Errorf("erreur = %v, attendu l'erreur de la migration", err)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		save, game string
+		want       VersionCheck
+	}{
+		{"0.3.0", "0.3.0", VersionCurrent},
+		{"0.3.1", "0.3.4", VersionCurrent},
+		{"v0.3.0", "0.3.2", VersionCurrent},
+		{"0.2.0", "0.3.0", VersionMigrated},
+		{"0.3.0", "1.0.0", VersionIncompatible},
+		{"", "0.3.0", VersionIncompatible},
+		{"inconnue", "0.3.0", VersionIncompatible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.save+"→"+tt.game, func(t *testing.T) {
+			if got := CompareVersions(tt.save, tt.game); got != tt.want {
+				t.Errorf("CompareVersions = %d, attendu %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// internal/save/user_preferences.go - Préférences du joueur, communes à tous les slots
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// DefaultPreferencesPath fichier des préférences, à côté de la configuration
+const DefaultPreferencesPath = "configs/prefs.json"
+
+// UserPreferences préférences du joueur, gardées hors des sauvegardes de
+// partie : elles valent quel que soit le slot chargé
+type UserPreferences struct {
+	SeenIntro    bool    `json:"seen_intro"`    // L'introduction a été vue jusqu'au bout (ou passée)
+	LastSlot     int     `json:"last_slot"`     // Dernier slot sauvegardé ou chargé
+	MasterVolume float64 `json:"master_volume"` // 0 à 1
+}
+
+// DefaultUserPreferences retourne les préférences d'un premier lancement
+func DefaultUserPreferences() UserPreferences {
+	return UserPreferences{LastSlot: 1, MasterVolume: 1.0}
+}
+
+// LoadUserPreferences lit les préférences. Au premier lancement (fichier
+// absent), les préférences par défaut sont écrites ; created l'indique.
+func LoadUserPreferences(path string) (prefs *UserPreferences, created bool, err error) {
+	defaults := DefaultUserPreferences()
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err := defaults.Save(path); err != nil {
+			return &defaults, false, err
+		}
+		return &defaults, true, nil
+	}
+	if err != nil {
+		return &defaults, false, fmt.Errorf("lecture des préférences impossible: %w", err)
+	}
+
+	loaded := defaults
+	if err := json.Unmarshal(content, &loaded); err != nil {
+		return &defaults, false, fmt.Errorf("préférences corrompues (%s): %w", path, err)
+	}
+	loaded.normalize()
+	return &loaded, false, nil
+}
+
+// Save écrit les préférences
+func (p *UserPreferences) Save(path string) error {
+	p.normalize()
+	content, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("sérialisation des préférences impossible: %w", err)
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("impossible de créer %s: %w", dir, err)
+		}
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("écriture des préférences impossible: %w", err)
+	}
+	return nil
+}
+
+// normalize ramène les valeurs hors bornes d'un fichier édité à la main
+func (p *UserPreferences) normalize() {
+	if p.LastSlot < 1 {
+		p.LastSlot = 1
+	}
+	if p.MasterVolume < 0 {
+		p.MasterVolume = 0
+	} else if p.MasterVolume > 1 {
+		p.MasterVolume = 1
+	}
+}
@@ -0,0 +1,73 @@
+package save
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUserPreferencesFirstRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs", "prefs.json")
+
+	prefs, created, err := LoadUserPreferences(path)
+	if err != nil {
+		t.Fatalf("LoadUserPreferences: %v", err)
+	}
+	if !created {
+		t.Error("le premier lancement doit créer le fichier")
+	}
+	if *prefs != DefaultUserPreferences() {
+		t.Errorf("préférences = %+v, attendu %+v", *prefs, DefaultUserPreferences())
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("fichier non écrit: %v", err)
+	}
+
+	// Lancement suivant : le drapeau enregistré est relu
+	prefs.SeenIntro = true
+	prefs.LastSlot = 3
+	if err := prefs.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	again, created, err := LoadUserPreferences(path)
+	if err != nil {
+		t.Fatalf("LoadUserPreferences: %v", err)
+	}
+	if created || !again.SeenIntro || again.LastSlot != 3 {
+		t.Errorf("créé = %t, préférences = %+v, attendu l'intro vue et le slot 3", created, *again)
+	}
+}
+
+func TestUserPreferencesFileContents(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    UserPreferences
+		wantErr bool
+	}{
+		{"complet", `{"seen_intro": true, "last_slot": 2, "master_volume": 0.4}`, UserPreferences{SeenIntro: true, LastSlot: 2, MasterVolume: 0.4}, false},
+		{"champs absents", `{"seen_intro": true}`, UserPreferences{SeenIntro: true, LastSlot: 1, MasterVolume: 1}, false},
+		{"valeurs hors bornes", `{"last_slot": -4, "master_volume": 3}`, UserPreferences{LastSlot: 1, MasterVolume: 1}, false},
+		{"corrompu", `{"seen_intro": tru`, DefaultUserPreferences(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prefs.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			prefs, created, err := LoadUserPreferences(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("erreur = %v, attendu une erreur : %t", err, tt.wantErr)
+			}
+			if created {
+				t.Error("un fichier existant ne doit pas être recréé")
+			}
+			if *prefs != tt.want {
+				t.Errorf("préférences = %+v, attendu %+v", *prefs, tt.want)
+			}
+		})
+	}
+}
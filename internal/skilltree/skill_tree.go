@@ -0,0 +1,254 @@
+// internal/skilltree/skill_tree.go - Arbre de compétences débloquées avec les points de niveau
+package skilltree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// DefaultSkillsFile fichier de l'arbre, relatif au dossier de données
+const DefaultSkillsFile = "skills.json"
+
+// Types d'effets appliqués au joueur
+const (
+	EffectMaxHP        = "maxHP"        // Santé maximale (et actuelle)
+	EffectMaxStamina   = "maxStamina"   // Stamina maximale
+	EffectStaminaRegen = "staminaRegen" // Régénération de stamina, par seconde
+	EffectAttack       = "attack"       // Puissance d'attaque
+	EffectDefense      = "defense"      // Défense
+	EffectCritChance   = "critChance"   // Chance de critique (0.02 : +2 %)
+)
+
+// Erreurs de déblocage
+var (
+	ErrUnknownSkill        = errors.New("compétence inconnue")
+	ErrAlreadyUnlocked     = errors.New("compétence déjà débloquée")
+	ErrMissingPrerequisite = errors.New("prérequis manquant")
+	ErrNotEnoughPoints     = errors.New("points de compétence insuffisants")
+)
+
+// SkillEffect bonus appliqué au joueur quand le nœud est débloqué
+type SkillEffect struct {
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+}
+
+// SkillNode nœud de l'arbre : coût en points et nœuds à débloquer avant lui
+type SkillNode struct {
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Cost          int         `json:"cost"`
+	Effect        SkillEffect `json:"effect"`
+	Prerequisites []string    `json:"prerequisites"`
+}
+
+// skillsFile structure du fichier JSON de l'arbre
+type skillsFile struct {
+	Nodes []*SkillNode `json:"nodes"`
+}
+
+// SkillTree graphe des compétences et nœuds débloqués ; les effets sont
+// appliqués au joueur cible
+type SkillTree struct {
+	nodes    []*SkillNode
+	byID     map[string]*SkillNode
+	unlocked []string // Dans l'ordre de déblocage
+	isOpen   map[string]bool
+
+	player *components.PlayerComponent
+}
+
+// NewSkillTree crée un arbre à partir de nœuds ; les doublons sont ignorés et
+// les prérequis doivent désigner des nœuds de l'arbre
+func NewSkillTree(nodes []*SkillNode) (*SkillTree, error) {
+	st := &SkillTree{
+		nodes:  make([]*SkillNode, 0, len(nodes)),
+		byID:   make(map[string]*SkillNode, len(nodes)),
+		isOpen: make(map[string]bool),
+	}
+	for _, node := range nodes {
+		if node == nil || node.ID == "" {
+			continue
+		}
+		if _, exists := st.byID[node.ID]; exists {
+			fmt.Printf("⚠ Compétence '%s' en double ignorée\n", node.ID)
+			continue
+		}
+		st.nodes = append(st.nodes, node)
+		st.byID[node.ID] = node
+	}
+
+	for _, node := range st.nodes {
+		for _, prerequisite := range node.Prerequisites {
+			if _, exists := st.byID[prerequisite]; !exists {
+				return nil, fmt.Errorf("compétence '%s': prérequis '%s' inconnu", node.ID, prerequisite)
+			}
+		}
+	}
+	return st, nil
+}
+
+// LoadSkillTree charge l'arbre d'un fichier JSON
+func LoadSkillTree(path string) (*SkillTree, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossible de lire %s: %w", path, err)
+	}
+
+	var file skillsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("arbre de compétences invalide %s: %w", path, err)
+	}
+
+	for i, node := range file.Nodes {
+		if err := node.validate(); err != nil {
+			return nil, fmt.Errorf("compétence %d de %s: %w", i, path, err)
+		}
+	}
+	return NewSkillTree(file.Nodes)
+}
+
+// validate vérifie qu'un nœud est exploitable
+func (sn *SkillNode) validate() error {
+	if sn == nil {
+		return errors.New("nœud vide")
+	}
+	if sn.ID == "" {
+		return errors.New("identifiant manquant")
+	}
+	if sn.Cost < 0 {
+		return fmt.Errorf("coût négatif pour '%s'", sn.ID)
+	}
+	switch sn.Effect.Type {
+	case EffectMaxHP, EffectMaxStamina, EffectStaminaRegen, EffectAttack, EffectDefense, EffectCritChance:
+	default:
+		return fmt.Errorf("effet inconnu '%s' pour '%s'", sn.Effect.Type, sn.ID)
+	}
+	return nil
+}
+
+// SetPlayer définit le joueur qui reçoit les effets (sans les appliquer)
+func (st *SkillTree) SetPlayer(player *components.PlayerComponent) {
+	st.player = player
+}
+
+// GetNodes retourne les nœuds, dans l'ordre du fichier
+func (st *SkillTree) GetNodes() []*SkillNode {
+	return st.nodes
+}
+
+// GetNode retourne un nœud par identifiant (nil s'il n'existe pas)
+func (st *SkillTree) GetNode(id string) *SkillNode {
+	return st.byID[id]
+}
+
+// IsUnlocked retourne si un nœud est débloqué
+func (st *SkillTree) IsUnlocked(id string) bool {
+	return st.isOpen[id]
+}
+
+// Unlocked retourne les nœuds débloqués, dans l'ordre de déblocage
+func (st *SkillTree) Unlocked() []string {
+	return append([]string(nil), st.unlocked...)
+}
+
+// CanUnlock vérifie qu'un nœud est débloquable avec les points disponibles
+func (st *SkillTree) CanUnlock(nodeID string, availablePoints int) error {
+	node := st.byID[nodeID]
+	if node == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownSkill, nodeID)
+	}
+	if st.isOpen[nodeID] {
+		return fmt.Errorf("%w: %s", ErrAlreadyUnlocked, nodeID)
+	}
+	for _, prerequisite := range node.Prerequisites {
+		if !st.isOpen[prerequisite] {
+			return fmt.Errorf("%w: %s avant %s", ErrMissingPrerequisite, prerequisite, nodeID)
+		}
+	}
+	if availablePoints < node.Cost {
+		return fmt.Errorf("%w: %d/%d", ErrNotEnoughPoints, availablePoints, node.Cost)
+	}
+	return nil
+}
+
+// Unlock débloque un nœud si ses prérequis sont débloqués et que les points
+// suffisent, puis applique son effet au joueur. Les points ne sont pas
+// décomptés : c'est à l'appelant de retirer le coût du nœud.
+func (st *SkillTree) Unlock(nodeID string, availablePoints int) error {
+	if err := st.CanUnlock(nodeID, availablePoints); err != nil {
+		return err
+	}
+	st.open(st.byID[nodeID])
+	return nil
+}
+
+// open marque un nœud débloqué et applique son effet
+func (st *SkillTree) open(node *SkillNode) {
+	st.isOpen[node.ID] = true
+	st.unlocked = append(st.unlocked, node.ID)
+	st.apply(node.Effect, 1)
+}
+
+// Reset oublie les nœuds débloqués sans toucher au joueur (nouvelle partie)
+func (st *SkillTree) Reset() {
+	st.unlocked = st.unlocked[:0]
+	st.isOpen = make(map[string]bool)
+}
+
+// Restore remplace les nœuds débloqués par ceux d'une sauvegarde : les effets
+// actuels sont retirés du joueur, puis ceux des nœuds restaurés appliqués.
+// Le coût n'est pas repayé ; les nœuds inconnus ou sans leurs prérequis sont ignorés.
+func (st *SkillTree) Restore(nodeIDs []string) {
+	for _, id := range st.unlocked {
+		st.apply(st.byID[id].Effect, -1)
+	}
+	st.Reset()
+
+	for _, id := range nodeIDs {
+		if err := st.CanUnlock(id, math.MaxInt); err != nil {
+			fmt.Printf("⚠ Compétence sauvegardée ignorée: %v\n", err)
+			continue
+		}
+		st.open(st.byID[id])
+	}
+}
+
+// apply ajoute (sign 1) ou retire (sign -1) un effet au joueur
+func (st *SkillTree) apply(effect SkillEffect, sign float64) {
+	if st.player == nil {
+		return
+	}
+	player := st.player
+	value := effect.Value * sign
+
+	switch effect.Type {
+	case EffectMaxHP:
+		player.MaxHealth += int(value)
+		player.Health += int(value)
+		if player.Health > player.MaxHealth {
+			player.Health = player.MaxHealth
+		}
+		if player.Health < 1 {
+			player.Health = 1
+		}
+	case EffectMaxStamina:
+		player.MaxStamina += value
+		if player.Stamina > player.MaxStamina {
+			player.Stamina = player.MaxStamina
+		}
+	case EffectStaminaRegen:
+		player.StaminaRegen += value
+	case EffectAttack:
+		player.AttackPower += int(value)
+	case EffectDefense:
+		player.Defense += int(value)
+	case EffectCritChance:
+		player.AddCriticalBonus(value)
+	}
+}
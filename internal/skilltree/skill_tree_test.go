@@ -0,0 +1,130 @@
+package skilltree
+
+import (
+	"errors"
+	"math"
+	"path/filepath"
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// testTree arbre à deux branches ; "maitrise" demande les deux
+func testTree(t *testing.T) *SkillTree {
+	t.Helper()
+	tree, err := NewSkillTree([]*SkillNode{
+		{ID: "vigueur_1", Cost: 1, Effect: SkillEffect{Type: EffectMaxHP, Value: 10}},
+		{ID: "vigueur_2", Cost: 2, Effect: SkillEffect{Type: EffectMaxHP, Value: 20}, Prerequisites: []string{"vigueur_1"}},
+		{ID: "precision_1", Cost: 1, Effect: SkillEffect{Type: EffectCritChance, Value: 0.02}},
+		{ID: "precision_2", Cost: 1, Effect: SkillEffect{Type: EffectCritChance, Value: 0.02}, Prerequisites: []string{"precision_1"}},
+		{ID: "maitrise", Cost: 3, Effect: SkillEffect{Type: EffectAttack, Value: 5}, Prerequisites: []string{"vigueur_2", "precision_2"}},
+	})
+	if err != nil {
+		t.Fatalf("NewSkillTree: %v", err)
+	}
+	return tree
+}
+
+func TestSkillTreePrerequisites(t *testing.T) {
+	tests := []struct {
+		name     string
+		unlocked []string // Débloqués avant le test
+		node     string
+		points   int
+		wantErr  error
+	}{
+		{"racine", nil, "vigueur_1", 1, nil},
+		{"prérequis manquant", nil, "vigueur_2", 5, ErrMissingPrerequisite},
+		{"prérequis débloqué", []string{"vigueur_1"}, "vigueur_2", 2, nil},
+		{"un prérequis sur deux", []string{"vigueur_1", "vigueur_2"}, "maitrise", 5, ErrMissingPrerequisite},
+		{"tous les prérequis", []string{"vigueur_1", "vigueur_2", "precision_1", "precision_2"}, "maitrise", 3, nil},
+		{"points insuffisants", []string{"vigueur_1"}, "vigueur_2", 1, ErrNotEnoughPoints},
+		{"déjà débloqué", []string{"vigueur_1"}, "vigueur_1", 5, ErrAlreadyUnlocked},
+		{"inconnu", nil, "vol", 5, ErrUnknownSkill},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := testTree(t)
+			for _, id := range tt.unlocked {
+				if err := tree.Unlock(id, math.MaxInt); err != nil {
+					t.Fatalf("Unlock(%s): %v", id, err)
+				}
+			}
+
+			err := tree.Unlock(tt.node, tt.points)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unlock(%s) = %v, attendu %v", tt.node, err, tt.wantErr)
+			}
+			if wantOpen := tt.wantErr == nil || errors.Is(tt.wantErr, ErrAlreadyUnlocked); tree.IsUnlocked(tt.node) != wantOpen {
+				t.Errorf("IsUnlocked(%s) = %t, attendu %t", tt.node, tree.IsUnlocked(tt.node), wantOpen)
+			}
+		})
+	}
+}
+
+func TestSkillTreeEffectsAccumulate(t *testing.T) {
+	tree := testTree(t)
+	player := components.NewPlayerComponent()
+	tree.SetPlayer(player)
+	baseCrit := player.CriticalChance
+
+	for _, id := range []string{"vigueur_1", "vigueur_2", "precision_1", "precision_2", "maitrise"} {
+		if err := tree.Unlock(id, math.MaxInt); err != nil {
+			t.Fatalf("Unlock(%s): %v", id, err)
+		}
+	}
+
+	if player.MaxHealth != 130 || player.Health != 130 {
+		t.Errorf("santé = %d/%d, attendu 130/130", player.Health, player.MaxHealth)
+	}
+	if math.Abs(player.CriticalChance-(baseCrit+0.04)) > 1e-9 {
+		t.Errorf("chance de critique = %.3f, attendu %.3f", player.CriticalChance, baseCrit+0.04)
+	}
+	if player.AttackPower != 15 {
+		t.Errorf("attaque = %d, attendu 15", player.AttackPower)
+	}
+
+	// Une erreur de déblocage n'applique aucun effet
+	tree.Unlock("vigueur_1", math.MaxInt)
+	if player.MaxHealth != 130 {
+		t.Errorf("santé maximale = %d après un déblocage refusé, attendu 130", player.MaxHealth)
+	}
+}
+
+func TestSkillTreeRestore(t *testing.T) {
+	tree := testTree(t)
+	player := components.NewPlayerComponent()
+	tree.SetPlayer(player)
+	tree.Unlock("vigueur_1", 1)
+	tree.Unlock("vigueur_2", 2)
+
+	// Sauvegarde : un nœud inconnu et un nœud sans son prérequis sont ignorés
+	tree.Restore([]string{"precision_1", "vol", "maitrise"})
+
+	if got := tree.Unlocked(); len(got) != 1 || got[0] != "precision_1" {
+		t.Errorf("débloqués = %v, attendu [precision_1]", got)
+	}
+	if player.MaxHealth != 100 {
+		t.Errorf("santé maximale = %d, attendu 100 (effets retirés)", player.MaxHealth)
+	}
+}
+
+func TestNewSkillTreeRejectsUnknownPrerequisite(t *testing.T) {
+	_, err := NewSkillTree([]*SkillNode{
+		{ID: "a", Effect: SkillEffect{Type: EffectAttack, Value: 1}, Prerequisites: []string{"fantôme"}},
+	})
+	if err == nil {
+		t.Error("un prérequis inconnu doit être refusé")
+	}
+}
+
+func TestLoadShippedSkillTree(t *testing.T) {
+	tree, err := LoadSkillTree(filepath.Join("..", "..", "assets", "data", DefaultSkillsFile))
+	if err != nil {
+		t.Fatalf("LoadSkillTree: %v", err)
+	}
+	if len(tree.GetNodes()) == 0 {
+		t.Error("aucune compétence chargée")
+	}
+}
@@ -51,7 +51,8 @@ type Button struct {
 	TextColor     Color
 
 	// État interne
-	wasPressed bool
+	wasPressed   bool
+	pressStarted bool // L'appui en cours a commencé sur ce bouton
 }
 
 // NewButton crée un nouveau bouton
@@ -91,18 +92,28 @@ func (b *Button) Contains(point Vector2) bool {
 func (b *Button) Update(mousePos Vector2, mousePressed bool) {
 	if !b.Visible || !b.Enabled {
 		b.State = ButtonDisabled
+		b.pressStarted = false
 		return
 	}
 
 	isHovering := b.Contains(mousePos)
 
+	// Début d'un appui : on retient s'il a commencé sur le bouton
+	if mousePressed && !b.wasPressed {
+		b.pressStarted = isHovering
+	}
+
+	// Relâchement : le clic n'est valide que si l'appui a commencé
+	// et se termine sur le même bouton
+	if !mousePressed && b.wasPressed {
+		if isHovering && b.pressStarted && b.OnClick != nil {
+			b.OnClick()
+		}
+		b.pressStarted = false
+	}
+
 	if isHovering {
-		if mousePressed && !b.wasPressed {
-			b.State = ButtonPressed
-			if b.OnClick != nil {
-				b.OnClick()
-			}
-		} else if mousePressed {
+		if mousePressed && b.pressStarted {
 			b.State = ButtonPressed
 		} else {
 			b.State = ButtonHover
@@ -3,6 +3,7 @@ package ui
 
 import (
 	"log"
+	"zelda-souls-game/internal/localization"
 )
 
 // MenuManager gère le menu principal
@@ -12,6 +13,9 @@ type MenuManager struct {
 	screenWidth  int
 	screenHeight int
 
+	// Textes de l'interface
+	localizer *localization.Localizer
+
 	// Callbacks
 	OnNewGame  func()
 	OnLoadGame func()
@@ -23,8 +27,10 @@ type MenuManager struct {
 
 // NewMenuManager crée un nouveau gestionnaire de menu
 func NewMenuManager(screenWidth, screenHeight int) *MenuManager {
+	localizer := localization.Default()
 	menu := &MenuManager{
-		title:        "ZELDA SOULS",
+		title:        localizer.Get("ui.title"),
+		localizer:    localizer,
 		screenWidth:  screenWidth,
 		screenHeight: screenHeight,
 		buttons:      make([]*Button, 0),
@@ -49,7 +55,7 @@ func (m *MenuManager) createButtons() {
 		startY-buttonSpacing,
 		buttonWidth,
 		buttonHeight,
-		"Nouvelle Partie",
+		m.localizer.Get("ui.menu.new_game"),
 		func() {
 			log.Println("Nouvelle Partie cliquée")
 			if m.OnNewGame != nil {
@@ -64,7 +70,7 @@ func (m *MenuManager) createButtons() {
 		startY,
 		buttonWidth,
 		buttonHeight,
-		"Charger Partie",
+		m.localizer.Get("ui.menu.load_game"),
 		func() {
 			log.Println("Charger Partie cliquée")
 			if m.OnLoadGame != nil {
@@ -79,7 +85,7 @@ func (m *MenuManager) createButtons() {
 		startY+buttonSpacing,
 		buttonWidth,
 		buttonHeight,
-		"Quitter",
+		m.localizer.Get("ui.menu.quit"),
 		func() {
 			log.Println("Quitter cliqué")
 			if m.OnQuitGame != nil {
@@ -123,7 +129,7 @@ func (m *MenuManager) Render(renderer Renderer) {
 	renderer.DrawText(m.title, Vector2{titleX, titleY}, Color{255, 255, 100, 255})
 
 	// Dessiner un sous-titre
-	subtitle := "Adventure Awaits"
+	subtitle := m.localizer.Get("ui.subtitle")
 	subtitleX := float64(m.screenWidth)/2 - float64(len(subtitle)*8)/2
 	subtitleY := 140.0
 	renderer.DrawText(subtitle, Vector2{subtitleX, subtitleY}, Color{200, 200, 200, 255})
@@ -135,7 +141,7 @@ func (m *MenuManager) Render(renderer Renderer) {
 
 	// Instructions en bas
 	instructionY := float64(m.screenHeight) - 50
-	instruction := "Utilisez la souris pour naviguer"
+	instruction := m.localizer.Get("ui.menu.hint")
 	instrX := float64(m.screenWidth)/2 - float64(len(instruction)*8)/2
 	renderer.DrawText(instruction, Vector2{instrX, instructionY}, Color{150, 150, 150, 255})
 }
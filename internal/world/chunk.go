@@ -0,0 +1,157 @@
+// internal/world/chunk.go - Découpage de la grille en chunks et sauvegarde différentielle
+package world
+
+import (
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// ChunkSize côté d'un chunk, en tuiles
+const ChunkSize = 16
+
+// Chunk bloc de ChunkSize x ChunkSize tuiles ; Dirty indique qu'une tuile a
+// été modifiée depuis le chargement du niveau (seuls ces chunks sont sauvegardés)
+type Chunk struct {
+	Coord core.ChunkCoord
+	Dirty bool
+}
+
+// ChunkSaveData tuiles d'un chunk modifié, ligne par ligne
+type ChunkSaveData struct {
+	X, Y      int
+	Solid     []bool
+	Materials []int
+}
+
+// WorldSaveData sauvegarde du monde : les chunks intacts sont relus dans le
+// fichier de niveau référencé, seuls les chunks modifiés sont stockés
+type WorldSaveData struct {
+	MapFile string
+	Chunks  []ChunkSaveData
+}
+
+// newChunks crée les chunks couvrant une grille de width x height tuiles
+func newChunks(width, height int) (chunks []Chunk, columns int) {
+	columns = (width + ChunkSize - 1) / ChunkSize
+	rows := (height + ChunkSize - 1) / ChunkSize
+	chunks = make([]Chunk, 0, columns*rows)
+	for y := 0; y < rows; y++ {
+		for x := 0; x < columns; x++ {
+			chunks = append(chunks, Chunk{Coord: core.NewChunkCoord(x, y)})
+		}
+	}
+	return chunks, columns
+}
+
+// chunkAt retourne le chunk contenant une tuile (nil hors de la grille)
+func (tm *TileMap) chunkAt(tx, ty int) *Chunk {
+	if !tm.InBounds(tx, ty) {
+		return nil
+	}
+	return &tm.chunks[(ty/ChunkSize)*tm.chunkColumns+tx/ChunkSize]
+}
+
+// markDirty marque le chunk d'une tuile comme modifié
+func (tm *TileMap) markDirty(tx, ty int) {
+	if chunk := tm.chunkAt(tx, ty); chunk != nil {
+		chunk.Dirty = true
+	}
+}
+
+// DirtyChunks retourne les chunks modifiés depuis le chargement du niveau
+func (tm *TileMap) DirtyChunks() []*Chunk {
+	dirty := make([]*Chunk, 0)
+	for i := range tm.chunks {
+		if tm.chunks[i].Dirty {
+			dirty = append(dirty, &tm.chunks[i])
+		}
+	}
+	return dirty
+}
+
+// ClearDirty considère l'état courant comme celui du niveau
+func (tm *TileMap) ClearDirty() {
+	for i := range tm.chunks {
+		tm.chunks[i].Dirty = false
+	}
+}
+
+// chunkTiles parcourt les tuiles d'un chunk dans la grille
+func (tm *TileMap) chunkTiles(coord core.ChunkCoord, fn func(index int)) {
+	for ty := coord.Y * ChunkSize; ty < (coord.Y+1)*ChunkSize && ty < tm.Height; ty++ {
+		for tx := coord.X * ChunkSize; tx < (coord.X+1)*ChunkSize && tx < tm.Width; tx++ {
+			fn(ty*tm.Width + tx)
+		}
+	}
+}
+
+// saveChunk copie les tuiles d'un chunk
+func (tm *TileMap) saveChunk(coord core.ChunkCoord) ChunkSaveData {
+	data := ChunkSaveData{X: coord.X, Y: coord.Y}
+	tm.chunkTiles(coord, func(index int) {
+		data.Solid = append(data.Solid, tm.solid[index])
+		data.Materials = append(data.Materials, int(tm.materials[index]))
+	})
+	return data
+}
+
+// loadChunk restaure les tuiles d'un chunk sauvegardé et le marque modifié ;
+// false si le chunk ne correspond pas à la grille
+func (tm *TileMap) loadChunk(data ChunkSaveData) bool {
+	coord := core.NewChunkCoord(data.X, data.Y)
+	chunk := tm.chunkAt(coord.X*ChunkSize, coord.Y*ChunkSize)
+	if chunk == nil {
+		return false
+	}
+
+	count := 0
+	tm.chunkTiles(coord, func(int) { count++ })
+	if len(data.Solid) != count || len(data.Materials) != count {
+		return false
+	}
+
+	i := 0
+	tm.chunkTiles(coord, func(index int) {
+		tm.solid[index] = data.Solid[i]
+		tm.materials[index] = components.TileMaterial(data.Materials[i])
+		if !tm.solid[index] {
+			delete(tm.destructibles, index) // Mur brisé dans la sauvegarde
+		}
+		i++
+	})
+	chunk.Dirty = true
+	return true
+}
+
+// tileSnapshot copie des tuiles telles que chargées depuis le fichier de niveau
+type tileSnapshot struct {
+	solid         []bool
+	materials     []components.TileMaterial
+	destructibles map[int]destructibleTile
+}
+
+// snapshot copie les tuiles de la grille
+func (tm *TileMap) snapshot() tileSnapshot {
+	destructibles := make(map[int]destructibleTile, len(tm.destructibles))
+	for index, wall := range tm.destructibles {
+		destructibles[index] = wall
+	}
+	return tileSnapshot{
+		solid:         append([]bool(nil), tm.solid...),
+		materials:     append([]components.TileMaterial(nil), tm.materials...),
+		destructibles: destructibles,
+	}
+}
+
+// restore remet la grille dans l'état d'une copie ; plus aucun chunk n'est modifié
+func (tm *TileMap) restore(snapshot tileSnapshot) {
+	if len(snapshot.solid) == len(tm.solid) && len(snapshot.materials) == len(tm.materials) {
+		copy(tm.solid, snapshot.solid)
+		copy(tm.materials, snapshot.materials)
+		tm.destructibles = make(map[int]destructibleTile, len(snapshot.destructibles))
+		for index, wall := range snapshot.destructibles {
+			tm.destructibles[index] = wall
+		}
+	}
+	tm.ClearDirty()
+}
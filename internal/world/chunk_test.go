@@ -0,0 +1,138 @@
+package world
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"zelda-souls-game/internal/ecs/components"
+	"zelda-souls-game/internal/save"
+)
+
+// screenConfig écran 1280x720 en tuiles de 32 px : 40x23 tuiles, soit 3x2 chunks
+type screenConfig struct{}
+
+func (screenConfig) WindowWidth() int  { return 1280 }
+func (screenConfig) WindowHeight() int { return 720 }
+func (screenConfig) TileSize() int     { return 32 }
+
+// loadStartWorld charge la carte de départ livrée avec le jeu
+func loadStartWorld(t *testing.T) *World {
+	t.Helper()
+	w, err := NewWorld(screenConfig{}, nil)
+	if err != nil {
+		t.Fatalf("NewWorld: %v", err)
+	}
+	if err := w.LoadMap(filepath.Join("..", "..", "assets", "data", "maps", "start.yaml")); err != nil {
+		t.Fatalf("LoadMap: %v", err)
+	}
+	return w
+}
+
+// toggleSolid inverse l'état solide d'une tuile
+func toggleSolid(tm *TileMap, tx, ty int) {
+	tm.SetSolid(tx, ty, !tm.IsSolid(tx, ty))
+}
+
+func TestFreshWorldHasNoDirtyChunks(t *testing.T) {
+	w := loadStartWorld(t)
+
+	if dirty := w.GetTileMap().DirtyChunks(); len(dirty) != 0 {
+		t.Errorf("%d chunks modifiés après le chargement, attendu 0", len(dirty))
+	}
+
+	data, err := w.CreateSaveData()
+	if err != nil {
+		t.Fatalf("CreateSaveData: %v", err)
+	}
+	if chunks := data.(*WorldSaveData).Chunks; len(chunks) != 0 {
+		t.Errorf("%d chunks sauvegardés, attendu 0", len(chunks))
+	}
+}
+
+func TestTileChangesMarkChunksDirty(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(tm *TileMap)
+		want   int
+	}{
+		{"une tuile solide", func(tm *TileMap) { toggleSolid(tm, 1, 1) }, 1},
+		{"un matériau", func(tm *TileMap) {
+			tm.SetMaterial(2, 2, components.MaterialMetal)
+			tm.SetMaterial(2, 2, components.MaterialWood)
+		}, 1},
+		{"deux tuiles du même chunk", func(tm *TileMap) {
+			toggleSolid(tm, 1, 1)
+			toggleSolid(tm, 15, 15)
+		}, 1},
+		{"deux chunks voisins", func(tm *TileMap) {
+			toggleSolid(tm, 15, 1)
+			toggleSolid(tm, 16, 1)
+		}, 2},
+		{"valeur inchangée", func(tm *TileMap) { tm.SetSolid(1, 1, tm.IsSolid(1, 1)) }, 0},
+		{"hors de la grille", func(tm *TileMap) { toggleSolid(tm, -1, 50) }, 0},
+		{"mur brisé", func(tm *TileMap) {
+			tm.SetDestructible(20, 20, 1, components.MaterialStone)
+			tm.ClearDirty()
+			tm.DamageTile(20, 20, 1)
+		}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := loadStartWorld(t)
+			tt.modify(w.GetTileMap())
+
+			if dirty := w.GetTileMap().DirtyChunks(); len(dirty) != tt.want {
+				t.Errorf("%d chunks modifiés, attendu %d", len(dirty), tt.want)
+			}
+		})
+	}
+}
+
+func TestWorldSaveRoundTrip(t *testing.T) {
+	w := loadStartWorld(t)
+	tm := w.GetTileMap()
+	saved := !tm.IsSolid(1, 1)
+	unsaved := tm.IsSolid(20, 1)
+
+	toggleSolid(tm, 1, 1)
+	data, err := w.CreateSaveData()
+	if err != nil {
+		t.Fatalf("CreateSaveData: %v", err)
+	}
+
+	// La sauvegarde repasse par le JSON du slot
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		t.Fatal(err)
+	}
+
+	// Modification ultérieure, absente de la sauvegarde
+	toggleSolid(tm, 20, 1)
+
+	if err := w.LoadFromSave(&save.SaveData{WorldData: generic}); err != nil {
+		t.Fatalf("LoadFromSave: %v", err)
+	}
+	if tm.IsSolid(1, 1) != saved {
+		t.Error("la tuile sauvegardée n'a pas été restaurée")
+	}
+	if tm.IsSolid(20, 1) != unsaved {
+		t.Error("un chunk non sauvegardé doit revenir à l'état du niveau")
+	}
+	if dirty := tm.DirtyChunks(); len(dirty) != 1 {
+		t.Errorf("%d chunks modifiés après le chargement, attendu 1", len(dirty))
+	}
+
+	// Sans données de monde, le niveau est remis à neuf
+	if err := w.LoadFromSave(nil); err != nil {
+		t.Fatalf("LoadFromSave(nil): %v", err)
+	}
+	if tm.IsSolid(1, 1) == saved || len(tm.DirtyChunks()) != 0 {
+		t.Error("LoadFromSave(nil) doit restaurer les tuiles du niveau")
+	}
+}
@@ -0,0 +1,91 @@
+// internal/world/destructible_tiles.go - Murs destructibles de la grille
+package world
+
+import (
+	"fmt"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// DefaultDestructibleHealth coups nécessaires pour briser un mur destructible
+const DefaultDestructibleHealth = 3
+
+// destructibleTile état d'un mur destructible encore debout
+type destructibleTile struct {
+	health    int
+	destroyed components.TileMaterial
+}
+
+// DestructibleWallConfig rectangle de murs destructibles d'une carte (en
+// tuiles) ; Destroyed est le matériau laissé au sol une fois le mur brisé
+type DestructibleWallConfig struct {
+	X         int    `yaml:"x"`
+	Y         int    `yaml:"y"`
+	Width     int    `yaml:"width"`
+	Height    int    `yaml:"height"`
+	Health    int    `yaml:"health"`    // DefaultDestructibleHealth si nul
+	Destroyed string `yaml:"destroyed"` // stone, grass, wood, metal (stone par défaut)
+}
+
+// SetDestructible fait d'une tuile un mur destructible : elle devient solide
+// et résiste à health coups avant de prendre le matériau destroyed
+func (tm *TileMap) SetDestructible(tx, ty, health int, destroyed components.TileMaterial) {
+	if !tm.InBounds(tx, ty) {
+		return
+	}
+	if health <= 0 {
+		health = DefaultDestructibleHealth
+	}
+	tm.destructibles[ty*tm.Width+tx] = destructibleTile{health: health, destroyed: destroyed}
+	tm.SetSolid(tx, ty, true)
+}
+
+// DamageTile retire damage points de vie à un mur destructible. hit indique
+// que la tuile est un mur destructible ; à zéro, le mur est brisé (destroyed) :
+// la tuile n'est plus solide, prend son matériau de gravats et son chunk est
+// marqué modifié.
+func (tm *TileMap) DamageTile(tx, ty, damage int) (hit, destroyed bool) {
+	if !tm.IsSolid(tx, ty) {
+		return false, false
+	}
+	index := ty*tm.Width + tx
+	wall, exists := tm.destructibles[index]
+	if !exists {
+		return false, false
+	}
+
+	wall.health -= damage
+	if wall.health > 0 {
+		tm.destructibles[index] = wall
+		return true, false
+	}
+
+	delete(tm.destructibles, index)
+	tm.ClearSlope(tx, ty)
+	tm.SetMaterial(tx, ty, wall.destroyed)
+	tm.SetSolid(tx, ty, false)
+	return true, true
+}
+
+// applyDestructibleWalls place les murs destructibles d'une carte ; les
+// rectangles invalides sont ignorés avec un avertissement
+func (tm *TileMap) applyDestructibleWalls(mapName string, walls []DestructibleWallConfig) {
+	for i, wall := range walls {
+		if wall.Width <= 0 || wall.Height <= 0 {
+			fmt.Printf("⚠ Carte %s, mur destructible %d ignoré: taille %dx%d\n", mapName, i, wall.Width, wall.Height)
+			continue
+		}
+		destroyed := components.MaterialStone
+		if wall.Destroyed != "" {
+			material, ok := components.ParseTileMaterial(wall.Destroyed)
+			if !ok {
+				fmt.Printf("⚠ Carte %s, mur destructible %d: matériau inconnu %q\n", mapName, i, wall.Destroyed)
+			}
+			destroyed = material
+		}
+		for ty := wall.Y; ty < wall.Y+wall.Height; ty++ {
+			for tx := wall.X; tx < wall.X+wall.Width; tx++ {
+				tm.SetDestructible(tx, ty, wall.Health, destroyed)
+			}
+		}
+	}
+}
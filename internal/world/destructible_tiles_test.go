@@ -0,0 +1,148 @@
+package world
+
+import (
+	"testing"
+
+	"zelda-souls-game/internal/audio"
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/ecs/components"
+	"zelda-souls-game/internal/ecs/systems"
+)
+
+// soundLog retient les effets sonores joués
+type soundLog []string
+
+func (s *soundLog) Play(sfxID string, pitch float64, source audio.SoundSource) bool {
+	*s = append(*s, sfxID)
+	return true
+}
+
+// greatswordHit zone de frappe d'un coup d'espadon centrée sur la tuile (tx, ty)
+func greatswordHit(tx, ty int) components.HitboxComponent {
+	return components.HitboxComponent{
+		Bounds:          components.Rectangle{X: float64(tx)*32 + 8, Y: float64(ty)*32 + 8, Width: 16, Height: 16},
+		Heavy:           true,
+		CanDestroyTiles: true,
+	}
+}
+
+func TestDestructibleTileBrokenByOneHit(t *testing.T) {
+	tileMap := NewTileMap(40, 30, 32)
+	tileMap.SetDestructible(20, 10, 1, components.MaterialWood)
+	tileMap.ClearDirty()
+
+	sounds := &soundLog{}
+	destructibles := systems.NewDestructibleTileSystem(nil)
+	destructibles.SetGrid(tileMap)
+	destructibles.SetSoundPlayer(sounds)
+	var events []systems.TileDestroyedEvent
+	destructibles.OnTileDestroyed = func(event systems.TileDestroyedEvent) {
+		events = append(events, event)
+	}
+
+	destructibles.BeginSwing()
+	if destroyed := destructibles.Strike(greatswordHit(20, 10)); destroyed != 1 {
+		t.Fatalf("murs brisés = %d, attendu 1", destroyed)
+	}
+
+	// Le mur laisse place à des gravats praticables
+	tile, _ := tileMap.GetTileAt(core.Vector2{X: 20*32 + 16, Y: 10*32 + 16})
+	if tile.Solid || tile.Destructible {
+		t.Errorf("tuile = %+v, attendu praticable", tile)
+	}
+	if tile.Material != components.MaterialWood {
+		t.Errorf("matériau = %v, attendu %v", tile.Material, components.MaterialWood)
+	}
+	if !tileMap.HasLineOfSight(core.Vector2{X: 19*32 + 16, Y: 10*32 + 16}, core.Vector2{X: 21*32 + 16, Y: 10*32 + 16}) {
+		t.Error("le mur brisé ne doit plus bloquer la vue")
+	}
+
+	dirty := tileMap.DirtyChunks()
+	if want := (core.ChunkCoord{X: 20 / ChunkSize, Y: 10 / ChunkSize}); len(dirty) != 1 || dirty[0].Coord != want {
+		t.Errorf("chunks modifiés = %v, attendu le seul chunk %+v", dirty, want)
+	}
+
+	if len(events) != 1 || events[0].TileX != 20 || events[0].TileY != 10 {
+		t.Errorf("événements = %+v, attendu un mur brisé en (20, 10)", events)
+	}
+	if len(*sounds) != 1 || (*sounds)[0] != systems.SFXTileBreak {
+		t.Errorf("sons = %v, attendu [%s]", *sounds, systems.SFXTileBreak)
+	}
+}
+
+func TestDestructibleTileStrikes(t *testing.T) {
+	tests := []struct {
+		name          string
+		health        int
+		swings        int
+		canDestroy    bool
+		wantDestroyed bool
+		wantHealth    int
+	}{
+		{"un coup suffit", 1, 1, true, true, 0},
+		{"mur résistant entamé", 3, 2, true, false, 1},
+		{"mur résistant brisé", 3, 3, true, true, 0},
+		{"arme ordinaire", 1, 3, false, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tileMap := NewTileMap(40, 30, 32)
+			tileMap.SetDestructible(5, 5, tt.health, components.MaterialStone)
+			destructibles := systems.NewDestructibleTileSystem(nil)
+			destructibles.SetGrid(tileMap)
+
+			hitbox := greatswordHit(5, 5)
+			hitbox.CanDestroyTiles = tt.canDestroy
+			for i := 0; i < tt.swings; i++ {
+				destructibles.BeginSwing()
+				destructibles.Strike(hitbox)
+			}
+
+			if destroyed := !tileMap.IsSolid(5, 5); destroyed != tt.wantDestroyed {
+				t.Errorf("brisé = %t, attendu %t", destroyed, tt.wantDestroyed)
+			}
+			if tile, _ := tileMap.GetTileAt(core.Vector2{X: 5*32 + 16, Y: 5*32 + 16}); !tt.wantDestroyed && tile.Health != tt.wantHealth {
+				t.Errorf("points de vie = %d, attendu %d", tile.Health, tt.wantHealth)
+			}
+		})
+	}
+}
+
+func TestDestructibleTileHitOncePerSwing(t *testing.T) {
+	tileMap := NewTileMap(40, 30, 32)
+	tileMap.SetDestructible(5, 5, 2, components.MaterialStone)
+	destructibles := systems.NewDestructibleTileSystem(nil)
+	destructibles.SetGrid(tileMap)
+
+	// La zone de frappe reste active plusieurs frames pendant un même coup
+	destructibles.BeginSwing()
+	for frame := 0; frame < 5; frame++ {
+		destructibles.Strike(greatswordHit(5, 5))
+	}
+	if !tileMap.IsSolid(5, 5) {
+		t.Error("un même coup ne doit toucher le mur qu'une fois")
+	}
+
+	destructibles.BeginSwing()
+	destructibles.Strike(greatswordHit(5, 5))
+	if tileMap.IsSolid(5, 5) {
+		t.Error("le second coup doit briser le mur")
+	}
+}
+
+func TestDestructibleTileIgnoresPlainWalls(t *testing.T) {
+	tileMap := NewTileMap(40, 30, 32)
+	tileMap.SetSolid(5, 5, true)
+	tileMap.ClearDirty()
+	destructibles := systems.NewDestructibleTileSystem(nil)
+	destructibles.SetGrid(tileMap)
+
+	destructibles.BeginSwing()
+	if destroyed := destructibles.Strike(greatswordHit(5, 5)); destroyed != 0 || !tileMap.IsSolid(5, 5) {
+		t.Error("un mur ordinaire ne doit pas céder")
+	}
+	if len(tileMap.DirtyChunks()) != 0 {
+		t.Error("aucun chunk ne doit être modifié")
+	}
+}
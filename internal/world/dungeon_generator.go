@@ -0,0 +1,254 @@
+// internal/world/dungeon_generator.go - Génération de donjons par partition binaire de l'espace (BSP)
+package world
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Réglages par défaut du générateur de donjons (en tuiles)
+const (
+	DefaultDungeonMinRoomSize   = 4
+	DefaultDungeonMaxRoomSize   = 10
+	DefaultDungeonCorridorWidth = 1
+
+	// Mur laissé entre une salle et le bord de sa cellule
+	dungeonRoomMargin = 1
+)
+
+// DungeonRoom salle générée, en tuiles
+type DungeonRoom struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Center retourne la tuile centrale de la salle
+func (r DungeonRoom) Center() (int, int) {
+	return r.X + r.Width/2, r.Y + r.Height/2
+}
+
+// Contains vérifie qu'une tuile est dans la salle
+func (r DungeonRoom) Contains(tx, ty int) bool {
+	return tx >= r.X && ty >= r.Y && tx < r.X+r.Width && ty < r.Y+r.Height
+}
+
+// dungeonNode cellule de la partition : une feuille porte une salle, un
+// nœud interne ses deux moitiés
+type dungeonNode struct {
+	X, Y          int
+	Width, Height int
+	Left, Right   *dungeonNode
+	Room          *DungeonRoom
+}
+
+// DungeonGenerator découpe récursivement la grille en deux jusqu'à la taille
+// minimale des cellules, place une salle de taille aléatoire dans chaque
+// feuille, puis relie les deux moitiés de chaque découpe par un couloir en L.
+// Le résultat ne dépend que de la graine.
+type DungeonGenerator struct {
+	Width         int // Largeur de la grille en tuiles
+	Height        int // Hauteur de la grille en tuiles
+	MinRoomSize   int
+	MaxRoomSize   int
+	CorridorWidth int
+	TileSize      float64 // Taille d'une tuile en pixels
+
+	rng    *rand.Rand
+	leaves []*dungeonNode
+	rooms  []DungeonRoom
+}
+
+// NewDungeonGenerator crée un générateur pour une grille de width x height tuiles
+func NewDungeonGenerator(width, height int) *DungeonGenerator {
+	return &DungeonGenerator{
+		Width:         width,
+		Height:        height,
+		MinRoomSize:   DefaultDungeonMinRoomSize,
+		MaxRoomSize:   DefaultDungeonMaxRoomSize,
+		CorridorWidth: DefaultDungeonCorridorWidth,
+		TileSize:      32,
+	}
+}
+
+// minCellSize taille minimale d'une cellule : la plus petite salle et ses murs
+func (g *DungeonGenerator) minCellSize() int {
+	return g.MinRoomSize + 2*dungeonRoomMargin
+}
+
+// validate vérifie que les paramètres permettent au moins une salle
+func (g *DungeonGenerator) validate() error {
+	if g.MinRoomSize < 1 {
+		return fmt.Errorf("taille minimale des salles invalide: %d", g.MinRoomSize)
+	}
+	if g.MaxRoomSize < g.MinRoomSize {
+		return fmt.Errorf("taille maximale des salles (%d) inférieure à la minimale (%d)", g.MaxRoomSize, g.MinRoomSize)
+	}
+	if g.CorridorWidth < 1 || g.CorridorWidth > g.MinRoomSize {
+		return fmt.Errorf("largeur des couloirs invalide: %d (1 à %d)", g.CorridorWidth, g.MinRoomSize)
+	}
+	if g.Width < g.minCellSize() || g.Height < g.minCellSize() {
+		return fmt.Errorf("grille %dx%d trop petite pour une salle de %d tuiles", g.Width, g.Height, g.MinRoomSize)
+	}
+	return nil
+}
+
+// Generate construit un donjon : tout est mur, sauf les salles et les couloirs
+func (g *DungeonGenerator) Generate(seed int64) (*TileMap, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+
+	g.rng = rand.New(rand.NewSource(seed))
+	g.leaves = g.leaves[:0]
+	g.rooms = g.rooms[:0]
+
+	tileMap := NewTileMap(g.Width, g.Height, g.TileSize)
+	for ty := 0; ty < g.Height; ty++ {
+		for tx := 0; tx < g.Width; tx++ {
+			tileMap.SetSolid(tx, ty, true)
+		}
+	}
+
+	root := &dungeonNode{Width: g.Width, Height: g.Height}
+	g.split(root)
+	for _, leaf := range g.leaves {
+		g.placeRoom(tileMap, leaf)
+	}
+	g.connect(tileMap, root)
+
+	// Le donjon généré est l'état de référence : rien à sauvegarder
+	tileMap.ClearDirty()
+	return tileMap, nil
+}
+
+// Rooms retourne les salles de la dernière génération
+func (g *DungeonGenerator) Rooms() []DungeonRoom {
+	return g.rooms
+}
+
+// split découpe une cellule tant que ses deux moitiés peuvent contenir une
+// salle ; une cellule qui tient déjà la plus grande salle peut rester entière
+func (g *DungeonGenerator) split(node *dungeonNode) {
+	minCell := g.minCellSize()
+	maxCell := g.MaxRoomSize + 2*dungeonRoomMargin
+	canSplitX := node.Width >= 2*minCell
+	canSplitY := node.Height >= 2*minCell
+
+	mustSplit := node.Width > maxCell || node.Height > maxCell
+	if (!canSplitX && !canSplitY) || (!mustSplit && g.rng.Intn(2) == 0) {
+		g.leaves = append(g.leaves, node)
+		return
+	}
+
+	// Découpe de préférence dans le sens le plus long
+	vertical := canSplitX
+	if canSplitX && canSplitY {
+		switch {
+		case node.Width > node.Height*5/4:
+			vertical = true
+		case node.Height > node.Width*5/4:
+			vertical = false
+		default:
+			vertical = g.rng.Intn(2) == 0
+		}
+	}
+
+	if vertical {
+		cut := minCell + g.rng.Intn(node.Width-2*minCell+1)
+		node.Left = &dungeonNode{X: node.X, Y: node.Y, Width: cut, Height: node.Height}
+		node.Right = &dungeonNode{X: node.X + cut, Y: node.Y, Width: node.Width - cut, Height: node.Height}
+	} else {
+		cut := minCell + g.rng.Intn(node.Height-2*minCell+1)
+		node.Left = &dungeonNode{X: node.X, Y: node.Y, Width: node.Width, Height: cut}
+		node.Right = &dungeonNode{X: node.X, Y: node.Y + cut, Width: node.Width, Height: node.Height - cut}
+	}
+	g.split(node.Left)
+	g.split(node.Right)
+}
+
+// placeRoom creuse une salle de taille aléatoire dans une feuille
+func (g *DungeonGenerator) placeRoom(tileMap *TileMap, leaf *dungeonNode) {
+	width := g.randomRoomSize(leaf.Width - 2*dungeonRoomMargin)
+	height := g.randomRoomSize(leaf.Height - 2*dungeonRoomMargin)
+	room := DungeonRoom{
+		X:      leaf.X + dungeonRoomMargin + g.rng.Intn(leaf.Width-2*dungeonRoomMargin-width+1),
+		Y:      leaf.Y + dungeonRoomMargin + g.rng.Intn(leaf.Height-2*dungeonRoomMargin-height+1),
+		Width:  width,
+		Height: height,
+	}
+	leaf.Room = &room
+	g.rooms = append(g.rooms, room)
+	g.carve(tileMap, room.X, room.Y, room.Width, room.Height)
+}
+
+// randomRoomSize tire une taille de salle entre MinRoomSize et MaxRoomSize,
+// sans dépasser la place disponible
+func (g *DungeonGenerator) randomRoomSize(available int) int {
+	maximum := g.MaxRoomSize
+	if available < maximum {
+		maximum = available
+	}
+	return g.MinRoomSize + g.rng.Intn(maximum-g.MinRoomSize+1)
+}
+
+// connect relie récursivement les deux moitiés de chaque découpe : une salle
+// de chaque côté, par un couloir en L. L'arbre entier est donc connexe.
+func (g *DungeonGenerator) connect(tileMap *TileMap, node *dungeonNode) {
+	if node.Left == nil || node.Right == nil {
+		return
+	}
+	g.connect(tileMap, node.Left)
+	g.connect(tileMap, node.Right)
+
+	from, to := g.pickRoom(node.Left), g.pickRoom(node.Right)
+	fromX, fromY := from.Center()
+	toX, toY := to.Center()
+
+	// Coude horizontal puis vertical, ou l'inverse
+	if g.rng.Intn(2) == 0 {
+		g.carveHorizontal(tileMap, fromX, toX, fromY)
+		g.carveVertical(tileMap, fromY, toY, toX)
+	} else {
+		g.carveVertical(tileMap, fromY, toY, fromX)
+		g.carveHorizontal(tileMap, fromX, toX, toY)
+	}
+}
+
+// pickRoom tire une salle parmi les feuilles d'un sous-arbre
+func (g *DungeonGenerator) pickRoom(node *dungeonNode) *DungeonRoom {
+	for node.Room == nil {
+		if g.rng.Intn(2) == 0 {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return node.Room
+}
+
+// carveHorizontal creuse un couloir horizontal de x1 à x2 sur la ligne y
+func (g *DungeonGenerator) carveHorizontal(tileMap *TileMap, x1, x2, y int) {
+	if x1 > x2 {
+		x1, x2 = x2, x1
+	}
+	half := g.CorridorWidth / 2
+	g.carve(tileMap, x1-half, y-half, x2-x1+g.CorridorWidth, g.CorridorWidth)
+}
+
+// carveVertical creuse un couloir vertical de y1 à y2 sur la colonne x
+func (g *DungeonGenerator) carveVertical(tileMap *TileMap, y1, y2, x int) {
+	if y1 > y2 {
+		y1, y2 = y2, y1
+	}
+	half := g.CorridorWidth / 2
+	g.carve(tileMap, x-half, y1-half, g.CorridorWidth, y2-y1+g.CorridorWidth)
+}
+
+// carve rend praticable un rectangle de tuiles (hors de la grille : ignoré)
+func (g *DungeonGenerator) carve(tileMap *TileMap, x, y, width, height int) {
+	for ty := y; ty < y+height; ty++ {
+		for tx := x; tx < x+width; tx++ {
+			tileMap.SetSolid(tx, ty, false)
+		}
+	}
+}
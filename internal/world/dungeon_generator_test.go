@@ -0,0 +1,169 @@
+package world
+
+import (
+	"reflect"
+	"testing"
+)
+
+// floodFloor parcourt en largeur les tuiles praticables depuis (tx, ty)
+func floodFloor(tileMap *TileMap, tx, ty int) map[[2]int]bool {
+	reached := map[[2]int]bool{{tx, ty}: true}
+	queue := [][2]int{{tx, ty}}
+	for len(queue) > 0 {
+		tile := queue[0]
+		queue = queue[1:]
+		for _, step := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			next := [2]int{tile[0] + step[0], tile[1] + step[1]}
+			if reached[next] || !tileMap.InBounds(next[0], next[1]) || tileMap.IsSolid(next[0], next[1]) {
+				continue
+			}
+			reached[next] = true
+			queue = append(queue, next)
+		}
+	}
+	return reached
+}
+
+// solidGrid relevé des tuiles solides d'une grille
+func solidGrid(tileMap *TileMap) []bool {
+	grid := make([]bool, 0, tileMap.Width*tileMap.Height)
+	for ty := 0; ty < tileMap.Height; ty++ {
+		for tx := 0; tx < tileMap.Width; tx++ {
+			grid = append(grid, tileMap.IsSolid(tx, ty))
+		}
+	}
+	return grid
+}
+
+// generateDungeon génère un donjon ou arrête le test
+func generateDungeon(t *testing.T, generator *DungeonGenerator, seed int64) *TileMap {
+	t.Helper()
+	tileMap, err := generator.Generate(seed)
+	if err != nil {
+		t.Fatalf("graine %d : %v", seed, err)
+	}
+	return tileMap
+}
+
+// dungeonConfigs générateurs testés : réglages par défaut, petites salles,
+// couloirs larges
+func dungeonConfigs() map[string]*DungeonGenerator {
+	small := NewDungeonGenerator(40, 30)
+	small.MinRoomSize, small.MaxRoomSize = 3, 5
+
+	wide := NewDungeonGenerator(80, 60)
+	wide.CorridorWidth = 3
+
+	return map[string]*DungeonGenerator{
+		"défaut":          NewDungeonGenerator(80, 60),
+		"petites salles":  small,
+		"couloirs larges": wide,
+	}
+}
+
+func TestDungeonEveryLeafHasRoom(t *testing.T) {
+	for name, generator := range dungeonConfigs() {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(1); seed <= 20; seed++ {
+				tileMap := generateDungeon(t, generator, seed)
+				if len(generator.leaves) < 2 || len(generator.Rooms()) != len(generator.leaves) {
+					t.Fatalf("graine %d : %d salles pour %d feuilles", seed, len(generator.Rooms()), len(generator.leaves))
+				}
+
+				for _, leaf := range generator.leaves {
+					room := leaf.Room
+					if room == nil {
+						t.Fatalf("graine %d : feuille %+v sans salle", seed, *leaf)
+					}
+					if room.X < leaf.X+dungeonRoomMargin || room.Y < leaf.Y+dungeonRoomMargin ||
+						room.X+room.Width > leaf.X+leaf.Width-dungeonRoomMargin ||
+						room.Y+room.Height > leaf.Y+leaf.Height-dungeonRoomMargin {
+						t.Errorf("graine %d : salle %+v hors de sa feuille", seed, *room)
+					}
+					if room.Width < generator.MinRoomSize || room.Width > generator.MaxRoomSize ||
+						room.Height < generator.MinRoomSize || room.Height > generator.MaxRoomSize {
+						t.Errorf("graine %d : salle %dx%d hors des tailles %d-%d", seed, room.Width, room.Height, generator.MinRoomSize, generator.MaxRoomSize)
+					}
+					if centerX, centerY := room.Center(); tileMap.IsSolid(centerX, centerY) {
+						t.Errorf("graine %d : salle %+v non creusée", seed, *room)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDungeonRoomsConnected(t *testing.T) {
+	for name, generator := range dungeonConfigs() {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(1); seed <= 20; seed++ {
+				tileMap := generateDungeon(t, generator, seed)
+
+				rooms := generator.Rooms()
+				startX, startY := rooms[0].Center()
+				reached := floodFloor(tileMap, startX, startY)
+				for _, room := range rooms {
+					if x, y := room.Center(); !reached[[2]int{x, y}] {
+						t.Errorf("graine %d : salle %+v inaccessible", seed, room)
+					}
+				}
+
+				// Aucune tuile praticable n'est isolée du reste du donjon
+				for ty := 0; ty < tileMap.Height; ty++ {
+					for tx := 0; tx < tileMap.Width; tx++ {
+						if !tileMap.IsSolid(tx, ty) && !reached[[2]int{tx, ty}] {
+							t.Fatalf("graine %d : tuile (%d, %d) isolée", seed, tx, ty)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDungeonDeterministic(t *testing.T) {
+	generator := NewDungeonGenerator(80, 60)
+
+	first := generateDungeon(t, generator, 1234)
+	firstRooms := append([]DungeonRoom(nil), generator.Rooms()...)
+
+	// Une autre génération entre les deux ne doit rien laisser derrière elle
+	other := generateDungeon(t, generator, 99)
+	second := generateDungeon(t, generator, 1234)
+
+	if !reflect.DeepEqual(solidGrid(first), solidGrid(second)) {
+		t.Error("même graine, grilles différentes")
+	}
+	if !reflect.DeepEqual(firstRooms, generator.Rooms()) {
+		t.Errorf("même graine, salles différentes : %v et %v", firstRooms, generator.Rooms())
+	}
+	if reflect.DeepEqual(solidGrid(first), solidGrid(other)) {
+		t.Error("deux graines différentes ont produit le même donjon")
+	}
+	if len(first.DirtyChunks()) != 0 {
+		t.Error("le donjon généré ne doit avoir aucun chunk modifié")
+	}
+}
+
+func TestDungeonInvalidParameters(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(g *DungeonGenerator)
+	}{
+		{"grille trop petite", func(g *DungeonGenerator) { g.Width = 5 }},
+		{"salle minimale nulle", func(g *DungeonGenerator) { g.MinRoomSize = 0 }},
+		{"maximale sous la minimale", func(g *DungeonGenerator) { g.MaxRoomSize = 3 }},
+		{"couloir nul", func(g *DungeonGenerator) { g.CorridorWidth = 0 }},
+		{"couloir plus large qu'une salle", func(g *DungeonGenerator) { g.CorridorWidth = 5 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator := NewDungeonGenerator(40, 30)
+			tt.configure(generator)
+			if _, err := generator.Generate(1); err == nil {
+				t.Error("Generate doit échouer")
+			}
+		})
+	}
+}
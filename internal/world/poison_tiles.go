@@ -0,0 +1,45 @@
+// internal/world/poison_tiles.go - Sols empoisonnés de la grille
+package world
+
+import (
+	"fmt"
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// PoisonFloorConfig rectangle de sol empoisonné d'une carte (en tuiles)
+type PoisonFloorConfig struct {
+	X      int `yaml:"x"`
+	Y      int `yaml:"y"`
+	Width  int `yaml:"width"`
+	Height int `yaml:"height"`
+}
+
+// applyPoisonFloors donne le matériau poison aux sols empoisonnés d'une carte
+// et retourne leurs rectangles en pixels ; les rectangles invalides ou hors
+// de la grille sont ignorés avec un avertissement
+func (tm *TileMap) applyPoisonFloors(mapName string, floors []PoisonFloorConfig) []core.Rectangle {
+	rects := make([]core.Rectangle, 0, len(floors))
+	for i, floor := range floors {
+		if floor.Width <= 0 || floor.Height <= 0 {
+			fmt.Printf("⚠ Carte %s, sol empoisonné %d ignoré: taille %dx%d\n", mapName, i, floor.Width, floor.Height)
+			continue
+		}
+		if !tm.InBounds(floor.X, floor.Y) || !tm.InBounds(floor.X+floor.Width-1, floor.Y+floor.Height-1) {
+			fmt.Printf("⚠ Carte %s, sol empoisonné %d hors de la grille en (%d, %d)\n", mapName, i, floor.X, floor.Y)
+			continue
+		}
+		for ty := floor.Y; ty < floor.Y+floor.Height; ty++ {
+			for tx := floor.X; tx < floor.X+floor.Width; tx++ {
+				tm.SetMaterial(tx, ty, components.MaterialPoison)
+			}
+		}
+		rects = append(rects, core.Rectangle{
+			X:      float64(floor.X) * tm.TileSize,
+			Y:      float64(floor.Y) * tm.TileSize,
+			Width:  float64(floor.Width) * tm.TileSize,
+			Height: float64(floor.Height) * tm.TileSize,
+		})
+	}
+	return rects
+}
@@ -0,0 +1,233 @@
+// internal/world/tilemap.go - Grille des tuiles solides et ligne de vue
+package world
+
+import (
+	"math"
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/ecs/components"
+)
+
+// TileMap grille de collision du monde. Les coordonnées monde sont en pixels,
+// la tuile (tx, ty) couvre [tx*TileSize, (tx+1)*TileSize[ sur chaque axe.
+// Hors de la grille, rien n'est solide.
+type TileMap struct {
+	Width    int     // Largeur en tuiles
+	Height   int     // Hauteur en tuiles
+	TileSize float64 // Taille d'une tuile en pixels
+
+	solid     []bool
+	materials []components.TileMaterial
+	density   []uint8 // Densité d'apparition (0 : aucune entité placée au hasard)
+	slopes    map[int]components.SlopedTile
+
+	// Murs destructibles : points de vie restants et matériau une fois brisés
+	destructibles map[int]destructibleTile
+
+	// Chunks et leur état modifié (tuiles solides ou matériaux changés)
+	chunks       []Chunk
+	chunkColumns int
+}
+
+// NewTileMap crée une grille vide de width x height tuiles
+func NewTileMap(width, height int, tileSize float64) *TileMap {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	if tileSize <= 0 {
+		tileSize = 32
+	}
+	chunks, chunkColumns := newChunks(width, height)
+	return &TileMap{
+		Width:         width,
+		Height:        height,
+		TileSize:      tileSize,
+		solid:         make([]bool, width*height),
+		materials:     make([]components.TileMaterial, width*height), // Pierre par défaut
+		density:       make([]uint8, width*height),
+		slopes:        make(map[int]components.SlopedTile),
+		destructibles: make(map[int]destructibleTile),
+		chunks:        chunks,
+		chunkColumns:  chunkColumns,
+	}
+}
+
+// NewTileMapForScreen crée une grille couvrant l'écran, à partir de la config
+func NewTileMapForScreen(config GameConfig) *TileMap {
+	tileSize := config.TileSize()
+	if tileSize <= 0 {
+		tileSize = 32
+	}
+	columns := (config.WindowWidth() + tileSize - 1) / tileSize
+	rows := (config.WindowHeight() + tileSize - 1) / tileSize
+	return NewTileMap(columns, rows, float64(tileSize))
+}
+
+// InBounds vérifie qu'une tuile est dans la grille
+func (tm *TileMap) InBounds(tx, ty int) bool {
+	return tx >= 0 && ty >= 0 && tx < tm.Width && ty < tm.Height
+}
+
+// GetTileSize retourne la taille d'une tuile en pixels
+func (tm *TileMap) GetTileSize() float64 {
+	return tm.TileSize
+}
+
+// SetSolid marque une tuile comme solide ou non (et son chunk modifié si elle change)
+func (tm *TileMap) SetSolid(tx, ty int, solid bool) {
+	if tm.InBounds(tx, ty) && tm.solid[ty*tm.Width+tx] != solid {
+		tm.solid[ty*tm.Width+tx] = solid
+		tm.markDirty(tx, ty)
+	}
+}
+
+// IsSolid retourne si une tuile bloque le passage et la vue
+func (tm *TileMap) IsSolid(tx, ty int) bool {
+	return tm.InBounds(tx, ty) && tm.solid[ty*tm.Width+tx]
+}
+
+// SetSlope fait d'une tuile une pente : elle devient solide, mais seule sa
+// partie sous la pente bloque le joueur
+func (tm *TileMap) SetSlope(tx, ty int, slope components.SlopedTile) {
+	if !tm.InBounds(tx, ty) {
+		return
+	}
+	tm.slopes[ty*tm.Width+tx] = slope
+	tm.SetSolid(tx, ty, true)
+}
+
+// ClearSlope rend à une tuile en pente sa forme carrée
+func (tm *TileMap) ClearSlope(tx, ty int) {
+	delete(tm.slopes, ty*tm.Width+tx)
+}
+
+// Slope retourne la pente d'une tuile ; false pour une tuile carrée
+func (tm *TileMap) Slope(tx, ty int) (components.SlopedTile, bool) {
+	if !tm.InBounds(tx, ty) {
+		return components.SlopedTile{}, false
+	}
+	slope, exists := tm.slopes[ty*tm.Width+tx]
+	return slope, exists
+}
+
+// GridSize retourne la taille de la grille en tuiles
+func (tm *TileMap) GridSize() (int, int) {
+	return tm.Width, tm.Height
+}
+
+// SetDensity définit la densité d'apparition d'une tuile (0-255)
+func (tm *TileMap) SetDensity(tx, ty int, density uint8) {
+	if tm.InBounds(tx, ty) {
+		tm.density[ty*tm.Width+tx] = density
+	}
+}
+
+// FillDensity applique une densité à un rectangle de tuiles
+func (tm *TileMap) FillDensity(tx, ty, width, height int, density uint8) {
+	for y := ty; y < ty+height; y++ {
+		for x := tx; x < tx+width; x++ {
+			tm.SetDensity(x, y, density)
+		}
+	}
+}
+
+// Density retourne la densité d'apparition d'une tuile (0 hors de la grille)
+func (tm *TileMap) Density(tx, ty int) uint8 {
+	if !tm.InBounds(tx, ty) {
+		return 0
+	}
+	return tm.density[ty*tm.Width+tx]
+}
+
+// SetMaterial définit le matériau de surface d'une tuile (et marque son chunk modifié s'il change)
+func (tm *TileMap) SetMaterial(tx, ty int, material components.TileMaterial) {
+	if tm.InBounds(tx, ty) && tm.materials[ty*tm.Width+tx] != material {
+		tm.materials[ty*tm.Width+tx] = material
+		tm.markDirty(tx, ty)
+	}
+}
+
+// GetTileAt retourne la tuile sous un point du monde ; false hors de la grille
+func (tm *TileMap) GetTileAt(position core.Vector2) (components.TileComponent, bool) {
+	tx, ty := tm.TileAt(position)
+	if !tm.InBounds(tx, ty) {
+		return components.TileComponent{}, false
+	}
+
+	return tm.tile(ty*tm.Width + tx), true
+}
+
+// tile retourne les propriétés d'une tuile de la grille
+func (tm *TileMap) tile(index int) components.TileComponent {
+	tile := components.TileComponent{Material: tm.materials[index], Solid: tm.solid[index]}
+	if wall, exists := tm.destructibles[index]; exists && tile.Solid {
+		tile.Destructible = true
+		tile.Health = wall.health
+		tile.DestroyedTileID = wall.destroyed
+	}
+	return tile
+}
+
+// TileAt retourne la tuile contenant un point du monde
+func (tm *TileMap) TileAt(position core.Vector2) (int, int) {
+	return int(math.Floor(position.X / tm.TileSize)), int(math.Floor(position.Y / tm.TileSize))
+}
+
+// HasLineOfSight parcourt les tuiles traversées par le segment [from, to]
+// (DDA d'Amanatides & Woo) et retourne false si l'une d'elles est solide.
+// Les tuiles de départ et d'arrivée sont testées aussi.
+func (tm *TileMap) HasLineOfSight(from, to core.Vector2) bool {
+	tx, ty := tm.TileAt(from)
+	endX, endY := tm.TileAt(to)
+
+	dx := to.X - from.X
+	dy := to.Y - from.Y
+
+	stepX, tMaxX, tDeltaX := tm.ddaAxis(from.X, dx, tx)
+	stepY, tMaxY, tDeltaY := tm.ddaAxis(from.Y, dy, ty)
+
+	// Nombre de tuiles traversées : borne la boucle même en cas d'imprécision
+	remaining := absInt(endX-tx) + absInt(endY-ty)
+	for {
+		if tm.IsSolid(tx, ty) {
+			return false
+		}
+		if remaining == 0 {
+			return true
+		}
+		remaining--
+
+		if tMaxX < tMaxY {
+			tMaxX += tDeltaX
+			tx += stepX
+		} else {
+			tMaxY += tDeltaY
+			ty += stepY
+		}
+	}
+}
+
+// ddaAxis prépare le parcours DDA sur un axe : sens du pas, paramètre du
+// prochain bord de tuile et paramètre à ajouter pour traverser une tuile
+func (tm *TileMap) ddaAxis(origin, delta float64, tile int) (step int, tMax, tDelta float64) {
+	switch {
+	case delta > 0:
+		boundary := float64(tile+1) * tm.TileSize
+		return 1, (boundary - origin) / delta, tm.TileSize / delta
+	case delta < 0:
+		boundary := float64(tile) * tm.TileSize
+		return -1, (boundary - origin) / delta, -tm.TileSize / delta
+	default:
+		return 0, math.Inf(1), math.Inf(1)
+	}
+}
+
+// absInt valeur absolue entière
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,141 @@
+// internal/world/treasure_room.go - Génération des salles au trésor
+package world
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+	"zelda-souls-game/internal/core"
+	"zelda-souls-game/internal/ecs/systems"
+)
+
+// treasureRoomTemplate plan fixe d'une salle au trésor, une rune par tuile :
+// # mur, D porte renforcée, P plaque de pression, C coffre,
+// b emplacement possible d'un rocher, . sol
+var treasureRoomTemplate = []string{
+	"#######D#######",
+	"#.............#",
+	"#.P..b...b..P.#",
+	"#...b.....b...#",
+	"#.b....C....b.#",
+	"#...b.....b...#",
+	"#.P..b...b..P.#",
+	"#.............#",
+	"###############",
+}
+
+// DefaultTreasureLootCount objets rares tirés pour le coffre
+const DefaultTreasureLootCount = 3
+
+// TreasureRoomConfig section treasure_room d'une carte
+type TreasureRoomConfig struct {
+	TileX     int                    `yaml:"tile_x"` // Coin haut-gauche de la salle, en tuiles
+	TileY     int                    `yaml:"tile_y"`
+	LootCount int                    `yaml:"loot_count"`
+	Loot      []core.SpawnTableEntry `yaml:"loot"` // Table pondérée des objets rares
+}
+
+// TreasureRoomGenerator construit une salle au trésor depuis le plan fixe :
+// les rochers sont répartis au hasard parmi les emplacements prévus, et le
+// butin du coffre est tiré dans la table de la carte
+type TreasureRoomGenerator struct {
+	rng *rand.Rand
+}
+
+// NewTreasureRoomGenerator crée un générateur (seed 0 : graine aléatoire)
+func NewTreasureRoomGenerator(seed int64) *TreasureRoomGenerator {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &TreasureRoomGenerator{rng: rand.New(rand.NewSource(seed))}
+}
+
+// Generate place la salle dans la grille (murs solides, densité d'apparition
+// nulle à l'intérieur) et retourne sa définition
+func (g *TreasureRoomGenerator) Generate(tileMap *TileMap, config TreasureRoomConfig) (core.TreasureRoomDef, error) {
+	height := len(treasureRoomTemplate)
+	width := len(treasureRoomTemplate[0])
+	if !tileMap.InBounds(config.TileX, config.TileY) || !tileMap.InBounds(config.TileX+width-1, config.TileY+height-1) {
+		return core.TreasureRoomDef{}, fmt.Errorf("salle au trésor hors de la grille en (%d, %d)", config.TileX, config.TileY)
+	}
+
+	tileSize := tileMap.GetTileSize()
+	room := core.TreasureRoomDef{
+		Bounds: core.Rectangle{
+			X:      float64(config.TileX) * tileSize,
+			Y:      float64(config.TileY) * tileSize,
+			Width:  float64(width) * tileSize,
+			Height: float64(height) * tileSize,
+		},
+	}
+
+	var boulderSlots []core.Vector2
+	for row, line := range treasureRoomTemplate {
+		for column, cell := range line {
+			tx, ty := config.TileX+column, config.TileY+row
+			tile := core.Rectangle{X: float64(tx) * tileSize, Y: float64(ty) * tileSize, Width: tileSize, Height: tileSize}
+			center := core.Vector2{X: tile.X + tileSize/2, Y: tile.Y + tileSize/2}
+
+			tileMap.SetDensity(tx, ty, 0)
+			switch cell {
+			case '#':
+				tileMap.SetSolid(tx, ty, true)
+				room.Walls = append(room.Walls, tile)
+			case 'D':
+				room.Door = tile
+			case 'P':
+				room.Plates = append(room.Plates, center)
+			case 'C':
+				room.Chest = center
+			case 'b':
+				boulderSlots = append(boulderSlots, center)
+			}
+		}
+	}
+
+	// Un rocher par plaque, sur des emplacements tirés au hasard
+	g.rng.Shuffle(len(boulderSlots), func(i, j int) {
+		boulderSlots[i], boulderSlots[j] = boulderSlots[j], boulderSlots[i]
+	})
+	if len(boulderSlots) < len(room.Plates) {
+		return core.TreasureRoomDef{}, fmt.Errorf("plan de salle au trésor invalide: %d rochers pour %d plaques", len(boulderSlots), len(room.Plates))
+	}
+	room.Boulders = boulderSlots[:len(room.Plates)]
+
+	count := config.LootCount
+	if count <= 0 {
+		count = DefaultTreasureLootCount
+	}
+	room.Loot = g.drawLoot(config.Loot, count)
+	return room, nil
+}
+
+// drawLoot tire count objets distincts dans la table (moins si elle est trop courte)
+func (g *TreasureRoomGenerator) drawLoot(entries []core.SpawnTableEntry, count int) []string {
+	table := make(systems.SpawnTable, 0, len(entries))
+	for _, entry := range entries {
+		table = append(table, systems.SpawnTableEntry{Kind: core.SpawnTypeItem, ID: entry.ID, Weight: entry.Weight})
+	}
+
+	loot := make([]string, 0, count)
+	for attempts := count * 10; attempts > 0 && len(loot) < count; attempts-- {
+		entry, ok := table.Pick(g.rng)
+		if !ok {
+			break
+		}
+		if !containsString(loot, entry.ID) {
+			loot = append(loot, entry.ID)
+		}
+	}
+	return loot
+}
+
+// containsString vérifie la présence d'une chaîne dans une liste
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package world
+
+import (
+	"testing"
+
+	"zelda-souls-game/internal/core"
+)
+
+func TestTreasureRoomGenerate(t *testing.T) {
+	tileMap := NewTileMap(30, 20, 32)
+	config := TreasureRoomConfig{
+		TileX:     2,
+		TileY:     3,
+		LootCount: 2,
+		Loot: []core.SpawnTableEntry{
+			{ID: "Anneau", Weight: 1},
+			{ID: "Lame", Weight: 1},
+			{ID: "Titanite", Weight: 1},
+		},
+	}
+
+	room, err := NewTreasureRoomGenerator(42).Generate(tileMap, config)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	if len(room.Plates) != 4 || len(room.Boulders) != 4 {
+		t.Errorf("%d plaques et %d rochers, attendu 4 et 4", len(room.Plates), len(room.Boulders))
+	}
+	if want := (core.Vector2{X: (2 + 7.5) * 32, Y: (3 + 4.5) * 32}); room.Chest != want {
+		t.Errorf("coffre en %+v, attendu %+v", room.Chest, want)
+	}
+	if !tileMap.IsSolid(2, 3) || tileMap.IsSolid(2+7, 3) {
+		t.Error("le pourtour doit être solide, sauf la porte")
+	}
+	if tileMap.Density(2+7, 3+4) != 0 {
+		t.Error("aucune apparition aléatoire ne doit avoir lieu dans la salle")
+	}
+
+	if len(room.Loot) != 2 || room.Loot[0] == room.Loot[1] {
+		t.Errorf("butin = %v, attendu 2 objets distincts", room.Loot)
+	}
+}
+
+func TestTreasureRoomOutOfBounds(t *testing.T) {
+	tileMap := NewTileMap(10, 10, 32)
+	if _, err := NewTreasureRoomGenerator(1).Generate(tileMap, TreasureRoomConfig{TileX: 0, TileY: 0}); err == nil {
+		t.Error("une salle plus grande que la grille doit être refusée")
+	}
+}
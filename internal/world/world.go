@@ -2,10 +2,20 @@
 package world
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
 	"zelda-souls-game/internal/assets"
+	"zelda-souls-game/internal/core"
 	"zelda-souls-game/internal/save"
 )
 
+// DefaultMapFile carte chargée au lancement, relative au dossier de données
+const DefaultMapFile = "maps/start.yaml"
+
 // GameConfig interface minimale pour éviter le cycle d'import
 type GameConfig interface {
 	WindowWidth() int
@@ -15,6 +25,51 @@ type GameConfig interface {
 
 type World struct {
 	entities []interface{}
+
+	// Grille des tuiles et entités placées par la carte chargée
+	tileMap *TileMap
+	mapName string
+	mapPath string
+	spawns  []core.SpawnDef
+
+	// Tuiles telles que chargées depuis le fichier de niveau : les chunks non
+	// modifiés d'une sauvegarde en sont relus
+	baseline tileSnapshot
+
+	// Apparitions aléatoires selon la couche de densité de la grille
+	spawnTable    []core.SpawnTableEntry
+	densitySpawns int
+
+	// Salle au trésor générée au chargement (nil si la carte n'en a pas)
+	treasureRoom *core.TreasureRoomDef
+
+	// Chaînes, lianes et ponts de corde
+	ropes []core.RopeDef
+
+	// PNJ et leurs bavardages
+	npcs []core.NPCDef
+
+	// Sols empoisonnés (en pixels)
+	poisonFloors []core.Rectangle
+}
+
+// mapFile structure du fichier YAML d'une carte
+type mapFile struct {
+	Name   string          `yaml:"name"`
+	Spawns []core.SpawnDef `yaml:"spawns"`
+
+	// Couche de densité (en tuiles) et table des entités placées au hasard
+	Density       []core.DensityRegion   `yaml:"density"`
+	SpawnTable    []core.SpawnTableEntry `yaml:"spawn_table"`
+	DensitySpawns int                    `yaml:"density_spawns"` // Entités tirées au lancement
+
+	TreasureRoom *TreasureRoomConfig `yaml:"treasure_room"`
+
+	DestructibleWalls []DestructibleWallConfig `yaml:"destructible_walls"`
+	PoisonFloors      []PoisonFloorConfig      `yaml:"poison_floors"`
+
+	Ropes []core.RopeDef `yaml:"ropes"`
+	NPCs  []core.NPCDef  `yaml:"npcs"`
 }
 
 type PlayerData struct {
@@ -24,12 +79,190 @@ type PlayerData struct {
 }
 
 func NewWorld(config GameConfig, assetManager *assets.AssetManager) (*World, error) {
-	return &World{entities: make([]interface{}, 0)}, nil
+	return &World{
+		entities: make([]interface{}, 0),
+		tileMap:  NewTileMapForScreen(config),
+		spawns:   make([]core.SpawnDef, 0),
+	}, nil
+}
+
+// LoadMap charge une carte ; les définitions invalides sont ignorées avec un avertissement
+func (w *World) LoadMap(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("impossible de lire la carte %s: %w", path, err)
+	}
+
+	var file mapFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("carte invalide %s: %w", path, err)
+	}
+
+	w.mapName = file.Name
+	w.spawns = w.spawns[:0]
+	for i, spawn := range file.Spawns {
+		if err := spawn.Validate(); err != nil {
+			fmt.Printf("⚠ Carte %s, entité %d ignorée: %v\n", file.Name, i, err)
+			continue
+		}
+		w.spawns = append(w.spawns, spawn)
+	}
+
+	for _, region := range file.Density {
+		density := region.Density
+		if density < 0 {
+			density = 0
+		} else if density > 255 {
+			density = 255
+		}
+		w.tileMap.FillDensity(region.X, region.Y, region.Width, region.Height, uint8(density))
+	}
+	w.spawnTable = file.SpawnTable
+	w.densitySpawns = file.DensitySpawns
+
+	w.ropes = w.ropes[:0]
+	for i, rope := range file.Ropes {
+		if err := rope.Validate(); err != nil {
+			fmt.Printf("⚠ Carte %s, corde %d ignorée: %v\n", file.Name, i, err)
+			continue
+		}
+		w.ropes = append(w.ropes, rope)
+	}
+
+	w.npcs = w.npcs[:0]
+	for i, npc := range file.NPCs {
+		if err := npc.Validate(); err != nil {
+			fmt.Printf("⚠ Carte %s, PNJ %d ignoré: %v\n", file.Name, i, err)
+			continue
+		}
+		w.npcs = append(w.npcs, npc)
+	}
+
+	w.tileMap.applyDestructibleWalls(file.Name, file.DestructibleWalls)
+	w.poisonFloors = w.tileMap.applyPoisonFloors(file.Name, file.PoisonFloors)
+
+	w.treasureRoom = nil
+	if file.TreasureRoom != nil {
+		room, err := NewTreasureRoomGenerator(0).Generate(w.tileMap, *file.TreasureRoom)
+		if err != nil {
+			fmt.Printf("⚠ Carte %s: %v\n", file.Name, err)
+		} else {
+			w.treasureRoom = &room
+			fmt.Printf("✓ Salle au trésor générée: %d plaque(s), butin %v\n", len(room.Plates), room.Loot)
+		}
+	}
+
+	// L'état chargé sert de référence : aucun chunk n'est encore modifié
+	w.mapPath = path
+	w.baseline = w.tileMap.snapshot()
+	w.tileMap.ClearDirty()
+
+	fmt.Printf("✓ Carte '%s' chargée: %d entité(s)\n", file.Name, len(w.spawns))
+	return nil
+}
+
+// GetSpawns retourne les entités à placer au lancement du niveau
+func (w *World) GetSpawns() []core.SpawnDef {
+	return w.spawns
+}
+
+// GetSpawnTable retourne la table des entités placées selon la densité
+func (w *World) GetSpawnTable() []core.SpawnTableEntry {
+	return w.spawnTable
+}
+
+// GetDensitySpawnCount retourne le nombre d'entités à placer selon la densité
+func (w *World) GetDensitySpawnCount() int {
+	return w.densitySpawns
+}
+
+// GetTreasureRoom retourne la salle au trésor générée (false si la carte n'en a pas)
+func (w *World) GetTreasureRoom() (core.TreasureRoomDef, bool) {
+	if w.treasureRoom == nil {
+		return core.TreasureRoomDef{}, false
+	}
+	return *w.treasureRoom, true
+}
+
+// GetRopes retourne les cordes et ponts de la carte
+func (w *World) GetRopes() []core.RopeDef {
+	return w.ropes
+}
+
+// GetPoisonFloors retourne les sols empoisonnés de la carte, en pixels
+func (w *World) GetPoisonFloors() []core.Rectangle {
+	return w.poisonFloors
+}
+
+// GetNPCs retourne les PNJ de la carte
+func (w *World) GetNPCs() []core.NPCDef {
+	return w.npcs
+}
+
+// GetTileMap retourne la grille des tuiles du niveau
+func (w *World) GetTileMap() *TileMap {
+	return w.tileMap
+}
+
+// GetMapName retourne le nom de la carte chargée
+func (w *World) GetMapName() string {
+	return w.mapName
 }
 
 func (w *World) GetEntities() []interface{}                     { return w.entities }
 func (w *World) Cleanup()                                       {}
 func (w *World) Reset()                                         {}
 func (w *World) InitializeNewGame(playerData *PlayerData) error { return nil }
-func (w *World) LoadFromSave(saveData *save.SaveData) error     { return nil }
-func (w *World) CreateSaveData() (interface{}, error)           { return nil, nil }
+
+// CreateSaveData retourne la sauvegarde du monde : la carte de référence et
+// les seuls chunks modifiés
+func (w *World) CreateSaveData() (interface{}, error) {
+	data := &WorldSaveData{MapFile: w.mapPath}
+	for _, chunk := range w.tileMap.DirtyChunks() {
+		data.Chunks = append(data.Chunks, w.tileMap.saveChunk(chunk.Coord))
+	}
+	return data, nil
+}
+
+// LoadFromSave remet les tuiles du niveau puis applique les chunks modifiés
+// de la sauvegarde
+func (w *World) LoadFromSave(saveData *save.SaveData) error {
+	w.tileMap.restore(w.baseline)
+	if saveData == nil || saveData.WorldData == nil {
+		return nil
+	}
+
+	data, err := decodeWorldSaveData(saveData.WorldData)
+	if err != nil {
+		return err
+	}
+	if data.MapFile != "" && data.MapFile != w.mapPath {
+		return fmt.Errorf("sauvegarde de la carte %s, carte chargée %s", data.MapFile, w.mapPath)
+	}
+
+	for _, chunk := range data.Chunks {
+		if !w.tileMap.loadChunk(chunk) {
+			fmt.Printf("⚠ Chunk (%d, %d) de la sauvegarde ignoré\n", chunk.X, chunk.Y)
+		}
+	}
+	fmt.Printf("✓ Monde restauré: %d chunk(s) modifié(s)\n", len(data.Chunks))
+	return nil
+}
+
+// decodeWorldSaveData relit les données du monde ; relues depuis le JSON du
+// slot, elles arrivent sous forme générique
+func decodeWorldSaveData(worldData interface{}) (*WorldSaveData, error) {
+	if data, ok := worldData.(*WorldSaveData); ok {
+		return data, nil
+	}
+
+	raw, err := json.Marshal(worldData)
+	if err != nil {
+		return nil, fmt.Errorf("données du monde invalides: %w", err)
+	}
+	var data WorldSaveData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("données du monde invalides: %w", err)
+	}
+	return &data, nil
+}